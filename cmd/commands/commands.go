@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"log"
+
+	carrierWebhookRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/carrier_webhook"
+	productBatchRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/product_batch"
+	shipmentRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/shipment"
+	stockMovementRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/scheduler"
+	carrierWebhookService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/carrier_webhook"
+	productBatchService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/product_batch"
+	stockMovementService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/stock_movement"
+)
+
+// Run executes the subcommand named by args[0] against the given database, writing its report to out.
+func Run(ctx context.Context, mysql *sql.DB, out io.Writer, args []string) error {
+	switch args[0] {
+	case "reconcile-stock":
+		return reconcileStock(ctx, mysql, out)
+	case "quarantine-expired":
+		return quarantineExpired(ctx, mysql, out)
+	case "rotate-webhook-secret":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: rotate-webhook-secret <carrier cid>")
+		}
+		return rotateWebhookSecret(ctx, mysql, out, args[1])
+	default:
+		return fmt.Errorf("unknown command %q, available commands: reconcile-stock, quarantine-expired, rotate-webhook-secret", args[0])
+	}
+}
+
+// reconcileStock compares every batch current_quantity with its stock ledger balance.
+// It fails when any batch has drifted so it can be used from cron or CI.
+func reconcileStock(ctx context.Context, mysql *sql.DB, out io.Writer) error {
+	svc := stockMovementService.NewStockMovementService(stockMovementRepository.NewStockMovementRepository(mysql))
+
+	drifts, err := svc.Reconcile(ctx)
+	if err != nil {
+		return err
+	}
+	if len(drifts) == 0 {
+		fmt.Fprintln(out, "stock ledger reconciled: no drift found")
+		return nil
+	}
+
+	for _, d := range drifts {
+		fmt.Fprintf(out, "batch %d (number %d): current_quantity=%d ledger_balance=%d drift=%d\n",
+			d.ProductBatchId, d.BatchNumber, d.CurrentQuantity, d.LedgerBalance, d.CurrentQuantity-d.LedgerBalance)
+	}
+	return fmt.Errorf("%d product batches drifted from the stock ledger", len(drifts))
+}
+
+// quarantineExpired runs the daily expiry job once, on demand.
+func quarantineExpired(ctx context.Context, mysql *sql.DB, out io.Writer) error {
+	svc := productBatchService.NewProductBatchesService(productBatchRepository.NewProductBatchesRepository(mysql))
+	job := scheduler.QuarantineExpiredBatchesJob(svc, log.New(out, "", log.LstdFlags))
+	return job.Run(ctx)
+}
+
+// rotateWebhookSecret mints a new webhook secret for the carrier and prints it once.
+// It is kept off the HTTP API so the secret is only handed out to operators.
+func rotateWebhookSecret(ctx context.Context, mysql *sql.DB, out io.Writer, cid string) error {
+	svc := carrierWebhookService.NewCarrierWebhookService(
+		carrierWebhookRepository.NewCarrierWebhookRepository(mysql),
+		shipmentRepository.NewShipmentRepository(mysql),
+	)
+
+	secret, err := svc.RotateSecret(ctx, cid)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "carrier %s webhook secret: %s\n", secret.Cid, secret.Secret)
+	return nil
+}
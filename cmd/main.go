@@ -1,10 +1,13 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"os"
 
 	"github.com/joho/godotenv"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/cmd/commands"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/cmd/server"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/database"
 )
@@ -22,6 +25,17 @@ func main() {
 	}
 	defer mysql.Close()
 
+	// cli
+	// - any argument runs a one-off command instead of the server
+	if len(os.Args) > 1 {
+		if err := commands.Run(context.Background(), mysql, os.Stdout, os.Args[1:]); err != nil {
+			fmt.Println(err)
+			mysql.Close()
+			os.Exit(1)
+		}
+		return
+	}
+
 	// app
 	// - config
 	cfg := &server.ConfigServerChi{
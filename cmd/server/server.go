@@ -1,8 +1,10 @@
 package server
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"log"
 	"net/http"
 
 	sectionHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/section"
@@ -32,6 +34,7 @@ import (
 	sellerRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/seller"
 	wRepo "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/warehouse"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/router"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/scheduler"
 
 	buyerHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/buyer"
 	purchaseOrderHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/purchase_order"
@@ -46,6 +49,90 @@ import (
 	sellerService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/seller"
 	wService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/warehouse"
 
+	allocationHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/allocation"
+	allocationRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/allocation"
+	allocationService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/allocation"
+
+	stockMovementHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/stock_movement"
+	stockMovementRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	stockMovementService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/stock_movement"
+
+	transferHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/transfer"
+	transferRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/transfer"
+	transferService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/transfer"
+
+	batchStatusHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/batch_status"
+	batchStatusRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/batch_status"
+	batchStatusService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/batch_status"
+
+	traceabilityHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/traceability"
+	traceabilityRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/traceability"
+	traceabilityService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/traceability"
+
+	recallHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/recall"
+	recallRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/recall"
+	recallService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/recall"
+
+	occupancyHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/occupancy"
+	occupancyRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/occupancy"
+	occupancyService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/occupancy"
+
+	inventoryHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/inventory"
+	inventoryRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/inventory"
+	inventoryService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/inventory"
+
+	cycleCountHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/cycle_count"
+	cycleCountRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/cycle_count"
+	cycleCountService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/cycle_count"
+
+	replenishmentHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/replenishment"
+	replenishmentRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/replenishment"
+	replenishmentService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/replenishment"
+
+	receivingHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/receiving"
+	receivingRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/receiving"
+	receivingService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/receiving"
+
+	warehouseExceptionHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/warehouse_exception"
+	warehouseExceptionRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/warehouse_exception"
+	warehouseExceptionService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/warehouse_exception"
+
+	asnHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/asn"
+	asnRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/asn"
+	asnService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/asn"
+
+	dockHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/dock"
+	dockRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/dock"
+	dockService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/dock"
+
+	pickingHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/picking"
+	pickingRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/picking"
+	pickingService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/picking"
+
+	shipmentHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/shipment"
+	shipmentRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/shipment"
+	shipmentService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/shipment"
+
+	carrierWebhookHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/carrier_webhook"
+	carrierWebhookRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/carrier_webhook"
+	carrierWebhookService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/carrier_webhook"
+
+	shippingHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/shipping"
+	shippingRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/shipping"
+	shippingService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/shipping"
+
+	routingHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/routing"
+	routingRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/routing"
+	routingService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/routing"
+
+	sourcingHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/sourcing"
+	sourcingRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/sourcing"
+	sourcingService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/sourcing"
+
+	buyerAddressHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/buyer_address"
+	buyerAddressRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/buyer_address"
+	buyerAddressService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/buyer_address"
+
 	geographyHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/geography"
 	geographyRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/geography"
 	geographyService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/geography"
@@ -92,6 +179,27 @@ func (s *ServerChi) Run(mysql *sql.DB) (err error) {
 	if err != nil {
 		return err
 	}
+	repoAllocation := allocationRepository.NewAllocationRepository(mysql)
+	repoStockMovement := stockMovementRepository.NewStockMovementRepository(mysql)
+	repoTransfer := transferRepository.NewTransferRepository(mysql)
+	repoBatchStatus := batchStatusRepository.NewBatchStatusRepository(mysql)
+	repoTraceability := traceabilityRepository.NewTraceabilityRepository(mysql)
+	repoRecall := recallRepository.NewRecallRepository(mysql)
+	repoOccupancy := occupancyRepository.NewOccupancyRepository(mysql)
+	repoInventory := inventoryRepository.NewInventoryRepository(mysql)
+	repoCycleCount := cycleCountRepository.NewCycleCountRepository(mysql)
+	repoReplenishment := replenishmentRepository.NewReplenishmentRepository(mysql)
+	repoReceiving := receivingRepository.NewReceivingRepository(mysql)
+	repoWarehouseException := warehouseExceptionRepository.NewWarehouseExceptionRepository(mysql)
+	repoAsn := asnRepository.NewAsnRepository(mysql)
+	repoDock := dockRepository.NewDockRepository(mysql)
+	repoPicking := pickingRepository.NewPickingRepository(mysql)
+	repoShipment := shipmentRepository.NewShipmentRepository(mysql)
+	repoCarrierWebhook := carrierWebhookRepository.NewCarrierWebhookRepository(mysql)
+	repoShipping := shippingRepository.NewShippingRepository(mysql)
+	repoRouting := routingRepository.NewRoutingRepository(mysql)
+	repoSourcing := sourcingRepository.NewSourcingRepository(mysql)
+	repoBuyerAddress := buyerAddressRepository.NewBuyerAddressRepository(mysql)
 
 	// - service
 	svcSeller := sellerService.NewSellerService(repoSeller, repoGeography)
@@ -103,9 +211,30 @@ func (s *ServerChi) Run(mysql *sql.DB) (err error) {
 	svcProductBatches := productBatchService.NewProductBatchesService(repoProductBatches)
 	svcCarry := carryService.NewCarryService(repoCarry, repoGeography)
 	svcGeography := geographyService.NewGeographyService(repoGeography)
-	svcInboundOrder := inbService.NewInboundOrderService(repoInboundOrder, repoEmployee, repoWarehouse)
-	svcPurchaseOrder := purchaseOrderService.NewPurchaseOrderService(repoPurchaseOrder)
+	svcInboundOrder := inbService.NewInboundOrderService(repoInboundOrder, repoEmployee, repoWarehouse, repoWarehouseException)
+	svcPurchaseOrder := purchaseOrderService.NewPurchaseOrderService(repoPurchaseOrder, repoBuyerAddress)
 	svcProductRecord := productRecordService.NewProductRecordService(repoProductRecord)
+	svcSourcing := sourcingService.NewSourcingService(repoSourcing, repoRouting)
+	svcAllocation := allocationService.NewAllocationService(repoAllocation, repoStockMovement, svcSourcing)
+	svcStockMovement := stockMovementService.NewStockMovementService(repoStockMovement)
+	svcTransfer := transferService.NewTransferService(repoTransfer, repoStockMovement)
+	svcBatchStatus := batchStatusService.NewBatchStatusService(repoBatchStatus, repoStockMovement)
+	svcTraceability := traceabilityService.NewTraceabilityService(repoTraceability)
+	svcRecall := recallService.NewRecallService(repoRecall, svcBatchStatus, svcTraceability)
+	svcOccupancy := occupancyService.NewOccupancyService(repoOccupancy)
+	svcInventory := inventoryService.NewInventoryService(repoInventory)
+	svcCycleCount := cycleCountService.NewCycleCountService(repoCycleCount, repoStockMovement)
+	svcReplenishment := replenishmentService.NewReplenishmentService(repoReplenishment)
+	svcReceiving := receivingService.NewReceivingService(repoReceiving, repoStockMovement, repoWarehouseException, repoAsn)
+	svcWarehouseException := warehouseExceptionService.NewWarehouseExceptionService(repoWarehouseException)
+	svcAsn := asnService.NewAsnService(repoAsn)
+	svcDock := dockService.NewDockService(repoDock)
+	svcPicking := pickingService.NewPickingService(repoPicking, repoStockMovement, repoWarehouseException)
+	svcShipment := shipmentService.NewShipmentService(repoShipment)
+	svcCarrierWebhook := carrierWebhookService.NewCarrierWebhookService(repoCarrierWebhook, repoShipment)
+	svcShipping := shippingService.NewShippingService(repoShipping)
+	svcRouting := routingService.NewRoutingService(repoRouting)
+	svcBuyerAddress := buyerAddressService.NewBuyerAddressService(repoBuyerAddress, repoGeography)
 
 	// - handler
 	hdBuyer := buyerHandler.NewBuyerHandler(svcBuyer)
@@ -120,12 +249,43 @@ func (s *ServerChi) Run(mysql *sql.DB) (err error) {
 	hdInboundOrder := inbHandler.NewInboundOrderHandler(svcInboundOrder)
 	hdPurchaseOrder := purchaseOrderHandler.NewPurchaseOrderHandler(svcPurchaseOrder)
 	hdProductRecord := productRecordHandler.NewProductRecordHandler(svcProductRecord)
+	hdAllocation := allocationHandler.NewAllocationHandler(svcAllocation)
+	hdStockMovement := stockMovementHandler.NewStockMovementHandler(svcStockMovement)
+	hdTransfer := transferHandler.NewTransferHandler(svcTransfer)
+	hdBatchStatus := batchStatusHandler.NewBatchStatusHandler(svcBatchStatus)
+	hdTraceability := traceabilityHandler.NewTraceabilityHandler(svcTraceability)
+	hdRecall := recallHandler.NewRecallHandler(svcRecall)
+	hdOccupancy := occupancyHandler.NewOccupancyHandler(svcOccupancy)
+	hdInventory := inventoryHandler.NewInventoryHandler(svcInventory)
+	hdCycleCount := cycleCountHandler.NewCycleCountHandler(svcCycleCount)
+	hdReplenishment := replenishmentHandler.NewReplenishmentHandler(svcReplenishment)
+	hdReceiving := receivingHandler.NewReceivingHandler(svcReceiving)
+	hdWarehouseException := warehouseExceptionHandler.NewWarehouseExceptionHandler(svcWarehouseException)
+	hdAsn := asnHandler.NewAsnHandler(svcAsn)
+	hdDock := dockHandler.NewDockHandler(svcDock)
+	hdPicking := pickingHandler.NewPickingHandler(svcPicking)
+	hdShipment := shipmentHandler.NewShipmentHandler(svcShipment)
+	hdCarrierWebhook := carrierWebhookHandler.NewCarrierWebhookHandler(svcCarrierWebhook)
+	hdShipping := shippingHandler.NewShippingHandler(svcShipping)
+	hdRouting := routingHandler.NewRoutingHandler(svcRouting)
+	hdSourcing := sourcingHandler.NewSourcingHandler(svcSourcing)
+	hdBuyerAddress := buyerAddressHandler.NewBuyerAddressHandler(svcBuyerAddress)
+
+	// - background jobs
+	jobs := scheduler.NewScheduler(log.Default())
+	jobs.Add(scheduler.QuarantineExpiredBatchesJob(svcProductBatches, log.Default()))
+	jobs.Add(scheduler.PurgeWebhookNoncesJob(svcCarrierWebhook, log.Default()))
+	jobs.Start(context.Background())
 
 	// router
 	rt := router.NewAPIRouter(
 		hdBuyer, hdSection, hdSeller, hdWarehouse, hdEmployee,
 		hdProduct, hdProductBatches, hdPurchaseOrder,
 		hdGeography, hdInboundOrder, hdCarry, hdProductRecord,
+		hdAllocation, hdStockMovement, hdTransfer, hdBatchStatus,
+		hdTraceability, hdRecall, hdOccupancy, hdInventory,
+		hdCycleCount, hdReplenishment, hdReceiving, hdWarehouseException, hdAsn, hdDock,
+		hdPicking, hdShipment, hdCarrierWebhook, hdShipping, hdRouting, hdSourcing, hdBuyerAddress,
 	)
 
 	fmt.Printf("Server running at http://localhost%s\n", s.serverAddress)
@@ -18,7 +18,9 @@ CREATE TABLE provinces (
 CREATE TABLE localities (
     id VARCHAR(255) UNIQUE PRIMARY KEY,
     name VARCHAR(255) NOT NULL,
-    province_id INT NOT NULL
+    province_id INT NOT NULL,
+    latitude DECIMAL(9,6) NULL,
+    longitude DECIMAL(9,6) NULL
 );
 -- Tabla: sellers
 CREATE TABLE sellers (
@@ -53,7 +55,9 @@ CREATE TABLE warehouse (
     warehouse_code VARCHAR(255) NOT NULL,
     minimum_capacity INT NOT NULL,
     minimum_temperature DECIMAL(19,2) NOT NULL,
-    locality_id VARCHAR(255) NOT NULL
+    locality_id VARCHAR(255) NOT NULL,
+    latitude DECIMAL(9,6) NULL,
+    longitude DECIMAL(9,6) NULL
 );
 -- Tabla: employees
 CREATE TABLE employees (
@@ -108,8 +112,12 @@ CREATE TABLE purchase_orders (
     order_date DATETIME(6) NOT NULL,
     tracking_code VARCHAR(255),
     buyer_id INT NOT NULL,
-    product_record_id INT NOT NULL
-
+    product_record_id INT NOT NULL,
+    order_status_id INT NOT NULL DEFAULT 1,
+    delivery_address_id INT NULL,
+    delivery_address VARCHAR(255) NULL,
+    delivery_locality_id VARCHAR(255) NULL,
+    INDEX idx_purchase_orders_buyer_date (buyer_id, order_date)
 );
 -- Tabla: product_batches
 CREATE TABLE product_batches (
@@ -123,7 +131,11 @@ CREATE TABLE product_batches (
     manufacturing_hour INT NOT NULL,
     minimum_temperature DECIMAL(19,2) NOT NULL,
     product_id INT NOT NULL,
-    section_id INT NOT NULL
+    section_id INT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'available',
+    status_reason VARCHAR(50),
+    status_set_by VARCHAR(255),
+    status_updated_at DATETIME(6)
 );
 -- Tabla: inbound_orders
 CREATE TABLE inbound_orders (
@@ -132,7 +144,8 @@ CREATE TABLE inbound_orders (
     order_number VARCHAR(255) NOT NULL,
     employee_id INT NOT NULL,
     product_batch_id INT NOT NULL,
-    warehouse_id INT NOT NULL
+    warehouse_id INT NOT NULL,
+    asn_id INT NULL
 );
 -- Tabla: product_records
 CREATE TABLE product_records (
@@ -140,7 +153,8 @@ CREATE TABLE product_records (
     last_update_date DATETIME(6),
     purchase_price DECIMAL(19,2),
     sale_price DECIMAL(19,2),
-    product_id INT NOT NULL
+    product_id INT NOT NULL,
+    INDEX idx_product_records_product_date (product_id, last_update_date)
 );
 -- Tabla: order_details
 CREATE TABLE order_details (
@@ -151,6 +165,274 @@ CREATE TABLE order_details (
     product_record_id INT NOT NULL,
     purchase_order_id INT NOT NULL
 );
+-- Tabla: stock_allocations
+CREATE TABLE stock_allocations (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    purchase_order_id INT NOT NULL,
+    order_detail_id INT NOT NULL,
+    product_batch_id INT NOT NULL,
+    quantity INT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'reserved',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    released_at DATETIME NULL
+);
+-- Tabla: stock_movements
+CREATE TABLE stock_movements (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    product_batch_id INT NOT NULL,
+    movement_type VARCHAR(20) NOT NULL,
+    quantity INT NOT NULL,
+    reason VARCHAR(255) NOT NULL,
+    actor VARCHAR(255) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    INDEX idx_stock_movements_batch (product_batch_id, created_at)
+);
+-- Tabla: batch_transfers
+CREATE TABLE batch_transfers (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    source_batch_id INT NOT NULL,
+    destination_batch_id INT NOT NULL,
+    from_section_id INT NOT NULL,
+    to_section_id INT NOT NULL,
+    from_warehouse_id INT NOT NULL,
+    to_warehouse_id INT NOT NULL,
+    quantity INT NOT NULL,
+    reason VARCHAR(255) NOT NULL,
+    actor VARCHAR(255) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+);
+-- Tabla: batch_status_history
+CREATE TABLE batch_status_history (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    product_batch_id INT NOT NULL,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL,
+    reason_code VARCHAR(50) NOT NULL,
+    note VARCHAR(255) NOT NULL DEFAULT '',
+    actor VARCHAR(255) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    INDEX idx_batch_status_history_batch (product_batch_id, created_at)
+);
+-- Tabla: recalls
+CREATE TABLE recalls (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    note VARCHAR(255) NOT NULL DEFAULT '',
+    actor VARCHAR(255) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+);
+-- Tabla: recall_batches
+CREATE TABLE recall_batches (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    recall_id INT NOT NULL,
+    product_batch_id INT NOT NULL,
+    previous_status VARCHAR(20) NOT NULL,
+    held BOOLEAN NOT NULL,
+    UNIQUE KEY uq_recall_batches (recall_id, product_batch_id)
+);
+-- Tabla: cycle_counts
+CREATE TABLE cycle_counts (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    section_id INT NOT NULL,
+    status VARCHAR(30) NOT NULL DEFAULT 'open',
+    note VARCHAR(255) NOT NULL DEFAULT '',
+    created_by VARCHAR(255) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    approved_by VARCHAR(255) NULL,
+    second_approved_by VARCHAR(255) NULL,
+    approved_at DATETIME(6) NULL,
+    INDEX idx_cycle_counts_section (section_id, status)
+);
+-- Tabla: cycle_count_lines
+CREATE TABLE cycle_count_lines (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    cycle_count_id INT NOT NULL,
+    product_batch_id INT NOT NULL,
+    system_quantity INT NULL,
+    counted_quantity INT NULL,
+    variance INT NULL,
+    reason_code VARCHAR(50) NULL,
+    counted_by VARCHAR(255) NULL,
+    counted_at DATETIME(6) NULL,
+    UNIQUE KEY uq_cycle_count_lines (cycle_count_id, product_batch_id)
+);
+-- Tabla: cycle_count_history
+CREATE TABLE cycle_count_history (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    cycle_count_id INT NOT NULL,
+    from_status VARCHAR(30) NULL,
+    to_status VARCHAR(30) NOT NULL,
+    note VARCHAR(255) NOT NULL DEFAULT '',
+    actor VARCHAR(255) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    INDEX idx_cycle_count_history_count (cycle_count_id, created_at)
+);
+-- Tabla: reorder_points
+CREATE TABLE reorder_points (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    product_id INT NOT NULL,
+    warehouse_id INT NOT NULL,
+    reorder_point INT NOT NULL,
+    target_quantity INT NOT NULL,
+    UNIQUE KEY uq_reorder_points (product_id, warehouse_id)
+);
+-- Tabla: employee_warehouse_exceptions
+CREATE TABLE employee_warehouse_exceptions (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    employee_id INT NOT NULL,
+    warehouse_id INT NOT NULL,
+    reason VARCHAR(255) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    UNIQUE KEY uq_employee_warehouse_exceptions (employee_id, warehouse_id)
+);
+-- Tabla: asns
+CREATE TABLE asns (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    asn_number VARCHAR(255) NOT NULL UNIQUE,
+    seller_id INT NOT NULL,
+    warehouse_id INT NOT NULL,
+    status VARCHAR(30) NOT NULL DEFAULT 'scheduled',
+    expected_at DATETIME(6) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    arrived_at DATETIME(6) NULL,
+    received_at DATETIME(6) NULL,
+    closed_at DATETIME(6) NULL,
+    INDEX idx_asns_seller (seller_id, status)
+);
+-- Tabla: asn_lines
+CREATE TABLE asn_lines (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    asn_id INT NOT NULL,
+    product_id INT NOT NULL,
+    expected_quantity INT NOT NULL,
+    received_quantity INT NOT NULL DEFAULT 0,
+    UNIQUE KEY uq_asn_lines (asn_id, product_id)
+);
+-- Tabla: docks
+CREATE TABLE docks (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    warehouse_id INT NOT NULL,
+    code VARCHAR(50) NOT NULL,
+    opens_at TIME NOT NULL,
+    closes_at TIME NOT NULL,
+    UNIQUE KEY uq_docks_code (warehouse_id, code)
+);
+-- Tabla: dock_appointments
+CREATE TABLE dock_appointments (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    dock_id INT NOT NULL,
+    seller_id INT NULL,
+    carrier_id INT NULL,
+    asn_id INT NULL,
+    starts_at DATETIME(6) NOT NULL,
+    ends_at DATETIME(6) NOT NULL,
+    status VARCHAR(30) NOT NULL DEFAULT 'booked',
+    inbound_order_id INT NULL UNIQUE,
+    arrived_at DATETIME(6) NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    INDEX idx_dock_appointments_slot (dock_id, starts_at)
+);
+-- Tabla: pick_waves
+CREATE TABLE pick_waves (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    warehouse_id INT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    completed_at DATETIME(6) NULL
+);
+-- Tabla: pick_lists
+CREATE TABLE pick_lists (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    pick_wave_id INT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    employee_id INT NULL,
+    confirmed_at DATETIME(6) NULL
+);
+-- Tabla: pick_list_lines
+CREATE TABLE pick_list_lines (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    pick_list_id INT NOT NULL,
+    stock_allocation_id INT NOT NULL UNIQUE,
+    sequence INT NOT NULL,
+    quantity INT NOT NULL,
+    picked_quantity INT NULL
+);
+-- Tabla: shipments
+CREATE TABLE shipments (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    tracking_code VARCHAR(32) NOT NULL UNIQUE,
+    carrier_id INT NOT NULL,
+    warehouse_id INT NOT NULL,
+    destination_locality_id VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'created',
+    promised_at DATETIME NULL,
+    max_temperature DECIMAL(19,2) NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- Tabla: shipment_orders
+CREATE TABLE shipment_orders (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    shipment_id INT NOT NULL,
+    purchase_order_id INT NOT NULL UNIQUE
+);
+-- Tabla: shipment_events
+CREATE TABLE shipment_events (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    shipment_id INT NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    location VARCHAR(255) NOT NULL DEFAULT '',
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    occurred_at DATETIME NOT NULL,
+    temperature DECIMAL(19,2) NULL,
+    external_event_id VARCHAR(255) NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_shipment_events_external (shipment_id, external_event_id)
+);
+-- Tabla: carrier_webhooks
+CREATE TABLE carrier_webhooks (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    carrier_id INT NOT NULL UNIQUE,
+    secret VARCHAR(128) NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- Tabla: carrier_webhook_nonces
+CREATE TABLE carrier_webhook_nonces (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    carrier_id INT NOT NULL,
+    nonce VARCHAR(128) NOT NULL,
+    received_at DATETIME NOT NULL,
+    UNIQUE KEY uq_carrier_webhook_nonces (carrier_id, nonce)
+);
+-- Tabla: carrier_coverage_areas
+CREATE TABLE carrier_coverage_areas (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    carrier_id INT NOT NULL,
+    locality_id VARCHAR(255) NULL,
+    province_id INT NULL,
+    country_id INT NULL,
+    transit_days INT NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- Tabla: carrier_rate_bands
+CREATE TABLE carrier_rate_bands (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    coverage_area_id INT NOT NULL,
+    max_weight DECIMAL(19,2) NOT NULL,
+    max_volume DECIMAL(19,2) NOT NULL,
+    price DECIMAL(19,2) NOT NULL,
+    UNIQUE KEY uq_carrier_rate_bands (coverage_area_id, max_weight, max_volume)
+);
+-- Tabla: buyer_addresses
+CREATE TABLE buyer_addresses (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    buyer_id INT NOT NULL,
+    label VARCHAR(255) NOT NULL DEFAULT '',
+    address VARCHAR(255) NOT NULL,
+    locality_id VARCHAR(255) NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_buyer_addresses_buyer (buyer_id)
+);
 
 -- Índices y Claves Foráneas
 -- Provincias -> countries
@@ -206,6 +488,10 @@ FOREIGN KEY(buyer_id) REFERENCES buyers(id);
 ALTER TABLE purchase_orders
 ADD CONSTRAINT fk_purchase_orders_product_record
 FOREIGN KEY(product_record_id) REFERENCES product_records(id);
+-- Purchase_orders -> order_status
+ALTER TABLE purchase_orders
+ADD CONSTRAINT fk_purchase_orders_order_status
+FOREIGN KEY(order_status_id) REFERENCES order_status(id);
 
 
 -- Product_batches -> products
@@ -240,6 +526,185 @@ FOREIGN KEY(product_record_id) REFERENCES product_records(id);
 ALTER TABLE order_details
 ADD CONSTRAINT fk_order_details_purchase_order
 FOREIGN KEY(purchase_order_id) REFERENCES purchase_orders(id);
+-- Stock_allocations -> purchase_orders
+ALTER TABLE stock_allocations
+ADD CONSTRAINT fk_stock_allocations_purchase_order
+FOREIGN KEY(purchase_order_id) REFERENCES purchase_orders(id);
+-- Stock_allocations -> order_details
+ALTER TABLE stock_allocations
+ADD CONSTRAINT fk_stock_allocations_order_detail
+FOREIGN KEY(order_detail_id) REFERENCES order_details(id);
+-- Stock_allocations -> product_batches
+ALTER TABLE stock_allocations
+ADD CONSTRAINT fk_stock_allocations_batch
+FOREIGN KEY(product_batch_id) REFERENCES product_batches(id);
+-- Stock_movements -> product_batches
+ALTER TABLE stock_movements
+ADD CONSTRAINT fk_stock_movements_batch
+FOREIGN KEY(product_batch_id) REFERENCES product_batches(id);
+-- Batch_transfers -> product_batches
+ALTER TABLE batch_transfers
+ADD CONSTRAINT fk_batch_transfers_source_batch
+FOREIGN KEY(source_batch_id) REFERENCES product_batches(id);
+ALTER TABLE batch_transfers
+ADD CONSTRAINT fk_batch_transfers_destination_batch
+FOREIGN KEY(destination_batch_id) REFERENCES product_batches(id);
+-- Batch_transfers -> sections
+ALTER TABLE batch_transfers
+ADD CONSTRAINT fk_batch_transfers_from_section
+FOREIGN KEY(from_section_id) REFERENCES sections(id);
+ALTER TABLE batch_transfers
+ADD CONSTRAINT fk_batch_transfers_to_section
+FOREIGN KEY(to_section_id) REFERENCES sections(id);
+-- Batch_status_history -> product_batches
+ALTER TABLE batch_status_history
+ADD CONSTRAINT fk_batch_status_history_batch
+FOREIGN KEY(product_batch_id) REFERENCES product_batches(id);
+-- Recall_batches -> recalls, product_batches
+ALTER TABLE recall_batches
+ADD CONSTRAINT fk_recall_batches_recall
+FOREIGN KEY(recall_id) REFERENCES recalls(id);
+ALTER TABLE recall_batches
+ADD CONSTRAINT fk_recall_batches_batch
+FOREIGN KEY(product_batch_id) REFERENCES product_batches(id);
+-- Cycle_counts -> sections
+ALTER TABLE cycle_counts
+ADD CONSTRAINT fk_cycle_counts_section
+FOREIGN KEY(section_id) REFERENCES sections(id);
+-- Cycle_count_lines -> cycle_counts, product_batches
+ALTER TABLE cycle_count_lines
+ADD CONSTRAINT fk_cycle_count_lines_count
+FOREIGN KEY(cycle_count_id) REFERENCES cycle_counts(id);
+ALTER TABLE cycle_count_lines
+ADD CONSTRAINT fk_cycle_count_lines_batch
+FOREIGN KEY(product_batch_id) REFERENCES product_batches(id);
+-- Cycle_count_history -> cycle_counts
+ALTER TABLE cycle_count_history
+ADD CONSTRAINT fk_cycle_count_history_count
+FOREIGN KEY(cycle_count_id) REFERENCES cycle_counts(id);
+-- Reorder_points -> products, warehouse
+ALTER TABLE reorder_points
+ADD CONSTRAINT fk_reorder_points_product
+FOREIGN KEY(product_id) REFERENCES products(id);
+ALTER TABLE reorder_points
+ADD CONSTRAINT fk_reorder_points_warehouse
+FOREIGN KEY(warehouse_id) REFERENCES warehouse(id);
+-- Employee_warehouse_exceptions -> employees, warehouse
+ALTER TABLE employee_warehouse_exceptions
+ADD CONSTRAINT fk_employee_warehouse_exceptions_employee
+FOREIGN KEY(employee_id) REFERENCES employees(id);
+ALTER TABLE employee_warehouse_exceptions
+ADD CONSTRAINT fk_employee_warehouse_exceptions_warehouse
+FOREIGN KEY(warehouse_id) REFERENCES warehouse(id);
+-- Asns -> sellers, warehouse
+ALTER TABLE asns
+ADD CONSTRAINT fk_asns_seller
+FOREIGN KEY(seller_id) REFERENCES sellers(id);
+ALTER TABLE asns
+ADD CONSTRAINT fk_asns_warehouse
+FOREIGN KEY(warehouse_id) REFERENCES warehouse(id);
+-- Asn_lines -> asns, products
+ALTER TABLE asn_lines
+ADD CONSTRAINT fk_asn_lines_asn
+FOREIGN KEY(asn_id) REFERENCES asns(id);
+ALTER TABLE asn_lines
+ADD CONSTRAINT fk_asn_lines_product
+FOREIGN KEY(product_id) REFERENCES products(id);
+-- Inbound_orders -> asns
+ALTER TABLE inbound_orders
+ADD CONSTRAINT fk_inbound_orders_asn
+FOREIGN KEY(asn_id) REFERENCES asns(id);
+-- Docks -> warehouse
+ALTER TABLE docks
+ADD CONSTRAINT fk_docks_warehouse
+FOREIGN KEY(warehouse_id) REFERENCES warehouse(id);
+-- Dock_appointments -> docks, sellers, carriers, asns, inbound_orders
+ALTER TABLE dock_appointments
+ADD CONSTRAINT fk_dock_appointments_dock
+FOREIGN KEY(dock_id) REFERENCES docks(id);
+ALTER TABLE dock_appointments
+ADD CONSTRAINT fk_dock_appointments_seller
+FOREIGN KEY(seller_id) REFERENCES sellers(id);
+ALTER TABLE dock_appointments
+ADD CONSTRAINT fk_dock_appointments_carrier
+FOREIGN KEY(carrier_id) REFERENCES carriers(id);
+ALTER TABLE dock_appointments
+ADD CONSTRAINT fk_dock_appointments_asn
+FOREIGN KEY(asn_id) REFERENCES asns(id);
+ALTER TABLE dock_appointments
+ADD CONSTRAINT fk_dock_appointments_inbound_order
+FOREIGN KEY(inbound_order_id) REFERENCES inbound_orders(id);
+-- Pick_waves -> warehouse
+ALTER TABLE pick_waves
+ADD CONSTRAINT fk_pick_waves_warehouse
+FOREIGN KEY(warehouse_id) REFERENCES warehouse(id);
+-- Pick_lists -> pick_waves, employees
+ALTER TABLE pick_lists
+ADD CONSTRAINT fk_pick_lists_wave
+FOREIGN KEY(pick_wave_id) REFERENCES pick_waves(id);
+ALTER TABLE pick_lists
+ADD CONSTRAINT fk_pick_lists_employee
+FOREIGN KEY(employee_id) REFERENCES employees(id);
+-- Pick_list_lines -> pick_lists, stock_allocations
+ALTER TABLE pick_list_lines
+ADD CONSTRAINT fk_pick_list_lines_list
+FOREIGN KEY(pick_list_id) REFERENCES pick_lists(id);
+ALTER TABLE pick_list_lines
+ADD CONSTRAINT fk_pick_list_lines_allocation
+FOREIGN KEY(stock_allocation_id) REFERENCES stock_allocations(id);
+-- Shipments -> carriers, warehouse, localities
+ALTER TABLE shipments
+ADD CONSTRAINT fk_shipments_carrier
+FOREIGN KEY(carrier_id) REFERENCES carriers(id);
+ALTER TABLE shipments
+ADD CONSTRAINT fk_shipments_warehouse
+FOREIGN KEY(warehouse_id) REFERENCES warehouse(id);
+ALTER TABLE shipments
+ADD CONSTRAINT fk_shipments_destination_locality
+FOREIGN KEY(destination_locality_id) REFERENCES localities(id);
+-- Shipment_orders -> shipments, purchase_orders
+ALTER TABLE shipment_orders
+ADD CONSTRAINT fk_shipment_orders_shipment
+FOREIGN KEY(shipment_id) REFERENCES shipments(id);
+ALTER TABLE shipment_orders
+ADD CONSTRAINT fk_shipment_orders_purchase_order
+FOREIGN KEY(purchase_order_id) REFERENCES purchase_orders(id);
+-- Shipment_events -> shipments
+ALTER TABLE shipment_events
+ADD CONSTRAINT fk_shipment_events_shipment
+FOREIGN KEY(shipment_id) REFERENCES shipments(id);
+-- Carrier_webhooks -> carriers
+ALTER TABLE carrier_webhooks
+ADD CONSTRAINT fk_carrier_webhooks_carrier
+FOREIGN KEY(carrier_id) REFERENCES carriers(id);
+-- Carrier_webhook_nonces -> carriers
+ALTER TABLE carrier_webhook_nonces
+ADD CONSTRAINT fk_carrier_webhook_nonces_carrier
+FOREIGN KEY(carrier_id) REFERENCES carriers(id);
+-- Carrier_coverage_areas -> carriers, localities, provinces, countries
+ALTER TABLE carrier_coverage_areas
+ADD CONSTRAINT fk_carrier_coverage_areas_carrier
+FOREIGN KEY(carrier_id) REFERENCES carriers(id);
+ALTER TABLE carrier_coverage_areas
+ADD CONSTRAINT fk_carrier_coverage_areas_locality
+FOREIGN KEY(locality_id) REFERENCES localities(id);
+ALTER TABLE carrier_coverage_areas
+ADD CONSTRAINT fk_carrier_coverage_areas_province
+FOREIGN KEY(province_id) REFERENCES provinces(id);
+ALTER TABLE carrier_coverage_areas
+ADD CONSTRAINT fk_carrier_coverage_areas_country
+FOREIGN KEY(country_id) REFERENCES countries(id);
+-- Carrier_rate_bands -> carrier_coverage_areas
+ALTER TABLE carrier_rate_bands
+ADD CONSTRAINT fk_carrier_rate_bands_area
+FOREIGN KEY(coverage_area_id) REFERENCES carrier_coverage_areas(id);
+-- Buyer_addresses -> buyers, localities
+ALTER TABLE buyer_addresses
+ADD CONSTRAINT fk_buyer_addresses_buyer
+FOREIGN KEY(buyer_id) REFERENCES buyers(id);
+ALTER TABLE buyer_addresses
+ADD CONSTRAINT fk_buyer_addresses_locality
+FOREIGN KEY(locality_id) REFERENCES localities(id);
 
 -- Índices Únicos
 -- warehouse_code
@@ -112,6 +112,10 @@ VALUES
     (9, 9, 39, 3, '2024-06-05 00:00:00', 44, '2024-05-01 00:00:00', 8, 2, 9, 9),
     (10, 10, 88, 7, '2024-11-21 00:00:00', 90, '2024-05-25 00:00:00', 12, 7, 10, 10);
 
+-- Opening balance of the stock ledger for the seeded batches
+INSERT INTO stock_movements (product_batch_id, movement_type, quantity, reason, actor, created_at)
+SELECT id, 'receipt', current_quantity, 'opening balance', 'system', manufacturing_date FROM product_batches;
+
 INSERT INTO inbound_orders (id, order_date, order_number, employee_id, product_batch_id, warehouse_id)
 VALUES
     (1, '2024-05-10 09:00:00', 'order#1', 1, 1, 1),
@@ -0,0 +1,51 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Executor wraps types that can run SQL statements, so repository operations work both inside and outside a transaction.
+// Both *sql.DB and *sql.Tx satisfy it.
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Transactor defines how services open and close the transactions their repository calls run in.
+type Transactor interface {
+	// BeginTx starts a new database transaction and returns the transaction object.
+	BeginTx(ctx context.Context) (*sql.Tx, error)
+
+	// CommitTx commits the provided transaction.
+	CommitTx(tx *sql.Tx) error
+
+	// RollbackTx aborts the provided transaction.
+	RollbackTx(tx *sql.Tx) error
+}
+
+// MySQLTransactor implements Transactor on a MySQL connection.
+// Repositories embed it to provide their transaction methods.
+type MySQLTransactor struct {
+	mysql *sql.DB
+}
+
+// NewMySQLTransactor returns a MySQLTransactor using the given MySQL connection.
+func NewMySQLTransactor(mysql *sql.DB) MySQLTransactor {
+	return MySQLTransactor{
+		mysql: mysql,
+	}
+}
+
+func (t MySQLTransactor) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	return t.mysql.BeginTx(ctx, nil)
+}
+
+func (t MySQLTransactor) CommitTx(tx *sql.Tx) error {
+	return tx.Commit()
+}
+
+func (t MySQLTransactor) RollbackTx(tx *sql.Tx) error {
+	return tx.Rollback()
+}
@@ -0,0 +1,49 @@
+package database_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/database"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestMySQLTransactor(t *testing.T) {
+	t.Run("commit", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		mock.ExpectBegin()
+		mock.ExpectCommit()
+
+		tr := database.NewMySQLTransactor(db)
+		tx, err := tr.BeginTx(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, tr.CommitTx(tx))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		mock.ExpectBegin()
+		mock.ExpectRollback()
+
+		tr := database.NewMySQLTransactor(db)
+		tx, err := tr.BeginTx(context.Background())
+		require.NoError(t, err)
+		require.NoError(t, tr.RollbackTx(tx))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("begin error", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		mock.ExpectBegin().WillReturnError(errors.New("db down"))
+
+		_, err := database.NewMySQLTransactor(db).BeginTx(context.Background())
+		require.Error(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"net/http"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/allocation"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+)
+
+// AllocationHandler handles HTTP requests that reserve and release stock for purchase orders.
+type AllocationHandler struct {
+	sv service.AllocationService
+}
+
+// NewAllocationHandler creates a new AllocationHandler with the provided service.
+func NewAllocationHandler(sv service.AllocationService) *AllocationHandler {
+	return &AllocationHandler{
+		sv: sv,
+	}
+}
+
+// Confirm handles POST /purchaseOrders/{id}/confirm.
+// - Reserves stock FEFO for every order line and returns the allocations made.
+// - An optional body with 'delivery_locality_id' reserves from the warehouses nearest to it and returns the sourcing plan.
+// - Responds 409 OUT_OF_STOCK when a line cannot be fully served.
+func (h *AllocationHandler) Confirm(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	var req models.ConfirmRequest
+	if r.ContentLength != 0 {
+		if err := httputil.DecodeJSON(r, &req); err != nil {
+			response.Error(w, err)
+			return
+		}
+		if err := validators.ValidateConfirmRequest(req); err != nil {
+			response.Error(w, err)
+			return
+		}
+	}
+
+	res, err := h.sv.Confirm(r.Context(), id, req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, res)
+}
+
+// Cancel handles POST /purchaseOrders/{id}/cancel.
+// - Releases the reserved stock back to its batches and returns the released allocations.
+func (h *AllocationHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	res, err := h.sv.Cancel(r.Context(), id)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, res)
+}
+
+// FindByOrder handles GET /purchaseOrders/{id}/allocations.
+func (h *AllocationHandler) FindByOrder(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	allocations, err := h.sv.FindByOrder(r.Context(), id)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, allocations)
+}
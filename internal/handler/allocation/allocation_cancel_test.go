@@ -0,0 +1,93 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/allocation"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/allocation"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+)
+
+func TestAllocationHandler_Cancel(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockService   func() *mocks.AllocationServiceMock
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name: "success",
+			mockService: func() *mocks.AllocationServiceMock {
+				return &mocks.AllocationServiceMock{
+					FuncCancel: func(ctx context.Context, orderId int) (*models.AllocationResponse, error) {
+						return &models.AllocationResponse{PurchaseOrderId: orderId, OrderStatusId: 3, Allocations: []models.StockAllocation{}}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "error - order cannot be cancelled",
+			mockService: func() *mocks.AllocationServiceMock {
+				return &mocks.AllocationServiceMock{
+					FuncCancel: func(ctx context.Context, orderId int) (*models.AllocationResponse, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeConflict, "only pending or confirmed purchase orders can be cancelled")
+					},
+				}
+			},
+			wantStatus:    http.StatusConflict,
+			wantErrorCode: apperrors.CodeConflict,
+		},
+		{
+			name: "error - order not found",
+			mockService: func() *mocks.AllocationServiceMock {
+				return &mocks.AllocationServiceMock{
+					FuncCancel: func(ctx context.Context, orderId int) (*models.AllocationResponse, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeNotFound, "purchase order not found")
+					},
+				}
+			},
+			wantStatus:    http.StatusNotFound,
+			wantErrorCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/purchaseOrders/1/cancel", nil)
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", "1")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewAllocationHandler(tt.mockService())
+
+			h.Cancel(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var envelope struct {
+					Data models.AllocationResponse `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &envelope))
+				require.Equal(t, 3, envelope.Data.OrderStatusId)
+				return
+			}
+
+			var body struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantErrorCode, body.Error.Code)
+		})
+	}
+}
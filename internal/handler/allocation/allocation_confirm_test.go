@@ -0,0 +1,138 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/allocation"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/allocation"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestAllocationHandler_Confirm(t *testing.T) {
+	tests := []struct {
+		name          string
+		routeID       string
+		body          string
+		mockService   func() *mocks.AllocationServiceMock
+		wantStatus    int
+		wantErrorCode string
+		wantDetails   map[string]any
+	}{
+		{
+			name:    "success",
+			routeID: "1",
+			mockService: func() *mocks.AllocationServiceMock {
+				return &mocks.AllocationServiceMock{
+					FuncConfirm: func(ctx context.Context, orderId int, req models.ConfirmRequest) (*models.AllocationResponse, error) {
+						return &models.AllocationResponse{
+							PurchaseOrderId: orderId,
+							OrderStatusId:   2,
+							Allocations:     []models.StockAllocation{testhelpers.DummyStockAllocation(1, 1, 4)},
+						}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:    "success - with delivery locality",
+			routeID: "1",
+			body:    `{"delivery_locality_id":"1000"}`,
+			mockService: func() *mocks.AllocationServiceMock {
+				return &mocks.AllocationServiceMock{
+					FuncConfirm: func(ctx context.Context, orderId int, req models.ConfirmRequest) (*models.AllocationResponse, error) {
+						require.Equal(t, "1000", *req.DeliveryLocalityId)
+						return &models.AllocationResponse{
+							PurchaseOrderId: orderId,
+							OrderStatusId:   2,
+							Allocations:     []models.StockAllocation{testhelpers.DummyStockAllocation(1, 1, 4)},
+						}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:    "error - empty delivery locality",
+			routeID: "1",
+			body:    `{"delivery_locality_id":" "}`,
+			mockService: func() *mocks.AllocationServiceMock {
+				return &mocks.AllocationServiceMock{}
+			},
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:    "error - invalid id",
+			routeID: "abc",
+			mockService: func() *mocks.AllocationServiceMock {
+				return &mocks.AllocationServiceMock{}
+			},
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:    "error - out of stock",
+			routeID: "1",
+			mockService: func() *mocks.AllocationServiceMock {
+				return &mocks.AllocationServiceMock{
+					FuncConfirm: func(ctx context.Context, orderId int, req models.ConfirmRequest) (*models.AllocationResponse, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeOutOfStock, "not enough stock to allocate the order line").
+							WithDetail("product_id", 7).
+							WithDetail("requested", 20).
+							WithDetail("available", 14)
+					},
+				}
+			},
+			wantStatus:    http.StatusConflict,
+			wantErrorCode: apperrors.CodeOutOfStock,
+			wantDetails:   map[string]any{"product_id": float64(7), "requested": float64(20), "available": float64(14)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/purchaseOrders/"+tt.routeID+"/confirm", strings.NewReader(tt.body))
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", tt.routeID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewAllocationHandler(tt.mockService())
+
+			h.Confirm(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var envelope struct {
+					Data models.AllocationResponse `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &envelope))
+				require.Equal(t, 1, envelope.Data.PurchaseOrderId)
+				require.Len(t, envelope.Data.Allocations, 1)
+				return
+			}
+
+			var body struct {
+				Error struct {
+					Code    string         `json:"code"`
+					Details map[string]any `json:"details"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantErrorCode, body.Error.Code)
+			for k, v := range tt.wantDetails {
+				require.Equal(t, v, body.Error.Details[k])
+			}
+		})
+	}
+}
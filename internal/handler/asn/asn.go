@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/asn"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/asn"
+)
+
+// AsnHandler handles HTTP requests for expected deliveries (ASNs) and their discrepancies.
+type AsnHandler struct {
+	sv service.AsnService
+}
+
+// NewAsnHandler creates a new AsnHandler with the provided service.
+func NewAsnHandler(sv service.AsnService) *AsnHandler {
+	return &AsnHandler{
+		sv: sv,
+	}
+}
+
+// FindAll handles GET /asns.
+// - 'seller_id', 'warehouse_id' and 'status' optionally filter the ASNs.
+func (h *AsnHandler) FindAll(w http.ResponseWriter, r *http.Request) {
+	sellerId, err := httputil.ParseOptionalIntParam(r, "seller_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	warehouseId, err := httputil.ParseOptionalIntParam(r, "warehouse_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	status := r.URL.Query().Get("status")
+	if err := validators.ValidateAsnStatus(status); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	asns, err := h.sv.FindAll(r.Context(), models.AsnFilter{
+		SellerId:    sellerId,
+		WarehouseId: warehouseId,
+		Status:      status,
+	})
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, asns)
+}
+
+// FindById handles GET /asns/{id}.
+func (h *AsnHandler) FindById(w http.ResponseWriter, r *http.Request) {
+	h.withId(w, r, http.StatusOK, h.sv.FindById)
+}
+
+// Create handles POST /asns.
+// - Responds 409 when the ASN number already exists.
+// - Responds 422 when a line product does not belong to the seller.
+func (h *AsnHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req models.PostAsn
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateAsnPost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	asn, err := h.sv.Create(r.Context(), req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, asn)
+}
+
+// Arrive handles POST /asns/{id}/arrive.
+// - Responds 409 when the ASN is not scheduled.
+func (h *AsnHandler) Arrive(w http.ResponseWriter, r *http.Request) {
+	h.withId(w, r, http.StatusOK, h.sv.Arrive)
+}
+
+// Complete handles POST /asns/{id}/complete.
+// - Responds 409 when the ASN is not arriving or receiving.
+func (h *AsnHandler) Complete(w http.ResponseWriter, r *http.Request) {
+	h.withId(w, r, http.StatusOK, h.sv.Complete)
+}
+
+// Close handles POST /asns/{id}/close.
+// - Responds 409 when the ASN is not received.
+func (h *AsnHandler) Close(w http.ResponseWriter, r *http.Request) {
+	h.withId(w, r, http.StatusOK, h.sv.Close)
+}
+
+// DiscrepancyReport handles GET /asns/discrepancies.
+// - 'seller_id' and 'warehouse_id' optionally narrow the report.
+func (h *AsnHandler) DiscrepancyReport(w http.ResponseWriter, r *http.Request) {
+	sellerId, err := httputil.ParseOptionalIntParam(r, "seller_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	warehouseId, err := httputil.ParseOptionalIntParam(r, "warehouse_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	report, err := h.sv.DiscrepancyReport(r.Context(), sellerId, warehouseId)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, report)
+}
+
+// withId parses the {id} path parameter and responds with the ASN returned by fn.
+func (h *AsnHandler) withId(w http.ResponseWriter, r *http.Request, status int,
+	fn func(ctx context.Context, id int) (*models.AsnDetail, error)) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	asn, err := fn(r.Context(), id)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, status, asn)
+}
@@ -0,0 +1,222 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/asn"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/asn"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/asn"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestAsnHandler_Create(t *testing.T) {
+	validBody := `{"asn_number":"ASN-001","seller_id":3,"warehouse_id":1,"expected_at":"2025-06-02T09:00:00Z",` +
+		`"lines":[{"product_id":22,"expected_quantity":100}]}`
+	tests := []struct {
+		name          string
+		body          string
+		mockService   func() *mocks.AsnServiceMock
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name: "success",
+			body: validBody,
+			mockService: func() *mocks.AsnServiceMock {
+				return &mocks.AsnServiceMock{
+					FuncCreate: func(ctx context.Context, req models.PostAsn) (*models.AsnDetail, error) {
+						return &models.AsnDetail{Asn: testhelpers.DummyAsn(models.StatusScheduled)}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "error - duplicate product line",
+			body: `{"asn_number":"ASN-001","seller_id":3,"warehouse_id":1,"expected_at":"2025-06-02T09:00:00Z",` +
+				`"lines":[{"product_id":22,"expected_quantity":100},{"product_id":22,"expected_quantity":5}]}`,
+			mockService:   func() *mocks.AsnServiceMock { return &mocks.AsnServiceMock{} },
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - no lines",
+			body:          `{"asn_number":"ASN-001","seller_id":3,"warehouse_id":1,"expected_at":"2025-06-02T09:00:00Z"}`,
+			mockService:   func() *mocks.AsnServiceMock { return &mocks.AsnServiceMock{} },
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - invalid json",
+			body:          `{`,
+			mockService:   func() *mocks.AsnServiceMock { return &mocks.AsnServiceMock{} },
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name: "error - duplicate asn number",
+			body: validBody,
+			mockService: func() *mocks.AsnServiceMock {
+				return &mocks.AsnServiceMock{
+					FuncCreate: func(ctx context.Context, req models.PostAsn) (*models.AsnDetail, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeConflict, "asn number already exists")
+					},
+				}
+			},
+			wantStatus:    http.StatusConflict,
+			wantErrorCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/asns", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			h := handler.NewAsnHandler(tt.mockService())
+
+			h.Create(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func TestAsnHandler_FindAll(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success - filtered by status",
+			query:      "?seller_id=3&status=receiving",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "error - unknown status",
+			query:         "?status=lost",
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.AsnServiceMock{
+				FuncFindAll: func(ctx context.Context, filter models.AsnFilter) ([]models.Asn, error) {
+					require.Equal(t, models.AsnFilter{SellerId: 3, Status: models.StatusReceiving}, filter)
+					return []models.Asn{testhelpers.DummyAsn(models.StatusReceiving)}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/asns"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			h := handler.NewAsnHandler(sv)
+
+			h.FindAll(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func TestAsnHandler_Arrive(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		err           error
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success",
+			id:         "7",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "error - already arrived",
+			id:            "7",
+			err:           apperrors.NewAppError(apperrors.CodeConflict, "asn cannot move to the requested status"),
+			wantStatus:    http.StatusConflict,
+			wantErrorCode: apperrors.CodeConflict,
+		},
+		{
+			name:          "error - invalid id",
+			id:            "abc",
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.AsnServiceMock{
+				FuncArrive: func(ctx context.Context, id int) (*models.AsnDetail, error) {
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					return &models.AsnDetail{Asn: testhelpers.DummyAsn(models.StatusArriving)}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/asns/"+tt.id+"/arrive", nil)
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", tt.id)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewAsnHandler(sv)
+
+			h.Arrive(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func TestAsnHandler_DiscrepancyReport(t *testing.T) {
+	sv := &mocks.AsnServiceMock{
+		FuncDiscrepancyReport: func(ctx context.Context, sellerId int, warehouseId int) ([]models.SupplierDiscrepancy, error) {
+			require.Equal(t, 3, sellerId)
+			require.Equal(t, 0, warehouseId)
+			return []models.SupplierDiscrepancy{{SellerId: 3, AsnCount: 2, ShortQuantity: 10, FillRate: 95}}, nil
+		},
+	}
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/asns/discrepancies?seller_id=3", nil)
+	rec := httptest.NewRecorder()
+	h := handler.NewAsnHandler(sv)
+
+	h.DiscrepancyReport(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body struct {
+		Data []models.SupplierDiscrepancy `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Len(t, body.Data, 1)
+	require.Equal(t, 95.0, body.Data[0].FillRate)
+}
+
+func requireErrorCode(t *testing.T, rec *httptest.ResponseRecorder, code string) {
+	t.Helper()
+	if code == "" {
+		return
+	}
+	var body struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, code, body.Error.Code)
+}
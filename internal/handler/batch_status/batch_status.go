@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/batch_status"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/batch_status"
+)
+
+// BatchStatusHandler handles HTTP requests that place and release quality holds on product batches.
+type BatchStatusHandler struct {
+	sv service.BatchStatusService
+}
+
+// NewBatchStatusHandler creates a new BatchStatusHandler with the provided service.
+func NewBatchStatusHandler(sv service.BatchStatusService) *BatchStatusHandler {
+	return &BatchStatusHandler{
+		sv: sv,
+	}
+}
+
+// Hold handles POST /productBatches/{id}/hold.
+// - Batches on hold are excluded from allocation and from the section product report.
+// - Responds 409 when the batch status does not allow a hold.
+func (h *BatchStatusHandler) Hold(w http.ResponseWriter, r *http.Request) {
+	h.change(w, r, h.sv.Hold)
+}
+
+// Release handles POST /productBatches/{id}/release.
+// - Responds 409 when the batch is not on hold.
+func (h *BatchStatusHandler) Release(w http.ResponseWriter, r *http.Request) {
+	h.change(w, r, h.sv.Release)
+}
+
+// FindStatus handles GET /productBatches/{id}/status.
+func (h *BatchStatusHandler) FindStatus(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	status, err := h.sv.FindStatus(r.Context(), id)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, status)
+}
+
+// change decodes and validates a status change request and applies it with apply.
+func (h *BatchStatusHandler) change(w http.ResponseWriter, r *http.Request, apply func(ctx context.Context, batchId int, req models.PostStatusChange) (*models.StatusChange, error)) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	var req models.PostStatusChange
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateStatusChangePost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	change, err := apply(r.Context(), id, req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, change)
+}
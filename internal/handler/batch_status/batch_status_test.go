@@ -0,0 +1,115 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/batch_status"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/batch_status"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/batch_status"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+)
+
+func TestBatchStatusHandler_Hold(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		mockService   func() *mocks.BatchStatusServiceMock
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name: "success",
+			body: `{"reason_code": "inspection_failed", "note": "seal broken", "actor": "qa.lead"}`,
+			mockService: func() *mocks.BatchStatusServiceMock {
+				return &mocks.BatchStatusServiceMock{
+					FuncHold: func(ctx context.Context, batchId int, req models.PostStatusChange) (*models.StatusChange, error) {
+						return &models.StatusChange{Id: 1, ProductBatchId: batchId, FromStatus: batchModels.BatchStatusAvailable, ToStatus: batchModels.BatchStatusOnHold, ReasonCode: req.ReasonCode, Actor: req.Actor}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "error - unknown reason code",
+			body: `{"reason_code": "because", "actor": "qa.lead"}`,
+			mockService: func() *mocks.BatchStatusServiceMock {
+				return &mocks.BatchStatusServiceMock{}
+			},
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name: "error - missing actor",
+			body: `{"reason_code": "inspection_failed"}`,
+			mockService: func() *mocks.BatchStatusServiceMock {
+				return &mocks.BatchStatusServiceMock{}
+			},
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name: "error - invalid json",
+			body: `{"reason_code": 1}`,
+			mockService: func() *mocks.BatchStatusServiceMock {
+				return &mocks.BatchStatusServiceMock{}
+			},
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name: "error - status does not allow a hold",
+			body: `{"reason_code": "inspection_failed", "actor": "qa.lead"}`,
+			mockService: func() *mocks.BatchStatusServiceMock {
+				return &mocks.BatchStatusServiceMock{
+					FuncHold: func(ctx context.Context, batchId int, req models.PostStatusChange) (*models.StatusChange, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeConflict, "product batch is already on_hold")
+					},
+				}
+			},
+			wantStatus:    http.StatusConflict,
+			wantErrorCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/productBatches/1/hold", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", "1")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewBatchStatusHandler(tt.mockService())
+
+			h.Hold(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var envelope struct {
+					Data models.StatusChange `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &envelope))
+				require.Equal(t, batchModels.BatchStatusOnHold, envelope.Data.ToStatus)
+				require.Equal(t, "qa.lead", envelope.Data.Actor)
+				return
+			}
+
+			var body struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantErrorCode, body.Error.Code)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"net/http"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/buyer_address"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer_address"
+)
+
+// BuyerAddressHandler handles HTTP requests for the delivery addresses of buyers.
+type BuyerAddressHandler struct {
+	sv service.BuyerAddressService
+}
+
+// NewBuyerAddressHandler creates a new BuyerAddressHandler with the provided service.
+func NewBuyerAddressHandler(sv service.BuyerAddressService) *BuyerAddressHandler {
+	return &BuyerAddressHandler{
+		sv: sv,
+	}
+}
+
+// FindAll handles GET /buyers/{id}/addresses.
+// - Responds 404 when the buyer does not exist.
+func (h *BuyerAddressHandler) FindAll(w http.ResponseWriter, r *http.Request) {
+	buyerId, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	addresses, err := h.sv.FindAll(r.Context(), buyerId)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, addresses)
+}
+
+// FindById handles GET /buyers/{id}/addresses/{addressId}.
+func (h *BuyerAddressHandler) FindById(w http.ResponseWriter, r *http.Request) {
+	buyerId, id, err := parseIds(r)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	address, err := h.sv.FindById(r.Context(), buyerId, id)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, address)
+}
+
+// Create handles POST /buyers/{id}/addresses.
+// - The first address of a buyer becomes its default.
+// - Responds 404 when the buyer or the locality does not exist.
+func (h *BuyerAddressHandler) Create(w http.ResponseWriter, r *http.Request) {
+	buyerId, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	var req models.PostAddress
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateAddressPost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	address, err := h.sv.Create(r.Context(), buyerId, req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, address)
+}
+
+// Update handles PATCH /buyers/{id}/addresses/{addressId}.
+// - 'is_default' true makes the address the default of the buyer.
+// - Responds 409 when unsetting the default flag of the default address.
+func (h *BuyerAddressHandler) Update(w http.ResponseWriter, r *http.Request) {
+	buyerId, id, err := parseIds(r)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	var req models.PatchAddress
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateAddressPatch(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	address, err := h.sv.Update(r.Context(), buyerId, id, req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, address)
+}
+
+// Delete handles DELETE /buyers/{id}/addresses/{addressId}.
+// - Deleting the default makes the oldest remaining address the default.
+func (h *BuyerAddressHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	buyerId, id, err := parseIds(r)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	if err := h.sv.Delete(r.Context(), buyerId, id); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, nil)
+}
+
+// parseIds parses the {id} buyer and {addressId} path parameters.
+func parseIds(r *http.Request) (int, int, error) {
+	buyerId, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		return 0, 0, err
+	}
+	id, err := httputil.ParseIDParam(r, "addressId")
+	if err != nil {
+		return 0, 0, err
+	}
+	return buyerId, id, nil
+}
@@ -0,0 +1,213 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/buyer_address"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/buyer_address"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer_address"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestBuyerAddressHandler_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		buyerId       string
+		body          string
+		serviceErr    error
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success",
+			buyerId:    "101",
+			body:       `{"label":"home","address":"Av. Corrientes 1234","locality_id":"1001"}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:          "error - address missing",
+			buyerId:       "101",
+			body:          `{"label":"home","locality_id":"1001"}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - locality missing",
+			buyerId:       "101",
+			body:          `{"address":"Av. Corrientes 1234","locality_id":"9999"}`,
+			serviceErr:    apperrors.NewAppError(apperrors.CodeNotFound, "locality not found"),
+			wantStatus:    http.StatusNotFound,
+			wantErrorCode: apperrors.CodeNotFound,
+		},
+		{
+			name:          "error - invalid buyer id",
+			buyerId:       "abc",
+			body:          `{"address":"Av. Corrientes 1234","locality_id":"1001"}`,
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.BuyerAddressServiceMock{
+				FuncCreate: func(ctx context.Context, buyerId int, req models.PostAddress) (*models.Address, error) {
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					require.Equal(t, 101, buyerId)
+					a := testhelpers.DummyAddress(1, true)
+					return &a, nil
+				},
+			}
+			req := withParams(httptest.NewRequest(http.MethodPost, "/api/v1/buyers/"+tt.buyerId+"/addresses", strings.NewReader(tt.body)),
+				map[string]string{"id": tt.buyerId})
+			rec := httptest.NewRecorder()
+			h := handler.NewBuyerAddressHandler(sv)
+
+			h.Create(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func TestBuyerAddressHandler_Update(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		serviceErr    error
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success - made the default",
+			body:       `{"is_default":true}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "error - nothing to update",
+			body:          `{}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - blank address",
+			body:          `{"address":"  "}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - default unset",
+			body:          `{"is_default":false}`,
+			serviceErr:    apperrors.NewAppError(apperrors.CodeConflict, "the default address cannot be unset, make another address the default instead"),
+			wantStatus:    http.StatusConflict,
+			wantErrorCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.BuyerAddressServiceMock{
+				FuncUpdate: func(ctx context.Context, buyerId int, id int, req models.PatchAddress) (*models.Address, error) {
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					require.Equal(t, 101, buyerId)
+					require.Equal(t, 2, id)
+					a := testhelpers.DummyAddress(id, true)
+					return &a, nil
+				},
+			}
+			req := withParams(httptest.NewRequest(http.MethodPatch, "/api/v1/buyers/101/addresses/2", strings.NewReader(tt.body)),
+				map[string]string{"id": "101", "addressId": "2"})
+			rec := httptest.NewRecorder()
+			h := handler.NewBuyerAddressHandler(sv)
+
+			h.Update(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func TestBuyerAddressHandler_Delete(t *testing.T) {
+	tests := []struct {
+		name          string
+		addressId     string
+		serviceErr    error
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success",
+			addressId:  "2",
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:          "error - address missing",
+			addressId:     "9",
+			serviceErr:    apperrors.NewAppError(apperrors.CodeNotFound, "buyer address not found"),
+			wantStatus:    http.StatusNotFound,
+			wantErrorCode: apperrors.CodeNotFound,
+		},
+		{
+			name:          "error - invalid address id",
+			addressId:     "x",
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.BuyerAddressServiceMock{
+				FuncDelete: func(ctx context.Context, buyerId int, id int) error {
+					return tt.serviceErr
+				},
+			}
+			req := withParams(httptest.NewRequest(http.MethodDelete, "/api/v1/buyers/101/addresses/"+tt.addressId, nil),
+				map[string]string{"id": "101", "addressId": tt.addressId})
+			rec := httptest.NewRecorder()
+			h := handler.NewBuyerAddressHandler(sv)
+
+			h.Delete(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func withParams(req *http.Request, params map[string]string) *http.Request {
+	routeCtx := chi.NewRouteContext()
+	for k, v := range params {
+		routeCtx.URLParams.Add(k, v)
+	}
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+}
+
+func requireErrorCode(t *testing.T, rec *httptest.ResponseRecorder, code string) {
+	t.Helper()
+	if code == "" {
+		return
+	}
+	var body struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, code, body.Error.Code)
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/carrier_webhook"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/carrier_webhook"
+)
+
+// maxWebhookBody caps the size of a webhook request body.
+const maxWebhookBody = 1 << 20
+
+// CarrierWebhookHandler handles HTTP requests for carrier webhooks. Secrets are rotated from the
+// rotate-webhook-secret command, never over the API carriers can reach.
+type CarrierWebhookHandler struct {
+	sv service.CarrierWebhookService
+}
+
+// NewCarrierWebhookHandler creates a new CarrierWebhookHandler with the provided service.
+func NewCarrierWebhookHandler(sv service.CarrierWebhookService) *CarrierWebhookHandler {
+	return &CarrierWebhookHandler{
+		sv: sv,
+	}
+}
+
+// Receive handles POST /webhooks/carriers/{cid}.
+// - Requires the X-Carrier-Timestamp, X-Carrier-Nonce and X-Carrier-Signature headers.
+// - Responds 201 when the event is recorded and 200 when it had already been.
+// - Responds 401 when the signature is missing, invalid or too old.
+// - Responds 409 when the nonce was already used or the shipment cannot move to the event status.
+func (h *CarrierWebhookHandler) Receive(w http.ResponseWriter, r *http.Request) {
+	sig := models.Signature{
+		Timestamp: r.Header.Get("X-Carrier-Timestamp"),
+		Nonce:     r.Header.Get("X-Carrier-Nonce"),
+		Signature: r.Header.Get("X-Carrier-Signature"),
+	}
+	if err := validators.ValidateWebhookSignature(sig); err != nil {
+		response.Error(w, err)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBody))
+	if err != nil {
+		response.Error(w, apperrors.NewAppError(apperrors.CodeBadRequest, "could not read request body"))
+		return
+	}
+
+	receipt, err := h.sv.Receive(r.Context(), chi.URLParam(r, "cid"), sig, body)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	status := http.StatusCreated
+	if receipt.Duplicate {
+		status = http.StatusOK
+	}
+	response.JSON(w, status, receipt)
+}
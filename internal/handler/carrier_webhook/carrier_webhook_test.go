@@ -0,0 +1,112 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/carrier_webhook"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/carrier_webhook"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/carrier_webhook"
+)
+
+func TestCarrierWebhookHandler_Receive(t *testing.T) {
+	body := `{"event_id":"ev-1","tracking_code":"TRK-ABCDEFGH23","status":"delivered","occurred_at":"2025-06-02T10:00:00Z"}`
+	signedHeaders := map[string]string{
+		"X-Carrier-Timestamp": "1748858400",
+		"X-Carrier-Nonce":     "n-1",
+		"X-Carrier-Signature": "abc123",
+	}
+
+	tests := []struct {
+		name          string
+		headers       map[string]string
+		duplicate     bool
+		serviceErr    error
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success - event recorded",
+			headers:    signedHeaders,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "success - event already recorded",
+			headers:    signedHeaders,
+			duplicate:  true,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "error - unsigned request",
+			headers:       map[string]string{"X-Carrier-Timestamp": "1748858400"},
+			wantStatus:    http.StatusUnauthorized,
+			wantErrorCode: apperrors.CodeUnauthorized,
+		},
+		{
+			name:          "error - invalid signature",
+			headers:       signedHeaders,
+			serviceErr:    apperrors.NewAppError(apperrors.CodeUnauthorized, "invalid signature"),
+			wantStatus:    http.StatusUnauthorized,
+			wantErrorCode: apperrors.CodeUnauthorized,
+		},
+		{
+			name:          "error - replayed nonce",
+			headers:       signedHeaders,
+			serviceErr:    apperrors.NewAppError(apperrors.CodeConflict, "nonce already used"),
+			wantStatus:    http.StatusConflict,
+			wantErrorCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.CarrierWebhookServiceMock{
+				FuncReceive: func(ctx context.Context, cid string, sig models.Signature, raw []byte) (*models.Receipt, error) {
+					require.Equal(t, "CID-2", cid)
+					require.Equal(t, body, string(raw))
+					require.Equal(t, "n-1", sig.Nonce)
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					return &models.Receipt{TrackingCode: "TRK-ABCDEFGH23", EventId: "ev-1", Status: "delivered", Duplicate: tt.duplicate}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/webhooks/carriers/CID-2", strings.NewReader(body))
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("cid", "CID-2")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewCarrierWebhookHandler(sv)
+
+			h.Receive(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func requireErrorCode(t *testing.T, rec *httptest.ResponseRecorder, code string) {
+	t.Helper()
+	if code == "" {
+		return
+	}
+	var body struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, code, body.Error.Code)
+}
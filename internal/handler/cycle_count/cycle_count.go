@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/cycle_count"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/cycle_count"
+)
+
+// CycleCountHandler handles HTTP requests for section cycle counts.
+type CycleCountHandler struct {
+	sv service.CycleCountService
+}
+
+// NewCycleCountHandler creates a new CycleCountHandler with the provided service.
+func NewCycleCountHandler(sv service.CycleCountService) *CycleCountHandler {
+	return &CycleCountHandler{
+		sv: sv,
+	}
+}
+
+// Create handles POST /cycleCounts.
+// - Opens a count with one line per batch of the section.
+// - Responds 409 when the section already has an active count or holds no batches.
+func (h *CycleCountHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req models.PostCycleCount
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateCycleCountPost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	count, err := h.sv.Create(r.Context(), req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, count)
+}
+
+// FindAll handles GET /cycleCounts.
+// - 'section_id' and 'status' optionally filter the counts.
+func (h *CycleCountHandler) FindAll(w http.ResponseWriter, r *http.Request) {
+	sectionId, err := httputil.ParseOptionalIntParam(r, "section_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	counts, err := h.sv.FindAll(r.Context(), sectionId, r.URL.Query().Get("status"))
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, counts)
+}
+
+// FindById handles GET /cycleCounts/{id}.
+// - Returns the count with its lines and history.
+func (h *CycleCountHandler) FindById(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	count, err := h.sv.FindById(r.Context(), id)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, count)
+}
+
+// RecordCounts handles POST /cycleCounts/{id}/counts.
+// - Stores counted quantities; a reason_code is required for each variance.
+func (h *CycleCountHandler) RecordCounts(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	var req models.PostCountedLines
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateCountedLinesPost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	count, err := h.sv.RecordCounts(r.Context(), id, req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, count)
+}
+
+// Approve handles POST /cycleCounts/{id}/approve.
+// - Posts the variances as stock adjustments, or waits for a second approver when a variance is large.
+func (h *CycleCountHandler) Approve(w http.ResponseWriter, r *http.Request) {
+	h.decide(w, r, h.sv.Approve)
+}
+
+// Cancel handles POST /cycleCounts/{id}/cancel.
+func (h *CycleCountHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	h.decide(w, r, h.sv.Cancel)
+}
+
+// decide parses and validates a decision on a count and applies it with the given service operation.
+func (h *CycleCountHandler) decide(w http.ResponseWriter, r *http.Request, apply func(ctx context.Context, id int, req models.PostCountDecision) (*models.CycleCountDetail, error)) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	var req models.PostCountDecision
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateCountDecisionPost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	count, err := apply(r.Context(), id, req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, count)
+}
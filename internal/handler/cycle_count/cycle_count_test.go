@@ -0,0 +1,200 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/cycle_count"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/cycle_count"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/cycle_count"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestCycleCountHandler_RecordCounts(t *testing.T) {
+	tests := []struct {
+		name          string
+		routeID       string
+		body          string
+		mockService   func() *mocks.CycleCountServiceMock
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:    "success",
+			routeID: "1",
+			body:    `{"actor": "counter", "lines": [{"product_batch_id": 1, "counted_quantity": 48, "reason_code": "damaged"}]}`,
+			mockService: func() *mocks.CycleCountServiceMock {
+				return &mocks.CycleCountServiceMock{
+					FuncRecordCounts: func(ctx context.Context, id int, req models.PostCountedLines) (*models.CycleCountDetail, error) {
+						return &models.CycleCountDetail{
+							CycleCount: testhelpers.DummyCycleCount(id, models.StatusCounted),
+							Lines:      []models.CountLine{testhelpers.DummyCountLine(1, 50, req.Lines[0].CountedQuantity, req.Lines[0].ReasonCode)},
+						}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:    "error - invalid id",
+			routeID: "abc",
+			body:    `{}`,
+			mockService: func() *mocks.CycleCountServiceMock {
+				return &mocks.CycleCountServiceMock{}
+			},
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:    "error - missing counted quantity",
+			routeID: "1",
+			body:    `{"actor": "counter", "lines": [{"product_batch_id": 1}]}`,
+			mockService: func() *mocks.CycleCountServiceMock {
+				return &mocks.CycleCountServiceMock{}
+			},
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:    "error - unknown reason code",
+			routeID: "1",
+			body:    `{"actor": "counter", "lines": [{"product_batch_id": 1, "counted_quantity": 3, "reason_code": "lost"}]}`,
+			mockService: func() *mocks.CycleCountServiceMock {
+				return &mocks.CycleCountServiceMock{}
+			},
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:    "error - count already approved",
+			routeID: "1",
+			body:    `{"actor": "counter", "lines": [{"product_batch_id": 1, "counted_quantity": 50}]}`,
+			mockService: func() *mocks.CycleCountServiceMock {
+				return &mocks.CycleCountServiceMock{
+					FuncRecordCounts: func(ctx context.Context, id int, req models.PostCountedLines) (*models.CycleCountDetail, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeConflict, "cycle count can no longer be counted")
+					},
+				}
+			},
+			wantStatus:    http.StatusConflict,
+			wantErrorCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/cycleCounts/"+tt.routeID+"/counts", strings.NewReader(tt.body))
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", tt.routeID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewCycleCountHandler(tt.mockService())
+
+			h.RecordCounts(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var envelope struct {
+					Data models.CycleCountDetail `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &envelope))
+				require.Equal(t, models.StatusCounted, envelope.Data.Status)
+				require.Len(t, envelope.Data.Lines, 1)
+				require.Equal(t, -2, *envelope.Data.Lines[0].Variance)
+				return
+			}
+
+			var body struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantErrorCode, body.Error.Code)
+		})
+	}
+}
+
+func TestCycleCountHandler_Approve(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		mockService   func() *mocks.CycleCountServiceMock
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name: "success",
+			body: `{"actor": "manager"}`,
+			mockService: func() *mocks.CycleCountServiceMock {
+				return &mocks.CycleCountServiceMock{
+					FuncApprove: func(ctx context.Context, id int, req models.PostCountDecision) (*models.CycleCountDetail, error) {
+						return &models.CycleCountDetail{CycleCount: testhelpers.DummyCycleCount(id, models.StatusApproved)}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "error - missing actor",
+			body: `{"note": "ok"}`,
+			mockService: func() *mocks.CycleCountServiceMock {
+				return &mocks.CycleCountServiceMock{}
+			},
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name: "error - same approver twice",
+			body: `{"actor": "manager"}`,
+			mockService: func() *mocks.CycleCountServiceMock {
+				return &mocks.CycleCountServiceMock{
+					FuncApprove: func(ctx context.Context, id int, req models.PostCountDecision) (*models.CycleCountDetail, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeValidationError, "a large variance must be approved by a second, different approver")
+					},
+				}
+			},
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/cycleCounts/1/approve", strings.NewReader(tt.body))
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", "1")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewCycleCountHandler(tt.mockService())
+
+			h.Approve(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var envelope struct {
+					Data models.CycleCountDetail `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &envelope))
+				require.Equal(t, models.StatusApproved, envelope.Data.Status)
+				return
+			}
+
+			var body struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantErrorCode, body.Error.Code)
+		})
+	}
+}
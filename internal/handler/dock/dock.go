@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/dock"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/dock"
+)
+
+// DockHandler handles HTTP requests for warehouse docks and dock appointments.
+type DockHandler struct {
+	sv service.DockService
+}
+
+// NewDockHandler creates a new DockHandler with the provided service.
+func NewDockHandler(sv service.DockService) *DockHandler {
+	return &DockHandler{
+		sv: sv,
+	}
+}
+
+// FindDocks handles GET /docks.
+// - 'warehouse_id' optionally filters the docks.
+func (h *DockHandler) FindDocks(w http.ResponseWriter, r *http.Request) {
+	warehouseId, err := httputil.ParseOptionalIntParam(r, "warehouse_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	docks, err := h.sv.FindDocks(r.Context(), warehouseId)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, docks)
+}
+
+// CreateDock handles POST /docks.
+// - Responds 409 when the warehouse already has a dock with the code.
+func (h *DockHandler) CreateDock(w http.ResponseWriter, r *http.Request) {
+	var req models.PostDock
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateDockPost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	dock, err := h.sv.CreateDock(r.Context(), req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, dock)
+}
+
+// Book handles POST /dockAppointments.
+// - Responds 409 when the slot overlaps another booking on the dock.
+// - Responds 422 when the slot is outside the dock opening hours.
+func (h *DockHandler) Book(w http.ResponseWriter, r *http.Request) {
+	var req models.PostAppointment
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateAppointmentPost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	appointment, err := h.sv.Book(r.Context(), req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, appointment)
+}
+
+// FindAppointmentById handles GET /dockAppointments/{id}.
+func (h *DockHandler) FindAppointmentById(w http.ResponseWriter, r *http.Request) {
+	h.withId(w, r, h.sv.FindAppointmentById)
+}
+
+// Cancel handles POST /dockAppointments/{id}/cancel.
+// - Responds 409 when the appointment is no longer booked.
+func (h *DockHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	h.withId(w, r, h.sv.Cancel)
+}
+
+// MarkMissed handles POST /dockAppointments/{id}/missed.
+// - Responds 409 when the appointment is no longer booked or its slot is not over.
+func (h *DockHandler) MarkMissed(w http.ResponseWriter, r *http.Request) {
+	h.withId(w, r, h.sv.MarkMissed)
+}
+
+// RecordArrival handles POST /dockAppointments/{id}/arrival.
+// - Links the inbound order that arrived, also for missed appointments.
+// - Responds 409 when the appointment was cancelled or already has an arrival.
+func (h *DockHandler) RecordArrival(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	var req models.PostArrival
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateArrivalPost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	appointment, err := h.sv.RecordArrival(r.Context(), id, req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, appointment)
+}
+
+// DaySchedule handles GET /warehouses/{id}/dockSchedule.
+// - 'date' picks the day as YYYY-MM-DD (default today).
+func (h *DockHandler) DaySchedule(w http.ResponseWriter, r *http.Request) {
+	warehouseId, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	day, err := httputil.ParseDateQueryParam(r, "date", time.Now())
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	schedule, err := h.sv.DaySchedule(r.Context(), warehouseId, day)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, schedule)
+}
+
+// withId parses the {id} path parameter and responds with the appointment returned by fn.
+func (h *DockHandler) withId(w http.ResponseWriter, r *http.Request, fn func(ctx context.Context, id int) (*models.Appointment, error)) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	appointment, err := fn(r.Context(), id)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, appointment)
+}
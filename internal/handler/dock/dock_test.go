@@ -0,0 +1,188 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/dock"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/dock"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/dock"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestDockHandler_CreateDock(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success",
+			body:       `{"warehouse_id":1,"code":"D-01","opens_at":"08:00","closes_at":"18:00"}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:          "error - closes before opening",
+			body:          `{"warehouse_id":1,"code":"D-01","opens_at":"18:00","closes_at":"08:00"}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - invalid clock",
+			body:          `{"warehouse_id":1,"code":"D-01","opens_at":"8am","closes_at":"18:00"}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.DockServiceMock{
+				FuncCreateDock: func(ctx context.Context, req models.PostDock) (*models.Dock, error) {
+					d := testhelpers.DummyDock(5)
+					return &d, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/docks", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			h := handler.NewDockHandler(sv)
+
+			h.CreateDock(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func TestDockHandler_Book(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		serviceErr    error
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success",
+			body:       `{"dock_id":5,"seller_id":3,"starts_at":"2025-06-02T09:00:00Z","ends_at":"2025-06-02T10:00:00Z"}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:          "error - seller and carrier both booking",
+			body:          `{"dock_id":5,"seller_id":3,"carrier_id":2,"starts_at":"2025-06-02T09:00:00Z","ends_at":"2025-06-02T10:00:00Z"}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - slot ends before it starts",
+			body:          `{"dock_id":5,"carrier_id":2,"starts_at":"2025-06-02T10:00:00Z","ends_at":"2025-06-02T09:00:00Z"}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - double booking",
+			body:          `{"dock_id":5,"carrier_id":2,"starts_at":"2025-06-02T09:00:00Z","ends_at":"2025-06-02T10:00:00Z"}`,
+			serviceErr:    apperrors.NewAppError(apperrors.CodeConflict, "dock already booked for the slot"),
+			wantStatus:    http.StatusConflict,
+			wantErrorCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.DockServiceMock{
+				FuncBook: func(ctx context.Context, req models.PostAppointment) (*models.Appointment, error) {
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					a := testhelpers.DummyAppointment(models.AppointmentBooked, req.StartsAt)
+					return &a, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/dockAppointments", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			h := handler.NewDockHandler(sv)
+
+			h.Book(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func TestDockHandler_DaySchedule(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		wantDay       string
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success - given date",
+			query:      "?date=2025-06-02",
+			wantDay:    "2025-06-02",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success - today by default",
+			wantDay:    time.Now().Format(time.DateOnly),
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "error - invalid date",
+			query:         "?date=02/06/2025",
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.DockServiceMock{
+				FuncDaySchedule: func(ctx context.Context, warehouseId int, day time.Time) (*models.DaySchedule, error) {
+					require.Equal(t, 1, warehouseId)
+					require.Equal(t, tt.wantDay, day.Format(time.DateOnly))
+					return &models.DaySchedule{WarehouseId: warehouseId, Date: tt.wantDay, Docks: []models.DockDay{}}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses/1/dockSchedule"+tt.query, nil)
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", "1")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewDockHandler(sv)
+
+			h.DaySchedule(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func requireErrorCode(t *testing.T, rec *httptest.ResponseRecorder, code string) {
+	t.Helper()
+	if code == "" {
+		return
+	}
+	var body struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, code, body.Error.Code)
+}
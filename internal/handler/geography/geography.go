@@ -3,6 +3,7 @@ package handler
 import (
 	"net/http"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/geography"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
@@ -48,6 +49,28 @@ func (h *GeographyHandler) Create(w http.ResponseWriter, r *http.Request) {
 	response.JSON(w, http.StatusCreated, s)
 }
 
+// SetCoordinates handles PUT /localities/{id}/coordinates.
+// - Responds 404 when the locality does not exist.
+func (h *GeographyHandler) SetCoordinates(w http.ResponseWriter, r *http.Request) {
+	var req models.RequestCoordinates
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateCoordinates(req.Latitude, req.Longitude); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	locality, err := h.sv.SetLocalityCoordinates(r.Context(), chi.URLParam(r, "id"), req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, locality)
+}
+
 // CountSellersByLocality handles HTTP GET requests to return the number of sellers by locality.
 // - If 'id' is provided as a query parameter, it returns the count for that locality.
 // - If 'id' is not provided, it returns the grouped counts for all localities.
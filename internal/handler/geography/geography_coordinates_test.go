@@ -0,0 +1,88 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/geography"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/geography"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/geography"
+)
+
+func TestGeographyHandler_SetCoordinates(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		serviceErr    error
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success",
+			body:       `{"latitude":-34.603722,"longitude":-58.381592}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "error - longitude missing",
+			body:          `{"latitude":-34.603722}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - latitude out of range",
+			body:          `{"latitude":-91,"longitude":-58.381592}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - locality not found",
+			body:          `{"latitude":-34.603722,"longitude":-58.381592}`,
+			serviceErr:    apperrors.NewAppError(apperrors.CodeNotFound, "locality not found"),
+			wantStatus:    http.StatusNotFound,
+			wantErrorCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.GeographyServiceMock{
+				SetLocalityCoordinatesFn: func(ctx context.Context, id string, c models.RequestCoordinates) (*models.Locality, error) {
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					return &models.Locality{Id: id, Name: "Buenos Aires", ProvinceId: 2, Latitude: c.Latitude, Longitude: c.Longitude}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/localities/1000/coordinates", strings.NewReader(tt.body))
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", "1000")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewGeographyHandler(sv)
+
+			h.SetCoordinates(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			var body struct {
+				Data  models.Locality `json:"data"`
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantErrorCode, body.Error.Code)
+			if tt.wantErrorCode == "" {
+				require.Equal(t, "1000", body.Data.Id)
+				require.Equal(t, -58.381592, *body.Data.Longitude)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"net/http"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/inventory"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inventory"
+)
+
+// InventoryHandler handles HTTP requests for the stock on hand across warehouses.
+type InventoryHandler struct {
+	sv service.InventoryService
+}
+
+// NewInventoryHandler creates a new InventoryHandler with the provided service.
+func NewInventoryHandler(sv service.InventoryService) *InventoryHandler {
+	return &InventoryHandler{
+		sv: sv,
+	}
+}
+
+// FindOnHand handles GET /inventory.
+// - Optional filters: product_id, seller_id, warehouse_id, section_id and product_type_id.
+// - 'group_by' splits each product by warehouse or section (default product).
+// - 'exclude_held' and 'exclude_expired' drop held or expired batches.
+// - Returns 400 if any query param is malformed.
+func (h *InventoryHandler) FindOnHand(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseFilter(r)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	lines, err := h.sv.FindOnHand(r.Context(), filter)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, lines)
+}
+
+// parseFilter reads the inventory filter from the query string.
+func parseFilter(r *http.Request) (models.InventoryFilter, error) {
+	f := models.InventoryFilter{GroupBy: r.URL.Query().Get("group_by")}
+	ids := []struct {
+		name string
+		dst  *int
+	}{
+		{"product_id", &f.ProductId},
+		{"seller_id", &f.SellerId},
+		{"warehouse_id", &f.WarehouseId},
+		{"section_id", &f.SectionId},
+		{"product_type_id", &f.ProductTypeId},
+	}
+	var err error
+	for _, p := range ids {
+		if *p.dst, err = httputil.ParseOptionalIntParam(r, p.name); err != nil {
+			return f, err
+		}
+	}
+	if f.ExcludeHeld, err = httputil.ParseBoolQueryParam(r, "exclude_held"); err != nil {
+		return f, err
+	}
+	if f.ExcludeExpired, err = httputil.ParseBoolQueryParam(r, "exclude_expired"); err != nil {
+		return f, err
+	}
+	return f, nil
+}
@@ -0,0 +1,97 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/inventory"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/inventory"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inventory"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestInventoryHandler_FindOnHand(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		wantFilter    models.InventoryFilter
+		serviceErr    error
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success - no filters",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:  "success - every filter",
+			query: "?product_id=22&seller_id=4&warehouse_id=1&section_id=3&product_type_id=2&group_by=section&exclude_held=true&exclude_expired=1",
+			wantFilter: models.InventoryFilter{ProductId: 22, SellerId: 4, WarehouseId: 1, SectionId: 3, ProductTypeId: 2,
+				GroupBy: models.GroupBySection, ExcludeHeld: true, ExcludeExpired: true},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "error - invalid id filter",
+			query:         "?warehouse_id=north",
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:          "error - invalid boolean",
+			query:         "?exclude_held=maybe",
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:          "error - service rejects grouping",
+			query:         "?group_by=seller",
+			wantFilter:    models.InventoryFilter{GroupBy: "seller"},
+			serviceErr:    apperrors.NewAppError(apperrors.CodeBadRequest, "group_by must be one of product, warehouse or section"),
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.InventoryServiceMock{
+				FuncFindOnHand: func(ctx context.Context, filter models.InventoryFilter) ([]models.InventoryLine, error) {
+					require.Equal(t, tt.wantFilter, filter)
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					return []models.InventoryLine{testhelpers.DummyInventoryLine(22)}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/inventory"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			h := handler.NewInventoryHandler(sv)
+
+			h.FindOnHand(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var envelope struct {
+					Data []models.InventoryLine `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &envelope))
+				require.Equal(t, []models.InventoryLine{testhelpers.DummyInventoryLine(22)}, envelope.Data)
+				return
+			}
+
+			var body struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantErrorCode, body.Error.Code)
+		})
+	}
+}
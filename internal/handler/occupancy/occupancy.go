@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/occupancy"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+)
+
+// OccupancyHandler handles HTTP requests for the warehouse capacity dashboard.
+type OccupancyHandler struct {
+	sv service.OccupancyService
+}
+
+// NewOccupancyHandler creates a new OccupancyHandler with the provided service.
+func NewOccupancyHandler(sv service.OccupancyService) *OccupancyHandler {
+	return &OccupancyHandler{
+		sv: sv,
+	}
+}
+
+// FindAll handles GET /warehouses/occupancy.
+// - 'within' sets the window used to count batches near expiry (default 7d).
+func (h *OccupancyHandler) FindAll(w http.ResponseWriter, r *http.Request) {
+	within, err := httputil.ParseDurationQueryParam(r, "within", 7*24*time.Hour)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	occupancy, err := h.sv.FindAll(r.Context(), within)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, occupancy)
+}
+
+// FindById handles GET /warehouses/{id}/occupancy.
+// - 'within' sets the window used to count batches near expiry (default 7d).
+// - Returns 404 if the warehouse does not exist.
+func (h *OccupancyHandler) FindById(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	within, err := httputil.ParseDurationQueryParam(r, "within", 7*24*time.Hour)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	occupancy, err := h.sv.FindById(r.Context(), id, within)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, occupancy)
+}
@@ -0,0 +1,128 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/occupancy"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/occupancy"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/occupancy"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestOccupancyHandler_FindById(t *testing.T) {
+	tests := []struct {
+		name          string
+		routeID       string
+		query         string
+		mockService   func() *mocks.OccupancyServiceMock
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:    "success - default expiry window",
+			routeID: "1",
+			mockService: func() *mocks.OccupancyServiceMock {
+				return &mocks.OccupancyServiceMock{
+					FuncFindById: func(ctx context.Context, warehouseId int, within time.Duration) (*models.WarehouseOccupancy, error) {
+						if within != 7*24*time.Hour {
+							return nil, apperrors.NewAppError(apperrors.CodeInternal, "unexpected window")
+						}
+						o := testhelpers.DummyWarehouseOccupancy(warehouseId)
+						return &o, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:    "success - custom expiry window",
+			routeID: "1",
+			query:   "?within=30d",
+			mockService: func() *mocks.OccupancyServiceMock {
+				return &mocks.OccupancyServiceMock{
+					FuncFindById: func(ctx context.Context, warehouseId int, within time.Duration) (*models.WarehouseOccupancy, error) {
+						if within != 30*24*time.Hour {
+							return nil, apperrors.NewAppError(apperrors.CodeInternal, "unexpected window")
+						}
+						o := testhelpers.DummyWarehouseOccupancy(warehouseId)
+						return &o, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:    "error - invalid id",
+			routeID: "abc",
+			mockService: func() *mocks.OccupancyServiceMock {
+				return &mocks.OccupancyServiceMock{}
+			},
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:    "error - invalid window",
+			routeID: "1",
+			query:   "?within=soon",
+			mockService: func() *mocks.OccupancyServiceMock {
+				return &mocks.OccupancyServiceMock{}
+			},
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:    "error - warehouse not found",
+			routeID: "99",
+			mockService: func() *mocks.OccupancyServiceMock {
+				return &mocks.OccupancyServiceMock{
+					FuncFindById: func(ctx context.Context, warehouseId int, within time.Duration) (*models.WarehouseOccupancy, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeNotFound, "warehouse not found")
+					},
+				}
+			},
+			wantStatus:    http.StatusNotFound,
+			wantErrorCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/warehouses/"+tt.routeID+"/occupancy"+tt.query, nil)
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", tt.routeID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewOccupancyHandler(tt.mockService())
+
+			h.FindById(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var envelope struct {
+					Data models.WarehouseOccupancy `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &envelope))
+				require.Equal(t, 1, envelope.Data.WarehouseId)
+				require.Equal(t, 300, envelope.Data.TotalCapacity)
+				return
+			}
+
+			var body struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantErrorCode, body.Error.Code)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"net/http"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/picking"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/picking"
+)
+
+// PickingHandler handles HTTP requests for pick waves, pick lists and pick shortages.
+type PickingHandler struct {
+	sv service.PickingService
+}
+
+// NewPickingHandler creates a new PickingHandler with the provided service.
+func NewPickingHandler(sv service.PickingService) *PickingHandler {
+	return &PickingHandler{
+		sv: sv,
+	}
+}
+
+// FindWaves handles GET /pickWaves.
+// - 'warehouse_id' and 'status' optionally filter the waves.
+func (h *PickingHandler) FindWaves(w http.ResponseWriter, r *http.Request) {
+	warehouseId, err := httputil.ParseOptionalIntParam(r, "warehouse_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	status := r.URL.Query().Get("status")
+	if err := validators.ValidateWaveStatus(status); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	waves, err := h.sv.FindWaves(r.Context(), warehouseId, status)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, waves)
+}
+
+// CreateWave handles POST /pickWaves.
+// - Responds 422 when the warehouse has no confirmed purchase orders left to pick.
+func (h *PickingHandler) CreateWave(w http.ResponseWriter, r *http.Request) {
+	var req models.PostWave
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateWavePost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	wave, err := h.sv.CreateWave(r.Context(), req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, wave)
+}
+
+// FindWave handles GET /pickWaves/{id}.
+func (h *PickingHandler) FindWave(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	wave, err := h.sv.FindWave(r.Context(), id)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, wave)
+}
+
+// FindList handles GET /pickLists/{id}.
+func (h *PickingHandler) FindList(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	list, err := h.sv.FindList(r.Context(), id)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, list)
+}
+
+// Confirm handles POST /pickLists/{id}/confirm.
+// - Responds 409 when the pick list is already confirmed.
+// - Responds 422 when the lines do not match the pick list or the employee works at another warehouse.
+func (h *PickingHandler) Confirm(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	var req models.PostPickConfirmation
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidatePickConfirmation(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	confirmation, err := h.sv.Confirm(r.Context(), id, req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, confirmation)
+}
+
+// Shortages handles GET /pickShortages.
+// - 'warehouse_id' optionally filters the shortages.
+func (h *PickingHandler) Shortages(w http.ResponseWriter, r *http.Request) {
+	warehouseId, err := httputil.ParseOptionalIntParam(r, "warehouse_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	shortages, err := h.sv.Shortages(r.Context(), warehouseId)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, shortages)
+}
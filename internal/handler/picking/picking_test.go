@@ -0,0 +1,210 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/picking"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/picking"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/picking"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestPickingHandler_CreateWave(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		serviceErr    error
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success",
+			body:       `{"warehouse_id":1,"max_lines":20}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:          "error - missing warehouse",
+			body:          `{"max_lines":20}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - negative max_lines",
+			body:          `{"warehouse_id":1,"max_lines":-1}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - nothing to pick",
+			body:          `{"warehouse_id":1}`,
+			serviceErr:    apperrors.NewAppError(apperrors.CodeValidationError, "no confirmed purchase orders to pick at the warehouse"),
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.PickingServiceMock{
+				FuncCreateWave: func(ctx context.Context, req models.PostWave) (*models.WaveDetail, error) {
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					return &models.WaveDetail{Wave: models.Wave{Id: 2, WarehouseId: req.WarehouseId}, Lists: []models.PickList{}}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/pickWaves", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			h := handler.NewPickingHandler(sv)
+
+			h.CreateWave(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func TestPickingHandler_FindWaves(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success - filtered",
+			query:      "?warehouse_id=1&status=open",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "error - unknown status",
+			query:         "?status=done",
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.PickingServiceMock{
+				FuncFindWaves: func(ctx context.Context, warehouseId int, status string) ([]models.Wave, error) {
+					require.Equal(t, 1, warehouseId)
+					require.Equal(t, models.WaveStatusOpen, status)
+					return []models.Wave{}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/pickWaves"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			h := handler.NewPickingHandler(sv)
+
+			h.FindWaves(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func TestPickingHandler_Confirm(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		serviceErr    error
+		wantStatus    int
+		wantErrorCode string
+		wantShortages int
+	}{
+		{
+			name:          "success - short pick reported",
+			body:          `{"employee_id":6,"lines":[{"line_id":1,"picked_quantity":3}]}`,
+			wantStatus:    http.StatusOK,
+			wantShortages: 1,
+		},
+		{
+			name:          "error - missing picked quantity",
+			body:          `{"employee_id":6,"lines":[{"line_id":1}]}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - negative picked quantity",
+			body:          `{"employee_id":6,"lines":[{"line_id":1,"picked_quantity":-1}]}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - no lines",
+			body:          `{"employee_id":6,"lines":[]}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - list already confirmed",
+			body:          `{"employee_id":6,"lines":[{"line_id":1,"picked_quantity":5}]}`,
+			serviceErr:    apperrors.NewAppError(apperrors.CodeConflict, "pick list is already confirmed"),
+			wantStatus:    http.StatusConflict,
+			wantErrorCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.PickingServiceMock{
+				FuncConfirm: func(ctx context.Context, listId int, req models.PostPickConfirmation) (*models.PickConfirmation, error) {
+					require.Equal(t, 9, listId)
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					line := testhelpers.DummyPickLine(1, 5)
+					line.PickedQuantity = req.Lines[0].PickedQuantity
+					return &models.PickConfirmation{
+						PickList:  models.PickList{Id: listId, Status: models.ListStatusConfirmed, Lines: []models.PickLine{line}},
+						Shortages: []models.PickShortage{{PickListId: listId, Quantity: 5, PickedQuantity: 3, ShortQuantity: 2}},
+					}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/pickLists/9/confirm", strings.NewReader(tt.body))
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", "9")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewPickingHandler(sv)
+
+			h.Confirm(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+			if tt.wantErrorCode == "" {
+				var body struct {
+					Data models.PickConfirmation `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				require.Len(t, body.Data.Shortages, tt.wantShortages)
+			}
+		})
+	}
+}
+
+func requireErrorCode(t *testing.T, rec *httptest.ResponseRecorder, code string) {
+	t.Helper()
+	if code == "" {
+		return
+	}
+	var body struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, code, body.Error.Code)
+}
@@ -4,6 +4,7 @@ import (
 	svsProductBatch "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/product_batch"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/mappers"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
@@ -49,13 +50,19 @@ func (h *ProductBatchesHandler) CreateProductBatches(w http.ResponseWriter, r *h
 }
 
 // GetReportProduct handles GET requests for product batch reports.
-// - If 'id' query param is present, returns report for specific section; else returns all.
-// - Returns 400 if 'id' is not a valid integer.
+// - If 'id' query param is present, returns report for specific section; else returns all,
+// optionally restricted to the sections of the 'warehouse_id' query param.
+// - Returns 400 if 'id' or 'warehouse_id' is not a valid integer.
 func (h *ProductBatchesHandler) GetReportProduct(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	id := r.URL.Query().Get("id")
 	if id == "" {
-		report, err := h.sv.GetReportProduct(ctx)
+		warehouseId, err := httputil.ParseOptionalIntParam(r, "warehouse_id")
+		if err != nil {
+			response.Error(w, err)
+			return
+		}
+		report, err := h.sv.GetReportProduct(ctx, warehouseId)
 		if err != nil {
 			response.Error(w, err)
 			return
@@ -77,3 +84,27 @@ func (h *ProductBatchesHandler) GetReportProduct(w http.ResponseWriter, r *http.
 
 	response.JSON(w, http.StatusOK, report)
 }
+
+// GetExpiring handles GET requests for batches near or past their due date.
+// - 'within' sets the look-ahead window (default 7d); 'warehouse_id' optionally restricts to one warehouse.
+// - Returns 400 if either query param is malformed.
+func (h *ProductBatchesHandler) GetExpiring(w http.ResponseWriter, r *http.Request) {
+	within, err := httputil.ParseDurationQueryParam(r, "within", 7*24*time.Hour)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	warehouseId, err := httputil.ParseOptionalIntParam(r, "warehouse_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	batches, err := h.sv.GetExpiring(r.Context(), within, warehouseId)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, batches)
+}
@@ -0,0 +1,74 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/product_batch"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/product_batch"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestProductBatchesHandler_GetExpiring(t *testing.T) {
+	due := time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		query         string
+		wantWithin    time.Duration
+		wantWarehouse int
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{name: "success - defaults to seven days", query: "", wantWithin: 7 * 24 * time.Hour, wantStatus: http.StatusOK},
+		{name: "success - days and warehouse", query: "within=3d&warehouse_id=2", wantWithin: 3 * 24 * time.Hour, wantWarehouse: 2, wantStatus: http.StatusOK},
+		{name: "success - hours", query: "within=12h", wantWithin: 12 * time.Hour, wantStatus: http.StatusOK},
+		{name: "error - invalid within", query: "within=soon", wantStatus: http.StatusBadRequest, wantErrorCode: apperrors.CodeBadRequest},
+		{name: "error - invalid warehouse id", query: "warehouse_id=abc", wantStatus: http.StatusBadRequest, wantErrorCode: apperrors.CodeBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotWithin time.Duration
+			var gotWarehouse int
+			mock := &mocks.ProductBatchServiceMock{
+				FuncGetExpiring: func(ctx context.Context, within time.Duration, warehouseId int) ([]models.ExpiringBatch, error) {
+					gotWithin, gotWarehouse = within, warehouseId
+					return []models.ExpiringBatch{testhelpers.DummyExpiringBatch(1, due)}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/productBatches/expiring?"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			h := handler.NewProductBatchesHandler(mock)
+
+			h.GetExpiring(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				require.Equal(t, tt.wantWithin, gotWithin)
+				require.Equal(t, tt.wantWarehouse, gotWarehouse)
+				var envelope struct {
+					Data []models.ExpiringBatch `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &envelope))
+				require.Equal(t, []models.ExpiringBatch{testhelpers.DummyExpiringBatch(1, due)}, envelope.Data)
+				return
+			}
+
+			var body struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantErrorCode, body.Error.Code)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -30,7 +31,7 @@ func TestProductBatchesHandler_GetReportProduct(t *testing.T) {
 			query: "",
 			mockService: func() *mocks.ProductBatchServiceMock {
 				mock := &mocks.ProductBatchServiceMock{}
-				mock.FuncGetReport = func(ctx context.Context) ([]models.ReportProduct, error) {
+				mock.FuncGetReport = func(ctx context.Context, warehouseId int) ([]models.ReportProduct, error) {
 					return testhelpers.DummyReportProductsList(), nil
 				}
 				return mock
@@ -52,6 +53,31 @@ func TestProductBatchesHandler_GetReportProduct(t *testing.T) {
 			wantStatus:   http.StatusOK,
 			wantResponse: testhelpers.DummyReportProduct(),
 		},
+		{
+			name:  "success - filters by warehouse",
+			query: "warehouse_id=2",
+			mockService: func() *mocks.ProductBatchServiceMock {
+				mock := &mocks.ProductBatchServiceMock{}
+				mock.FuncGetReport = func(ctx context.Context, warehouseId int) ([]models.ReportProduct, error) {
+					if warehouseId != 2 {
+						return nil, apperrors.NewAppError(apperrors.CodeInternal, "unexpected warehouse filter")
+					}
+					return []models.ReportProduct{}, nil
+				}
+				return mock
+			},
+			wantStatus:   http.StatusOK,
+			wantResponse: []models.ReportProduct{},
+		},
+		{
+			name:  "error - invalid warehouse_id query parameter",
+			query: "warehouse_id=north",
+			mockService: func() *mocks.ProductBatchServiceMock {
+				return &mocks.ProductBatchServiceMock{}
+			},
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
 		{
 			name:  "error - invalid id query parameter",
 			query: "id=pepe",
@@ -67,7 +93,7 @@ func TestProductBatchesHandler_GetReportProduct(t *testing.T) {
 			query: "",
 			mockService: func() *mocks.ProductBatchServiceMock {
 				mock := &mocks.ProductBatchServiceMock{}
-				mock.FuncGetReport = func(ctx context.Context) ([]models.ReportProduct, error) {
+				mock.FuncGetReport = func(ctx context.Context, warehouseId int) ([]models.ReportProduct, error) {
 					return nil, apperrors.NewAppError(apperrors.CodeInternal, "db fail")
 				}
 				return mock
@@ -109,7 +135,7 @@ func TestProductBatchesHandler_GetReportProduct(t *testing.T) {
 			require.Equal(t, tt.wantStatus, rec.Code)
 
 			if tt.wantStatus == http.StatusOK {
-				if tt.query == "" {
+				if !strings.HasPrefix(tt.query, "id=") {
 					// 🟢 Usamos el envelope correcto:
 					var envelope struct {
 						Data []models.ReportProduct `json:"data"`
@@ -0,0 +1,155 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/product_record"
+	productrecordmock "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/product_record"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_record"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func sampleMarginReport() *models.MarginReport {
+	rate := 0.375
+	return &models.MarginReport{
+		GroupBy: models.GroupBySeller,
+		From:    "2025-01-01",
+		To:      "2025-03-31",
+		Lines: []models.MarginReportLine{
+			{Key: "1", Name: "Frutas SA", Orders: 3, Units: 12, Revenue: 240, Cost: 150, Margin: 90, MarginRate: &rate},
+			{Key: "2", Name: "Lacteos", Orders: 1, Units: 2, Cost: 5, Margin: -5},
+		},
+		Revenue: 240,
+		Cost:    155,
+		Margin:  85,
+	}
+}
+
+// groupedBy matches the filter the handler hands to the service by its grouping and, when set, its range.
+func groupedBy(groupBy, from, to string) interface{} {
+	return mock.MatchedBy(func(f models.MarginReportFilter) bool {
+		if f.GroupBy != groupBy {
+			return false
+		}
+		if from == "" {
+			return !f.To.Before(f.From)
+		}
+		return f.From.Format(time.DateOnly) == from && f.To.Format(time.DateOnly) == to
+	})
+}
+
+/*
+Test for GET /productRecords/marginReport handler.
+
+Covered scenarios
+  - 200 OK  (default grouping and range)
+  - 200 OK  (CSV download)
+  - 400 BAD_REQUEST     – unknown group_by, reversed range, bad date, unknown format
+  - 500 INTERNAL_ERROR  – service failure
+*/
+func TestProductRecordHandler_GetMarginReport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		url       string
+		mockSetup func(*productrecordmock.MockProductRecordService)
+		status    int
+		appErr    string // expected AppError.Code ("" == success)
+		wantCSV   string
+	}{
+		{
+			name: "default_grouping_success",
+			url:  "/productRecords/marginReport",
+			mockSetup: func(m *productrecordmock.MockProductRecordService) {
+				m.On("GetMarginReport", mock.Anything, groupedBy(models.GroupByProduct, "", "")).
+					Return(sampleMarginReport(), nil).Once()
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "csv_success",
+			url:  "/productRecords/marginReport?group_by=seller&from=2025-01-01&to=2025-03-31&format=csv",
+			mockSetup: func(m *productrecordmock.MockProductRecordService) {
+				m.On("GetMarginReport", mock.Anything, groupedBy(models.GroupBySeller, "2025-01-01", "2025-03-31")).
+					Return(sampleMarginReport(), nil).Once()
+			},
+			status: http.StatusOK,
+			wantCSV: "key,name,orders,units,revenue,cost,margin,margin_rate\n" +
+				"1,Frutas SA,3,12,240.00,150.00,90.00,0.375\n" +
+				"2,Lacteos,1,2,0.00,5.00,-5.00,\n",
+		},
+		{
+			name:      "unknown_group_by",
+			url:       "/productRecords/marginReport?group_by=warehouse",
+			mockSetup: func(_ *productrecordmock.MockProductRecordService) {},
+			status:    http.StatusBadRequest,
+			appErr:    apperrors.CodeBadRequest,
+		},
+		{
+			name:      "reversed_range",
+			url:       "/productRecords/marginReport?from=2025-03-31&to=2025-01-01",
+			mockSetup: func(_ *productrecordmock.MockProductRecordService) {},
+			status:    http.StatusBadRequest,
+			appErr:    apperrors.CodeBadRequest,
+		},
+		{
+			name:      "invalid_date",
+			url:       "/productRecords/marginReport?from=31-01-2025",
+			mockSetup: func(_ *productrecordmock.MockProductRecordService) {},
+			status:    http.StatusBadRequest,
+			appErr:    apperrors.CodeBadRequest,
+		},
+		{
+			name:      "unknown_format",
+			url:       "/productRecords/marginReport?format=xml",
+			mockSetup: func(_ *productrecordmock.MockProductRecordService) {},
+			status:    http.StatusBadRequest,
+			appErr:    apperrors.CodeBadRequest,
+		},
+		{
+			name: "service_internal_error",
+			url:  "/productRecords/marginReport?group_by=month",
+			mockSetup: func(m *productrecordmock.MockProductRecordService) {
+				m.On("GetMarginReport", mock.Anything, groupedBy(models.GroupByMonth, "", "")).
+					Return(nil, apperrors.NewAppError(apperrors.CodeInternal, "db down")).Once()
+			},
+			status: http.StatusInternalServerError,
+			appErr: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// arrange
+			svc := &productrecordmock.MockProductRecordService{}
+			tc.mockSetup(svc)
+			h := handler.NewProductRecordHandler(svc)
+
+			// act
+			rec := testhelpers.DoRequest(t, http.MethodGet, tc.url, nil, http.HandlerFunc(h.GetMarginReport))
+
+			// assert
+			require.Equal(t, tc.status, rec.Code)
+			if tc.appErr != "" {
+				app, derr := testhelpers.DecodeAppErr(rec.Body)
+				require.NoError(t, derr)
+				testhelpers.RequireAppErr(t, app, tc.appErr)
+			}
+			if tc.wantCSV != "" {
+				require.Equal(t, "text/csv", rec.Header().Get("Content-Type"))
+				require.Equal(t, tc.wantCSV, rec.Body.String())
+			}
+			svc.AssertExpectations(t)
+		})
+	}
+}
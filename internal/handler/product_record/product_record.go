@@ -4,6 +4,8 @@ import (
 	productRecordMappers "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/mappers/product_record"
 	productRecordService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/product_record"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
@@ -67,3 +69,63 @@ func (h *ProductRecordHandler) GetRecordsReport(w http.ResponseWriter, r *http.R
 
 	response.JSON(w, http.StatusOK, report)
 }
+
+// GetMarginReport handles GET /productRecords/marginReport.
+// - 'group_by' is product, seller, product_type, buyer or month (default product).
+// - 'from' and 'to' bound the order dates, as YYYY-MM-DD (default the last 12 months).
+// - 'format=csv' downloads the report lines as CSV instead of JSON.
+func (h *ProductRecordHandler) GetMarginReport(w http.ResponseWriter, r *http.Request) {
+	to, err := httputil.ParseDateQueryParam(r, "to", time.Now())
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	from, err := httputil.ParseDateQueryParam(r, "from", to.AddDate(-1, 0, 1))
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	filter := models.MarginReportFilter{GroupBy: r.URL.Query().Get("group_by"), From: from, To: to}
+	if filter.GroupBy == "" {
+		filter.GroupBy = models.GroupByProduct
+	}
+	if err := validators.ValidateMarginReportFilter(filter); err != nil {
+		response.Error(w, err)
+		return
+	}
+	format := r.URL.Query().Get("format")
+	if err := validators.ValidateReportFormat(format); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	report, err := h.svc.GetMarginReport(r.Context(), filter)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	if format == "csv" {
+		response.CSV(w, http.StatusOK, "margin_report_"+filter.GroupBy+".csv", marginHeader, marginRecords(report.Lines))
+		return
+	}
+	response.JSON(w, http.StatusOK, report)
+}
+
+var marginHeader = []string{"key", "name", "orders", "units", "revenue", "cost", "margin", "margin_rate"}
+
+// marginRecords lays out the report lines in the order of marginHeader, leaving missing margin rates empty.
+func marginRecords(lines []models.MarginReportLine) [][]string {
+	records := make([][]string, len(lines))
+	for i, l := range lines {
+		rate := ""
+		if l.MarginRate != nil {
+			rate = strconv.FormatFloat(*l.MarginRate, 'f', -1, 64)
+		}
+		records[i] = []string{
+			l.Key, l.Name, strconv.Itoa(l.Orders), strconv.Itoa(l.Units), strconv.FormatFloat(l.Revenue, 'f', 2, 64),
+			strconv.FormatFloat(l.Cost, 'f', 2, 64), strconv.FormatFloat(l.Margin, 'f', 2, 64), rate,
+		}
+	}
+	return records
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"errors"
 	"net/http"
+	"time"
 
 	purchaseOrderService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/purchase_order"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
@@ -68,3 +69,65 @@ func (h *PurchaseOrderHandler) GetReport(w http.ResponseWriter, r *http.Request)
 
 	response.JSON(w, http.StatusOK, report)
 }
+
+// History handles GET /buyers/{id}/purchaseOrders.
+// - 'from' and 'to' bound the days the orders were placed, as YYYY-MM-DD (default the last 12 months).
+// - 'page' and 'page_size' pick the page of orders, newest first (default 1 and 20, at most 100 per page).
+// - 'period' groups the totals by day, month (default) or year.
+func (h *PurchaseOrderHandler) History(w http.ResponseWriter, r *http.Request) {
+	buyerID, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	to, err := httputil.ParseDateQueryParam(r, "to", time.Now())
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	from, err := httputil.ParseDateQueryParam(r, "from", to.AddDate(-1, 0, 1))
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	page, err := httputil.ParseOptionalIntParam(r, "page")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	pageSize, err := httputil.ParseOptionalIntParam(r, "page_size")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	filter := models.PurchaseHistoryFilter{
+		BuyerID:  buyerID,
+		From:     from,
+		To:       to,
+		Period:   r.URL.Query().Get("period"),
+		Page:     page,
+		PageSize: pageSize,
+	}
+	if !r.URL.Query().Has("period") {
+		filter.Period = models.PeriodMonth
+	}
+	if !r.URL.Query().Has("page") {
+		filter.Page = 1
+	}
+	if !r.URL.Query().Has("page_size") {
+		filter.PageSize = 20
+	}
+	if err := validators.ValidatePurchaseHistoryFilter(filter); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	history, err := h.service.History(r.Context(), filter)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, history)
+}
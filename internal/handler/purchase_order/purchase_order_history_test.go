@@ -0,0 +1,110 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/purchase_order"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/purchase_order"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+)
+
+func TestPurchaseOrderHandler_History(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		want          models.PurchaseHistoryFilter
+		serviceErr    error
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:  "success - given filters",
+			query: "?from=2025-01-01&to=2025-06-30&page=2&page_size=50&period=year",
+			want: models.PurchaseHistoryFilter{
+				BuyerID: 101, From: time.Date(2025, 1, 1, 0, 0, 0, 0, time.Local), To: time.Date(2025, 6, 30, 0, 0, 0, 0, time.Local),
+				Period: models.PeriodYear, Page: 2, PageSize: 50,
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:  "success - defaults",
+			query: "?to=2025-06-30",
+			want: models.PurchaseHistoryFilter{
+				BuyerID: 101, From: time.Date(2024, 7, 1, 0, 0, 0, 0, time.Local), To: time.Date(2025, 6, 30, 0, 0, 0, 0, time.Local),
+				Period: models.PeriodMonth, Page: 1, PageSize: 20,
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "error - from after to",
+			query:         "?from=2025-07-01&to=2025-06-30",
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:          "error - unknown period",
+			query:         "?period=week",
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:          "error - page size too large",
+			query:         "?page_size=500",
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:          "error - page zero",
+			query:         "?page=0",
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:          "error - buyer missing",
+			serviceErr:    apperrors.NewAppError(apperrors.CodeNotFound, "buyer not found"),
+			wantStatus:    http.StatusNotFound,
+			wantErrorCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.PurchaseOrderServiceMock{
+				HistoryFn: func(ctx context.Context, filter models.PurchaseHistoryFilter) (*models.PurchaseHistory, error) {
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					require.Equal(t, tt.want, filter)
+					return &models.PurchaseHistory{BuyerID: filter.BuyerID, Orders: []models.PurchaseHistoryOrder{}, Totals: []models.PeriodTotal{}}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/buyers/101/purchaseOrders"+tt.query, nil)
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", "101")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewPurchaseOrderHandler(sv)
+
+			h.History(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantErrorCode != "" {
+				var body struct {
+					Error struct {
+						Code string `json:"code"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				require.Equal(t, tt.wantErrorCode, body.Error.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"net/http"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/recall"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/recall"
+)
+
+// RecallHandler handles HTTP requests for product recalls.
+type RecallHandler struct {
+	sv service.RecallService
+}
+
+// NewRecallHandler creates a new RecallHandler with the provided service.
+func NewRecallHandler(sv service.RecallService) *RecallHandler {
+	return &RecallHandler{
+		sv: sv,
+	}
+}
+
+// Create handles POST /recalls.
+// - Places every affected batch on hold and responds with the buyers to notify.
+// - Responds 404 when a batch does not exist or the product has no batches.
+func (h *RecallHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req models.PostRecall
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateRecallPost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	recall, err := h.sv.Create(r.Context(), req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, recall)
+}
+
+// FindById handles GET /recalls/{id}.
+func (h *RecallHandler) FindById(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	recall, err := h.sv.FindById(r.Context(), id)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, recall)
+}
@@ -0,0 +1,116 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/recall"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/recall"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/recall"
+	traceModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/traceability"
+)
+
+func TestRecallHandler_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		mockService   func() *mocks.RecallServiceMock
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name: "success",
+			body: `{"product_batch_ids": [1, 2], "note": "listeria", "actor": "qa.lead"}`,
+			mockService: func() *mocks.RecallServiceMock {
+				return &mocks.RecallServiceMock{
+					FuncCreate: func(ctx context.Context, req models.PostRecall) (*models.Recall, error) {
+						return &models.Recall{
+							Id:      1,
+							Actor:   req.Actor,
+							Batches: []models.RecallBatch{{ProductBatchId: 1, Held: true}, {ProductBatchId: 2, Held: true}},
+							Buyers:  []traceModels.AffectedBuyer{{BuyerId: 10}},
+						}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "error - batches and product both given",
+			body: `{"product_batch_ids": [1], "product_id": 22, "actor": "qa.lead"}`,
+			mockService: func() *mocks.RecallServiceMock {
+				return &mocks.RecallServiceMock{}
+			},
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name: "error - nothing to recall",
+			body: `{"actor": "qa.lead"}`,
+			mockService: func() *mocks.RecallServiceMock {
+				return &mocks.RecallServiceMock{}
+			},
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name: "error - invalid json",
+			body: `{"product_batch_ids": "1"}`,
+			mockService: func() *mocks.RecallServiceMock {
+				return &mocks.RecallServiceMock{}
+			},
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name: "error - batch not found",
+			body: `{"product_batch_ids": [99], "actor": "qa.lead"}`,
+			mockService: func() *mocks.RecallServiceMock {
+				return &mocks.RecallServiceMock{
+					FuncCreate: func(ctx context.Context, req models.PostRecall) (*models.Recall, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeNotFound, "product batches not found")
+					},
+				}
+			},
+			wantStatus:    http.StatusNotFound,
+			wantErrorCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/recalls", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			h := handler.NewRecallHandler(tt.mockService())
+
+			h.Create(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusCreated {
+				var envelope struct {
+					Data models.Recall `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &envelope))
+				require.Len(t, envelope.Data.Batches, 2)
+				require.Len(t, envelope.Data.Buyers, 1)
+				return
+			}
+
+			var body struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantErrorCode, body.Error.Code)
+		})
+	}
+}
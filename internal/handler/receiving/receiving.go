@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/receiving"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/receiving"
+)
+
+// ReceivingHandler handles HTTP requests to receive product batches into warehouses.
+type ReceivingHandler struct {
+	sv service.ReceivingService
+}
+
+// NewReceivingHandler creates a new ReceivingHandler with the provided service.
+func NewReceivingHandler(sv service.ReceivingService) *ReceivingHandler {
+	return &ReceivingHandler{
+		sv: sv,
+	}
+}
+
+// Receive handles POST /inboundOrders/receive.
+// - Creates the product batch and its inbound order in one transaction.
+// - Responds 422 when the employee or the section does not belong to the warehouse, or the section is full.
+func (h *ReceivingHandler) Receive(w http.ResponseWriter, r *http.Request) {
+	var req models.PostReceipt
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateReceiptPost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	receipt, err := h.sv.Receive(r.Context(), req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, receipt)
+}
@@ -0,0 +1,111 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/receiving"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/receiving"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	inboundOrderModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inbound_order"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/receiving"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestReceivingHandler_Receive(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          func() any
+		mockService   func() *mocks.ReceivingServiceMock
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name: "success",
+			body: func() any { return testhelpers.DummyPostReceipt() },
+			mockService: func() *mocks.ReceivingServiceMock {
+				return &mocks.ReceivingServiceMock{
+					FuncReceive: func(ctx context.Context, req models.PostReceipt) (*models.Receipt, error) {
+						return &models.Receipt{
+							InboundOrder: inboundOrderModels.InboundOrder{ID: 1, OrderNumber: req.OrderNumber, ProductBatchID: 9},
+							ProductBatch: testhelpers.DummyResponseProductBatch(9),
+						}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "error - batch without stock",
+			body: func() any {
+				req := testhelpers.DummyPostReceipt()
+				req.ProductBatch.CurrentQuantity = testhelpers.IntPtr(0)
+				return req
+			},
+			mockService:   func() *mocks.ReceivingServiceMock { return &mocks.ReceivingServiceMock{} },
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name: "error - missing order number",
+			body: func() any {
+				req := testhelpers.DummyPostReceipt()
+				req.OrderNumber = ""
+				return req
+			},
+			mockService:   func() *mocks.ReceivingServiceMock { return &mocks.ReceivingServiceMock{} },
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name: "error - section in another warehouse",
+			body: func() any { return testhelpers.DummyPostReceipt() },
+			mockService: func() *mocks.ReceivingServiceMock {
+				return &mocks.ReceivingServiceMock{
+					FuncReceive: func(ctx context.Context, req models.PostReceipt) (*models.Receipt, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeValidationError, "section does not belong to the receiving warehouse")
+					},
+				}
+			},
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := json.Marshal(tt.body())
+			require.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/inboundOrders/receive", bytes.NewReader(payload))
+			rec := httptest.NewRecorder()
+			h := handler.NewReceivingHandler(tt.mockService())
+
+			h.Receive(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusCreated {
+				var envelope struct {
+					Data models.Receipt `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &envelope))
+				require.Equal(t, 9, envelope.Data.ProductBatch.Id)
+				require.Equal(t, 9, envelope.Data.InboundOrder.ProductBatchID)
+				return
+			}
+
+			var body struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantErrorCode, body.Error.Code)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/replenishment"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/replenishment"
+)
+
+// ReplenishmentHandler handles HTTP requests for reorder points and replenishment suggestions.
+type ReplenishmentHandler struct {
+	sv service.ReplenishmentService
+}
+
+// NewReplenishmentHandler creates a new ReplenishmentHandler with the provided service.
+func NewReplenishmentHandler(sv service.ReplenishmentService) *ReplenishmentHandler {
+	return &ReplenishmentHandler{
+		sv: sv,
+	}
+}
+
+// FindAll handles GET /reorderPoints.
+// - 'warehouse_id' and 'product_id' optionally filter the reorder points.
+func (h *ReplenishmentHandler) FindAll(w http.ResponseWriter, r *http.Request) {
+	warehouseId, err := httputil.ParseOptionalIntParam(r, "warehouse_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	productId, err := httputil.ParseOptionalIntParam(r, "product_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	points, err := h.sv.FindAll(r.Context(), warehouseId, productId)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, points)
+}
+
+// Create handles POST /reorderPoints.
+// - Responds 409 when the product already has a reorder point at the warehouse.
+func (h *ReplenishmentHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req models.PostReorderPoint
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateReorderPointPost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	point, err := h.sv.Create(r.Context(), req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, point)
+}
+
+// Update handles PATCH /reorderPoints/{id}.
+func (h *ReplenishmentHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	var req models.PatchReorderPoint
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if req.ReorderPoint == nil && req.TargetQuantity == nil {
+		response.Error(w, apperrors.NewAppError(apperrors.CodeValidationError, "at least one field must be provided"))
+		return
+	}
+
+	point, err := h.sv.Update(r.Context(), id, req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, point)
+}
+
+// Delete handles DELETE /reorderPoints/{id}.
+func (h *ReplenishmentHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	if err := h.sv.Delete(r.Context(), id); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, nil)
+}
+
+// Report handles GET /replenishment.
+// - 'warehouse_id' and 'seller_id' optionally narrow the report.
+// - 'window' sets how far back purchase order demand is counted (default 30d).
+func (h *ReplenishmentHandler) Report(w http.ResponseWriter, r *http.Request) {
+	warehouseId, err := httputil.ParseOptionalIntParam(r, "warehouse_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	sellerId, err := httputil.ParseOptionalIntParam(r, "seller_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	window, err := httputil.ParseDurationQueryParam(r, "window", 30*24*time.Hour)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	report, err := h.sv.Report(r.Context(), warehouseId, sellerId, window)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, report)
+}
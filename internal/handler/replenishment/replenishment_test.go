@@ -0,0 +1,183 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/replenishment"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/replenishment"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/replenishment"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestReplenishmentHandler_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		mockService   func() *mocks.ReplenishmentServiceMock
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name: "success",
+			body: `{"product_id":1,"warehouse_id":1,"reorder_point":20,"target_quantity":100}`,
+			mockService: func() *mocks.ReplenishmentServiceMock {
+				return &mocks.ReplenishmentServiceMock{
+					FuncCreate: func(ctx context.Context, p models.PostReorderPoint) (*models.ReorderPoint, error) {
+						point := testhelpers.DummyReorderPoint(1)
+						return &point, nil
+					},
+				}
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:          "error - target not above reorder point",
+			body:          `{"product_id":1,"warehouse_id":1,"reorder_point":20,"target_quantity":20}`,
+			mockService:   func() *mocks.ReplenishmentServiceMock { return &mocks.ReplenishmentServiceMock{} },
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - missing levels",
+			body:          `{"product_id":1,"warehouse_id":1}`,
+			mockService:   func() *mocks.ReplenishmentServiceMock { return &mocks.ReplenishmentServiceMock{} },
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - invalid json",
+			body:          `{`,
+			mockService:   func() *mocks.ReplenishmentServiceMock { return &mocks.ReplenishmentServiceMock{} },
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name: "error - duplicate",
+			body: `{"product_id":1,"warehouse_id":1,"reorder_point":20,"target_quantity":100}`,
+			mockService: func() *mocks.ReplenishmentServiceMock {
+				return &mocks.ReplenishmentServiceMock{
+					FuncCreate: func(ctx context.Context, p models.PostReorderPoint) (*models.ReorderPoint, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeConflict, "the product already has a reorder point at this warehouse")
+					},
+				}
+			},
+			wantStatus:    http.StatusConflict,
+			wantErrorCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/reorderPoints", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			h := handler.NewReplenishmentHandler(tt.mockService())
+
+			h.Create(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantErrorCode == "" {
+				return
+			}
+			var body struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantErrorCode, body.Error.Code)
+		})
+	}
+}
+
+func TestReplenishmentHandler_Report(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		mockService   func() *mocks.ReplenishmentServiceMock
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:  "success - default window",
+			query: "?seller_id=2",
+			mockService: func() *mocks.ReplenishmentServiceMock {
+				return &mocks.ReplenishmentServiceMock{
+					FuncReport: func(ctx context.Context, warehouseId int, sellerId int, window time.Duration) ([]models.SellerReplenishment, error) {
+						if window != 30*24*time.Hour || sellerId != 2 || warehouseId != 0 {
+							return nil, apperrors.NewAppError(apperrors.CodeInternal, "unexpected params")
+						}
+						return []models.SellerReplenishment{{SellerId: 2, TotalSuggested: 80}}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:  "success - custom window",
+			query: "?warehouse_id=1&window=7d",
+			mockService: func() *mocks.ReplenishmentServiceMock {
+				return &mocks.ReplenishmentServiceMock{
+					FuncReport: func(ctx context.Context, warehouseId int, sellerId int, window time.Duration) ([]models.SellerReplenishment, error) {
+						if window != 7*24*time.Hour || warehouseId != 1 {
+							return nil, apperrors.NewAppError(apperrors.CodeInternal, "unexpected params")
+						}
+						return []models.SellerReplenishment{{SellerId: 2, TotalSuggested: 80}}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "error - invalid seller id",
+			query:         "?seller_id=abc",
+			mockService:   func() *mocks.ReplenishmentServiceMock { return &mocks.ReplenishmentServiceMock{} },
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:          "error - invalid window",
+			query:         "?window=soon",
+			mockService:   func() *mocks.ReplenishmentServiceMock { return &mocks.ReplenishmentServiceMock{} },
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/replenishment"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			h := handler.NewReplenishmentHandler(tt.mockService())
+
+			h.Report(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var envelope struct {
+					Data []models.SellerReplenishment `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &envelope))
+				require.Len(t, envelope.Data, 1)
+				require.Equal(t, 80, envelope.Data[0].TotalSuggested)
+				return
+			}
+
+			var body struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantErrorCode, body.Error.Code)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/routing"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/routing"
+)
+
+// RoutingHandler handles HTTP requests for route plans and distances between localities.
+type RoutingHandler struct {
+	sv service.RoutingService
+}
+
+// NewRoutingHandler creates a new RoutingHandler with the provided service.
+func NewRoutingHandler(sv service.RoutingService) *RoutingHandler {
+	return &RoutingHandler{
+		sv: sv,
+	}
+}
+
+// Plan handles GET /routePlan.
+// - 'warehouse_id' and 'carrier_id' are required.
+// - 'date' picks the shipments promised that day, as YYYY-MM-DD (default today).
+func (h *RoutingHandler) Plan(w http.ResponseWriter, r *http.Request) {
+	warehouseId, err := httputil.ParseIntParam(r, "warehouse_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	carrierId, err := httputil.ParseIntParam(r, "carrier_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	date, err := httputil.ParseDateQueryParam(r, "date", time.Now())
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	plan, err := h.sv.Plan(r.Context(), models.PlanFilter{WarehouseId: warehouseId, CarrierId: carrierId, Date: date})
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	response.JSON(w, http.StatusOK, plan)
+}
+
+// Distance handles GET /distance.
+// - 'from_locality_id' and 'to_locality_id' are required.
+func (h *RoutingHandler) Distance(w http.ResponseWriter, r *http.Request) {
+	from, to := r.URL.Query().Get("from_locality_id"), r.URL.Query().Get("to_locality_id")
+	if from == "" || to == "" {
+		response.Error(w, apperrors.NewAppError(apperrors.CodeBadRequest, "from_locality_id and to_locality_id parameters are required"))
+		return
+	}
+
+	distance, err := h.sv.Distance(r.Context(), from, to)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	response.JSON(w, http.StatusOK, distance)
+}
@@ -0,0 +1,128 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/routing"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/routing"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/routing"
+)
+
+func TestRoutingHandler_Plan(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		serviceErr    error
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success",
+			query:      "?warehouse_id=1&carrier_id=2&date=2025-06-02",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "error - carrier missing",
+			query:         "?warehouse_id=1",
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:          "error - invalid date",
+			query:         "?warehouse_id=1&carrier_id=2&date=02/06/2025",
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:          "error - warehouse without coordinates",
+			query:         "?warehouse_id=1&carrier_id=2",
+			serviceErr:    apperrors.NewAppError(apperrors.CodeConflict, "warehouse has no coordinates"),
+			wantStatus:    http.StatusConflict,
+			wantErrorCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.RoutingServiceMock{
+				FuncPlan: func(ctx context.Context, filter models.PlanFilter) (*models.RoutePlan, error) {
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					require.Equal(t, 1, filter.WarehouseId)
+					require.Equal(t, 2, filter.CarrierId)
+					require.Equal(t, time.June, filter.Date.Month())
+					return &models.RoutePlan{WarehouseId: 1, CarrierId: 2, Date: "2025-06-02", Stops: []models.Stop{}}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/routePlan"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			h := handler.NewRoutingHandler(sv)
+
+			h.Plan(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func TestRoutingHandler_Distance(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success",
+			query:      "?from_locality_id=1000&to_locality_id=2000",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "error - destination missing",
+			query:         "?from_locality_id=1000",
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.RoutingServiceMock{
+				FuncDistance: func(ctx context.Context, fromLocalityId, toLocalityId string) (*models.Distance, error) {
+					return &models.Distance{FromLocalityId: fromLocalityId, ToLocalityId: toLocalityId, DistanceKm: 279.32}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/distance"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			h := handler.NewRoutingHandler(sv)
+
+			h.Distance(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func requireErrorCode(t *testing.T, rec *httptest.ResponseRecorder, code string) {
+	t.Helper()
+	if code == "" {
+		return
+	}
+	var body struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, code, body.Error.Code)
+}
@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/shipment"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipment"
+)
+
+// ShipmentHandler handles HTTP requests for shipments and their tracking events.
+type ShipmentHandler struct {
+	sv service.ShipmentService
+}
+
+// NewShipmentHandler creates a new ShipmentHandler with the provided service.
+func NewShipmentHandler(sv service.ShipmentService) *ShipmentHandler {
+	return &ShipmentHandler{
+		sv: sv,
+	}
+}
+
+// FindAll handles GET /shipments.
+// - 'carrier_id' and 'status' optionally filter the shipments.
+func (h *ShipmentHandler) FindAll(w http.ResponseWriter, r *http.Request) {
+	carrierId, err := httputil.ParseOptionalIntParam(r, "carrier_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	status := r.URL.Query().Get("status")
+	if err := validators.ValidateShipmentStatus(status); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	shipments, err := h.sv.FindAll(r.Context(), models.ShipmentFilter{CarrierId: carrierId, Status: status})
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, shipments)
+}
+
+// Create handles POST /shipments.
+// - Responds 404 when a purchase order, the carrier, the warehouse or the locality does not exist.
+// - Responds 409 when a purchase order is not confirmed or already shipped.
+func (h *ShipmentHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req models.PostShipment
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateShipmentPost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	shipment, err := h.sv.Create(r.Context(), req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, shipment)
+}
+
+// FindByTrackingCode handles GET /shipments/{tracking_code}.
+func (h *ShipmentHandler) FindByTrackingCode(w http.ResponseWriter, r *http.Request) {
+	shipment, err := h.sv.FindByTrackingCode(r.Context(), chi.URLParam(r, "tracking_code"))
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, shipment)
+}
+
+// RecordEvent handles POST /shipments/{tracking_code}/events.
+// - Responds 409 when the shipment cannot move to the event status.
+func (h *ShipmentHandler) RecordEvent(w http.ResponseWriter, r *http.Request) {
+	var req models.PostEvent
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateShipmentEventPost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	shipment, err := h.sv.RecordEvent(r.Context(), chi.URLParam(r, "tracking_code"), req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, shipment)
+}
+
+// Performance handles GET /carrierPerformance.
+// - 'from' and 'to' bound the days the shipments were created, as YYYY-MM-DD (default the last 30 days).
+// - 'carrier_id' optionally narrows the report to one carrier.
+// - 'format=csv' downloads the report as CSV instead of JSON.
+func (h *ShipmentHandler) Performance(w http.ResponseWriter, r *http.Request) {
+	to, err := httputil.ParseDateQueryParam(r, "to", time.Now())
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	from, err := httputil.ParseDateQueryParam(r, "from", to.AddDate(0, 0, -29))
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateReportPeriod(from, to); err != nil {
+		response.Error(w, err)
+		return
+	}
+	carrierId, err := httputil.ParseOptionalIntParam(r, "carrier_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	format := r.URL.Query().Get("format")
+	if err := validators.ValidateReportFormat(format); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	report, err := h.sv.Performance(r.Context(), models.PerformanceFilter{From: from, To: to, CarrierId: carrierId})
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	if format == "csv" {
+		response.CSV(w, http.StatusOK, "carrier_performance.csv", performanceHeader, performanceRecords(report))
+		return
+	}
+	response.JSON(w, http.StatusOK, report)
+}
+
+var performanceHeader = []string{
+	"carrier_id", "cid", "company_name", "shipments_handled", "delivered", "on_time", "on_time_rate",
+	"average_transit_hours", "failed_deliveries", "cold_chain_shipments", "temperature_excursions",
+}
+
+// performanceRecords lays out the report lines in the order of performanceHeader, leaving missing rates empty.
+func performanceRecords(report []models.CarrierPerformance) [][]string {
+	records := make([][]string, len(report))
+	for i, p := range report {
+		records[i] = []string{
+			strconv.Itoa(p.CarrierId), p.Cid, p.CompanyName, strconv.Itoa(p.ShipmentsHandled), strconv.Itoa(p.Delivered),
+			strconv.Itoa(p.OnTime), formatOptional(p.OnTimeRate), formatOptional(p.AverageTransitHours),
+			strconv.Itoa(p.FailedDeliveries), strconv.Itoa(p.ColdChainShipments), strconv.Itoa(p.TemperatureExcursions),
+		}
+	}
+	return records
+}
+
+func formatOptional(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
@@ -0,0 +1,265 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/shipment"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/shipment"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipment"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestShipmentHandler_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		serviceErr    error
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success",
+			body:       `{"carrier_id":2,"warehouse_id":1,"destination_locality_id":"1001","purchase_order_ids":[7,8]}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:          "error - no purchase orders",
+			body:          `{"carrier_id":2,"warehouse_id":1,"destination_locality_id":"1001","purchase_order_ids":[]}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - purchase order listed twice",
+			body:          `{"carrier_id":2,"warehouse_id":1,"destination_locality_id":"1001","purchase_order_ids":[7,7]}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - missing destination",
+			body:          `{"carrier_id":2,"warehouse_id":1,"purchase_order_ids":[7]}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - order already shipped",
+			body:          `{"carrier_id":2,"warehouse_id":1,"destination_locality_id":"1001","purchase_order_ids":[7]}`,
+			serviceErr:    apperrors.NewAppError(apperrors.CodeConflict, "purchase order already shipped"),
+			wantStatus:    http.StatusConflict,
+			wantErrorCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.ShipmentServiceMock{
+				FuncCreate: func(ctx context.Context, req models.PostShipment) (*models.ShipmentDetail, error) {
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					return &models.ShipmentDetail{Shipment: testhelpers.DummyShipment(models.StatusCreated), PurchaseOrderIds: req.PurchaseOrderIds}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/shipments", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			h := handler.NewShipmentHandler(sv)
+
+			h.Create(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func TestShipmentHandler_FindByTrackingCode(t *testing.T) {
+	tests := []struct {
+		name          string
+		serviceErr    error
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "error - unknown tracking code",
+			serviceErr:    apperrors.NewAppError(apperrors.CodeNotFound, "shipment not found"),
+			wantStatus:    http.StatusNotFound,
+			wantErrorCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.ShipmentServiceMock{
+				FuncFindByTrackingCode: func(ctx context.Context, trackingCode string) (*models.ShipmentDetail, error) {
+					require.Equal(t, "TRK-ABCDEFGH23", trackingCode)
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					return &models.ShipmentDetail{Shipment: testhelpers.DummyShipment(models.StatusInTransit)}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/shipments/TRK-ABCDEFGH23", nil)
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("tracking_code", "TRK-ABCDEFGH23")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewShipmentHandler(sv)
+
+			h.FindByTrackingCode(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func TestShipmentHandler_RecordEvent(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		serviceErr    error
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success",
+			body:       `{"status":"in_transit","location":"Rosario hub"}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:          "error - created cannot be recorded",
+			body:          `{"status":"created"}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - unknown status",
+			body:          `{"status":"lost"}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - already delivered",
+			body:          `{"status":"failed"}`,
+			serviceErr:    apperrors.NewAppError(apperrors.CodeConflict, "shipment cannot move to the requested status"),
+			wantStatus:    http.StatusConflict,
+			wantErrorCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.ShipmentServiceMock{
+				FuncRecordEvent: func(ctx context.Context, trackingCode string, req models.PostEvent) (*models.ShipmentDetail, error) {
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					return &models.ShipmentDetail{Shipment: testhelpers.DummyShipment(req.Status)}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/shipments/TRK-ABCDEFGH23/events", strings.NewReader(tt.body))
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("tracking_code", "TRK-ABCDEFGH23")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewShipmentHandler(sv)
+
+			h.RecordEvent(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func requireErrorCode(t *testing.T, rec *httptest.ResponseRecorder, code string) {
+	t.Helper()
+	if code == "" {
+		return
+	}
+	var body struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, code, body.Error.Code)
+}
+
+func TestShipmentHandler_Performance(t *testing.T) {
+	rate := 50.0
+	report := []models.CarrierPerformance{
+		{CarrierId: 2, Cid: "CID-2", CompanyName: "Carrier", ShipmentsHandled: 3, Delivered: 2, OnTime: 1, OnTimeRate: &rate},
+	}
+
+	tests := []struct {
+		name          string
+		query         string
+		wantStatus    int
+		wantType      string
+		wantErrorCode string
+	}{
+		{
+			name:       "success - json",
+			query:      "?from=2025-06-01&to=2025-06-30",
+			wantStatus: http.StatusOK,
+			wantType:   "application/json",
+		},
+		{
+			name:       "success - csv",
+			query:      "?from=2025-06-01&to=2025-06-30&format=csv",
+			wantStatus: http.StatusOK,
+			wantType:   "text/csv",
+		},
+		{
+			name:          "error - period ends before it starts",
+			query:         "?from=2025-06-30&to=2025-06-01",
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:          "error - unknown format",
+			query:         "?format=xlsx",
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.ShipmentServiceMock{
+				FuncPerformance: func(ctx context.Context, filter models.PerformanceFilter) ([]models.CarrierPerformance, error) {
+					return report, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/carrierPerformance"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			h := handler.NewShipmentHandler(sv)
+
+			h.Performance(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+			if tt.wantType != "" {
+				require.Equal(t, tt.wantType, rec.Header().Get("Content-Type"))
+			}
+			if tt.wantType == "text/csv" {
+				require.Equal(t, "carrier_id,cid,company_name,shipments_handled,delivered,on_time,on_time_rate,"+
+					"average_transit_hours,failed_deliveries,cold_chain_shipments,temperature_excursions\n"+
+					"2,CID-2,Carrier,3,2,1,50,,0,0,0\n", rec.Body.String())
+			}
+		})
+	}
+}
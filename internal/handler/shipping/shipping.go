@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"net/http"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/shipping"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipping"
+)
+
+// ShippingHandler handles HTTP requests for carrier coverage areas and shipping quotes.
+type ShippingHandler struct {
+	sv service.ShippingService
+}
+
+// NewShippingHandler creates a new ShippingHandler with the provided service.
+func NewShippingHandler(sv service.ShippingService) *ShippingHandler {
+	return &ShippingHandler{
+		sv: sv,
+	}
+}
+
+// FindAreas handles GET /coverageAreas.
+// - 'carrier_id' optionally narrows the areas to one carrier.
+func (h *ShippingHandler) FindAreas(w http.ResponseWriter, r *http.Request) {
+	carrierId, err := httputil.ParseOptionalIntParam(r, "carrier_id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	areas, err := h.sv.FindAreas(r.Context(), models.CoverageFilter{CarrierId: carrierId})
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	response.JSON(w, http.StatusOK, areas)
+}
+
+// FindAreaById handles GET /coverageAreas/{id}.
+func (h *ShippingHandler) FindAreaById(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	area, err := h.sv.FindAreaById(r.Context(), id)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	response.JSON(w, http.StatusOK, area)
+}
+
+// CreateArea handles POST /coverageAreas.
+// - Responds 404 when the carrier or the locality, province or country does not exist.
+// - Responds 409 when the carrier already covers the area.
+func (h *ShippingHandler) CreateArea(w http.ResponseWriter, r *http.Request) {
+	var req models.PostCoverageArea
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateCoverageAreaPost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	area, err := h.sv.CreateArea(r.Context(), req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	response.JSON(w, http.StatusCreated, area)
+}
+
+// DeleteArea handles DELETE /coverageAreas/{id}.
+func (h *ShippingHandler) DeleteArea(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	if err := h.sv.DeleteArea(r.Context(), id); err != nil {
+		response.Error(w, err)
+		return
+	}
+	response.JSON(w, http.StatusNoContent, nil)
+}
+
+// Quote handles POST /shipping/quote.
+// - Responds 404 when the warehouse, the destination locality or a product does not exist.
+// - Responds 200 with no quotes when no carrier can take the shipment.
+func (h *ShippingHandler) Quote(w http.ResponseWriter, r *http.Request) {
+	var req models.QuoteRequest
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateQuoteRequest(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	quote, err := h.sv.Quote(r.Context(), req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	response.JSON(w, http.StatusOK, quote)
+}
@@ -0,0 +1,142 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/shipping"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/shipping"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipping"
+)
+
+func TestShippingHandler_CreateArea(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		serviceErr    error
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success",
+			body:       `{"carrier_id":2,"province_id":2,"transit_days":3,"rate_bands":[{"max_weight":5,"max_volume":5000,"price":500}]}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:          "error - two areas named",
+			body:          `{"carrier_id":2,"province_id":2,"country_id":1,"transit_days":3,"rate_bands":[{"max_weight":5,"max_volume":5000,"price":500}]}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - no rate bands",
+			body:          `{"carrier_id":2,"locality_id":"1001","transit_days":1,"rate_bands":[]}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - area already covered",
+			body:          `{"carrier_id":2,"country_id":1,"transit_days":3,"rate_bands":[{"max_weight":5,"max_volume":5000,"price":500}]}`,
+			serviceErr:    apperrors.NewAppError(apperrors.CodeConflict, "carrier already covers this area"),
+			wantStatus:    http.StatusConflict,
+			wantErrorCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.ShippingServiceMock{
+				FuncCreateArea: func(ctx context.Context, req models.PostCoverageArea) (*models.CoverageArea, error) {
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					return &models.CoverageArea{Id: 7, CarrierId: req.CarrierId, ProvinceId: req.ProvinceId}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/coverageAreas", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			h := handler.NewShippingHandler(sv)
+
+			h.CreateArea(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func TestShippingHandler_Quote(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		serviceErr    error
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success",
+			body:       `{"warehouse_id":1,"destination_locality_id":"1001","items":[{"product_id":1,"quantity":3}]}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "error - no items",
+			body:          `{"warehouse_id":1,"destination_locality_id":"1001","items":[]}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - product listed twice",
+			body:          `{"warehouse_id":1,"destination_locality_id":"1001","items":[{"product_id":1,"quantity":3},{"product_id":1,"quantity":1}]}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - unknown destination",
+			body:          `{"warehouse_id":1,"destination_locality_id":"9999","items":[{"product_id":1,"quantity":3}]}`,
+			serviceErr:    apperrors.NewAppError(apperrors.CodeNotFound, "locality not found"),
+			wantStatus:    http.StatusNotFound,
+			wantErrorCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.ShippingServiceMock{
+				FuncQuote: func(ctx context.Context, req models.QuoteRequest) (*models.Quote, error) {
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					return &models.Quote{WarehouseId: req.WarehouseId, DestinationLocalityId: req.DestinationLocalityId, Quotes: []models.CarrierQuote{}}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/shipping/quote", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			h := handler.NewShippingHandler(sv)
+
+			h.Quote(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func requireErrorCode(t *testing.T, rec *httptest.ResponseRecorder, code string) {
+	t.Helper()
+	if code == "" {
+		return
+	}
+	var body struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, code, body.Error.Code)
+}
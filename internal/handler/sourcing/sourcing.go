@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/sourcing"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/sourcing"
+)
+
+// SourcingHandler handles HTTP requests that choose the warehouses filling an order.
+type SourcingHandler struct {
+	sv service.SourcingService
+}
+
+// NewSourcingHandler creates a new SourcingHandler with the provided service.
+func NewSourcingHandler(sv service.SourcingService) *SourcingHandler {
+	return &SourcingHandler{
+		sv: sv,
+	}
+}
+
+// Plan handles POST /sourcing/plan.
+// - A dry run: returns the warehouses that would ship the lines without reserving any stock.
+// - Responds 200 with the shortages when the warehouses together cannot fill the order.
+// - Responds 404 when the delivery locality does not exist and 409 when it has no coordinates.
+func (h *SourcingHandler) Plan(w http.ResponseWriter, r *http.Request) {
+	var req models.SourcingRequest
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateSourcingRequest(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	plan, err := h.sv.Plan(r.Context(), req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	response.JSON(w, http.StatusOK, plan)
+}
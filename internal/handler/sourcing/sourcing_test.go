@@ -0,0 +1,92 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/sourcing"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/sourcing"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/sourcing"
+)
+
+func TestSourcingHandler_Plan(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		serviceErr    error
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:       "success",
+			body:       `{"delivery_locality_id":"1000","lines":[{"product_id":7,"quantity":5},{"product_id":7,"quantity":1}]}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:          "error - delivery locality missing",
+			body:          `{"lines":[{"product_id":7,"quantity":5}]}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - non positive quantity",
+			body:          `{"delivery_locality_id":"1000","lines":[{"product_id":7,"quantity":0}]}`,
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - invalid json",
+			body:          `{"delivery_locality_id":`,
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:          "error - delivery locality without coordinates",
+			body:          `{"delivery_locality_id":"1000","lines":[{"product_id":7,"quantity":5}]}`,
+			serviceErr:    apperrors.NewAppError(apperrors.CodeConflict, "delivery locality has no coordinates"),
+			wantStatus:    http.StatusConflict,
+			wantErrorCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := &mocks.SourcingServiceMock{
+				FuncPlan: func(ctx context.Context, req models.SourcingRequest) (*models.SourcingPlan, error) {
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					return &models.SourcingPlan{DeliveryLocalityId: req.DeliveryLocalityId, Fulfillable: true}, nil
+				},
+			}
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/sourcing/plan", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			h := handler.NewSourcingHandler(sv)
+
+			h.Plan(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			requireErrorCode(t, rec, tt.wantErrorCode)
+		})
+	}
+}
+
+func requireErrorCode(t *testing.T, rec *httptest.ResponseRecorder, code string) {
+	t.Helper()
+	if code == "" {
+		return
+	}
+	var body struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, code, body.Error.Code)
+}
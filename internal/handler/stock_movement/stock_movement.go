@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"net/http"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+)
+
+// StockMovementHandler handles HTTP requests for the stock ledger.
+type StockMovementHandler struct {
+	sv service.StockMovementService
+}
+
+// NewStockMovementHandler creates a new StockMovementHandler with the provided service.
+func NewStockMovementHandler(sv service.StockMovementService) *StockMovementHandler {
+	return &StockMovementHandler{
+		sv: sv,
+	}
+}
+
+// FindByBatch handles GET /productBatches/{id}/movements.
+// - Returns the movements of the batch, oldest first.
+func (h *StockMovementHandler) FindByBatch(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	movements, err := h.sv.FindByBatch(r.Context(), id)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, movements)
+}
@@ -0,0 +1,99 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/stock_movement"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestStockMovementHandler_FindByBatch(t *testing.T) {
+	movements := []models.StockMovement{
+		testhelpers.DummyStockMovement(1, 1, 50, models.MovementTypeReceipt),
+		testhelpers.DummyStockMovement(2, 1, -5, models.MovementTypeAllocation),
+	}
+
+	tests := []struct {
+		name          string
+		routeID       string
+		mockService   func() *mocks.StockMovementServiceMock
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:    "success",
+			routeID: "1",
+			mockService: func() *mocks.StockMovementServiceMock {
+				return &mocks.StockMovementServiceMock{
+					FuncFindByBatch: func(ctx context.Context, batchId int) ([]models.StockMovement, error) {
+						return movements, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:    "error - invalid id",
+			routeID: "abc",
+			mockService: func() *mocks.StockMovementServiceMock {
+				return &mocks.StockMovementServiceMock{}
+			},
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:    "error - batch not found",
+			routeID: "99",
+			mockService: func() *mocks.StockMovementServiceMock {
+				return &mocks.StockMovementServiceMock{
+					FuncFindByBatch: func(ctx context.Context, batchId int) ([]models.StockMovement, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeNotFound, "product batch not found")
+					},
+				}
+			},
+			wantStatus:    http.StatusNotFound,
+			wantErrorCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/productBatches/"+tt.routeID+"/movements", nil)
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", tt.routeID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewStockMovementHandler(tt.mockService())
+
+			h.FindByBatch(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var envelope struct {
+					Data []models.StockMovement `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &envelope))
+				require.Equal(t, movements, envelope.Data)
+				return
+			}
+
+			var body struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantErrorCode, body.Error.Code)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"net/http"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/traceability"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+)
+
+// TraceabilityHandler handles HTTP requests that trace batches forward and orders backward.
+type TraceabilityHandler struct {
+	sv service.TraceabilityService
+}
+
+// NewTraceabilityHandler creates a new TraceabilityHandler with the provided service.
+func NewTraceabilityHandler(sv service.TraceabilityService) *TraceabilityHandler {
+	return &TraceabilityHandler{
+		sv: sv,
+	}
+}
+
+// TraceBatch handles GET /productBatches/{id}/trace.
+// - Lists the purchase orders and buyers that received product from the batch.
+func (h *TraceabilityHandler) TraceBatch(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	trace, err := h.sv.TraceBatch(r.Context(), id)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, trace)
+}
+
+// TraceOrder handles GET /purchaseOrders/{id}/trace.
+// - Lists the batches that served the order with the inbound order, employee and warehouse that received each one.
+func (h *TraceabilityHandler) TraceOrder(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	trace, err := h.sv.TraceOrder(r.Context(), id)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, trace)
+}
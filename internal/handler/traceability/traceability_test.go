@@ -0,0 +1,99 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/traceability"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/traceability"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/traceability"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestTraceabilityHandler_TraceBatch(t *testing.T) {
+	tests := []struct {
+		name          string
+		routeID       string
+		mockService   func() *mocks.TraceabilityServiceMock
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:    "success",
+			routeID: "1",
+			mockService: func() *mocks.TraceabilityServiceMock {
+				return &mocks.TraceabilityServiceMock{
+					FuncTraceBatch: func(ctx context.Context, batchId int) (*models.BatchTrace, error) {
+						return &models.BatchTrace{
+							Batch:  testhelpers.DummyTracedBatch(),
+							Orders: []models.TracedOrder{testhelpers.DummyTracedOrder(1, 10, models.LinkAllocation)},
+						}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:    "error - invalid id",
+			routeID: "abc",
+			mockService: func() *mocks.TraceabilityServiceMock {
+				return &mocks.TraceabilityServiceMock{}
+			},
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:    "error - batch not found",
+			routeID: "99",
+			mockService: func() *mocks.TraceabilityServiceMock {
+				return &mocks.TraceabilityServiceMock{
+					FuncTraceBatch: func(ctx context.Context, batchId int) (*models.BatchTrace, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeNotFound, "product batch not found")
+					},
+				}
+			},
+			wantStatus:    http.StatusNotFound,
+			wantErrorCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/productBatches/"+tt.routeID+"/trace", nil)
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", tt.routeID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewTraceabilityHandler(tt.mockService())
+
+			h.TraceBatch(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusOK {
+				var envelope struct {
+					Data models.BatchTrace `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &envelope))
+				require.Equal(t, 1, envelope.Data.Batch.Id)
+				require.Len(t, envelope.Data.Orders, 1)
+				require.Equal(t, 10, envelope.Data.Orders[0].BuyerId)
+				return
+			}
+
+			var body struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantErrorCode, body.Error.Code)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"net/http"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/transfer"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/transfer"
+)
+
+// TransferHandler handles HTTP requests that move product batches between sections.
+type TransferHandler struct {
+	sv service.TransferService
+}
+
+// NewTransferHandler creates a new TransferHandler with the provided service.
+func NewTransferHandler(sv service.TransferService) *TransferHandler {
+	return &TransferHandler{
+		sv: sv,
+	}
+}
+
+// Transfer handles POST /productBatches/{id}/transfers.
+// - Moves the whole batch, or splits off quantity units into a new batch, to the destination section.
+// - Responds 422 when the destination lacks capacity or is too cold for the batch.
+func (h *TransferHandler) Transfer(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	var req models.PostBatchTransfer
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateBatchTransferPost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	transfer, err := h.sv.Transfer(r.Context(), id, req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, transfer)
+}
+
+// FindByBatch handles GET /productBatches/{id}/transfers.
+func (h *TransferHandler) FindByBatch(w http.ResponseWriter, r *http.Request) {
+	id, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	transfers, err := h.sv.FindByBatch(r.Context(), id)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, transfers)
+}
@@ -0,0 +1,105 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/transfer"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/transfer"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/transfer"
+)
+
+func TestTransferHandler_Transfer(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		mockService   func() *mocks.TransferServiceMock
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name: "success",
+			body: `{"to_section_id": 2, "quantity": 20, "reason": "rebalance", "actor": "jdoe"}`,
+			mockService: func() *mocks.TransferServiceMock {
+				return &mocks.TransferServiceMock{
+					FuncTransfer: func(ctx context.Context, batchId int, req models.PostBatchTransfer) (*models.BatchTransfer, error) {
+						return &models.BatchTransfer{Id: 1, SourceBatchId: batchId, DestinationBatchId: 7, ToSectionId: req.ToSectionId, Quantity: *req.Quantity}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "error - missing destination",
+			body: `{"quantity": 20, "reason": "rebalance", "actor": "jdoe"}`,
+			mockService: func() *mocks.TransferServiceMock {
+				return &mocks.TransferServiceMock{}
+			},
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name: "error - invalid json",
+			body: `{"to_section_id": "two"}`,
+			mockService: func() *mocks.TransferServiceMock {
+				return &mocks.TransferServiceMock{}
+			},
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name: "error - batch not found",
+			body: `{"to_section_id": 2, "reason": "rebalance", "actor": "jdoe"}`,
+			mockService: func() *mocks.TransferServiceMock {
+				return &mocks.TransferServiceMock{
+					FuncTransfer: func(ctx context.Context, batchId int, req models.PostBatchTransfer) (*models.BatchTransfer, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeNotFound, "product batch not found")
+					},
+				}
+			},
+			wantStatus:    http.StatusNotFound,
+			wantErrorCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/productBatches/1/transfers", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", "1")
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewTransferHandler(tt.mockService())
+
+			h.Transfer(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusCreated {
+				var envelope struct {
+					Data models.BatchTransfer `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &envelope))
+				require.Equal(t, 7, envelope.Data.DestinationBatchId)
+				require.Equal(t, 20, envelope.Data.Quantity)
+				return
+			}
+
+			var body struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantErrorCode, body.Error.Code)
+		})
+	}
+}
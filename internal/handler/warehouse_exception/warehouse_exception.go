@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"net/http"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/warehouse_exception"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/response"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/warehouse_exception"
+)
+
+// WarehouseExceptionHandler handles HTTP requests for the employee cross-warehouse exception list.
+type WarehouseExceptionHandler struct {
+	sv service.WarehouseExceptionService
+}
+
+// NewWarehouseExceptionHandler creates a new WarehouseExceptionHandler with the provided service.
+func NewWarehouseExceptionHandler(sv service.WarehouseExceptionService) *WarehouseExceptionHandler {
+	return &WarehouseExceptionHandler{
+		sv: sv,
+	}
+}
+
+// FindByEmployee handles GET /employees/{id}/warehouseExceptions.
+func (h *WarehouseExceptionHandler) FindByEmployee(w http.ResponseWriter, r *http.Request) {
+	employeeId, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	exceptions, err := h.sv.FindByEmployee(r.Context(), employeeId)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, exceptions)
+}
+
+// Create handles POST /employees/{id}/warehouseExceptions.
+// - Responds 409 when the employee already holds an exception for the warehouse.
+func (h *WarehouseExceptionHandler) Create(w http.ResponseWriter, r *http.Request) {
+	employeeId, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	var req models.PostWarehouseException
+	if err := httputil.DecodeJSON(r, &req); err != nil {
+		response.Error(w, err)
+		return
+	}
+	if err := validators.ValidateWarehouseExceptionPost(req); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	exception, err := h.sv.Create(r.Context(), employeeId, req)
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, exception)
+}
+
+// Delete handles DELETE /employees/{id}/warehouseExceptions/{warehouseId}.
+func (h *WarehouseExceptionHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	employeeId, err := httputil.ParseIDParam(r, "id")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+	warehouseId, err := httputil.ParseIDParam(r, "warehouseId")
+	if err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	if err := h.sv.Delete(r.Context(), employeeId, warehouseId); err != nil {
+		response.Error(w, err)
+		return
+	}
+
+	response.JSON(w, http.StatusNoContent, nil)
+}
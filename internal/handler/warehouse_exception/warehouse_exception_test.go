@@ -0,0 +1,105 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+
+	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/warehouse_exception"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/warehouse_exception"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/warehouse_exception"
+)
+
+func TestWarehouseExceptionHandler_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		routeID       string
+		body          string
+		mockService   func() *mocks.WarehouseExceptionServiceMock
+		wantStatus    int
+		wantErrorCode string
+	}{
+		{
+			name:    "success",
+			routeID: "4",
+			body:    `{"warehouse_id":7,"reason":"covers night shift"}`,
+			mockService: func() *mocks.WarehouseExceptionServiceMock {
+				return &mocks.WarehouseExceptionServiceMock{
+					FuncCreate: func(ctx context.Context, employeeId int, p models.PostWarehouseException) (*models.WarehouseException, error) {
+						return &models.WarehouseException{Id: 1, EmployeeId: employeeId, WarehouseId: p.WarehouseId, Reason: p.Reason}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:          "error - missing reason",
+			routeID:       "4",
+			body:          `{"warehouse_id":7}`,
+			mockService:   func() *mocks.WarehouseExceptionServiceMock { return &mocks.WarehouseExceptionServiceMock{} },
+			wantStatus:    http.StatusUnprocessableEntity,
+			wantErrorCode: apperrors.CodeValidationError,
+		},
+		{
+			name:          "error - invalid employee id",
+			routeID:       "abc",
+			body:          `{"warehouse_id":7,"reason":"covers night shift"}`,
+			mockService:   func() *mocks.WarehouseExceptionServiceMock { return &mocks.WarehouseExceptionServiceMock{} },
+			wantStatus:    http.StatusBadRequest,
+			wantErrorCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:    "error - already on the list",
+			routeID: "4",
+			body:    `{"warehouse_id":7,"reason":"covers night shift"}`,
+			mockService: func() *mocks.WarehouseExceptionServiceMock {
+				return &mocks.WarehouseExceptionServiceMock{
+					FuncCreate: func(ctx context.Context, employeeId int, p models.PostWarehouseException) (*models.WarehouseException, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeConflict, "the employee already has an exception for this warehouse")
+					},
+				}
+			},
+			wantStatus:    http.StatusConflict,
+			wantErrorCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/employees/"+tt.routeID+"/warehouseExceptions", strings.NewReader(tt.body))
+			routeCtx := chi.NewRouteContext()
+			routeCtx.URLParams.Add("id", tt.routeID)
+			req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+			rec := httptest.NewRecorder()
+			h := handler.NewWarehouseExceptionHandler(tt.mockService())
+
+			h.Create(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusCreated {
+				var envelope struct {
+					Data models.WarehouseException `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &envelope))
+				require.Equal(t, 4, envelope.Data.EmployeeId)
+				require.Equal(t, 7, envelope.Data.WarehouseId)
+				return
+			}
+
+			var body struct {
+				Error struct {
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			require.Equal(t, tt.wantErrorCode, body.Error.Code)
+		})
+	}
+}
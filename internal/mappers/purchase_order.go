@@ -23,12 +23,15 @@ func RequestPurchaseOrderToPurchaseOrder(req models.RequestPurchaseOrder) (model
 
 func PurchaseOrderToResponse(po models.PurchaseOrder) models.ResponsePurchaseOrder {
 	return models.ResponsePurchaseOrder{
-		ID:              po.ID,
-		OrderNumber:     po.OrderNumber,
-		OrderDate:       po.OrderDate.Format("2006-01-02"),
-		TrackingCode:    po.TrackingCode,
-		BuyerID:         po.BuyerID,
-		ProductRecordID: po.ProductRecordID,
+		ID:                 po.ID,
+		OrderNumber:        po.OrderNumber,
+		OrderDate:          po.OrderDate.Format("2006-01-02"),
+		TrackingCode:       po.TrackingCode,
+		BuyerID:            po.BuyerID,
+		ProductRecordID:    po.ProductRecordID,
+		DeliveryAddressID:  po.DeliveryAddressID,
+		DeliveryAddress:    po.DeliveryAddress,
+		DeliveryLocalityID: po.DeliveryLocalityID,
 	}
 }
 
@@ -11,6 +11,8 @@ func WarehouseToDoc(w *warehouse.Warehouse) warehouse.WarehouseDoc {
 		MinimumCapacity:    w.MinimumCapacity,
 		MinimumTemperature: w.MinimumTemperature,
 		LocalityId:         w.LocalityId,
+		Latitude:           w.Latitude,
+		Longitude:          w.Longitude,
 	}
 }
 
@@ -32,6 +34,8 @@ func RequestToWarehouse(req warehouse.WarehouseRequest) warehouse.Warehouse {
 		MinimumCapacity:    req.MinimumCapacity,
 		MinimumTemperature: *req.MinimumTemperature,
 		LocalityId:         req.LocalityId,
+		Latitude:           req.Latitude,
+		Longitude:          req.Longitude,
 	}
 }
 
@@ -54,4 +58,8 @@ func ApplyWarehousePatch(existing *warehouse.Warehouse, patch warehouse.Warehous
 	if patch.LocalityId != nil {
 		existing.LocalityId = *patch.LocalityId
 	}
+	if patch.Latitude != nil && patch.Longitude != nil {
+		existing.Latitude = patch.Latitude
+		existing.Longitude = patch.Longitude
+	}
 }
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+)
+
+const (
+	queryOrderStatusForUpdate = `SELECT order_status_id FROM purchase_orders WHERE id = ? FOR UPDATE`
+	queryOrderStatusUpdate    = `UPDATE purchase_orders SET order_status_id = ? WHERE id = ?`
+	queryOrderLines           = `SELECT od.id, pr.product_id, COALESCE(od.quantity, 0) FROM order_details od
+		INNER JOIN product_records pr ON od.product_record_id = pr.id
+		WHERE od.purchase_order_id = ? ORDER BY od.id`
+	queryAvailableBatchesForUpdate = `SELECT id, due_date, current_quantity FROM product_batches
+		WHERE product_id = ? AND current_quantity > 0 AND due_date > NOW() AND status = 'available'
+		ORDER BY due_date ASC, id ASC FOR UPDATE`
+	queryWarehouseBatchesForUpdate = `SELECT b.id, b.due_date, b.current_quantity FROM product_batches b
+		INNER JOIN sections s ON s.id = b.section_id
+		WHERE b.product_id = ? AND s.warehouse_id = ? AND b.current_quantity > 0 AND b.due_date > NOW() AND b.status = 'available'
+		ORDER BY b.due_date ASC, b.id ASC FOR UPDATE`
+	queryAllocationCreate    = `INSERT INTO stock_allocations (purchase_order_id, order_detail_id, product_batch_id, quantity, status) VALUES (?, ?, ?, ?, ?)`
+	queryAllocationsReserved = `SELECT id, purchase_order_id, order_detail_id, product_batch_id, quantity, status, created_at FROM stock_allocations
+		WHERE purchase_order_id = ? AND status = 'reserved' FOR UPDATE`
+	queryAllocationsRelease = `UPDATE stock_allocations SET status = 'released', released_at = NOW() WHERE purchase_order_id = ? AND status = 'reserved'`
+	queryAllocationsByOrder = `SELECT id, purchase_order_id, order_detail_id, product_batch_id, quantity, status, created_at FROM stock_allocations
+		WHERE purchase_order_id = ? ORDER BY id`
+)
+
+// GetOrderStatusForUpdate returns the current status of a purchase order and locks its row.
+// Returns a not found error if the purchase order does not exist.
+func (r *allocationRepository) GetOrderStatusForUpdate(ctx context.Context, exec Executor, orderId int) (int, error) {
+	var statusId int
+	err := exec.QueryRowContext(ctx, queryOrderStatusForUpdate, orderId).Scan(&statusId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, apperrors.NewAppError(apperrors.CodeNotFound, "purchase order not found")
+		}
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error querying purchase order status")
+	}
+	return statusId, nil
+}
+
+// UpdateOrderStatus sets the order_status_id of a purchase order.
+func (r *allocationRepository) UpdateOrderStatus(ctx context.Context, exec Executor, orderId int, statusId int) error {
+	if _, err := exec.ExecContext(ctx, queryOrderStatusUpdate, statusId, orderId); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating purchase order status")
+	}
+	return nil
+}
+
+// FindOrderLines returns the order lines of a purchase order with the product each one requests.
+// Lines without a quantity are returned with a quantity of 0.
+func (r *allocationRepository) FindOrderLines(ctx context.Context, exec Executor, orderId int) ([]models.OrderLine, error) {
+	rows, err := exec.QueryContext(ctx, queryOrderLines, orderId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying order lines")
+	}
+	defer rows.Close()
+
+	lines := make([]models.OrderLine, 0)
+	for rows.Next() {
+		var l models.OrderLine
+		if err := rows.Scan(&l.OrderDetailId, &l.ProductId, &l.Quantity); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning order line")
+		}
+		lines = append(lines, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating order lines")
+	}
+	return lines, nil
+}
+
+// FindAvailableBatchesForUpdate returns the batches a product can be allocated from,
+// earliest due date first, locking them so concurrent confirmations cannot oversell.
+func (r *allocationRepository) FindAvailableBatchesForUpdate(ctx context.Context, exec Executor, productId int) ([]models.BatchStock, error) {
+	return r.queryBatches(ctx, exec, queryAvailableBatchesForUpdate, productId)
+}
+
+// FindWarehouseBatchesForUpdate returns the available batches of a product stored in the sections of a warehouse.
+func (r *allocationRepository) FindWarehouseBatchesForUpdate(ctx context.Context, exec Executor, productId int, warehouseId int) ([]models.BatchStock, error) {
+	return r.queryBatches(ctx, exec, queryWarehouseBatchesForUpdate, productId, warehouseId)
+}
+
+// queryBatches runs an available batches query and scans its rows.
+func (r *allocationRepository) queryBatches(ctx context.Context, exec Executor, query string, args ...any) ([]models.BatchStock, error) {
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying available batches")
+	}
+	defer rows.Close()
+
+	batches := make([]models.BatchStock, 0)
+	for rows.Next() {
+		var b models.BatchStock
+		if err := rows.Scan(&b.ProductBatchId, &b.DueDate, &b.CurrentQuantity); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning available batch")
+		}
+		batches = append(batches, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating available batches")
+	}
+	return batches, nil
+}
+
+// CreateAllocation inserts a new allocation row and returns it with its generated id.
+func (r *allocationRepository) CreateAllocation(ctx context.Context, exec Executor, a models.StockAllocation) (*models.StockAllocation, error) {
+	res, err := exec.ExecContext(ctx, queryAllocationCreate, a.PurchaseOrderId, a.OrderDetailId, a.ProductBatchId, a.Quantity, a.Status)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error creating stock allocation")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	a.Id = int(id)
+	return &a, nil
+}
+
+// FindReservedByOrder returns the reserved allocations of a purchase order, locking them.
+func (r *allocationRepository) FindReservedByOrder(ctx context.Context, exec Executor, orderId int) ([]models.StockAllocation, error) {
+	return r.queryAllocations(ctx, exec, queryAllocationsReserved, orderId)
+}
+
+// ReleaseAllocations marks the reserved allocations of a purchase order as released.
+func (r *allocationRepository) ReleaseAllocations(ctx context.Context, exec Executor, orderId int) error {
+	if _, err := exec.ExecContext(ctx, queryAllocationsRelease, orderId); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error releasing stock allocations")
+	}
+	return nil
+}
+
+// FindByOrder returns every allocation of a purchase order.
+func (r *allocationRepository) FindByOrder(ctx context.Context, orderId int) ([]models.StockAllocation, error) {
+	return r.queryAllocations(ctx, r.mysql, queryAllocationsByOrder, orderId)
+}
+
+func (r *allocationRepository) queryAllocations(ctx context.Context, exec Executor, query string, orderId int) ([]models.StockAllocation, error) {
+	rows, err := exec.QueryContext(ctx, query, orderId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying stock allocations")
+	}
+	defer rows.Close()
+
+	allocations := make([]models.StockAllocation, 0)
+	for rows.Next() {
+		var a models.StockAllocation
+		if err := rows.Scan(&a.Id, &a.PurchaseOrderId, &a.OrderDetailId, &a.ProductBatchId, &a.Quantity, &a.Status, &a.CreatedAt); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning stock allocation")
+		}
+		allocations = append(allocations, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating stock allocations")
+	}
+	return allocations, nil
+}
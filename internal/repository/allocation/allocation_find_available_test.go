@@ -0,0 +1,103 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/allocation"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestAllocationRepository_FindAvailableBatchesForUpdate(t *testing.T) {
+	type testCase struct {
+		name     string
+		dbMock   func() (sqlmock.Sqlmock, *sql.DB)
+		expected []models.BatchStock
+		errCode  string
+	}
+
+	due := time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	testCases := []testCase{
+		{
+			name: "success - batches returned in due date order",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				rows := sqlmock.NewRows([]string{"id", "due_date", "current_quantity"}).
+					AddRow(3, due, 4).
+					AddRow(1, due.AddDate(0, 1, 0), 10)
+				mock.ExpectQuery("SELECT id, due_date, current_quantity FROM product_batches (.+) ORDER BY due_date ASC, id ASC FOR UPDATE").
+					WithArgs(7).
+					WillReturnRows(rows)
+				return mock, db
+			},
+			expected: []models.BatchStock{
+				{ProductBatchId: 3, DueDate: due, CurrentQuantity: 4},
+				{ProductBatchId: 1, DueDate: due.AddDate(0, 1, 0), CurrentQuantity: 10},
+			},
+		},
+		{
+			name: "success - no stock",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery("SELECT id, due_date, current_quantity FROM product_batches").
+					WithArgs(7).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "due_date", "current_quantity"}))
+				return mock, db
+			},
+			expected: []models.BatchStock{},
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery("SELECT id, due_date, current_quantity FROM product_batches").
+					WithArgs(7).
+					WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewAllocationRepository(db)
+
+			result, err := repo.FindAvailableBatchesForUpdate(context.Background(), db, 7)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAllocationRepository_FindWarehouseBatchesForUpdate(t *testing.T) {
+	due := time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+	mock, db := testhelpers.CreateMockDB()
+	defer db.Close()
+	mock.ExpectQuery("SELECT b.id, b.due_date, b.current_quantity FROM product_batches b (.+) WHERE b.product_id = \\? AND s.warehouse_id = \\? (.+) FOR UPDATE").
+		WithArgs(7, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "due_date", "current_quantity"}).AddRow(3, due, 4))
+	repo := repository.NewAllocationRepository(db)
+
+	result, err := repo.FindWarehouseBatchesForUpdate(context.Background(), db, 7, 2)
+
+	require.NoError(t, err)
+	require.Equal(t, []models.BatchStock{{ProductBatchId: 3, DueDate: due, CurrentQuantity: 4}}, result)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
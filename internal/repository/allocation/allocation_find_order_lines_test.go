@@ -0,0 +1,73 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/allocation"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestAllocationRepository_FindOrderLines(t *testing.T) {
+	type testCase struct {
+		name     string
+		dbMock   func() (sqlmock.Sqlmock, *sql.DB)
+		expected []models.OrderLine
+		errCode  string
+	}
+
+	testCases := []testCase{
+		{
+			name: "success - lines without quantity read as zero",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				rows := sqlmock.NewRows([]string{"id", "product_id", "quantity"}).
+					AddRow(1, 7, 6).
+					AddRow(2, 8, 0)
+				mock.ExpectQuery("SELECT od.id, pr.product_id, COALESCE\\(od.quantity, 0\\) FROM order_details od (.+) ORDER BY od.id").
+					WithArgs(1).
+					WillReturnRows(rows)
+				return mock, db
+			},
+			expected: []models.OrderLine{
+				{OrderDetailId: 1, ProductId: 7, Quantity: 6},
+				{OrderDetailId: 2, ProductId: 8, Quantity: 0},
+			},
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery("SELECT od.id, pr.product_id").
+					WithArgs(1).
+					WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewAllocationRepository(db)
+
+			result, err := repo.FindOrderLines(context.Background(), db, 1)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/database"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+)
+
+// AllocationRepository defines the data operations needed to reserve and release batch stock for purchase orders.
+type AllocationRepository interface {
+	// GetOrderStatusForUpdate returns the status of a purchase order, locking the row until the transaction ends.
+	GetOrderStatusForUpdate(ctx context.Context, exec Executor, orderId int) (int, error)
+
+	// UpdateOrderStatus sets the status of a purchase order.
+	UpdateOrderStatus(ctx context.Context, exec Executor, orderId int, statusId int) error
+
+	// FindOrderLines returns the order_details of a purchase order resolved to their products.
+	FindOrderLines(ctx context.Context, exec Executor, orderId int) ([]models.OrderLine, error)
+
+	// FindAvailableBatchesForUpdate returns the non-expired, available batches of a product with stock left,
+	// ordered First-Expired-First-Out and locked until the transaction ends.
+	FindAvailableBatchesForUpdate(ctx context.Context, exec Executor, productId int) ([]models.BatchStock, error)
+
+	// FindWarehouseBatchesForUpdate is FindAvailableBatchesForUpdate narrowed to the batches stored in one warehouse.
+	FindWarehouseBatchesForUpdate(ctx context.Context, exec Executor, productId int, warehouseId int) ([]models.BatchStock, error)
+
+	// CreateAllocation stores a reservation of batch stock for an order line.
+	CreateAllocation(ctx context.Context, exec Executor, a models.StockAllocation) (*models.StockAllocation, error)
+
+	// FindReservedByOrder returns the allocations of a purchase order that are still reserved.
+	FindReservedByOrder(ctx context.Context, exec Executor, orderId int) ([]models.StockAllocation, error)
+
+	// ReleaseAllocations marks every reserved allocation of a purchase order as released.
+	ReleaseAllocations(ctx context.Context, exec Executor, orderId int) error
+
+	// FindByOrder returns every allocation of a purchase order, whatever its status.
+	FindByOrder(ctx context.Context, orderId int) ([]models.StockAllocation, error)
+
+	database.Transactor
+}
+
+// Executor runs SQL statements either on the connection or inside a transaction.
+type Executor = database.Executor
+
+// allocationRepository implements AllocationRepository using MySQL.
+type allocationRepository struct {
+	database.MySQLTransactor
+	mysql *sql.DB
+}
+
+// NewAllocationRepository returns a new AllocationRepository using the given MySQL connection.
+func NewAllocationRepository(mysql *sql.DB) AllocationRepository {
+	return &allocationRepository{
+		MySQLTransactor: database.NewMySQLTransactor(mysql),
+		mysql:           mysql,
+	}
+}
@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/asn"
+)
+
+const (
+	queryAsnColumns    = `SELECT id, asn_number, seller_id, warehouse_id, status, expected_at, created_at, arrived_at, received_at, closed_at FROM asns`
+	queryAsnCreate     = `INSERT INTO asns (asn_number, seller_id, warehouse_id, status, expected_at, created_at) VALUES (?, ?, ?, ?, ?, ?)`
+	queryAsnLineCreate = `INSERT INTO asn_lines (asn_id, product_id, expected_quantity)
+		SELECT ?, id, ? FROM products WHERE id = ? AND seller_id = ?`
+	queryAsnFindAll = queryAsnColumns + ` WHERE (? = 0 OR seller_id = ?) AND (? = 0 OR warehouse_id = ?) AND (? = '' OR status = ?)
+		ORDER BY expected_at DESC, id DESC`
+	queryAsnFindById  = queryAsnColumns + ` WHERE id = ?`
+	queryAsnForUpdate = queryAsnFindById + ` FOR UPDATE`
+	queryAsnLines     = `SELECT l.id, l.product_id, p.product_code, l.expected_quantity, l.received_quantity
+		FROM asn_lines l INNER JOIN products p ON p.id = l.product_id WHERE l.asn_id = ? ORDER BY l.product_id`
+	queryAsnAddReceived = `INSERT INTO asn_lines (asn_id, product_id, expected_quantity, received_quantity)
+		SELECT ?, id, 0, ? FROM products WHERE id = ? AND seller_id = ?
+		ON DUPLICATE KEY UPDATE received_quantity = received_quantity + VALUES(received_quantity)`
+	queryAsnUpdateStatus     = `UPDATE asns SET status = ?, arrived_at = ?, received_at = ?, closed_at = ? WHERE id = ?`
+	queryAsnDiscrepancyLines = `SELECT a.seller_id, s.company_name, a.id, a.asn_number, a.warehouse_id, l.product_id, p.product_code,
+			l.expected_quantity, l.received_quantity
+		FROM asn_lines l
+		INNER JOIN asns a ON a.id = l.asn_id
+		INNER JOIN sellers s ON s.id = a.seller_id
+		INNER JOIN products p ON p.id = l.product_id
+		WHERE a.status IN ('received', 'closed') AND (? = 0 OR a.seller_id = ?) AND (? = 0 OR a.warehouse_id = ?)
+		ORDER BY a.seller_id, a.id, l.product_id`
+)
+
+// Create inserts the ASN.
+// Returns a conflict error if the ASN number is taken and a not found error if the seller or warehouse does not exist.
+func (r *asnRepository) Create(ctx context.Context, exec Executor, a models.Asn) (*models.Asn, error) {
+	res, err := exec.ExecContext(ctx, queryAsnCreate, a.AsnNumber, a.SellerId, a.WarehouseId, a.Status, a.ExpectedAt, a.CreatedAt)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
+			switch mysqlErr.Number {
+			case 1062:
+				return nil, apperrors.NewAppError(apperrors.CodeConflict, "asn_number already exists").
+					WithDetail("asn_number", a.AsnNumber)
+			case 1452:
+				return nil, apperrors.NewAppError(apperrors.CodeNotFound, "seller or warehouse not found").
+					WithDetail("seller_id", a.SellerId).
+					WithDetail("warehouse_id", a.WarehouseId)
+			}
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error creating asn")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	a.Id = int(id)
+	return &a, nil
+}
+
+// CreateLine inserts the line only when the product belongs to the seller.
+// Returns a validation error otherwise.
+func (r *asnRepository) CreateLine(ctx context.Context, exec Executor, asnId int, sellerId int, l models.PostAsnLine) error {
+	res, err := exec.ExecContext(ctx, queryAsnLineCreate, asnId, *l.ExpectedQuantity, l.ProductId, sellerId)
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error creating asn line")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error getting rows affected")
+	}
+	if n == 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "product not found for the seller").
+			WithDetail("product_id", l.ProductId).
+			WithDetail("seller_id", sellerId)
+	}
+	return nil
+}
+
+// FindAll returns the ASNs; zero filters are ignored.
+func (r *asnRepository) FindAll(ctx context.Context, filter models.AsnFilter) ([]models.Asn, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryAsnFindAll, filter.SellerId, filter.SellerId,
+		filter.WarehouseId, filter.WarehouseId, filter.Status, filter.Status)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying asns")
+	}
+	defer rows.Close()
+
+	asns := make([]models.Asn, 0)
+	for rows.Next() {
+		a, err := scanAsn(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning asn")
+		}
+		asns = append(asns, *a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating asns")
+	}
+	return asns, nil
+}
+
+// FindById returns the ASN.
+// Returns a not found error if it does not exist.
+func (r *asnRepository) FindById(ctx context.Context, id int) (*models.Asn, error) {
+	return findAsn(r.mysql.QueryRowContext(ctx, queryAsnFindById, id), id)
+}
+
+// FindForUpdate returns the ASN and locks its row.
+// Returns a not found error if it does not exist.
+func (r *asnRepository) FindForUpdate(ctx context.Context, exec Executor, id int) (*models.Asn, error) {
+	return findAsn(exec.QueryRowContext(ctx, queryAsnForUpdate, id), id)
+}
+
+// FindLines returns the lines of the ASN ordered by product, with their variance.
+func (r *asnRepository) FindLines(ctx context.Context, asnId int) ([]models.AsnLine, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryAsnLines, asnId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying asn lines")
+	}
+	defer rows.Close()
+
+	lines := make([]models.AsnLine, 0)
+	for rows.Next() {
+		var l models.AsnLine
+		if err := rows.Scan(&l.Id, &l.ProductId, &l.ProductCode, &l.ExpectedQuantity, &l.ReceivedQuantity); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning asn line")
+		}
+		l.Variance = l.ReceivedQuantity - l.ExpectedQuantity
+		lines = append(lines, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating asn lines")
+	}
+	return lines, nil
+}
+
+// AddReceived upserts the line of the product, adding quantity to what it already received.
+// Returns a validation error if the product does not belong to the seller.
+func (r *asnRepository) AddReceived(ctx context.Context, exec Executor, asnId int, sellerId int, productId int, quantity int) error {
+	res, err := exec.ExecContext(ctx, queryAsnAddReceived, asnId, quantity, productId, sellerId)
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating asn received quantity")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error getting rows affected")
+	}
+	if n == 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "product not found for the seller").
+			WithDetail("product_id", productId).
+			WithDetail("seller_id", sellerId)
+	}
+	return nil
+}
+
+// UpdateStatus stores the ASN status and its timestamps.
+func (r *asnRepository) UpdateStatus(ctx context.Context, exec Executor, a models.Asn) error {
+	if _, err := exec.ExecContext(ctx, queryAsnUpdateStatus, a.Status, a.ArrivedAt, a.ReceivedAt, a.ClosedAt, a.Id); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating asn status")
+	}
+	return nil
+}
+
+// FindDiscrepancyLines returns every line of the completed ASNs; zero filters are ignored.
+func (r *asnRepository) FindDiscrepancyLines(ctx context.Context, sellerId int, warehouseId int) ([]models.DiscrepancyLine, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryAsnDiscrepancyLines, sellerId, sellerId, warehouseId, warehouseId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying asn discrepancies")
+	}
+	defer rows.Close()
+
+	lines := make([]models.DiscrepancyLine, 0)
+	for rows.Next() {
+		var l models.DiscrepancyLine
+		if err := rows.Scan(&l.SellerId, &l.CompanyName, &l.AsnId, &l.AsnNumber, &l.WarehouseId, &l.ProductId, &l.ProductCode,
+			&l.ExpectedQuantity, &l.ReceivedQuantity); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning asn discrepancy")
+		}
+		l.Variance = l.ReceivedQuantity - l.ExpectedQuantity
+		lines = append(lines, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating asn discrepancies")
+	}
+	return lines, nil
+}
+
+// findAsn scans a single ASN row, mapping a missing row to a not found error.
+func findAsn(row *sql.Row, id int) (*models.Asn, error) {
+	a, err := scanAsn(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "asn not found").WithDetail("asn_id", id)
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying asn")
+	}
+	return a, nil
+}
+
+func scanAsn(row interface{ Scan(dest ...any) error }) (*models.Asn, error) {
+	var a models.Asn
+	var arrivedAt, receivedAt, closedAt sql.NullTime
+	if err := row.Scan(&a.Id, &a.AsnNumber, &a.SellerId, &a.WarehouseId, &a.Status, &a.ExpectedAt, &a.CreatedAt,
+		&arrivedAt, &receivedAt, &closedAt); err != nil {
+		return nil, err
+	}
+	a.ArrivedAt = nullTimePtr(arrivedAt)
+	a.ReceivedAt = nullTimePtr(receivedAt)
+	a.ClosedAt = nullTimePtr(closedAt)
+	return &a, nil
+}
+
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/database"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/asn"
+)
+
+// AsnRepository defines the data operations of advance shipping notices and their lines.
+type AsnRepository interface {
+	// Create inserts an ASN and returns it with its generated id.
+	Create(ctx context.Context, exec Executor, a models.Asn) (*models.Asn, error)
+
+	// CreateLine adds the expected quantity of a product to an ASN. The product must belong to the seller.
+	CreateLine(ctx context.Context, exec Executor, asnId int, sellerId int, l models.PostAsnLine) error
+
+	// FindAll returns the ASNs matching the filter, most recently expected first.
+	FindAll(ctx context.Context, filter models.AsnFilter) ([]models.Asn, error)
+
+	// FindById returns an ASN.
+	FindById(ctx context.Context, id int) (*models.Asn, error)
+
+	// FindForUpdate returns an ASN, locking it until the transaction ends.
+	FindForUpdate(ctx context.Context, exec Executor, id int) (*models.Asn, error)
+
+	// FindLines returns the lines of an ASN.
+	FindLines(ctx context.Context, asnId int) ([]models.AsnLine, error)
+
+	// AddReceived adds a received quantity of a product of the seller to an ASN, adding a line when the product was not expected.
+	AddReceived(ctx context.Context, exec Executor, asnId int, sellerId int, productId int, quantity int) error
+
+	// UpdateStatus stores the status and timestamps of an ASN.
+	UpdateStatus(ctx context.Context, exec Executor, a models.Asn) error
+
+	// FindDiscrepancyLines returns the lines of received and closed ASNs, ordered by seller.
+	FindDiscrepancyLines(ctx context.Context, sellerId int, warehouseId int) ([]models.DiscrepancyLine, error)
+
+	database.Transactor
+}
+
+// Executor runs SQL statements either on the connection or inside a transaction.
+type Executor = database.Executor
+
+// asnRepository implements AsnRepository using MySQL.
+type asnRepository struct {
+	database.MySQLTransactor
+	mysql *sql.DB
+}
+
+// NewAsnRepository returns a new AsnRepository using the given MySQL connection.
+func NewAsnRepository(mysql *sql.DB) AsnRepository {
+	return &asnRepository{
+		MySQLTransactor: database.NewMySQLTransactor(mysql),
+		mysql:           mysql,
+	}
+}
@@ -0,0 +1,224 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/asn"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/asn"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestAsnRepository_Create(t *testing.T) {
+	const query = `INSERT INTO asns \(asn_number, seller_id, warehouse_id, status, expected_at, created_at\) VALUES \(\?, \?, \?, \?, \?, \?\)`
+	asn := testhelpers.DummyAsn(models.StatusScheduled)
+
+	testCases := []struct {
+		name    string
+		dbMock  func() (sqlmock.Sqlmock, *sql.DB)
+		errCode string
+	}{
+		{
+			name: "success",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).
+					WithArgs("ASN-001", 3, 1, models.StatusScheduled, asn.ExpectedAt, asn.CreatedAt).
+					WillReturnResult(sqlmock.NewResult(7, 1))
+				return mock, db
+			},
+		},
+		{
+			name: "error - asn number taken",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WillReturnError(&mysql.MySQLError{Number: 1062})
+				return mock, db
+			},
+			errCode: apperrors.CodeConflict,
+		},
+		{
+			name: "error - seller or warehouse missing",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WillReturnError(&mysql.MySQLError{Number: 1452})
+				return mock, db
+			},
+			errCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewAsnRepository(db)
+
+			result, err := repo.Create(context.Background(), db, asn)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 7, result.Id)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAsnRepository_CreateLine(t *testing.T) {
+	query := regexp.QuoteMeta(`INSERT INTO asn_lines (asn_id, product_id, expected_quantity)`)
+	line := models.PostAsnLine{ProductId: 22, ExpectedQuantity: testhelpers.IntPtr(100)}
+
+	testCases := []struct {
+		name    string
+		rows    int64
+		errCode string
+	}{
+		{
+			name: "success",
+			rows: 1,
+		},
+		{
+			name:    "error - product of another seller",
+			rows:    0,
+			errCode: apperrors.CodeValidationError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := testhelpers.CreateMockDB()
+			defer db.Close()
+			mock.ExpectExec(query).WithArgs(7, 100, 22, 3).WillReturnResult(sqlmock.NewResult(0, tc.rows))
+			repo := repository.NewAsnRepository(db)
+
+			err := repo.CreateLine(context.Background(), db, 7, 3, line)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAsnRepository_FindById(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT id, asn_number, seller_id, warehouse_id, status, expected_at, created_at, arrived_at, received_at, closed_at FROM asns WHERE id = ?`)
+	columns := []string{"id", "asn_number", "seller_id", "warehouse_id", "status", "expected_at", "created_at", "arrived_at", "received_at", "closed_at"}
+	expectedAt := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+	arrivedAt := time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		dbMock  func() (sqlmock.Sqlmock, *sql.DB)
+		errCode string
+	}{
+		{
+			name: "success",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(7).WillReturnRows(sqlmock.NewRows(columns).
+					AddRow(7, "ASN-001", 3, 1, models.StatusArriving, expectedAt, expectedAt, arrivedAt, nil, nil))
+				return mock, db
+			},
+		},
+		{
+			name: "error - not found",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(7).WillReturnRows(sqlmock.NewRows(columns))
+				return mock, db
+			},
+			errCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewAsnRepository(db)
+
+			result, err := repo.FindById(context.Background(), 7)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, models.StatusArriving, result.Status)
+				require.Equal(t, arrivedAt, *result.ArrivedAt)
+				require.Nil(t, result.ReceivedAt)
+				require.Nil(t, result.ClosedAt)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAsnRepository_AddReceived(t *testing.T) {
+	query := regexp.QuoteMeta(`INSERT INTO asn_lines (asn_id, product_id, expected_quantity, received_quantity)
+		SELECT ?, id, 0, ? FROM products WHERE id = ? AND seller_id = ?
+		ON DUPLICATE KEY UPDATE received_quantity = received_quantity + VALUES(received_quantity)`)
+
+	testCases := []struct {
+		name     string
+		affected int64
+		errCode  string
+	}{
+		{name: "success - expected product", affected: 2},
+		{name: "success - unannounced product of the seller", affected: 1},
+		{name: "error - product of another seller", affected: 0, errCode: apperrors.CodeValidationError},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := testhelpers.CreateMockDB()
+			defer db.Close()
+			mock.ExpectExec(query).WithArgs(7, 50, 22, 3).WillReturnResult(sqlmock.NewResult(0, tc.affected))
+			repo := repository.NewAsnRepository(db)
+
+			err := repo.AddReceived(context.Background(), db, 7, 3, 22, 50)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestAsnRepository_FindDiscrepancyLines(t *testing.T) {
+	query := regexp.QuoteMeta(`WHERE a.status IN ('received', 'closed') AND (? = 0 OR a.seller_id = ?) AND (? = 0 OR a.warehouse_id = ?)`)
+	columns := []string{"seller_id", "company_name", "id", "asn_number", "warehouse_id", "product_id", "product_code",
+		"expected_quantity", "received_quantity"}
+
+	mock, db := testhelpers.CreateMockDB()
+	defer db.Close()
+	mock.ExpectQuery(query).WithArgs(3, 3, 0, 0).WillReturnRows(sqlmock.NewRows(columns).
+		AddRow(3, "Seller", 7, "ASN-001", 1, 22, "P-001", 100, 90).
+		AddRow(3, "Seller", 7, "ASN-001", 1, 23, "P-002", 0, 5))
+	repo := repository.NewAsnRepository(db)
+
+	lines, err := repo.FindDiscrepancyLines(context.Background(), 3, 0)
+
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	require.Equal(t, -10, lines[0].Variance)
+	require.Equal(t, 5, lines[1].Variance)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
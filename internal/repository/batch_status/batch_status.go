@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/batch_status"
+)
+
+const (
+	queryStatusSelect    = `SELECT id, status, status_reason, status_set_by, status_updated_at, current_quantity, section_id FROM product_batches WHERE id = ?`
+	queryStatusForUpdate = queryStatusSelect + ` FOR UPDATE`
+	queryStatusUpdate    = `UPDATE product_batches SET status = ?, status_reason = ?, status_set_by = ?, status_updated_at = ? WHERE id = ?`
+	queryChangeCreate    = `INSERT INTO batch_status_history (product_batch_id, from_status, to_status, reason_code, note, actor, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)`
+	queryChangesByBatch  = `SELECT id, product_batch_id, from_status, to_status, reason_code, note, actor, created_at FROM batch_status_history
+		WHERE product_batch_id = ? ORDER BY created_at ASC, id ASC`
+	queryReservedForUpdate   = `SELECT COALESCE(SUM(quantity), 0) FROM stock_allocations WHERE product_batch_id = ? AND status = 'reserved' FOR UPDATE`
+	queryReservationsRelease = `UPDATE stock_allocations SET status = 'released', released_at = NOW() WHERE product_batch_id = ? AND status = 'reserved'`
+	querySectionCapacityAdd  = `UPDATE sections SET current_capacity = current_capacity + ? WHERE id = ?`
+)
+
+// FindStatusForUpdate returns the batch status and locks its row.
+// Returns a not found error if the batch does not exist.
+func (r *batchStatusRepository) FindStatusForUpdate(ctx context.Context, exec Executor, batchId int) (*models.BatchStatus, error) {
+	return scanStatus(exec.QueryRowContext(ctx, queryStatusForUpdate, batchId))
+}
+
+// FindStatus returns the batch status.
+// Returns a not found error if the batch does not exist.
+func (r *batchStatusRepository) FindStatus(ctx context.Context, batchId int) (*models.BatchStatus, error) {
+	return scanStatus(r.mysql.QueryRowContext(ctx, queryStatusSelect, batchId))
+}
+
+// UpdateStatus stores the new status of the batch with its reason code, actor and time.
+func (r *batchStatusRepository) UpdateStatus(ctx context.Context, exec Executor, batchId int, status string, reasonCode string, actor string, at time.Time) error {
+	if _, err := exec.ExecContext(ctx, queryStatusUpdate, status, reasonCode, actor, at, batchId); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating product batch status")
+	}
+	return nil
+}
+
+// CreateChange inserts a status history entry and returns it with its generated id.
+func (r *batchStatusRepository) CreateChange(ctx context.Context, exec Executor, c models.StatusChange) (*models.StatusChange, error) {
+	res, err := exec.ExecContext(ctx, queryChangeCreate, c.ProductBatchId, c.FromStatus, c.ToStatus, c.ReasonCode, c.Note, c.Actor, c.CreatedAt)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error creating product batch status change")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	c.Id = int(id)
+	return &c, nil
+}
+
+// FindChanges returns the status history of the batch, oldest first.
+func (r *batchStatusRepository) FindChanges(ctx context.Context, batchId int) ([]models.StatusChange, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryChangesByBatch, batchId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying product batch status history")
+	}
+	defer rows.Close()
+
+	changes := make([]models.StatusChange, 0)
+	for rows.Next() {
+		var c models.StatusChange
+		if err := rows.Scan(&c.Id, &c.ProductBatchId, &c.FromStatus, &c.ToStatus, &c.ReasonCode, &c.Note, &c.Actor, &c.CreatedAt); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning product batch status change")
+		}
+		changes = append(changes, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating product batch status history")
+	}
+	return changes, nil
+}
+
+// FindReservedForUpdate returns the units of the batch reserved for purchase orders and not picked yet,
+// locking the allocations.
+func (r *batchStatusRepository) FindReservedForUpdate(ctx context.Context, exec Executor, batchId int) (int, error) {
+	var reserved int
+	if err := exec.QueryRowContext(ctx, queryReservedForUpdate, batchId).Scan(&reserved); err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error querying product batch reservations")
+	}
+	return reserved, nil
+}
+
+// ReleaseReservations marks the reserved allocations of the batch as released.
+func (r *batchStatusRepository) ReleaseReservations(ctx context.Context, exec Executor, batchId int) error {
+	if _, err := exec.ExecContext(ctx, queryReservationsRelease, batchId); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error releasing product batch reservations")
+	}
+	return nil
+}
+
+// AddSectionCapacity adds delta to the section current_capacity.
+func (r *batchStatusRepository) AddSectionCapacity(ctx context.Context, exec Executor, sectionId int, delta int) error {
+	if _, err := exec.ExecContext(ctx, querySectionCapacityAdd, delta, sectionId); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating section capacity")
+	}
+	return nil
+}
+
+func scanStatus(row *sql.Row) (*models.BatchStatus, error) {
+	var s models.BatchStatus
+	var reason, setBy sql.NullString
+	var updatedAt sql.NullTime
+	if err := row.Scan(&s.ProductBatchId, &s.Status, &reason, &setBy, &updatedAt, &s.CurrentQuantity, &s.SectionId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "product batch not found")
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying product batch status")
+	}
+	if reason.Valid {
+		s.ReasonCode = &reason.String
+	}
+	if setBy.Valid {
+		s.SetBy = &setBy.String
+	}
+	if updatedAt.Valid {
+		s.UpdatedAt = &updatedAt.Time
+	}
+	return &s, nil
+}
@@ -0,0 +1,97 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/batch_status"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestBatchStatusRepository_FindStatusForUpdate(t *testing.T) {
+	columns := []string{"id", "status", "status_reason", "status_set_by", "status_updated_at", "current_quantity", "section_id"}
+	const query = `SELECT (.+) FROM product_batches WHERE id = \? FOR UPDATE`
+	setAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		dbMock  func() (sqlmock.Sqlmock, *sql.DB)
+		check   func(t *testing.T, reason, setBy *string, at *time.Time)
+		errCode string
+	}{
+		{
+			name: "success - status never changed",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows(columns).
+					AddRow(1, batchModels.BatchStatusAvailable, nil, nil, nil, 40, 3))
+				return mock, db
+			},
+			check: func(t *testing.T, reason, setBy *string, at *time.Time) {
+				require.Nil(t, reason)
+				require.Nil(t, setBy)
+				require.Nil(t, at)
+			},
+		},
+		{
+			name: "success - status set by a user",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows(columns).
+					AddRow(1, batchModels.BatchStatusAvailable, "inspection_passed", "qa.lead", setAt, 40, 3))
+				return mock, db
+			},
+			check: func(t *testing.T, reason, setBy *string, at *time.Time) {
+				require.Equal(t, "inspection_passed", *reason)
+				require.Equal(t, "qa.lead", *setBy)
+				require.Equal(t, setAt, *at)
+			},
+		},
+		{
+			name: "error - batch not found",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1).WillReturnError(sql.ErrNoRows)
+				return mock, db
+			},
+			errCode: apperrors.CodeNotFound,
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewBatchStatusRepository(db)
+
+			result, err := repo.FindStatusForUpdate(context.Background(), db, 1)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 1, result.ProductBatchId)
+				require.Equal(t, 40, result.CurrentQuantity)
+				require.Equal(t, 3, result.SectionId)
+				tc.check(t, result.ReasonCode, result.SetBy, result.UpdatedAt)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/database"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/batch_status"
+)
+
+// BatchStatusRepository defines the data operations on the status of product batches.
+type BatchStatusRepository interface {
+	// FindStatusForUpdate returns the current status of a batch, locking it until the transaction ends.
+	FindStatusForUpdate(ctx context.Context, exec Executor, batchId int) (*models.BatchStatus, error)
+
+	// FindStatus returns the current status of a batch.
+	FindStatus(ctx context.Context, batchId int) (*models.BatchStatus, error)
+
+	// UpdateStatus sets the status of a batch along with the reason and who set it.
+	UpdateStatus(ctx context.Context, exec Executor, batchId int, status string, reasonCode string, actor string, at time.Time) error
+
+	// CreateChange appends an entry to the status history of a batch.
+	CreateChange(ctx context.Context, exec Executor, c models.StatusChange) (*models.StatusChange, error)
+
+	// FindChanges returns the status history of a batch, oldest first.
+	FindChanges(ctx context.Context, batchId int) ([]models.StatusChange, error)
+
+	// FindReservedForUpdate returns the units of a batch reserved for purchase orders and not picked yet,
+	// locking the allocations until the transaction ends.
+	FindReservedForUpdate(ctx context.Context, exec Executor, batchId int) (int, error)
+
+	// ReleaseReservations releases the reserved allocations of a batch.
+	ReleaseReservations(ctx context.Context, exec Executor, batchId int) error
+
+	// AddSectionCapacity adds delta (which may be negative) to a section current_capacity.
+	AddSectionCapacity(ctx context.Context, exec Executor, sectionId int, delta int) error
+
+	database.Transactor
+}
+
+// Executor runs SQL statements either on the connection or inside a transaction.
+type Executor = database.Executor
+
+// batchStatusRepository implements BatchStatusRepository using MySQL.
+type batchStatusRepository struct {
+	database.MySQLTransactor
+	mysql *sql.DB
+}
+
+// NewBatchStatusRepository returns a new BatchStatusRepository using the given MySQL connection.
+func NewBatchStatusRepository(mysql *sql.DB) BatchStatusRepository {
+	return &batchStatusRepository{
+		MySQLTransactor: database.NewMySQLTransactor(mysql),
+		mysql:           mysql,
+	}
+}
@@ -0,0 +1,106 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/batch_status"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestBatchStatusRepository_FindReservedForUpdate(t *testing.T) {
+	const query = `SELECT COALESCE\(SUM\(quantity\), 0\) FROM stock_allocations WHERE product_batch_id = \? AND status = 'reserved' FOR UPDATE`
+
+	testCases := []struct {
+		name     string
+		dbMock   func() (sqlmock.Sqlmock, *sql.DB)
+		expected int
+		errCode  string
+	}{
+		{
+			name: "success - reserved units",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"reserved"}).AddRow(12))
+				return mock, db
+			},
+			expected: 12,
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewBatchStatusRepository(db)
+
+			result, err := repo.FindReservedForUpdate(context.Background(), db, 1)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBatchStatusRepository_ReleaseReservations(t *testing.T) {
+	const query = `UPDATE stock_allocations SET status = 'released', released_at = NOW\(\) WHERE product_batch_id = \? AND status = 'reserved'`
+
+	testCases := []struct {
+		name    string
+		dbMock  func() (sqlmock.Sqlmock, *sql.DB)
+		errCode string
+	}{
+		{
+			name: "success",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 2))
+				return mock, db
+			},
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WithArgs(1).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewBatchStatusRepository(db)
+
+			err := repo.ReleaseReservations(context.Background(), db, 1)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
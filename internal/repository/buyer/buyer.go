@@ -61,7 +61,7 @@ func (r *buyerRepository) Delete(ctx context.Context, id int) error {
 		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
 			if mysqlErr.Number == 1451 {
 				return apperrors.NewAppError(apperrors.CodeConflict,
-					"cannot delete buyer: there are purchase orders associated")
+					"cannot delete buyer: there are purchase orders or addresses associated")
 			}
 		}
 		return apperrors.NewAppError(apperrors.CodeInternal,
@@ -96,7 +96,7 @@ func TestBuyerRepository_Delete(t *testing.T) {
 				ctx: context.Background(),
 			},
 			output: output{
-				err: apperrors.NewAppError(apperrors.CodeConflict, "cannot delete buyer: there are purchase orders associated"),
+				err: apperrors.NewAppError(apperrors.CodeConflict, "cannot delete buyer: there are purchase orders or addresses associated"),
 			},
 		},
 		{
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer_address"
+)
+
+const (
+	queryBuyerAddressBuyerExists = `SELECT EXISTS(SELECT 1 FROM buyers WHERE id = ?)`
+	queryBuyerAddressLockBuyer   = `SELECT id FROM buyers WHERE id = ? FOR UPDATE`
+	queryBuyerAddressColumns     = `SELECT id, buyer_id, label, address, locality_id, is_default, created_at FROM buyer_addresses`
+	queryBuyerAddressFindByBuyer = queryBuyerAddressColumns + ` WHERE buyer_id = ? ORDER BY id`
+	queryBuyerAddressFindById    = queryBuyerAddressColumns + ` WHERE buyer_id = ? AND id = ?`
+	queryBuyerAddressFindDefault = queryBuyerAddressColumns + ` WHERE buyer_id = ? AND is_default = TRUE`
+	queryBuyerAddressForUpdate   = queryBuyerAddressFindById + ` FOR UPDATE`
+	queryBuyerAddressFindOldest  = queryBuyerAddressColumns + ` WHERE buyer_id = ? ORDER BY id LIMIT 1`
+	queryBuyerAddressCount       = `SELECT COUNT(1) FROM buyer_addresses WHERE buyer_id = ?`
+	queryBuyerAddressCreate      = `INSERT INTO buyer_addresses (buyer_id, label, address, locality_id, is_default, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)`
+	queryBuyerAddressUpdate       = `UPDATE buyer_addresses SET label = ?, address = ?, locality_id = ?, is_default = ? WHERE id = ?`
+	queryBuyerAddressDelete       = `DELETE FROM buyer_addresses WHERE buyer_id = ? AND id = ?`
+	queryBuyerAddressClearDefault = `UPDATE buyer_addresses SET is_default = FALSE WHERE buyer_id = ? AND is_default = TRUE`
+)
+
+func (r *buyerAddressRepository) BuyerExists(ctx context.Context, buyerId int) (bool, error) {
+	var exists bool
+	if err := r.mysql.QueryRowContext(ctx, queryBuyerAddressBuyerExists, buyerId).Scan(&exists); err != nil {
+		return false, apperrors.NewAppError(apperrors.CodeInternal, "error querying buyer")
+	}
+	return exists, nil
+}
+
+// LockBuyer locks the buyer row for the rest of the transaction.
+// Returns a not found error if the buyer does not exist.
+func (r *buyerAddressRepository) LockBuyer(ctx context.Context, exec Executor, buyerId int) error {
+	var id int
+	if err := exec.QueryRowContext(ctx, queryBuyerAddressLockBuyer, buyerId).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.NewAppError(apperrors.CodeNotFound, "buyer not found").WithDetail("buyer_id", buyerId)
+		}
+		return apperrors.NewAppError(apperrors.CodeInternal, "error querying buyer")
+	}
+	return nil
+}
+
+// FindByBuyer returns the addresses of the buyer ordered by id.
+func (r *buyerAddressRepository) FindByBuyer(ctx context.Context, buyerId int) ([]models.Address, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryBuyerAddressFindByBuyer, buyerId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying buyer addresses")
+	}
+	defer rows.Close()
+
+	addresses := make([]models.Address, 0)
+	for rows.Next() {
+		a, err := scanAddress(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning buyer address")
+		}
+		addresses = append(addresses, *a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating buyer addresses")
+	}
+	return addresses, nil
+}
+
+// FindById returns the address.
+// Returns a not found error if it does not exist or belongs to another buyer.
+func (r *buyerAddressRepository) FindById(ctx context.Context, buyerId int, id int) (*models.Address, error) {
+	return findAddress(r.mysql.QueryRowContext(ctx, queryBuyerAddressFindById, buyerId, id), id)
+}
+
+// FindDefault returns the default address.
+// Returns a not found error if the buyer has no address.
+func (r *buyerAddressRepository) FindDefault(ctx context.Context, buyerId int) (*models.Address, error) {
+	a, err := scanAddress(r.mysql.QueryRowContext(ctx, queryBuyerAddressFindDefault, buyerId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "buyer has no default address").
+				WithDetail("buyer_id", buyerId)
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying buyer address")
+	}
+	return a, nil
+}
+
+// FindForUpdate returns the address and locks its row.
+// Returns a not found error if it does not exist or belongs to another buyer.
+func (r *buyerAddressRepository) FindForUpdate(ctx context.Context, exec Executor, buyerId int, id int) (*models.Address, error) {
+	return findAddress(exec.QueryRowContext(ctx, queryBuyerAddressForUpdate, buyerId, id), id)
+}
+
+func (r *buyerAddressRepository) FindOldest(ctx context.Context, exec Executor, buyerId int) (*models.Address, error) {
+	a, err := scanAddress(exec.QueryRowContext(ctx, queryBuyerAddressFindOldest, buyerId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying buyer address")
+	}
+	return a, nil
+}
+
+func (r *buyerAddressRepository) CountByBuyer(ctx context.Context, exec Executor, buyerId int) (int, error) {
+	var count int
+	if err := exec.QueryRowContext(ctx, queryBuyerAddressCount, buyerId).Scan(&count); err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error counting buyer addresses")
+	}
+	return count, nil
+}
+
+// Create inserts the address.
+// Returns a not found error if the buyer or the locality does not exist.
+func (r *buyerAddressRepository) Create(ctx context.Context, exec Executor, a models.Address) (int, error) {
+	res, err := exec.ExecContext(ctx, queryBuyerAddressCreate, a.BuyerId, a.Label, a.Address, a.LocalityId, a.IsDefault, a.CreatedAt)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1452 {
+			return 0, apperrors.NewAppError(apperrors.CodeNotFound, "buyer or locality not found")
+		}
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error creating buyer address")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	return int(id), nil
+}
+
+// Update stores the address.
+// Returns a not found error if the locality does not exist.
+func (r *buyerAddressRepository) Update(ctx context.Context, exec Executor, a models.Address) error {
+	if _, err := exec.ExecContext(ctx, queryBuyerAddressUpdate, a.Label, a.Address, a.LocalityId, a.IsDefault, a.Id); err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1452 {
+			return apperrors.NewAppError(apperrors.CodeNotFound, "locality not found").WithDetail("locality_id", a.LocalityId)
+		}
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating buyer address")
+	}
+	return nil
+}
+
+// Delete removes the address.
+// Returns a not found error if it does not exist or belongs to another buyer.
+func (r *buyerAddressRepository) Delete(ctx context.Context, exec Executor, buyerId int, id int) error {
+	res, err := exec.ExecContext(ctx, queryBuyerAddressDelete, buyerId, id)
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error deleting buyer address")
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error verifying affected rows")
+	}
+	if affected == 0 {
+		return apperrors.NewAppError(apperrors.CodeNotFound, "buyer address not found").WithDetail("address_id", id)
+	}
+	return nil
+}
+
+func (r *buyerAddressRepository) ClearDefault(ctx context.Context, exec Executor, buyerId int) error {
+	if _, err := exec.ExecContext(ctx, queryBuyerAddressClearDefault, buyerId); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating buyer addresses")
+	}
+	return nil
+}
+
+// findAddress scans a single address row, mapping a missing row to a not found error.
+func findAddress(row *sql.Row, id int) (*models.Address, error) {
+	a, err := scanAddress(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "buyer address not found").WithDetail("address_id", id)
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying buyer address")
+	}
+	return a, nil
+}
+
+func scanAddress(row interface{ Scan(dest ...any) error }) (*models.Address, error) {
+	var a models.Address
+	if err := row.Scan(&a.Id, &a.BuyerId, &a.Label, &a.Address, &a.LocalityId, &a.IsDefault, &a.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/database"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer_address"
+)
+
+// BuyerAddressRepository defines the data operations of the delivery addresses of buyers.
+type BuyerAddressRepository interface {
+	// BuyerExists reports whether the buyer exists.
+	BuyerExists(ctx context.Context, buyerId int) (bool, error)
+
+	// LockBuyer locks the buyer row, serialising the changes made to the addresses of the buyer.
+	LockBuyer(ctx context.Context, exec Executor, buyerId int) error
+
+	// FindByBuyer returns the addresses of a buyer.
+	FindByBuyer(ctx context.Context, buyerId int) ([]models.Address, error)
+
+	// FindById returns an address of a buyer.
+	FindById(ctx context.Context, buyerId int, id int) (*models.Address, error)
+
+	// FindDefault returns the default address of a buyer.
+	FindDefault(ctx context.Context, buyerId int) (*models.Address, error)
+
+	// FindForUpdate returns an address of a buyer and locks its row.
+	FindForUpdate(ctx context.Context, exec Executor, buyerId int, id int) (*models.Address, error)
+
+	// FindOldest returns the address of a buyer with the lowest id, or nil when the buyer has none.
+	FindOldest(ctx context.Context, exec Executor, buyerId int) (*models.Address, error)
+
+	// CountByBuyer counts the addresses of a buyer.
+	CountByBuyer(ctx context.Context, exec Executor, buyerId int) (int, error)
+
+	// Create inserts an address and returns its generated id.
+	Create(ctx context.Context, exec Executor, a models.Address) (int, error)
+
+	// Update stores the label, address, locality and default flag of an address.
+	Update(ctx context.Context, exec Executor, a models.Address) error
+
+	// Delete removes an address of a buyer.
+	Delete(ctx context.Context, exec Executor, buyerId int, id int) error
+
+	// ClearDefault unsets the default flag on every address of a buyer.
+	ClearDefault(ctx context.Context, exec Executor, buyerId int) error
+
+	database.Transactor
+}
+
+// Executor runs SQL statements either on the connection or inside a transaction.
+type Executor = database.Executor
+
+// buyerAddressRepository implements BuyerAddressRepository using MySQL.
+type buyerAddressRepository struct {
+	database.MySQLTransactor
+	mysql *sql.DB
+}
+
+// NewBuyerAddressRepository returns a new BuyerAddressRepository using the given MySQL connection.
+func NewBuyerAddressRepository(mysql *sql.DB) BuyerAddressRepository {
+	return &buyerAddressRepository{
+		MySQLTransactor: database.NewMySQLTransactor(mysql),
+		mysql:           mysql,
+	}
+}
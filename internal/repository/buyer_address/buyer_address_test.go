@@ -0,0 +1,236 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/buyer_address"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer_address"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+var addressColumns = []string{"id", "buyer_id", "label", "address", "locality_id", "is_default", "created_at"}
+
+func addressRow(rows *sqlmock.Rows, a models.Address) *sqlmock.Rows {
+	return rows.AddRow(a.Id, a.BuyerId, a.Label, a.Address, a.LocalityId, a.IsDefault, a.CreatedAt)
+}
+
+func TestBuyerAddressRepository_FindByBuyer(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT id, buyer_id, label, address, locality_id, is_default, created_at FROM buyer_addresses
+		WHERE buyer_id = ? ORDER BY id`)
+
+	mock, db := testhelpers.CreateMockDB()
+	defer db.Close()
+	rows := sqlmock.NewRows(addressColumns)
+	addressRow(rows, testhelpers.DummyAddress(1, true))
+	addressRow(rows, testhelpers.DummyAddress(2, false))
+	mock.ExpectQuery(query).WithArgs(101).WillReturnRows(rows)
+	repo := repository.NewBuyerAddressRepository(db)
+
+	addresses, err := repo.FindByBuyer(context.Background(), 101)
+
+	require.NoError(t, err)
+	require.Equal(t, []models.Address{testhelpers.DummyAddress(1, true), testhelpers.DummyAddress(2, false)}, addresses)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBuyerAddressRepository_FindDefault(t *testing.T) {
+	query := regexp.QuoteMeta(`FROM buyer_addresses WHERE buyer_id = ? AND is_default = TRUE`)
+
+	testCases := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		err     error
+		want    *models.Address
+		errCode string
+	}{
+		{
+			name: "success",
+			rows: addressRow(sqlmock.NewRows(addressColumns), testhelpers.DummyAddress(1, true)),
+			want: func() *models.Address { a := testhelpers.DummyAddress(1, true); return &a }(),
+		},
+		{
+			name:    "error - buyer without addresses",
+			err:     sql.ErrNoRows,
+			errCode: apperrors.CodeNotFound,
+		},
+		{
+			name:    "error - query failed",
+			err:     errors.New("connection reset"),
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := testhelpers.CreateMockDB()
+			defer db.Close()
+			expected := mock.ExpectQuery(query).WithArgs(101)
+			if tc.err != nil {
+				expected.WillReturnError(tc.err)
+			} else {
+				expected.WillReturnRows(tc.rows)
+			}
+			repo := repository.NewBuyerAddressRepository(db)
+
+			address, err := repo.FindDefault(context.Background(), 101)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, address)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.want, address)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBuyerAddressRepository_LockBuyer(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT id FROM buyers WHERE id = ? FOR UPDATE`)
+
+	t.Run("success", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		mock.ExpectQuery(query).WithArgs(101).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(101))
+		repo := repository.NewBuyerAddressRepository(db)
+
+		require.NoError(t, repo.LockBuyer(context.Background(), db, 101))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - buyer missing", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		mock.ExpectQuery(query).WithArgs(999).WillReturnError(sql.ErrNoRows)
+		repo := repository.NewBuyerAddressRepository(db)
+
+		testhelpers.RequireAppErr(t, repo.LockBuyer(context.Background(), db, 999), apperrors.CodeNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestBuyerAddressRepository_Create(t *testing.T) {
+	query := regexp.QuoteMeta(`INSERT INTO buyer_addresses (buyer_id, label, address, locality_id, is_default, created_at)`)
+	address := testhelpers.DummyAddress(0, true)
+
+	testCases := []struct {
+		name    string
+		err     error
+		errCode string
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:    "error - locality missing",
+			err:     &mysql.MySQLError{Number: 1452},
+			errCode: apperrors.CodeNotFound,
+		},
+		{
+			name:    "error - insert failed",
+			err:     errors.New("connection reset"),
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := testhelpers.CreateMockDB()
+			defer db.Close()
+			expected := mock.ExpectExec(query).
+				WithArgs(101, "home", "Av. Corrientes 1234", "1001", true, address.CreatedAt)
+			if tc.err != nil {
+				expected.WillReturnError(tc.err)
+			} else {
+				expected.WillReturnResult(sqlmock.NewResult(3, 1))
+			}
+			repo := repository.NewBuyerAddressRepository(db)
+
+			id, err := repo.Create(context.Background(), db, address)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 3, id)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBuyerAddressRepository_Delete(t *testing.T) {
+	query := regexp.QuoteMeta(`DELETE FROM buyer_addresses WHERE buyer_id = ? AND id = ?`)
+
+	testCases := []struct {
+		name     string
+		affected int64
+		errCode  string
+	}{
+		{
+			name:     "success",
+			affected: 1,
+		},
+		{
+			name:    "error - address of another buyer",
+			errCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := testhelpers.CreateMockDB()
+			defer db.Close()
+			mock.ExpectExec(query).WithArgs(101, 2).WillReturnResult(sqlmock.NewResult(0, tc.affected))
+			repo := repository.NewBuyerAddressRepository(db)
+
+			err := repo.Delete(context.Background(), db, 101, 2)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBuyerAddressRepository_FindOldest(t *testing.T) {
+	query := regexp.QuoteMeta(`FROM buyer_addresses WHERE buyer_id = ? ORDER BY id LIMIT 1`)
+
+	t.Run("success", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		mock.ExpectQuery(query).WithArgs(101).WillReturnRows(addressRow(sqlmock.NewRows(addressColumns), testhelpers.DummyAddress(2, false)))
+		repo := repository.NewBuyerAddressRepository(db)
+
+		address, err := repo.FindOldest(context.Background(), db, 101)
+
+		require.NoError(t, err)
+		require.Equal(t, 2, address.Id)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - no address left", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		mock.ExpectQuery(query).WithArgs(101).WillReturnRows(sqlmock.NewRows(addressColumns))
+		repo := repository.NewBuyerAddressRepository(db)
+
+		address, err := repo.FindOldest(context.Background(), db, 101)
+
+		require.NoError(t, err)
+		require.Nil(t, address)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/carrier_webhook"
+)
+
+const (
+	queryWebhookCredentials = `SELECT c.id, COALESCE(w.secret, '') FROM carriers c
+		LEFT JOIN carrier_webhooks w ON w.carrier_id = c.id WHERE c.cid = ?`
+	queryWebhookSaveSecret = `INSERT INTO carrier_webhooks (carrier_id, secret, created_at) VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE secret = VALUES(secret), created_at = VALUES(created_at)`
+	queryWebhookNonceCreate = `INSERT INTO carrier_webhook_nonces (carrier_id, nonce, received_at) VALUES (?, ?, ?)`
+	queryWebhookNonceDelete = `DELETE FROM carrier_webhook_nonces WHERE received_at < ?`
+)
+
+// FindCredentials returns the carrier and its secret.
+// Returns a not found error if no carrier has the cid.
+func (r *carrierWebhookRepository) FindCredentials(ctx context.Context, cid string) (*models.Credentials, error) {
+	var c models.Credentials
+	if err := r.mysql.QueryRowContext(ctx, queryWebhookCredentials, cid).Scan(&c.CarrierId, &c.Secret); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "carrier not found").WithDetail("cid", cid)
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying carrier webhook")
+	}
+	return &c, nil
+}
+
+// SaveSecret inserts or replaces the secret of the carrier.
+func (r *carrierWebhookRepository) SaveSecret(ctx context.Context, carrierId int, secret string, createdAt time.Time) error {
+	if _, err := r.mysql.ExecContext(ctx, queryWebhookSaveSecret, carrierId, secret, createdAt); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error saving carrier webhook secret")
+	}
+	return nil
+}
+
+// CreateNonce inserts the nonce.
+// Returns a conflict error if the carrier already used it, i.e. the request is a replay.
+func (r *carrierWebhookRepository) CreateNonce(ctx context.Context, exec Executor, carrierId int, nonce string, receivedAt time.Time) error {
+	if _, err := exec.ExecContext(ctx, queryWebhookNonceCreate, carrierId, nonce, receivedAt); err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1062 {
+			return apperrors.NewAppError(apperrors.CodeConflict, "nonce already used").WithDetail("nonce", nonce)
+		}
+		return apperrors.NewAppError(apperrors.CodeInternal, "error recording webhook nonce")
+	}
+	return nil
+}
+
+// DeleteNoncesBefore removes the nonces received before the given time.
+func (r *carrierWebhookRepository) DeleteNoncesBefore(ctx context.Context, before time.Time) (int64, error) {
+	res, err := r.mysql.ExecContext(ctx, queryWebhookNonceDelete, before)
+	if err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error deleting webhook nonces")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error getting rows affected")
+	}
+	return n, nil
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/database"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/carrier_webhook"
+)
+
+// CarrierWebhookRepository defines the data operations of carrier webhook secrets and the nonces already used.
+type CarrierWebhookRepository interface {
+	// FindCredentials returns the carrier with the given cid and its webhook secret.
+	FindCredentials(ctx context.Context, cid string) (*models.Credentials, error)
+
+	// SaveSecret stores the webhook secret of a carrier, replacing the previous one.
+	SaveSecret(ctx context.Context, carrierId int, secret string, createdAt time.Time) error
+
+	// CreateNonce records a nonce used by a carrier.
+	CreateNonce(ctx context.Context, exec Executor, carrierId int, nonce string, receivedAt time.Time) error
+
+	// DeleteNoncesBefore removes the nonces received before the given time and returns how many were removed.
+	DeleteNoncesBefore(ctx context.Context, before time.Time) (int64, error)
+
+	database.Transactor
+}
+
+// Executor runs SQL statements either on the connection or inside a transaction.
+type Executor = database.Executor
+
+// carrierWebhookRepository implements CarrierWebhookRepository using MySQL.
+type carrierWebhookRepository struct {
+	database.MySQLTransactor
+	mysql *sql.DB
+}
+
+// NewCarrierWebhookRepository returns a new CarrierWebhookRepository using the given MySQL connection.
+func NewCarrierWebhookRepository(mysql *sql.DB) CarrierWebhookRepository {
+	return &carrierWebhookRepository{
+		MySQLTransactor: database.NewMySQLTransactor(mysql),
+		mysql:           mysql,
+	}
+}
@@ -0,0 +1,116 @@
+package repository_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/carrier_webhook"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/carrier_webhook"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestCarrierWebhookRepository_FindCredentials(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT c.id, COALESCE(w.secret, '') FROM carriers c`)
+
+	testCases := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		want    *models.Credentials
+		errCode string
+	}{
+		{
+			name: "success - configured",
+			rows: sqlmock.NewRows([]string{"id", "secret"}).AddRow(2, "secret"),
+			want: &models.Credentials{CarrierId: 2, Secret: "secret"},
+		},
+		{
+			name: "success - no secret yet",
+			rows: sqlmock.NewRows([]string{"id", "secret"}).AddRow(2, ""),
+			want: &models.Credentials{CarrierId: 2},
+		},
+		{
+			name:    "error - unknown carrier",
+			rows:    sqlmock.NewRows([]string{"id", "secret"}),
+			errCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := testhelpers.CreateMockDB()
+			defer db.Close()
+			mock.ExpectQuery(query).WithArgs("CID-2").WillReturnRows(tc.rows)
+			repo := repository.NewCarrierWebhookRepository(db)
+
+			creds, err := repo.FindCredentials(context.Background(), "CID-2")
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, creds)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.want, creds)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCarrierWebhookRepository_CreateNonce(t *testing.T) {
+	query := regexp.QuoteMeta(`INSERT INTO carrier_webhook_nonces (carrier_id, nonce, received_at) VALUES (?, ?, ?)`)
+	receivedAt := time.Date(2025, 6, 2, 10, 0, 0, 0, time.Local)
+
+	testCases := []struct {
+		name    string
+		err     error
+		errCode string
+	}{
+		{name: "success"},
+		{name: "error - nonce already used", err: &mysql.MySQLError{Number: 1062}, errCode: apperrors.CodeConflict},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := testhelpers.CreateMockDB()
+			defer db.Close()
+			exp := mock.ExpectExec(query).WithArgs(2, "n-1", receivedAt)
+			if tc.err != nil {
+				exp.WillReturnError(tc.err)
+			} else {
+				exp.WillReturnResult(sqlmock.NewResult(1, 1))
+			}
+			repo := repository.NewCarrierWebhookRepository(db)
+
+			err := repo.CreateNonce(context.Background(), db, 2, "n-1", receivedAt)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCarrierWebhookRepository_DeleteNoncesBefore(t *testing.T) {
+	query := regexp.QuoteMeta(`DELETE FROM carrier_webhook_nonces WHERE received_at < ?`)
+	before := time.Date(2025, 6, 2, 10, 0, 0, 0, time.Local)
+
+	mock, db := testhelpers.CreateMockDB()
+	defer db.Close()
+	mock.ExpectExec(query).WithArgs(before).WillReturnResult(sqlmock.NewResult(0, 3))
+	repo := repository.NewCarrierWebhookRepository(db)
+
+	n, err := repo.DeleteNoncesBefore(context.Background(), before)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(3), n)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,258 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/cycle_count"
+)
+
+const (
+	queryCycleCountLockSection = `SELECT id FROM sections WHERE id = ? FOR UPDATE`
+	queryCycleCountHasActive   = `SELECT EXISTS(SELECT 1 FROM cycle_counts WHERE section_id = ? AND status IN ('open', 'counted', 'pending_second_approval'))`
+	queryCycleCountCreate      = `INSERT INTO cycle_counts (section_id, status, note, created_by, created_at) VALUES (?, ?, ?, ?, ?)`
+	queryCycleCountCreateLines = `INSERT INTO cycle_count_lines (cycle_count_id, product_batch_id)
+		SELECT ?, id FROM product_batches WHERE section_id = ? AND status <> 'disposed' ORDER BY id`
+	queryCycleCountColumns = `SELECT id, section_id, status, note, created_by, created_at, approved_by, second_approved_by, approved_at
+		FROM cycle_counts`
+	queryCycleCountFindForUpdate = queryCycleCountColumns + ` WHERE id = ? FOR UPDATE`
+	queryCycleCountFindById      = queryCycleCountColumns + ` WHERE id = ?`
+	queryCycleCountFindAll       = queryCycleCountColumns + ` WHERE (? = 0 OR section_id = ?) AND (? = '' OR status = ?) ORDER BY id DESC`
+	queryCycleCountLineColumns   = `SELECT l.id, l.cycle_count_id, l.product_batch_id, pb.batch_number, l.system_quantity, l.counted_quantity,
+			l.variance, l.reason_code, l.counted_by, l.counted_at, pb.current_quantity,
+			COALESCE((SELECT SUM(a.quantity) FROM stock_allocations a WHERE a.product_batch_id = pb.id AND a.status = 'reserved'), 0)
+		FROM cycle_count_lines l INNER JOIN product_batches pb ON pb.id = l.product_batch_id
+		WHERE l.cycle_count_id = ? ORDER BY l.product_batch_id`
+	queryCycleCountLinesForUpdate = queryCycleCountLineColumns + ` FOR UPDATE`
+	queryCycleCountUpdateLine     = `UPDATE cycle_count_lines SET system_quantity = ?, counted_quantity = ?, variance = ?, reason_code = ?,
+		counted_by = ?, counted_at = ? WHERE id = ?`
+	queryCycleCountUpdateStatus = `UPDATE cycle_counts SET status = ?, approved_by = ?, second_approved_by = ?, approved_at = ? WHERE id = ?`
+	queryCycleCountCreateEvent  = `INSERT INTO cycle_count_history (cycle_count_id, from_status, to_status, note, actor, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)`
+	queryCycleCountFindEvents = `SELECT id, cycle_count_id, from_status, to_status, note, actor, created_at
+		FROM cycle_count_history WHERE cycle_count_id = ? ORDER BY created_at ASC, id ASC`
+	querySectionCapacityAdd = `UPDATE sections SET current_capacity = current_capacity + ? WHERE id = ?`
+)
+
+// LockSection locks the section row.
+// Returns a not found error if the section does not exist.
+func (r *cycleCountRepository) LockSection(ctx context.Context, exec Executor, sectionId int) error {
+	var id int
+	if err := exec.QueryRowContext(ctx, queryCycleCountLockSection, sectionId).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.NewAppError(apperrors.CodeNotFound, "section not found").
+				WithDetail("section_id", sectionId)
+		}
+		return apperrors.NewAppError(apperrors.CodeInternal, "error querying section")
+	}
+	return nil
+}
+
+// HasActiveCount reports whether the section has an open, counted or pending count.
+func (r *cycleCountRepository) HasActiveCount(ctx context.Context, exec Executor, sectionId int) (bool, error) {
+	var exists bool
+	if err := exec.QueryRowContext(ctx, queryCycleCountHasActive, sectionId).Scan(&exists); err != nil {
+		return false, apperrors.NewAppError(apperrors.CodeInternal, "error querying active cycle counts")
+	}
+	return exists, nil
+}
+
+// Create inserts the count header.
+func (r *cycleCountRepository) Create(ctx context.Context, exec Executor, c models.CycleCount) (*models.CycleCount, error) {
+	res, err := exec.ExecContext(ctx, queryCycleCountCreate, c.SectionId, c.Status, c.Note, c.CreatedBy, c.CreatedAt)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error creating cycle count")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	c.Id = int(id)
+	return &c, nil
+}
+
+// CreateLines adds the uncounted lines of the section batches.
+func (r *cycleCountRepository) CreateLines(ctx context.Context, exec Executor, countId int, sectionId int) (int, error) {
+	res, err := exec.ExecContext(ctx, queryCycleCountCreateLines, countId, sectionId)
+	if err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error creating cycle count lines")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error getting rows affected")
+	}
+	return int(n), nil
+}
+
+// FindForUpdate returns the count header and locks it.
+// Returns a not found error if the count does not exist.
+func (r *cycleCountRepository) FindForUpdate(ctx context.Context, exec Executor, id int) (*models.CycleCount, error) {
+	return scanCount(exec.QueryRowContext(ctx, queryCycleCountFindForUpdate, id))
+}
+
+// FindById returns the count header.
+// Returns a not found error if the count does not exist.
+func (r *cycleCountRepository) FindById(ctx context.Context, id int) (*models.CycleCount, error) {
+	return scanCount(r.mysql.QueryRowContext(ctx, queryCycleCountFindById, id))
+}
+
+// FindAll returns the counts matching the filters; a zero section id or empty status means no filter.
+func (r *cycleCountRepository) FindAll(ctx context.Context, sectionId int, status string) ([]models.CycleCount, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryCycleCountFindAll, sectionId, sectionId, status, status)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying cycle counts")
+	}
+	defer rows.Close()
+
+	counts := make([]models.CycleCount, 0)
+	for rows.Next() {
+		c, err := scanCount(rows)
+		if err != nil {
+			return nil, err
+		}
+		counts = append(counts, *c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating cycle counts")
+	}
+	return counts, nil
+}
+
+// FindLinesForUpdate returns the lines of the count and locks them together with their batches.
+func (r *cycleCountRepository) FindLinesForUpdate(ctx context.Context, exec Executor, countId int) ([]models.CountLine, error) {
+	return findLines(ctx, exec, queryCycleCountLinesForUpdate, countId)
+}
+
+// FindLines returns the lines of the count.
+func (r *cycleCountRepository) FindLines(ctx context.Context, countId int) ([]models.CountLine, error) {
+	return findLines(ctx, r.mysql, queryCycleCountLineColumns, countId)
+}
+
+// UpdateLine stores the count of a line.
+func (r *cycleCountRepository) UpdateLine(ctx context.Context, exec Executor, l models.CountLine) error {
+	if _, err := exec.ExecContext(ctx, queryCycleCountUpdateLine, l.SystemQuantity, l.CountedQuantity, l.Variance,
+		l.ReasonCode, l.CountedBy, l.CountedAt, l.Id); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating cycle count line")
+	}
+	return nil
+}
+
+// UpdateStatus stores the status and approvals of the count.
+func (r *cycleCountRepository) UpdateStatus(ctx context.Context, exec Executor, c models.CycleCount) error {
+	if _, err := exec.ExecContext(ctx, queryCycleCountUpdateStatus, c.Status, c.ApprovedBy, c.SecondApprovedBy,
+		c.ApprovedAt, c.Id); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating cycle count")
+	}
+	return nil
+}
+
+// CreateEvent appends the event to the count history.
+func (r *cycleCountRepository) CreateEvent(ctx context.Context, exec Executor, e models.CountEvent) error {
+	if _, err := exec.ExecContext(ctx, queryCycleCountCreateEvent, e.CycleCountId, e.FromStatus, e.ToStatus,
+		e.Note, e.Actor, e.CreatedAt); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error creating cycle count history")
+	}
+	return nil
+}
+
+// AddSectionCapacity adds delta to the section current_capacity.
+func (r *cycleCountRepository) AddSectionCapacity(ctx context.Context, exec Executor, sectionId int, delta int) error {
+	if _, err := exec.ExecContext(ctx, querySectionCapacityAdd, delta, sectionId); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating section capacity")
+	}
+	return nil
+}
+
+// FindEvents returns the history of the count.
+func (r *cycleCountRepository) FindEvents(ctx context.Context, countId int) ([]models.CountEvent, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryCycleCountFindEvents, countId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying cycle count history")
+	}
+	defer rows.Close()
+
+	events := make([]models.CountEvent, 0)
+	for rows.Next() {
+		var e models.CountEvent
+		var from sql.NullString
+		if err := rows.Scan(&e.Id, &e.CycleCountId, &from, &e.ToStatus, &e.Note, &e.Actor, &e.CreatedAt); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning cycle count history")
+		}
+		e.FromStatus = nullStringPtr(from)
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating cycle count history")
+	}
+	return events, nil
+}
+
+// scanCount reads a count header from a row or from the current position of rows.
+func scanCount(row interface{ Scan(...any) error }) (*models.CycleCount, error) {
+	var c models.CycleCount
+	var approvedBy, secondApprovedBy sql.NullString
+	var approvedAt sql.NullTime
+	err := row.Scan(&c.Id, &c.SectionId, &c.Status, &c.Note, &c.CreatedBy, &c.CreatedAt, &approvedBy, &secondApprovedBy, &approvedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "cycle count not found")
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying cycle count")
+	}
+	c.ApprovedBy = nullStringPtr(approvedBy)
+	c.SecondApprovedBy = nullStringPtr(secondApprovedBy)
+	if approvedAt.Valid {
+		c.ApprovedAt = &approvedAt.Time
+	}
+	return &c, nil
+}
+
+func findLines(ctx context.Context, exec Executor, query string, countId int) ([]models.CountLine, error) {
+	rows, err := exec.QueryContext(ctx, query, countId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying cycle count lines")
+	}
+	defer rows.Close()
+
+	lines := make([]models.CountLine, 0)
+	for rows.Next() {
+		var l models.CountLine
+		var system, counted, variance sql.NullInt64
+		var reason, countedBy sql.NullString
+		var countedAt sql.NullTime
+		if err := rows.Scan(&l.Id, &l.CycleCountId, &l.ProductBatchId, &l.BatchNumber, &system, &counted,
+			&variance, &reason, &countedBy, &countedAt, &l.CurrentQuantity, &l.ReservedQuantity); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning cycle count line")
+		}
+		l.SystemQuantity = nullIntPtr(system)
+		l.CountedQuantity = nullIntPtr(counted)
+		l.Variance = nullIntPtr(variance)
+		l.ReasonCode = nullStringPtr(reason)
+		l.CountedBy = nullStringPtr(countedBy)
+		if countedAt.Valid {
+			l.CountedAt = &countedAt.Time
+		}
+		lines = append(lines, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating cycle count lines")
+	}
+	return lines, nil
+}
+
+func nullIntPtr(v sql.NullInt64) *int {
+	if !v.Valid {
+		return nil
+	}
+	i := int(v.Int64)
+	return &i
+}
+
+func nullStringPtr(v sql.NullString) *string {
+	if !v.Valid {
+		return nil
+	}
+	return &v.String
+}
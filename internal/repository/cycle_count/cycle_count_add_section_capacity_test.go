@@ -0,0 +1,62 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/cycle_count"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestCycleCountRepository_AddSectionCapacity(t *testing.T) {
+	const query = `UPDATE sections SET current_capacity = current_capacity \+ \? WHERE id = \?`
+
+	testCases := []struct {
+		name    string
+		dbMock  func() (sqlmock.Sqlmock, *sql.DB)
+		errCode string
+	}{
+		{
+			name: "success - variance applied to the section",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectBegin()
+				mock.ExpectExec(query).WithArgs(-5, 3).WillReturnResult(sqlmock.NewResult(0, 1))
+				return mock, db
+			},
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectBegin()
+				mock.ExpectExec(query).WithArgs(-5, 3).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewCycleCountRepository(db)
+			tx, err := db.Begin()
+			require.NoError(t, err)
+
+			err = repo.AddSectionCapacity(context.Background(), tx, 3, -5)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/cycle_count"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/cycle_count"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestCycleCountRepository_FindLinesForUpdate(t *testing.T) {
+	columns := []string{"id", "cycle_count_id", "product_batch_id", "batch_number", "system_quantity", "counted_quantity",
+		"variance", "reason_code", "counted_by", "counted_at", "current_quantity", "reserved_quantity"}
+	const query = `SELECT l.id, (.+) FROM cycle_count_lines l INNER JOIN product_batches pb ON pb.id = l.product_batch_id\s+WHERE l.cycle_count_id = \? ORDER BY l.product_batch_id FOR UPDATE`
+	uncounted := testhelpers.DummyCountLine(1, 50, nil, "")
+	counted := testhelpers.DummyCountLine(2, 20, testhelpers.IntPtr(18), models.ReasonDamaged)
+	reserved := testhelpers.DummyCountLine(3, 30, nil, "")
+	reserved.ReservedQuantity = 20
+
+	testCases := []struct {
+		name     string
+		dbMock   func() (sqlmock.Sqlmock, *sql.DB)
+		expected []models.CountLine
+		errCode  string
+	}{
+		{
+			name: "success - counted, uncounted and reserved lines",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows(columns).
+					AddRow(uncounted.Id, 1, uncounted.ProductBatchId, uncounted.BatchNumber, nil, nil, nil, nil, nil, nil, 50, 0).
+					AddRow(counted.Id, 1, counted.ProductBatchId, counted.BatchNumber, 20, 18, -2, models.ReasonDamaged, nil, nil, 20, 0).
+					AddRow(reserved.Id, 1, reserved.ProductBatchId, reserved.BatchNumber, nil, nil, nil, nil, nil, nil, 30, 20))
+				return mock, db
+			},
+			expected: []models.CountLine{uncounted, counted, reserved},
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectBegin()
+				mock.ExpectQuery(query).WithArgs(1).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewCycleCountRepository(db)
+			tx, err := db.Begin()
+			require.NoError(t, err)
+
+			result, err := repo.FindLinesForUpdate(context.Background(), tx, 1)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/database"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/cycle_count"
+)
+
+// CycleCountRepository defines the data operations of cycle counts, their lines and history.
+type CycleCountRepository interface {
+	// LockSection locks the section until the transaction ends, so only one count can be opened for it at a time.
+	LockSection(ctx context.Context, exec Executor, sectionId int) error
+
+	// HasActiveCount reports whether the section has a count that is neither approved nor cancelled.
+	HasActiveCount(ctx context.Context, exec Executor, sectionId int) (bool, error)
+
+	// Create inserts the count header and returns it with its generated id.
+	Create(ctx context.Context, exec Executor, c models.CycleCount) (*models.CycleCount, error)
+
+	// CreateLines adds one uncounted line per non-disposed batch of the section and returns how many were added.
+	CreateLines(ctx context.Context, exec Executor, countId int, sectionId int) (int, error)
+
+	// FindForUpdate returns the count header, locking it until the transaction ends.
+	FindForUpdate(ctx context.Context, exec Executor, id int) (*models.CycleCount, error)
+
+	// FindById returns the count header.
+	FindById(ctx context.Context, id int) (*models.CycleCount, error)
+
+	// FindAll returns the counts, optionally filtered by section and status, newest first.
+	FindAll(ctx context.Context, sectionId int, status string) ([]models.CycleCount, error)
+
+	// FindLinesForUpdate returns the lines of a count with the current quantity of their batches, locking the batches.
+	FindLinesForUpdate(ctx context.Context, exec Executor, countId int) ([]models.CountLine, error)
+
+	// FindLines returns the lines of a count ordered by batch.
+	FindLines(ctx context.Context, countId int) ([]models.CountLine, error)
+
+	// UpdateLine stores the counted quantity, system quantity, variance and reason of a line.
+	UpdateLine(ctx context.Context, exec Executor, l models.CountLine) error
+
+	// UpdateStatus stores the status and approvals of a count.
+	UpdateStatus(ctx context.Context, exec Executor, c models.CycleCount) error
+
+	// CreateEvent appends an entry to the count history.
+	CreateEvent(ctx context.Context, exec Executor, e models.CountEvent) error
+
+	// AddSectionCapacity adds delta (which may be negative) to a section current_capacity.
+	AddSectionCapacity(ctx context.Context, exec Executor, sectionId int, delta int) error
+
+	// FindEvents returns the history of a count, oldest first.
+	FindEvents(ctx context.Context, countId int) ([]models.CountEvent, error)
+
+	database.Transactor
+}
+
+// Executor runs SQL statements either on the connection or inside a transaction.
+type Executor = database.Executor
+
+// cycleCountRepository implements CycleCountRepository using MySQL.
+type cycleCountRepository struct {
+	database.MySQLTransactor
+	mysql *sql.DB
+}
+
+// NewCycleCountRepository returns a new CycleCountRepository using the given MySQL connection.
+func NewCycleCountRepository(mysql *sql.DB) CycleCountRepository {
+	return &cycleCountRepository{
+		MySQLTransactor: database.NewMySQLTransactor(mysql),
+		mysql:           mysql,
+	}
+}
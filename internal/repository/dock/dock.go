@@ -0,0 +1,260 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/dock"
+)
+
+const (
+	queryDockColumns   = `SELECT id, warehouse_id, code, opens_at, closes_at FROM docks`
+	queryDockFindAll   = queryDockColumns + ` WHERE (? = 0 OR warehouse_id = ?) ORDER BY warehouse_id, code`
+	queryDockForUpdate = queryDockColumns + ` WHERE id = ? FOR UPDATE`
+	queryDockCreate    = `INSERT INTO docks (warehouse_id, code, opens_at, closes_at) VALUES (?, ?, ?, ?)`
+	queryDockOverlap   = `SELECT COUNT(1) FROM dock_appointments
+		WHERE dock_id = ? AND status NOT IN ('cancelled', 'missed') AND starts_at < ? AND ends_at > ?`
+	queryDockAsnWarehouse          = `SELECT warehouse_id FROM asns WHERE id = ?`
+	queryDockInboundOrderWarehouse = `SELECT warehouse_id FROM inbound_orders WHERE id = ?`
+	queryAppointmentCreate         = `INSERT INTO dock_appointments (dock_id, seller_id, carrier_id, asn_id, starts_at, ends_at, status, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	queryAppointmentColumns = `SELECT a.id, a.dock_id, d.warehouse_id, a.seller_id, a.carrier_id, a.asn_id, a.starts_at, a.ends_at,
+			a.status, a.inbound_order_id, a.arrived_at, a.created_at
+		FROM dock_appointments a INNER JOIN docks d ON d.id = a.dock_id`
+	queryAppointmentFindById  = queryAppointmentColumns + ` WHERE a.id = ?`
+	queryAppointmentForUpdate = queryAppointmentFindById + ` FOR UPDATE`
+	queryAppointmentUpdate    = `UPDATE dock_appointments SET status = ?, inbound_order_id = ?, arrived_at = ? WHERE id = ?`
+	queryAppointmentsBetween  = queryAppointmentColumns + ` WHERE d.warehouse_id = ? AND a.status <> 'cancelled'
+		AND a.starts_at >= ? AND a.starts_at < ? ORDER BY a.dock_id, a.starts_at`
+)
+
+// FindDocks returns the docks ordered by warehouse and code; a zero warehouseId returns them all.
+func (r *dockRepository) FindDocks(ctx context.Context, warehouseId int) ([]models.Dock, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryDockFindAll, warehouseId, warehouseId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying docks")
+	}
+	defer rows.Close()
+
+	docks := make([]models.Dock, 0)
+	for rows.Next() {
+		d, err := scanDock(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning dock")
+		}
+		docks = append(docks, *d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating docks")
+	}
+	return docks, nil
+}
+
+// CreateDock inserts the dock.
+// Returns a conflict error if the warehouse already has a dock with the code and a not found error if the warehouse does not exist.
+func (r *dockRepository) CreateDock(ctx context.Context, d models.PostDock) (*models.Dock, error) {
+	res, err := r.mysql.ExecContext(ctx, queryDockCreate, d.WarehouseId, d.Code, d.OpensAt, d.ClosesAt)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
+			switch mysqlErr.Number {
+			case 1062:
+				return nil, apperrors.NewAppError(apperrors.CodeConflict, "the warehouse already has a dock with this code").
+					WithDetail("code", d.Code)
+			case 1452:
+				return nil, apperrors.NewAppError(apperrors.CodeNotFound, "warehouse not found").
+					WithDetail("warehouse_id", d.WarehouseId)
+			}
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error creating dock")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	return &models.Dock{
+		Id:          int(id),
+		WarehouseId: d.WarehouseId,
+		Code:        d.Code,
+		OpensAt:     d.OpensAt,
+		ClosesAt:    d.ClosesAt,
+	}, nil
+}
+
+// FindDockForUpdate returns the dock and locks its row.
+// Returns a not found error if it does not exist.
+func (r *dockRepository) FindDockForUpdate(ctx context.Context, exec Executor, id int) (*models.Dock, error) {
+	d, err := scanDock(exec.QueryRowContext(ctx, queryDockForUpdate, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "dock not found").WithDetail("dock_id", id)
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying dock")
+	}
+	return d, nil
+}
+
+// CountOverlapping counts the appointments of the dock, other than cancelled or missed ones, that start
+// before the slot ends and end after it starts. Back-to-back slots do not overlap.
+func (r *dockRepository) CountOverlapping(ctx context.Context, exec Executor, dockId int, startsAt time.Time, endsAt time.Time) (int, error) {
+	var count int
+	if err := exec.QueryRowContext(ctx, queryDockOverlap, dockId, endsAt, startsAt).Scan(&count); err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error checking dock availability")
+	}
+	return count, nil
+}
+
+// FindAsnWarehouse returns the warehouse the ASN is expected at.
+// Returns a not found error if the ASN does not exist.
+func (r *dockRepository) FindAsnWarehouse(ctx context.Context, exec Executor, asnId int) (int, error) {
+	var warehouseId int
+	if err := exec.QueryRowContext(ctx, queryDockAsnWarehouse, asnId).Scan(&warehouseId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, apperrors.NewAppError(apperrors.CodeNotFound, "asn not found").WithDetail("asn_id", asnId)
+		}
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error querying asn")
+	}
+	return warehouseId, nil
+}
+
+// FindInboundOrderWarehouse returns the warehouse the inbound order was received at.
+// Returns a not found error if the inbound order does not exist.
+func (r *dockRepository) FindInboundOrderWarehouse(ctx context.Context, exec Executor, inboundOrderId int) (int, error) {
+	var warehouseId int
+	if err := exec.QueryRowContext(ctx, queryDockInboundOrderWarehouse, inboundOrderId).Scan(&warehouseId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, apperrors.NewAppError(apperrors.CodeNotFound, "inbound order not found").
+				WithDetail("inbound_order_id", inboundOrderId)
+		}
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error querying inbound order")
+	}
+	return warehouseId, nil
+}
+
+// CreateAppointment inserts the appointment.
+// Returns a not found error if the seller or the carrier does not exist.
+func (r *dockRepository) CreateAppointment(ctx context.Context, exec Executor, a models.Appointment) (int, error) {
+	res, err := exec.ExecContext(ctx, queryAppointmentCreate, a.DockId, a.SellerId, a.CarrierId, a.AsnId,
+		a.StartsAt, a.EndsAt, a.Status, a.CreatedAt)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1452 {
+			return 0, apperrors.NewAppError(apperrors.CodeNotFound, "seller or carrier not found")
+		}
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error creating dock appointment")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	return int(id), nil
+}
+
+// FindAppointmentById returns the appointment.
+// Returns a not found error if it does not exist.
+func (r *dockRepository) FindAppointmentById(ctx context.Context, id int) (*models.Appointment, error) {
+	return findAppointment(r.mysql.QueryRowContext(ctx, queryAppointmentFindById, id), id)
+}
+
+// FindAppointmentForUpdate returns the appointment and locks its row.
+// Returns a not found error if it does not exist.
+func (r *dockRepository) FindAppointmentForUpdate(ctx context.Context, exec Executor, id int) (*models.Appointment, error) {
+	return findAppointment(exec.QueryRowContext(ctx, queryAppointmentForUpdate, id), id)
+}
+
+// UpdateAppointment stores the status, the linked inbound order and the arrival time.
+// Returns a conflict error if the inbound order is already linked to another appointment.
+func (r *dockRepository) UpdateAppointment(ctx context.Context, exec Executor, a models.Appointment) error {
+	if _, err := exec.ExecContext(ctx, queryAppointmentUpdate, a.Status, a.InboundOrderId, a.ArrivedAt, a.Id); err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1062 {
+			return apperrors.NewAppError(apperrors.CodeConflict, "inbound order already linked to another appointment").
+				WithDetail("inbound_order_id", *a.InboundOrderId)
+		}
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating dock appointment")
+	}
+	return nil
+}
+
+// FindAppointmentsBetween returns the appointments of the warehouse ordered by dock and start.
+func (r *dockRepository) FindAppointmentsBetween(ctx context.Context, warehouseId int, from time.Time, to time.Time) ([]models.Appointment, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryAppointmentsBetween, warehouseId, from, to)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying dock appointments")
+	}
+	defer rows.Close()
+
+	appointments := make([]models.Appointment, 0)
+	for rows.Next() {
+		a, err := scanAppointment(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning dock appointment")
+		}
+		appointments = append(appointments, *a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating dock appointments")
+	}
+	return appointments, nil
+}
+
+// scanDock reads a dock row. MySQL returns TIME columns as HH:MM:SS, trimmed here to HH:MM.
+func scanDock(row interface{ Scan(dest ...any) error }) (*models.Dock, error) {
+	var d models.Dock
+	if err := row.Scan(&d.Id, &d.WarehouseId, &d.Code, &d.OpensAt, &d.ClosesAt); err != nil {
+		return nil, err
+	}
+	d.OpensAt = clock(d.OpensAt)
+	d.ClosesAt = clock(d.ClosesAt)
+	return &d, nil
+}
+
+func clock(t string) string {
+	if len(t) > 5 {
+		return t[:5]
+	}
+	return t
+}
+
+// findAppointment scans a single appointment row, mapping a missing row to a not found error.
+func findAppointment(row *sql.Row, id int) (*models.Appointment, error) {
+	a, err := scanAppointment(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "dock appointment not found").WithDetail("appointment_id", id)
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying dock appointment")
+	}
+	return a, nil
+}
+
+// scanAppointment reads an appointment row and works out how late its delivery arrived.
+func scanAppointment(row interface{ Scan(dest ...any) error }) (*models.Appointment, error) {
+	var a models.Appointment
+	var sellerId, carrierId, asnId, inboundOrderId sql.NullInt64
+	var arrivedAt sql.NullTime
+	if err := row.Scan(&a.Id, &a.DockId, &a.WarehouseId, &sellerId, &carrierId, &asnId, &a.StartsAt, &a.EndsAt,
+		&a.Status, &inboundOrderId, &arrivedAt, &a.CreatedAt); err != nil {
+		return nil, err
+	}
+	a.SellerId = nullIntPtr(sellerId)
+	a.CarrierId = nullIntPtr(carrierId)
+	a.AsnId = nullIntPtr(asnId)
+	a.InboundOrderId = nullIntPtr(inboundOrderId)
+	if arrivedAt.Valid {
+		a.ArrivedAt = &arrivedAt.Time
+		if late := arrivedAt.Time.Sub(a.StartsAt); late > 0 {
+			a.LateMinutes = int(late / time.Minute)
+		}
+	}
+	return &a, nil
+}
+
+func nullIntPtr(n sql.NullInt64) *int {
+	if !n.Valid {
+		return nil
+	}
+	v := int(n.Int64)
+	return &v
+}
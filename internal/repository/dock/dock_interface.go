@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/database"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/dock"
+)
+
+// DockRepository defines the data operations of warehouse docks and the appointments booked on them.
+type DockRepository interface {
+	// FindDocks returns the docks of a warehouse, or of every warehouse when warehouseId is zero.
+	FindDocks(ctx context.Context, warehouseId int) ([]models.Dock, error)
+
+	// CreateDock inserts a dock and returns it with its generated id.
+	CreateDock(ctx context.Context, d models.PostDock) (*models.Dock, error)
+
+	// FindDockForUpdate returns a dock and locks its row, serialising the bookings made on it.
+	FindDockForUpdate(ctx context.Context, exec Executor, id int) (*models.Dock, error)
+
+	// CountOverlapping counts the appointments of the dock holding part of the slot.
+	CountOverlapping(ctx context.Context, exec Executor, dockId int, startsAt time.Time, endsAt time.Time) (int, error)
+
+	// FindAsnWarehouse returns the warehouse an ASN is expected at.
+	FindAsnWarehouse(ctx context.Context, exec Executor, asnId int) (int, error)
+
+	// FindInboundOrderWarehouse returns the warehouse an inbound order was received at.
+	FindInboundOrderWarehouse(ctx context.Context, exec Executor, inboundOrderId int) (int, error)
+
+	// CreateAppointment inserts an appointment and returns its generated id.
+	CreateAppointment(ctx context.Context, exec Executor, a models.Appointment) (int, error)
+
+	// FindAppointmentById returns an appointment.
+	FindAppointmentById(ctx context.Context, id int) (*models.Appointment, error)
+
+	// FindAppointmentForUpdate returns an appointment and locks its row.
+	FindAppointmentForUpdate(ctx context.Context, exec Executor, id int) (*models.Appointment, error)
+
+	// UpdateAppointment stores the status of an appointment and the inbound order that arrived for it.
+	UpdateAppointment(ctx context.Context, exec Executor, a models.Appointment) error
+
+	// FindAppointmentsBetween returns the appointments of a warehouse starting in [from, to), cancelled ones left out.
+	FindAppointmentsBetween(ctx context.Context, warehouseId int, from time.Time, to time.Time) ([]models.Appointment, error)
+
+	database.Transactor
+}
+
+// Executor runs SQL statements either on the connection or inside a transaction.
+type Executor = database.Executor
+
+// dockRepository implements DockRepository using MySQL.
+type dockRepository struct {
+	database.MySQLTransactor
+	mysql *sql.DB
+}
+
+// NewDockRepository returns a new DockRepository using the given MySQL connection.
+func NewDockRepository(mysql *sql.DB) DockRepository {
+	return &dockRepository{
+		MySQLTransactor: database.NewMySQLTransactor(mysql),
+		mysql:           mysql,
+	}
+}
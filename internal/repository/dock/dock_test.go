@@ -0,0 +1,213 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/dock"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/dock"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+var appointmentColumns = []string{"id", "dock_id", "warehouse_id", "seller_id", "carrier_id", "asn_id", "starts_at", "ends_at",
+	"status", "inbound_order_id", "arrived_at", "created_at"}
+
+func TestDockRepository_FindDocks(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT id, warehouse_id, code, opens_at, closes_at FROM docks WHERE (? = 0 OR warehouse_id = ?)`)
+
+	mock, db := testhelpers.CreateMockDB()
+	defer db.Close()
+	mock.ExpectQuery(query).WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "warehouse_id", "code", "opens_at", "closes_at"}).
+		AddRow(5, 1, "D-01", "08:00:00", "18:00:00"))
+	repo := repository.NewDockRepository(db)
+
+	docks, err := repo.FindDocks(context.Background(), 1)
+
+	require.NoError(t, err)
+	require.Equal(t, []models.Dock{testhelpers.DummyDock(5)}, docks)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDockRepository_CreateDock(t *testing.T) {
+	query := regexp.QuoteMeta(`INSERT INTO docks (warehouse_id, code, opens_at, closes_at) VALUES (?, ?, ?, ?)`)
+	dock := models.PostDock{WarehouseId: 1, Code: "D-01", OpensAt: "08:00", ClosesAt: "18:00"}
+
+	testCases := []struct {
+		name    string
+		dbMock  func() (sqlmock.Sqlmock, *sql.DB)
+		errCode string
+	}{
+		{
+			name: "success",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WithArgs(1, "D-01", "08:00", "18:00").WillReturnResult(sqlmock.NewResult(5, 1))
+				return mock, db
+			},
+		},
+		{
+			name: "error - code taken in the warehouse",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WillReturnError(&mysql.MySQLError{Number: 1062})
+				return mock, db
+			},
+			errCode: apperrors.CodeConflict,
+		},
+		{
+			name: "error - warehouse missing",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WillReturnError(&mysql.MySQLError{Number: 1452})
+				return mock, db
+			},
+			errCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewDockRepository(db)
+
+			result, err := repo.CreateDock(context.Background(), dock)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testhelpers.DummyDock(5), *result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDockRepository_CountOverlapping(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT COUNT(1) FROM dock_appointments
+		WHERE dock_id = ? AND status NOT IN ('cancelled', 'missed') AND starts_at < ? AND ends_at > ?`)
+	startsAt := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(time.Hour)
+
+	mock, db := testhelpers.CreateMockDB()
+	defer db.Close()
+	mock.ExpectQuery(query).WithArgs(5, endsAt, startsAt).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	repo := repository.NewDockRepository(db)
+
+	count, err := repo.CountOverlapping(context.Background(), db, 5, startsAt, endsAt)
+
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDockRepository_FindAppointmentById(t *testing.T) {
+	query := regexp.QuoteMeta(`FROM dock_appointments a INNER JOIN docks d ON d.id = a.dock_id WHERE a.id = ?`)
+	startsAt := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		arrivedAt any
+		wantLate  int
+		errCode   string
+	}{
+		{
+			name:      "success - arrived late",
+			arrivedAt: startsAt.Add(95 * time.Minute),
+			wantLate:  95,
+		},
+		{
+			name:      "success - arrived early",
+			arrivedAt: startsAt.Add(-10 * time.Minute),
+		},
+		{
+			name: "success - not arrived",
+		},
+		{
+			name:    "error - not found",
+			errCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := testhelpers.CreateMockDB()
+			defer db.Close()
+			rows := sqlmock.NewRows(appointmentColumns)
+			if tc.errCode == "" {
+				rows.AddRow(11, 5, 1, 3, nil, nil, startsAt, startsAt.Add(time.Hour), models.AppointmentMissed, 41, tc.arrivedAt, startsAt)
+			}
+			mock.ExpectQuery(query).WithArgs(11).WillReturnRows(rows)
+			repo := repository.NewDockRepository(db)
+
+			result, err := repo.FindAppointmentById(context.Background(), 11)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 3, *result.SellerId)
+				require.Nil(t, result.CarrierId)
+				require.Equal(t, 41, *result.InboundOrderId)
+				require.Equal(t, tc.wantLate, result.LateMinutes)
+				require.Equal(t, tc.arrivedAt == nil, result.ArrivedAt == nil)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDockRepository_UpdateAppointment(t *testing.T) {
+	query := regexp.QuoteMeta(`UPDATE dock_appointments SET status = ?, inbound_order_id = ?, arrived_at = ? WHERE id = ?`)
+	appointment := testhelpers.DummyAppointment(models.AppointmentArrived, time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC))
+	appointment.InboundOrderId = testhelpers.IntPtr(41)
+	appointment.ArrivedAt = testhelpers.Ptr(appointment.StartsAt)
+
+	testCases := []struct {
+		name    string
+		dbErr   error
+		errCode string
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:    "error - inbound order linked to another appointment",
+			dbErr:   &mysql.MySQLError{Number: 1062},
+			errCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := testhelpers.CreateMockDB()
+			defer db.Close()
+			expect := mock.ExpectExec(query).WithArgs(models.AppointmentArrived, appointment.InboundOrderId, appointment.ArrivedAt, 11)
+			if tc.dbErr != nil {
+				expect.WillReturnError(tc.dbErr)
+			} else {
+				expect.WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+			repo := repository.NewDockRepository(db)
+
+			err := repo.UpdateAppointment(context.Background(), db, appointment)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -14,7 +14,8 @@ const (
 	queryCountryFindById     = `SELECT id, name FROM countries WHERE LOWER(name) = LOWER(?)`
 	queryProvinceCreate      = `INSERT INTO provinces (name, country_id) VALUES (?, ?)`
 	queryProvinceFindById    = `SELECT id, name, country_id FROM provinces WHERE LOWER(name) = LOWER(?) AND country_id = ?`
-	queryLocalityCreate      = `INSERT INTO localities (id, name, province_id) VALUES (?, ?, ?)`
+	queryLocalityCreate      = `INSERT INTO localities (id, name, province_id, latitude, longitude) VALUES (?, ?, ?, ?, ?)`
+	queryLocalityCoordinates = `UPDATE localities SET latitude = ?, longitude = ? WHERE id = ?`
 	queryLocalityFindById    = `SELECT id, name, province_id FROM localities WHERE id = ?`
 	queryLocalityWithSellers = `SELECT l.id, l.name, COUNT(s.id) FROM localities l
 								LEFT JOIN sellers s ON l.id = s.locality_id
@@ -80,7 +81,7 @@ func (r *geographyRepository) FindProvinceByName(ctx context.Context, name strin
 }
 
 func (r *geographyRepository) CreateLocality(ctx context.Context, exec Executor, l models.Locality) (*models.Locality, error) {
-	_, err := exec.ExecContext(ctx, queryLocalityCreate, l.Id, l.Name, l.ProvinceId)
+	_, err := exec.ExecContext(ctx, queryLocalityCreate, l.Id, l.Name, l.ProvinceId, l.Latitude, l.Longitude)
 	if err != nil {
 		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1062 {
 			return nil, apperrors.NewAppError(apperrors.CodeConflict, "The locality you are creating already exists.").
@@ -105,6 +106,14 @@ func (r *geographyRepository) FindLocalityById(ctx context.Context, id string) (
 	return &locality, nil
 }
 
+// UpdateLocalityCoordinates stores the latitude and longitude of the locality.
+func (r *geographyRepository) UpdateLocalityCoordinates(ctx context.Context, id string, latitude float64, longitude float64) error {
+	if _, err := r.mysql.ExecContext(ctx, queryLocalityCoordinates, latitude, longitude, id); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "failed to update locality coordinates").WithDetail("error", err.Error())
+	}
+	return nil
+}
+
 func (r *geographyRepository) CountSellersByLocality(ctx context.Context, id string) (*models.ResponseLocalitySellers, error) {
 	var resp models.ResponseLocalitySellers
 	row := r.mysql.QueryRowContext(ctx, queryLocalityWithSellers, id)
@@ -140,18 +149,6 @@ func (r *geographyRepository) CountSellersGroupedByLocality(ctx context.Context)
 	return results, nil
 }
 
-func (r *geographyRepository) BeginTx(ctx context.Context) (*sql.Tx, error) {
-	return r.mysql.BeginTx(ctx, nil)
-}
-
-func (r *geographyRepository) CommitTx(tx *sql.Tx) error {
-	return tx.Commit()
-}
-
-func (r *geographyRepository) RollbackTx(tx *sql.Tx) error {
-	return tx.Rollback()
-}
-
 func (r *geographyRepository) GetDB() *sql.DB {
 	return r.mysql
 }
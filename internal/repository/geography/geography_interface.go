@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/database"
 	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/geography"
 )
 
@@ -33,6 +34,10 @@ type GeographyRepository interface {
 	// Returns the Locality model or an error if no locality is found.
 	FindLocalityById(ctx context.Context, id string) (*models.Locality, error)
 
+	// UpdateLocalityCoordinates sets the latitude and longitude of a locality.
+	// Returns an error if the operation fails.
+	UpdateLocalityCoordinates(ctx context.Context, id string, latitude float64, longitude float64) error
+
 	// CountSellersByLocality returns the number of sellers registered in the specified locality.
 	// Returns a response model or an error if the operation fails.
 	CountSellersByLocality(ctx context.Context, id string) (*models.ResponseLocalitySellers, error)
@@ -41,34 +46,25 @@ type GeographyRepository interface {
 	// Returns a slice of response models, or an error if the operation fails.
 	CountSellersGroupedByLocality(ctx context.Context) ([]models.ResponseLocalitySellers, error)
 
-	// BeginTx starts a new database transaction and returns the transaction object.
-	BeginTx(ctx context.Context) (*sql.Tx, error)
-
-	// CommitTx commits the provided transaction.
-	CommitTx(tx *sql.Tx) error
-
-	// RollbackTx aborts the provided transaction.
-	RollbackTx(tx *sql.Tx) error
+	database.Transactor
 
 	// GetDB returns the underlying *sql.DB instance.
 	GetDB() *sql.DB
 }
 
-// Executor wraps types that can execute SQL commands or queries within a context.
-// Used for supporting operations both in transactions and out of them.
-type Executor interface {
-	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
-	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
-}
+// Executor runs SQL statements either on the connection or inside a transaction.
+type Executor = database.Executor
 
 // geographyRepository implements the GeographyRepository interface using MySQL as the backend.
 type geographyRepository struct {
+	database.MySQLTransactor
 	mysql *sql.DB
 }
 
 // NewGeographyRepository creates a new GeographyRepository backed by a MySQL database.
 func NewGeographyRepository(mysql *sql.DB) GeographyRepository {
 	return &geographyRepository{
-		mysql: mysql,
+		MySQLTransactor: database.NewMySQLTransactor(mysql),
+		mysql:           mysql,
 	}
 }
@@ -18,6 +18,7 @@ import (
 const (
 	queryLocalityCreate      = "INSERT INTO localities"
 	queryLocalityFindById    = "SELECT id, name, province_id FROM localities WHERE id = \\?"
+	queryLocalityCoordinates = "UPDATE localities SET latitude = \\?, longitude = \\? WHERE id = \\?"
 	queryLocalityWithSellers = "SELECT l.id, l.name, COUNT\\(s.id\\) FROM localities l"
 )
 
@@ -34,7 +35,7 @@ func TestGeographyRepository_CreateLocality(t *testing.T) {
 			setup: func(mock sqlmock.Sqlmock) {
 				l := testhelpers.LocalitiesDummyMap["1900"]
 				mock.ExpectExec("^"+queryLocalityCreate).
-					WithArgs(l.Id, l.Name, l.ProvinceId).
+					WithArgs(l.Id, l.Name, l.ProvinceId, l.Latitude, l.Longitude).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			arg:     testhelpers.LocalitiesDummyMap["1900"],
@@ -46,7 +47,7 @@ func TestGeographyRepository_CreateLocality(t *testing.T) {
 				l := testhelpers.LocalitiesDummyMap["5000"]
 				mysqlErr := &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}
 				mock.ExpectExec("^"+queryLocalityCreate).
-					WithArgs(l.Id, l.Name, l.ProvinceId).
+					WithArgs(l.Id, l.Name, l.ProvinceId, l.Latitude, l.Longitude).
 					WillReturnError(mysqlErr)
 			},
 			arg:            testhelpers.LocalitiesDummyMap["5000"],
@@ -58,7 +59,7 @@ func TestGeographyRepository_CreateLocality(t *testing.T) {
 			setup: func(mock sqlmock.Sqlmock) {
 				l := testhelpers.LocalitiesDummyMap["2000"]
 				mock.ExpectExec("^"+queryLocalityCreate).
-					WithArgs(l.Id, l.Name, l.ProvinceId).
+					WithArgs(l.Id, l.Name, l.ProvinceId, l.Latitude, l.Longitude).
 					WillReturnError(errors.New("db failure"))
 			},
 			arg:            testhelpers.LocalitiesDummyMap["2000"],
@@ -164,6 +165,54 @@ func TestGeographyRepository_FindLocalityById(t *testing.T) {
 	}
 }
 
+func TestGeographyRepository_UpdateLocalityCoordinates(t *testing.T) {
+	tests := []struct {
+		name           string
+		setup          func(sqlmock.Sqlmock)
+		wantErr        bool
+		expectedErrMsg string
+	}{
+		{
+			name: "success",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("^"+queryLocalityCoordinates).
+					WithArgs(-34.603722, -58.381592, "5501").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "db error",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("^"+queryLocalityCoordinates).
+					WithArgs(-34.603722, -58.381592, "5501").
+					WillReturnError(errors.New("db is down"))
+			},
+			wantErr:        true,
+			expectedErrMsg: "failed to update locality coordinates",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tt.setup(mock)
+			repo := repository.NewGeographyRepository(db)
+			err = repo.UpdateLocalityCoordinates(context.Background(), "5501", -34.603722, -58.381592)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.expectedErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestGeographyRepository_CountSellersByLocality(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -19,6 +19,11 @@ const (
 	// Verifica si un order_number ya existe (debe ser único)
 	queryInboundOrderExistsByOrderNumber = `
 		SELECT COUNT(1) FROM inbound_orders WHERE order_number = ?`
+	// Verifica que el batch esté guardado en una sección del warehouse indicado
+	queryInboundOrderBatchInWarehouse = `
+		SELECT COUNT(1) FROM product_batches pb
+		INNER JOIN sections s ON s.id = pb.section_id
+		WHERE pb.id = ? AND s.warehouse_id = ?`
 	// Trae el reporte de inbound orders agrupado por employee
 	queryInboundOrdersReportAll = `
 		SELECT e.id, e.id_card_number, e.first_name, e.last_name, e.warehouse_id, 
@@ -77,6 +82,16 @@ func (r *InboundOrderMySQLRepository) ExistsByOrderNumber(ctx context.Context, o
 	return count > 0, nil
 }
 
+// Verifica si el batch pertenece a una sección del warehouse
+func (r *InboundOrderMySQLRepository) BatchInWarehouse(ctx context.Context, productBatchID int, warehouseID int) (bool, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, queryInboundOrderBatchInWarehouse, productBatchID, warehouseID).Scan(&count)
+	if err != nil {
+		return false, apperrors.NewAppError(apperrors.CodeInternal, "db error")
+	}
+	return count > 0, nil
+}
+
 // Genera el reporte de inbound orders para todos los empleados
 func (r *InboundOrderMySQLRepository) ReportAll(ctx context.Context) ([]models.InboundOrderReport, error) {
 	rows, err := r.db.QueryContext(ctx, queryInboundOrdersReportAll)
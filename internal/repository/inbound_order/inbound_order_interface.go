@@ -10,6 +10,7 @@ import (
 type InboundOrderRepository interface {
 	Create(ctx context.Context, o *models.InboundOrder) (*models.InboundOrder, error)
 	ExistsByOrderNumber(ctx context.Context, orderNumber string) (bool, error)
+	BatchInWarehouse(ctx context.Context, productBatchID int, warehouseID int) (bool, error)
 	ReportAll(ctx context.Context) ([]models.InboundOrderReport, error)
 	ReportByID(ctx context.Context, employeeID int) (*models.InboundOrderReport, error)
 }
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inventory"
+)
+
+// queryFindOnHand is completed with the location columns and grouping of the requested level.
+// Disposed and empty batches are never stock on hand.
+const queryFindOnHand = `SELECT p.id, p.product_code, p.description, %[1]s,
+		SUM(pb.current_quantity),
+		COALESCE(SUM(CASE WHEN pb.status = 'available' AND pb.due_date > ? THEN pb.current_quantity END), 0),
+		COALESCE(SUM(CASE WHEN pb.status IN ('on_hold', 'quarantined') THEN pb.current_quantity END), 0),
+		COALESCE(SUM(CASE WHEN pb.due_date <= ? THEN pb.current_quantity END), 0),
+		COUNT(pb.id)
+	FROM product_batches pb
+	INNER JOIN products p ON p.id = pb.product_id
+	INNER JOIN sections s ON s.id = pb.section_id
+	INNER JOIN warehouse w ON w.id = s.warehouse_id
+	WHERE pb.current_quantity > 0 AND pb.status <> 'disposed'
+		AND (? = 0 OR p.id = ?) AND (? = 0 OR p.seller_id = ?) AND (? = 0 OR w.id = ?)
+		AND (? = 0 OR s.id = ?) AND (? = 0 OR p.product_type_id = ?)
+		AND (NOT ? OR pb.status = 'available') AND (NOT ? OR pb.due_date > ?)
+	GROUP BY p.id, p.product_code, p.description%[2]s
+	ORDER BY p.id%[2]s`
+
+// onHandLevels holds the location columns and extra grouping of each grouping level.
+var onHandLevels = map[string][2]string{
+	models.GroupByProduct:   {"NULL, NULL, NULL, NULL", ""},
+	models.GroupByWarehouse: {"w.id, w.warehouse_code, NULL, NULL", ", w.id, w.warehouse_code"},
+	models.GroupBySection:   {"w.id, w.warehouse_code, s.id, s.section_number", ", w.id, w.warehouse_code, s.id, s.section_number"},
+}
+
+// FindOnHand returns one line per product, or per product and location when grouping by warehouse or section.
+func (r *inventoryRepository) FindOnHand(ctx context.Context, f models.InventoryFilter, now time.Time) ([]models.InventoryLine, error) {
+	level, ok := onHandLevels[f.GroupBy]
+	if !ok {
+		level = onHandLevels[models.GroupByProduct]
+	}
+	query := fmt.Sprintf(queryFindOnHand, level[0], level[1])
+
+	rows, err := r.mysql.QueryContext(ctx, query, now, now,
+		f.ProductId, f.ProductId, f.SellerId, f.SellerId, f.WarehouseId, f.WarehouseId,
+		f.SectionId, f.SectionId, f.ProductTypeId, f.ProductTypeId,
+		f.ExcludeHeld, f.ExcludeExpired, now)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying inventory")
+	}
+	defer rows.Close()
+
+	lines := make([]models.InventoryLine, 0)
+	for rows.Next() {
+		var l models.InventoryLine
+		var warehouseId, sectionId, sectionNumber sql.NullInt64
+		var warehouseCode sql.NullString
+		if err := rows.Scan(&l.ProductId, &l.ProductCode, &l.Description, &warehouseId, &warehouseCode, &sectionId, &sectionNumber,
+			&l.Quantity, &l.AvailableQuantity, &l.HeldQuantity, &l.ExpiredQuantity, &l.BatchCount); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning inventory")
+		}
+		l.WarehouseId = nullIntPtr(warehouseId)
+		l.WarehouseCode = nullStringPtr(warehouseCode)
+		l.SectionId = nullIntPtr(sectionId)
+		l.SectionNumber = nullIntPtr(sectionNumber)
+		lines = append(lines, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating inventory")
+	}
+	return lines, nil
+}
+
+func nullIntPtr(v sql.NullInt64) *int {
+	if !v.Valid {
+		return nil
+	}
+	i := int(v.Int64)
+	return &i
+}
+
+func nullStringPtr(v sql.NullString) *string {
+	if !v.Valid {
+		return nil
+	}
+	return &v.String
+}
@@ -0,0 +1,89 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/inventory"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inventory"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestInventoryRepository_FindOnHand(t *testing.T) {
+	columns := []string{"id", "product_code", "description", "warehouse_id", "warehouse_code", "section_id", "section_number",
+		"quantity", "available", "held", "expired", "batches"}
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	byProduct := testhelpers.DummyInventoryLine(22)
+	bySection := testhelpers.DummyInventorySectionLine(22, 1, 3)
+
+	testCases := []struct {
+		name     string
+		filter   models.InventoryFilter
+		dbMock   func() (sqlmock.Sqlmock, *sql.DB)
+		expected []models.InventoryLine
+		errCode  string
+	}{
+		{
+			name:   "success - grouped by product",
+			filter: models.InventoryFilter{GroupBy: models.GroupByProduct},
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(`SELECT p.id, p.product_code, p.description, NULL, NULL, NULL, NULL, (.+) GROUP BY p.id, p.product_code, p.description\s+ORDER BY p.id$`).
+					WithArgs(now, now, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, false, false, now).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(byProduct.ProductId, byProduct.ProductCode, byProduct.Description,
+						nil, nil, nil, nil, 150, 100, 30, 20, 4))
+				return mock, db
+			},
+			expected: []models.InventoryLine{byProduct},
+		},
+		{
+			name: "success - grouped by section with filters",
+			filter: models.InventoryFilter{ProductId: 22, WarehouseId: 1, GroupBy: models.GroupBySection,
+				ExcludeHeld: true, ExcludeExpired: true},
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(`SELECT p.id, p.product_code, p.description, w.id, w.warehouse_code, s.id, s.section_number, (.+) GROUP BY p.id, p.product_code, p.description, w.id, w.warehouse_code, s.id, s.section_number`).
+					WithArgs(now, now, 22, 22, 0, 0, 1, 1, 0, 0, 0, 0, true, true, now).
+					WillReturnRows(sqlmock.NewRows(columns).AddRow(bySection.ProductId, bySection.ProductCode, bySection.Description,
+						1, "WH001", 3, 30, 150, 100, 30, 20, 4))
+				return mock, db
+			},
+			expected: []models.InventoryLine{bySection},
+		},
+		{
+			name:   "error - database error",
+			filter: models.InventoryFilter{GroupBy: models.GroupByWarehouse},
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(`GROUP BY p.id, p.product_code, p.description, w.id, w.warehouse_code\s+ORDER BY`).
+					WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewInventoryRepository(db)
+
+			result, err := repo.FindOnHand(context.Background(), tc.filter, now)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inventory"
+)
+
+// InventoryRepository aggregates the stock on hand of product batches.
+type InventoryRepository interface {
+	// FindOnHand returns the stock on hand matching the filter, grouped as the filter requests.
+	// Batches due up to now count as expired.
+	FindOnHand(ctx context.Context, filter models.InventoryFilter, now time.Time) ([]models.InventoryLine, error)
+}
+
+// inventoryRepository implements InventoryRepository using MySQL.
+type inventoryRepository struct {
+	mysql *sql.DB
+}
+
+// NewInventoryRepository returns a new InventoryRepository using the given MySQL connection.
+func NewInventoryRepository(mysql *sql.DB) InventoryRepository {
+	return &inventoryRepository{
+		mysql: mysql,
+	}
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/occupancy"
+)
+
+const (
+	// Warehouses without sections are kept with zero capacity and no temperature.
+	queryFindWarehouses = `SELECT w.id, w.warehouse_code, w.minimum_capacity, COUNT(s.id),
+			COALESCE(SUM(s.maximum_capacity), 0), COALESCE(SUM(s.current_capacity), 0),
+			MIN(s.current_temperature), MAX(s.current_temperature), AVG(s.current_temperature)
+		FROM warehouse w LEFT JOIN sections s ON s.warehouse_id = w.id
+		WHERE (? = 0 OR w.id = ?)
+		GROUP BY w.id, w.warehouse_code, w.minimum_capacity
+		ORDER BY w.id`
+	queryFindProductTypeUsage = `SELECT s.warehouse_id, s.product_type_id, pt.description, COUNT(s.id),
+			COALESCE(SUM(s.maximum_capacity), 0), COALESCE(SUM(s.current_capacity), 0)
+		FROM sections s INNER JOIN products_types pt ON pt.id = s.product_type_id
+		WHERE (? = 0 OR s.warehouse_id = ?)
+		GROUP BY s.warehouse_id, s.product_type_id, pt.description
+		ORDER BY s.warehouse_id, s.product_type_id`
+	queryFindSectionsBelowMinimum = `SELECT s.warehouse_id, s.id, s.section_number, s.current_capacity, s.minimum_capacity
+		FROM sections s
+		WHERE s.current_capacity < s.minimum_capacity AND (? = 0 OR s.warehouse_id = ?)
+		ORDER BY s.warehouse_id, s.id`
+	queryFindExpiryCounts = `SELECT s.warehouse_id, SUM(pb.due_date <= ?), SUM(pb.due_date > ?)
+		FROM product_batches pb INNER JOIN sections s ON s.id = pb.section_id
+		WHERE pb.due_date <= ? AND pb.current_quantity > 0 AND pb.status <> 'disposed' AND (? = 0 OR s.warehouse_id = ?)
+		GROUP BY s.warehouse_id
+		ORDER BY s.warehouse_id`
+)
+
+// FindWarehouses returns one row per warehouse with its section totals.
+func (r *occupancyRepository) FindWarehouses(ctx context.Context, warehouseId int) ([]models.WarehouseOccupancy, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryFindWarehouses, warehouseId, warehouseId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying warehouse occupancy")
+	}
+	defer rows.Close()
+
+	warehouses := make([]models.WarehouseOccupancy, 0)
+	for rows.Next() {
+		var o models.WarehouseOccupancy
+		var minTemp, maxTemp, avgTemp sql.NullFloat64
+		if err := rows.Scan(&o.WarehouseId, &o.WarehouseCode, &o.MinimumCapacity, &o.SectionCount,
+			&o.TotalCapacity, &o.UsedCapacity, &minTemp, &maxTemp, &avgTemp); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning warehouse occupancy")
+		}
+		o.Temperature = models.TemperatureSpread{
+			Minimum: nullFloatPtr(minTemp),
+			Maximum: nullFloatPtr(maxTemp),
+			Average: nullFloatPtr(avgTemp),
+		}
+		warehouses = append(warehouses, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating warehouse occupancy")
+	}
+	return warehouses, nil
+}
+
+// FindProductTypeUsage returns the section totals grouped by warehouse and product type.
+func (r *occupancyRepository) FindProductTypeUsage(ctx context.Context, warehouseId int) ([]models.ProductTypeUsage, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryFindProductTypeUsage, warehouseId, warehouseId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying product type usage")
+	}
+	defer rows.Close()
+
+	usage := make([]models.ProductTypeUsage, 0)
+	for rows.Next() {
+		var u models.ProductTypeUsage
+		if err := rows.Scan(&u.WarehouseId, &u.ProductTypeId, &u.Description, &u.SectionCount,
+			&u.TotalCapacity, &u.UsedCapacity); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning product type usage")
+		}
+		usage = append(usage, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating product type usage")
+	}
+	return usage, nil
+}
+
+// FindSectionsBelowMinimum returns the under-filled sections, ordered by warehouse and section id.
+func (r *occupancyRepository) FindSectionsBelowMinimum(ctx context.Context, warehouseId int) ([]models.SectionBelowMinimum, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryFindSectionsBelowMinimum, warehouseId, warehouseId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying sections below minimum capacity")
+	}
+	defer rows.Close()
+
+	sections := make([]models.SectionBelowMinimum, 0)
+	for rows.Next() {
+		var s models.SectionBelowMinimum
+		if err := rows.Scan(&s.WarehouseId, &s.SectionId, &s.SectionNumber, &s.CurrentCapacity, &s.MinimumCapacity); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning section below minimum capacity")
+		}
+		sections = append(sections, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating sections below minimum capacity")
+	}
+	return sections, nil
+}
+
+// FindExpiryCounts counts the non-disposed batches with stock left per warehouse.
+// Batches due up to now are expired; the ones due after now and up to until are expiring.
+func (r *occupancyRepository) FindExpiryCounts(ctx context.Context, now, until time.Time, warehouseId int) ([]models.ExpiryCounts, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryFindExpiryCounts, now, now, until, warehouseId, warehouseId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying batch expiry counts")
+	}
+	defer rows.Close()
+
+	counts := make([]models.ExpiryCounts, 0)
+	for rows.Next() {
+		var c models.ExpiryCounts
+		if err := rows.Scan(&c.WarehouseId, &c.Expired, &c.Expiring); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning batch expiry counts")
+		}
+		counts = append(counts, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating batch expiry counts")
+	}
+	return counts, nil
+}
+
+func nullFloatPtr(v sql.NullFloat64) *float64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Float64
+}
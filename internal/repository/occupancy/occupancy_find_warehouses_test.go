@@ -0,0 +1,79 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/occupancy"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/occupancy"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestOccupancyRepository_FindWarehouses(t *testing.T) {
+	columns := []string{"id", "warehouse_code", "minimum_capacity", "sections", "total", "used", "min_temp", "max_temp", "avg_temp"}
+	const query = `SELECT w.id, w.warehouse_code, w.minimum_capacity, COUNT\(s.id\), (.+) FROM warehouse w LEFT JOIN sections s ON s.warehouse_id = w.id\s+WHERE \(\? = 0 OR w.id = \?\)\s+GROUP BY w.id`
+	stocked := testhelpers.DummyWarehouseOccupancy(1)
+	empty := models.WarehouseOccupancy{WarehouseId: 2, WarehouseCode: "WH002", MinimumCapacity: 50}
+
+	testCases := []struct {
+		name     string
+		dbMock   func() (sqlmock.Sqlmock, *sql.DB)
+		expected []models.WarehouseOccupancy
+		errCode  string
+	}{
+		{
+			name: "success - warehouse without sections has no temperature",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(0, 0).WillReturnRows(sqlmock.NewRows(columns).
+					AddRow(stocked.WarehouseId, stocked.WarehouseCode, stocked.MinimumCapacity, stocked.SectionCount, stocked.TotalCapacity,
+						stocked.UsedCapacity, *stocked.Temperature.Minimum, *stocked.Temperature.Maximum, *stocked.Temperature.Average).
+					AddRow(empty.WarehouseId, empty.WarehouseCode, empty.MinimumCapacity, 0, 0, 0, nil, nil, nil))
+				return mock, db
+			},
+			expected: []models.WarehouseOccupancy{stocked, empty},
+		},
+		{
+			name: "error - scan fails",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(0, 0).WillReturnRows(sqlmock.NewRows(columns).
+					AddRow("bad", "WH001", 1, 0, 0, 0, nil, nil, nil))
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(0, 0).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewOccupancyRepository(db)
+
+			result, err := repo.FindWarehouses(context.Background(), 0)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/occupancy"
+)
+
+// OccupancyRepository aggregates section capacities, temperatures and batch due dates per warehouse.
+// Every method takes a warehouse id filter; 0 means every warehouse.
+type OccupancyRepository interface {
+	// FindWarehouses returns the capacity and temperature totals of each warehouse, ordered by id.
+	FindWarehouses(ctx context.Context, warehouseId int) ([]models.WarehouseOccupancy, error)
+
+	// FindProductTypeUsage returns the capacity totals per warehouse and product type.
+	FindProductTypeUsage(ctx context.Context, warehouseId int) ([]models.ProductTypeUsage, error)
+
+	// FindSectionsBelowMinimum returns the sections whose current capacity is under their minimum capacity.
+	FindSectionsBelowMinimum(ctx context.Context, warehouseId int) ([]models.SectionBelowMinimum, error)
+
+	// FindExpiryCounts returns, per warehouse, the batches with stock left due before now and between now and until.
+	FindExpiryCounts(ctx context.Context, now, until time.Time, warehouseId int) ([]models.ExpiryCounts, error)
+}
+
+// occupancyRepository implements OccupancyRepository using MySQL.
+type occupancyRepository struct {
+	mysql *sql.DB
+}
+
+// NewOccupancyRepository returns a new OccupancyRepository using the given MySQL connection.
+func NewOccupancyRepository(mysql *sql.DB) OccupancyRepository {
+	return &occupancyRepository{
+		mysql: mysql,
+	}
+}
@@ -0,0 +1,365 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	buyerModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/picking"
+)
+
+const (
+	queryWaveCandidates = `SELECT a.id, a.status, a.purchase_order_id, pb.product_id, pb.id, pb.batch_number, pb.status, s.id, s.section_number,
+			a.quantity
+		FROM stock_allocations a
+		INNER JOIN purchase_orders po ON po.id = a.purchase_order_id
+		INNER JOIN product_batches pb ON pb.id = a.product_batch_id
+		INNER JOIN sections s ON s.id = pb.section_id
+		LEFT JOIN pick_list_lines l ON l.stock_allocation_id = a.id
+		WHERE s.warehouse_id = ? AND a.status = 'reserved' AND po.order_status_id = ? AND l.id IS NULL
+		ORDER BY s.section_number, pb.id, a.id FOR UPDATE`
+	queryWaveCreate   = `INSERT INTO pick_waves (warehouse_id, status, created_at) VALUES (?, ?, ?)`
+	queryListCreate   = `INSERT INTO pick_lists (pick_wave_id, status) VALUES (?, 'open')`
+	queryLineCreate   = `INSERT INTO pick_list_lines (pick_list_id, stock_allocation_id, sequence, quantity) VALUES (?, ?, ?, ?)`
+	queryWaveColumns  = `SELECT id, warehouse_id, status, created_at, completed_at FROM pick_waves`
+	queryWaveFindAll  = queryWaveColumns + ` WHERE (? = 0 OR warehouse_id = ?) AND (? = '' OR status = ?) ORDER BY id DESC`
+	queryWaveFindById = queryWaveColumns + ` WHERE id = ?`
+	queryListColumns  = `SELECT pl.id, pl.pick_wave_id, w.warehouse_id, pl.status, pl.employee_id, pl.confirmed_at
+		FROM pick_lists pl INNER JOIN pick_waves w ON w.id = pl.pick_wave_id`
+	queryListsByWave   = queryListColumns + ` WHERE pl.pick_wave_id = ? ORDER BY pl.id`
+	queryListFindById  = queryListColumns + ` WHERE pl.id = ?`
+	queryListForUpdate = queryListFindById + ` FOR UPDATE`
+	queryLines         = `SELECT l.id, l.sequence, a.id, a.status, a.purchase_order_id, pb.product_id, pb.id, pb.batch_number, s.id, s.section_number,
+			l.quantity, l.picked_quantity
+		FROM pick_list_lines l
+		INNER JOIN stock_allocations a ON a.id = l.stock_allocation_id
+		INNER JOIN product_batches pb ON pb.id = a.product_batch_id
+		INNER JOIN sections s ON s.id = pb.section_id
+		WHERE l.pick_list_id = ? ORDER BY l.sequence`
+	queryLinesForUpdate        = queryLines + ` FOR UPDATE`
+	queryLinePicked            = `UPDATE pick_list_lines SET picked_quantity = ? WHERE id = ?`
+	queryAllocationPicked      = `UPDATE stock_allocations SET status = 'picked' WHERE id = ? AND status = 'reserved'`
+	querySectionCapacityAdd    = `UPDATE sections SET current_capacity = current_capacity + ? WHERE id = ?`
+	queryListConfirm           = `UPDATE pick_lists SET status = ?, employee_id = ?, confirmed_at = ? WHERE id = ?`
+	queryOpenLists             = `SELECT COUNT(1) FROM pick_lists WHERE pick_wave_id = ? AND status = 'open'`
+	queryWaveComplete          = `UPDATE pick_waves SET status = 'completed', completed_at = ? WHERE id = ?`
+	queryPickEmployeeWarehouse = `SELECT warehouse_id FROM employees WHERE id = ?`
+	queryShortages             = `SELECT pl.id, w.id, w.warehouse_id, a.purchase_order_id, pb.product_id, pb.id, pb.batch_number, s.section_number,
+			l.quantity, l.picked_quantity, pl.confirmed_at
+		FROM pick_list_lines l
+		INNER JOIN pick_lists pl ON pl.id = l.pick_list_id
+		INNER JOIN pick_waves w ON w.id = pl.pick_wave_id
+		INNER JOIN stock_allocations a ON a.id = l.stock_allocation_id
+		INNER JOIN product_batches pb ON pb.id = a.product_batch_id
+		INNER JOIN sections s ON s.id = pb.section_id
+		WHERE pl.status = 'confirmed' AND a.status = 'picked' AND l.picked_quantity < l.quantity AND (? = 0 OR w.warehouse_id = ?)
+		ORDER BY pl.confirmed_at DESC, l.sequence`
+)
+
+// FindWaveCandidatesForUpdate returns the allocations to wave ordered by section number, then batch,
+// with the status of their batch. The locks keep two waves planned at the same time from taking the same allocation.
+func (r *pickingRepository) FindWaveCandidatesForUpdate(ctx context.Context, exec Executor, warehouseId int) ([]models.PickLine, error) {
+	rows, err := exec.QueryContext(ctx, queryWaveCandidates, warehouseId, buyerModels.OrderStatusConfirmed)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying allocations to pick")
+	}
+	defer rows.Close()
+
+	lines := make([]models.PickLine, 0)
+	for rows.Next() {
+		var l models.PickLine
+		if err := rows.Scan(&l.StockAllocationId, &l.AllocationStatus, &l.PurchaseOrderId, &l.ProductId, &l.ProductBatchId, &l.BatchNumber,
+			&l.BatchStatus, &l.SectionId, &l.SectionNumber, &l.Quantity); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning allocation to pick")
+		}
+		lines = append(lines, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating allocations to pick")
+	}
+	return lines, nil
+}
+
+// CreateWave inserts the wave.
+func (r *pickingRepository) CreateWave(ctx context.Context, exec Executor, w models.Wave) (int, error) {
+	res, err := exec.ExecContext(ctx, queryWaveCreate, w.WarehouseId, w.Status, w.CreatedAt)
+	if err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error creating pick wave")
+	}
+	return lastInsertId(res)
+}
+
+// CreateList inserts an open pick list.
+func (r *pickingRepository) CreateList(ctx context.Context, exec Executor, waveId int) (int, error) {
+	res, err := exec.ExecContext(ctx, queryListCreate, waveId)
+	if err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error creating pick list")
+	}
+	return lastInsertId(res)
+}
+
+// CreateLine inserts the line.
+// Returns a conflict error if the allocation is already in another pick list.
+func (r *pickingRepository) CreateLine(ctx context.Context, exec Executor, listId int, l models.PickLine) error {
+	if _, err := exec.ExecContext(ctx, queryLineCreate, listId, l.StockAllocationId, l.Sequence, l.Quantity); err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1062 {
+			return apperrors.NewAppError(apperrors.CodeConflict, "allocation already in a pick list").
+				WithDetail("stock_allocation_id", l.StockAllocationId)
+		}
+		return apperrors.NewAppError(apperrors.CodeInternal, "error creating pick line")
+	}
+	return nil
+}
+
+// FindWaves returns the waves, latest first.
+func (r *pickingRepository) FindWaves(ctx context.Context, warehouseId int, status string) ([]models.Wave, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryWaveFindAll, warehouseId, warehouseId, status, status)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying pick waves")
+	}
+	defer rows.Close()
+
+	waves := make([]models.Wave, 0)
+	for rows.Next() {
+		w, err := scanWave(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning pick wave")
+		}
+		waves = append(waves, *w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating pick waves")
+	}
+	return waves, nil
+}
+
+// FindWaveById returns the wave.
+// Returns a not found error if it does not exist.
+func (r *pickingRepository) FindWaveById(ctx context.Context, id int) (*models.Wave, error) {
+	w, err := scanWave(r.mysql.QueryRowContext(ctx, queryWaveFindById, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "pick wave not found").WithDetail("wave_id", id)
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying pick wave")
+	}
+	return w, nil
+}
+
+// FindListsByWave returns the pick lists of the wave in creation order.
+func (r *pickingRepository) FindListsByWave(ctx context.Context, waveId int) ([]models.PickList, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryListsByWave, waveId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying pick lists")
+	}
+	defer rows.Close()
+
+	lists := make([]models.PickList, 0)
+	for rows.Next() {
+		l, err := scanList(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning pick list")
+		}
+		lists = append(lists, *l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating pick lists")
+	}
+	return lists, nil
+}
+
+// FindListById returns the pick list.
+// Returns a not found error if it does not exist.
+func (r *pickingRepository) FindListById(ctx context.Context, id int) (*models.PickList, error) {
+	return findList(r.mysql.QueryRowContext(ctx, queryListFindById, id), id)
+}
+
+// FindListForUpdate returns the pick list and locks its row.
+// Returns a not found error if it does not exist.
+func (r *pickingRepository) FindListForUpdate(ctx context.Context, exec Executor, id int) (*models.PickList, error) {
+	return findList(exec.QueryRowContext(ctx, queryListForUpdate, id), id)
+}
+
+func (r *pickingRepository) FindLines(ctx context.Context, listId int) ([]models.PickLine, error) {
+	return findLines(ctx, r.mysql, queryLines, listId)
+}
+
+func (r *pickingRepository) FindLinesForUpdate(ctx context.Context, exec Executor, listId int) ([]models.PickLine, error) {
+	return findLines(ctx, exec, queryLinesForUpdate, listId)
+}
+
+// RecordPicked stores the picked quantity of the line.
+func (r *pickingRepository) RecordPicked(ctx context.Context, exec Executor, lineId int, picked int) error {
+	if _, err := exec.ExecContext(ctx, queryLinePicked, picked, lineId); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating pick line")
+	}
+	return nil
+}
+
+// MarkAllocationPicked marks the allocation as picked.
+// Returns a conflict error if it is no longer reserved, e.g. because its purchase order was cancelled.
+func (r *pickingRepository) MarkAllocationPicked(ctx context.Context, exec Executor, allocationId int) error {
+	res, err := exec.ExecContext(ctx, queryAllocationPicked, allocationId)
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating stock allocation")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error getting rows affected")
+	}
+	if n == 0 {
+		return apperrors.NewAppError(apperrors.CodeConflict, "stock allocation is no longer reserved").
+			WithDetail("stock_allocation_id", allocationId)
+	}
+	return nil
+}
+
+// AddSectionCapacity adds delta to the section current_capacity.
+func (r *pickingRepository) AddSectionCapacity(ctx context.Context, exec Executor, sectionId int, delta int) error {
+	if _, err := exec.ExecContext(ctx, querySectionCapacityAdd, delta, sectionId); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating section capacity")
+	}
+	return nil
+}
+
+// ConfirmList stores the status, the picker and the confirmation time of the pick list.
+func (r *pickingRepository) ConfirmList(ctx context.Context, exec Executor, l models.PickList) error {
+	if _, err := exec.ExecContext(ctx, queryListConfirm, l.Status, l.EmployeeId, l.ConfirmedAt, l.Id); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error confirming pick list")
+	}
+	return nil
+}
+
+// CountOpenLists counts the open pick lists of the wave.
+func (r *pickingRepository) CountOpenLists(ctx context.Context, exec Executor, waveId int) (int, error) {
+	var count int
+	if err := exec.QueryRowContext(ctx, queryOpenLists, waveId).Scan(&count); err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error counting open pick lists")
+	}
+	return count, nil
+}
+
+// CompleteWave marks the wave as completed.
+func (r *pickingRepository) CompleteWave(ctx context.Context, exec Executor, waveId int, completedAt time.Time) error {
+	if _, err := exec.ExecContext(ctx, queryWaveComplete, completedAt, waveId); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error completing pick wave")
+	}
+	return nil
+}
+
+// FindEmployeeWarehouse returns the warehouse of the employee.
+// Returns a not found error if the employee does not exist.
+func (r *pickingRepository) FindEmployeeWarehouse(ctx context.Context, exec Executor, employeeId int) (int, error) {
+	var warehouseId int
+	if err := exec.QueryRowContext(ctx, queryPickEmployeeWarehouse, employeeId).Scan(&warehouseId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, apperrors.NewAppError(apperrors.CodeNotFound, "employee not found").WithDetail("employee_id", employeeId)
+		}
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error querying employee")
+	}
+	return warehouseId, nil
+}
+
+// FindShortages returns the short-picked lines, latest confirmation first.
+func (r *pickingRepository) FindShortages(ctx context.Context, warehouseId int) ([]models.PickShortage, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryShortages, warehouseId, warehouseId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying pick shortages")
+	}
+	defer rows.Close()
+
+	shortages := make([]models.PickShortage, 0)
+	for rows.Next() {
+		var s models.PickShortage
+		if err := rows.Scan(&s.PickListId, &s.WaveId, &s.WarehouseId, &s.PurchaseOrderId, &s.ProductId, &s.ProductBatchId,
+			&s.BatchNumber, &s.SectionNumber, &s.Quantity, &s.PickedQuantity, &s.ConfirmedAt); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning pick shortage")
+		}
+		s.ShortQuantity = s.Quantity - s.PickedQuantity
+		shortages = append(shortages, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating pick shortages")
+	}
+	return shortages, nil
+}
+
+func lastInsertId(res sql.Result) (int, error) {
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	return int(id), nil
+}
+
+func scanWave(row interface{ Scan(dest ...any) error }) (*models.Wave, error) {
+	var w models.Wave
+	var completedAt sql.NullTime
+	if err := row.Scan(&w.Id, &w.WarehouseId, &w.Status, &w.CreatedAt, &completedAt); err != nil {
+		return nil, err
+	}
+	if completedAt.Valid {
+		w.CompletedAt = &completedAt.Time
+	}
+	return &w, nil
+}
+
+// findList scans a single pick list row, mapping a missing row to a not found error.
+func findList(row *sql.Row, id int) (*models.PickList, error) {
+	l, err := scanList(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "pick list not found").WithDetail("pick_list_id", id)
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying pick list")
+	}
+	return l, nil
+}
+
+func scanList(row interface{ Scan(dest ...any) error }) (*models.PickList, error) {
+	var l models.PickList
+	var employeeId sql.NullInt64
+	var confirmedAt sql.NullTime
+	if err := row.Scan(&l.Id, &l.WaveId, &l.WarehouseId, &l.Status, &employeeId, &confirmedAt); err != nil {
+		return nil, err
+	}
+	if employeeId.Valid {
+		id := int(employeeId.Int64)
+		l.EmployeeId = &id
+	}
+	if confirmedAt.Valid {
+		l.ConfirmedAt = &confirmedAt.Time
+	}
+	return &l, nil
+}
+
+func findLines(ctx context.Context, exec Executor, query string, listId int) ([]models.PickLine, error) {
+	rows, err := exec.QueryContext(ctx, query, listId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying pick lines")
+	}
+	defer rows.Close()
+
+	lines := make([]models.PickLine, 0)
+	for rows.Next() {
+		var l models.PickLine
+		var picked sql.NullInt64
+		if err := rows.Scan(&l.Id, &l.Sequence, &l.StockAllocationId, &l.AllocationStatus, &l.PurchaseOrderId, &l.ProductId, &l.ProductBatchId,
+			&l.BatchNumber, &l.SectionId, &l.SectionNumber, &l.Quantity, &picked); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning pick line")
+		}
+		if picked.Valid {
+			p := int(picked.Int64)
+			l.PickedQuantity = &p
+		}
+		lines = append(lines, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating pick lines")
+	}
+	return lines, nil
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/database"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/picking"
+)
+
+// PickingRepository defines the data operations of pick waves, pick lists and their lines.
+type PickingRepository interface {
+	// FindWaveCandidatesForUpdate returns the reserved allocations of confirmed purchase orders stored
+	// at the warehouse that are not in a pick list yet, in walking order, with the status of their batch, and locks them.
+	FindWaveCandidatesForUpdate(ctx context.Context, exec Executor, warehouseId int) ([]models.PickLine, error)
+
+	// CreateWave inserts a wave and returns its generated id.
+	CreateWave(ctx context.Context, exec Executor, w models.Wave) (int, error)
+
+	// CreateList inserts an open pick list of the wave and returns its generated id.
+	CreateList(ctx context.Context, exec Executor, waveId int) (int, error)
+
+	// CreateLine inserts a line into a pick list.
+	CreateLine(ctx context.Context, exec Executor, listId int, l models.PickLine) error
+
+	// FindWaves returns the waves matching the filters; zero values are ignored.
+	FindWaves(ctx context.Context, warehouseId int, status string) ([]models.Wave, error)
+
+	// FindWaveById returns a wave.
+	FindWaveById(ctx context.Context, id int) (*models.Wave, error)
+
+	// FindListsByWave returns the pick lists of a wave, without their lines.
+	FindListsByWave(ctx context.Context, waveId int) ([]models.PickList, error)
+
+	// FindListById returns a pick list, without its lines.
+	FindListById(ctx context.Context, id int) (*models.PickList, error)
+
+	// FindListForUpdate returns a pick list, without its lines, and locks its row.
+	FindListForUpdate(ctx context.Context, exec Executor, id int) (*models.PickList, error)
+
+	// FindLines returns the lines of a pick list in walking order.
+	FindLines(ctx context.Context, listId int) ([]models.PickLine, error)
+
+	// FindLinesForUpdate returns the lines of a pick list in walking order and locks them.
+	FindLinesForUpdate(ctx context.Context, exec Executor, listId int) ([]models.PickLine, error)
+
+	// RecordPicked stores the quantity picked for a line.
+	RecordPicked(ctx context.Context, exec Executor, lineId int, picked int) error
+
+	// MarkAllocationPicked marks a reserved stock allocation as picked.
+	MarkAllocationPicked(ctx context.Context, exec Executor, allocationId int) error
+
+	// AddSectionCapacity adds delta (which may be negative) to a section current_capacity.
+	AddSectionCapacity(ctx context.Context, exec Executor, sectionId int, delta int) error
+
+	// ConfirmList stores the status, the picker and the confirmation time of a pick list.
+	ConfirmList(ctx context.Context, exec Executor, l models.PickList) error
+
+	// CountOpenLists counts the pick lists of the wave still open.
+	CountOpenLists(ctx context.Context, exec Executor, waveId int) (int, error)
+
+	// CompleteWave marks the wave as completed.
+	CompleteWave(ctx context.Context, exec Executor, waveId int, completedAt time.Time) error
+
+	// FindEmployeeWarehouse returns the warehouse an employee works at.
+	FindEmployeeWarehouse(ctx context.Context, exec Executor, employeeId int) (int, error)
+
+	// FindShortages returns the confirmed pick lines that were picked short; a zero warehouseId returns them all.
+	FindShortages(ctx context.Context, warehouseId int) ([]models.PickShortage, error)
+
+	database.Transactor
+}
+
+// Executor runs SQL statements either on the connection or inside a transaction.
+type Executor = database.Executor
+
+// pickingRepository implements PickingRepository using MySQL.
+type pickingRepository struct {
+	database.MySQLTransactor
+	mysql *sql.DB
+}
+
+// NewPickingRepository returns a new PickingRepository using the given MySQL connection.
+func NewPickingRepository(mysql *sql.DB) PickingRepository {
+	return &pickingRepository{
+		MySQLTransactor: database.NewMySQLTransactor(mysql),
+		mysql:           mysql,
+	}
+}
@@ -0,0 +1,204 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/picking"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	buyerModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/picking"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestPickingRepository_FindWaveCandidatesForUpdate(t *testing.T) {
+	query := regexp.QuoteMeta(`ORDER BY s.section_number, pb.id, a.id FOR UPDATE`)
+
+	mock, db := testhelpers.CreateMockDB()
+	defer db.Close()
+	mock.ExpectQuery(query).WithArgs(1, buyerModels.OrderStatusConfirmed).WillReturnRows(sqlmock.NewRows([]string{"id", "status",
+		"purchase_order_id", "product_id", "product_batch_id", "batch_number", "batch_status", "section_id", "section_number", "quantity"}).
+		AddRow(101, "reserved", 7, 3, 20, 2001, batchModels.BatchStatusOnHold, 4, 40, 5))
+	repo := repository.NewPickingRepository(db)
+
+	lines, err := repo.FindWaveCandidatesForUpdate(context.Background(), db, 1)
+
+	require.NoError(t, err)
+	want := testhelpers.DummyPickLine(0, 5)
+	want.Sequence = 0
+	want.StockAllocationId = 101
+	want.BatchStatus = batchModels.BatchStatusOnHold
+	require.Equal(t, []models.PickLine{want}, lines)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPickingRepository_CreateLine(t *testing.T) {
+	query := regexp.QuoteMeta(`INSERT INTO pick_list_lines (pick_list_id, stock_allocation_id, sequence, quantity) VALUES (?, ?, ?, ?)`)
+
+	testCases := []struct {
+		name    string
+		dbMock  func() (sqlmock.Sqlmock, *sql.DB)
+		errCode string
+	}{
+		{
+			name: "success",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WithArgs(9, 101, 1, 5).WillReturnResult(sqlmock.NewResult(1, 1))
+				return mock, db
+			},
+		},
+		{
+			name: "error - allocation already in a pick list",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WillReturnError(&mysql.MySQLError{Number: 1062})
+				return mock, db
+			},
+			errCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewPickingRepository(db)
+
+			err := repo.CreateLine(context.Background(), db, 9, testhelpers.DummyPickLine(1, 5))
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPickingRepository_MarkAllocationPicked(t *testing.T) {
+	query := regexp.QuoteMeta(`UPDATE stock_allocations SET status = 'picked' WHERE id = ? AND status = 'reserved'`)
+
+	testCases := []struct {
+		name     string
+		affected int64
+		errCode  string
+	}{
+		{name: "success", affected: 1},
+		{name: "error - allocation no longer reserved", affected: 0, errCode: apperrors.CodeConflict},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := testhelpers.CreateMockDB()
+			defer db.Close()
+			mock.ExpectExec(query).WithArgs(101).WillReturnResult(sqlmock.NewResult(0, tc.affected))
+			repo := repository.NewPickingRepository(db)
+
+			err := repo.MarkAllocationPicked(context.Background(), db, 101)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPickingRepository_FindListById(t *testing.T) {
+	query := regexp.QuoteMeta(`FROM pick_lists pl INNER JOIN pick_waves w ON w.id = pl.pick_wave_id WHERE pl.id = ?`)
+	columns := []string{"id", "pick_wave_id", "warehouse_id", "status", "employee_id", "confirmed_at"}
+	confirmedAt := time.Date(2025, 6, 2, 10, 0, 0, 0, time.Local)
+
+	testCases := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		want    *models.PickList
+		errCode string
+	}{
+		{
+			name: "success - open list",
+			rows: sqlmock.NewRows(columns).AddRow(9, 2, 1, "open", nil, nil),
+			want: func() *models.PickList {
+				l := testhelpers.DummyPickList(models.ListStatusOpen)
+				return &l
+			}(),
+		},
+		{
+			name: "success - confirmed list",
+			rows: sqlmock.NewRows(columns).AddRow(9, 2, 1, "confirmed", 6, confirmedAt),
+			want: func() *models.PickList {
+				l := testhelpers.DummyPickList(models.ListStatusConfirmed)
+				l.EmployeeId = testhelpers.IntPtr(6)
+				l.ConfirmedAt = &confirmedAt
+				return &l
+			}(),
+		},
+		{
+			name:    "error - not found",
+			rows:    sqlmock.NewRows(columns),
+			errCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := testhelpers.CreateMockDB()
+			defer db.Close()
+			mock.ExpectQuery(query).WithArgs(9).WillReturnRows(tc.rows)
+			repo := repository.NewPickingRepository(db)
+
+			list, err := repo.FindListById(context.Background(), 9)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, list)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.want, list)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPickingRepository_FindShortages(t *testing.T) {
+	query := regexp.QuoteMeta(`WHERE pl.status = 'confirmed' AND a.status = 'picked' AND l.picked_quantity < l.quantity AND (? = 0 OR w.warehouse_id = ?)`)
+	confirmedAt := time.Date(2025, 6, 2, 10, 0, 0, 0, time.Local)
+
+	mock, db := testhelpers.CreateMockDB()
+	defer db.Close()
+	mock.ExpectQuery(query).WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{"pick_list_id", "wave_id", "warehouse_id",
+		"purchase_order_id", "product_id", "product_batch_id", "batch_number", "section_number", "quantity", "picked_quantity", "confirmed_at"}).
+		AddRow(9, 2, 1, 7, 3, 20, 2001, 40, 5, 3, confirmedAt))
+	repo := repository.NewPickingRepository(db)
+
+	shortages, err := repo.FindShortages(context.Background(), 1)
+
+	require.NoError(t, err)
+	require.Equal(t, []models.PickShortage{{
+		PickListId:      9,
+		WaveId:          2,
+		WarehouseId:     1,
+		PurchaseOrderId: 7,
+		ProductId:       3,
+		ProductBatchId:  20,
+		BatchNumber:     2001,
+		SectionNumber:   40,
+		Quantity:        5,
+		PickedQuantity:  3,
+		ShortQuantity:   2,
+		ConfirmedAt:     confirmedAt,
+	}}, shortages)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -40,12 +40,15 @@ func TestProductBatchesRepository_CreateProductBatches(t *testing.T) {
 	expBatch.Id = 1 // lastInsertId simulado
 
 	const insertRegex = `^INSERT INTO product_batches .*`
+	const receiptRegex = `^INSERT INTO stock_movements .*`
+	const capacityRegex = `^UPDATE sections SET current_capacity = current_capacity \+ \? WHERE id = \?`
 
 	testCases := []testCase{
 		{
 			name: "create a new product batch",
 			arrange: arrange{
 				dbMock: func(m sqlmock.Sqlmock) {
+					m.ExpectBegin()
 					m.ExpectExec(insertRegex).
 						WithArgs(
 							inputBatch.BatchNumber,
@@ -59,16 +62,67 @@ func TestProductBatchesRepository_CreateProductBatches(t *testing.T) {
 							inputBatch.ProductId,
 							inputBatch.SectionId,
 						).WillReturnResult(sqlmock.NewResult(1, 1))
+					m.ExpectExec(receiptRegex).
+						WithArgs(1, inputBatch.CurrentQuantity).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+					m.ExpectExec(capacityRegex).
+						WithArgs(inputBatch.CurrentQuantity, inputBatch.SectionId).
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					m.ExpectCommit()
 				},
 			},
 			input:  input{batch: &inputBatch},
 			output: output{expectedError: false, expected: &expBatch, err: nil},
 		},
+		{
+			name: "error recording the receipt movement",
+			arrange: arrange{
+				dbMock: func(m sqlmock.Sqlmock) {
+					m.ExpectBegin()
+					m.ExpectExec(insertRegex).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+					m.ExpectExec(receiptRegex).
+						WithArgs(1, inputBatch.CurrentQuantity).
+						WillReturnError(errors.New("ledger error"))
+					m.ExpectRollback()
+				},
+			},
+			input: input{batch: &inputBatch},
+			output: output{
+				expected:      nil,
+				expectedError: true,
+				err:           apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while creating the Product Batch."),
+			},
+		},
+		{
+			name: "error updating the section capacity",
+			arrange: arrange{
+				dbMock: func(m sqlmock.Sqlmock) {
+					m.ExpectBegin()
+					m.ExpectExec(insertRegex).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+					m.ExpectExec(receiptRegex).
+						WithArgs(1, inputBatch.CurrentQuantity).
+						WillReturnResult(sqlmock.NewResult(1, 1))
+					m.ExpectExec(capacityRegex).
+						WithArgs(inputBatch.CurrentQuantity, inputBatch.SectionId).
+						WillReturnError(errors.New("capacity error"))
+					m.ExpectRollback()
+				},
+			},
+			input: input{batch: &inputBatch},
+			output: output{
+				expected:      nil,
+				expectedError: true,
+				err:           apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while creating the Product Batch."),
+			},
+		},
 		{
 			name: "foreign key constraint error",
 			arrange: arrange{
 				dbMock: func(m sqlmock.Sqlmock) {
 					myErr := &mysql.MySQLError{Number: 1452, Message: "Cannot add or update a child row"}
+					m.ExpectBegin()
 					m.ExpectExec(insertRegex).
 						WithArgs(
 							inputBatch.BatchNumber,
@@ -82,6 +136,7 @@ func TestProductBatchesRepository_CreateProductBatches(t *testing.T) {
 							inputBatch.ProductId,
 							inputBatch.SectionId,
 						).WillReturnError(myErr)
+					m.ExpectRollback()
 				},
 			},
 			input: input{batch: &inputBatch},
@@ -96,6 +151,7 @@ func TestProductBatchesRepository_CreateProductBatches(t *testing.T) {
 			arrange: arrange{
 				dbMock: func(m sqlmock.Sqlmock) {
 					myErr := &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}
+					m.ExpectBegin()
 					m.ExpectExec(insertRegex).
 						WithArgs(
 							inputBatch.BatchNumber,
@@ -109,6 +165,7 @@ func TestProductBatchesRepository_CreateProductBatches(t *testing.T) {
 							inputBatch.ProductId,
 							inputBatch.SectionId,
 						).WillReturnError(myErr)
+					m.ExpectRollback()
 				},
 			},
 			input: input{batch: &inputBatch},
@@ -122,6 +179,7 @@ func TestProductBatchesRepository_CreateProductBatches(t *testing.T) {
 			name: "other db error",
 			arrange: arrange{
 				dbMock: func(m sqlmock.Sqlmock) {
+					m.ExpectBegin()
 					m.ExpectExec(insertRegex).
 						WithArgs(
 							inputBatch.BatchNumber,
@@ -135,6 +193,7 @@ func TestProductBatchesRepository_CreateProductBatches(t *testing.T) {
 							inputBatch.ProductId,
 							inputBatch.SectionId,
 						).WillReturnError(errors.New("unknown db error"))
+					m.ExpectRollback()
 				},
 			},
 			input: input{batch: &inputBatch},
@@ -147,6 +206,7 @@ func TestProductBatchesRepository_CreateProductBatches(t *testing.T) {
 			name: "error on LastInsertId",
 			arrange: arrange{
 				dbMock: func(m sqlmock.Sqlmock) {
+					m.ExpectBegin()
 					m.ExpectExec(insertRegex).
 						WithArgs(
 							inputBatch.BatchNumber,
@@ -160,6 +220,7 @@ func TestProductBatchesRepository_CreateProductBatches(t *testing.T) {
 							inputBatch.ProductId,
 							inputBatch.SectionId,
 						).WillReturnResult(sqlmock.NewErrorResult(errors.New("lastInsertId error")))
+					m.ExpectRollback()
 				},
 			},
 			input: input{batch: &inputBatch},
@@ -0,0 +1,159 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	repo "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/product_batch"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestProductBatchesRepository_GetExpiring(t *testing.T) {
+	until := time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)
+	expected := []models.ExpiringBatch{testhelpers.DummyExpiringBatch(1, until.AddDate(0, 0, -2))}
+	columns := []string{"id", "batch_number", "product_id", "product_code", "description", "section_id", "section_number", "warehouse_id", "current_quantity", "due_date", "status"}
+
+	const query = `SELECT (.+) FROM product_batches pb INNER JOIN products p ON p.id = pb.product_id INNER JOIN sections s ON s.id = pb.section_id\s+WHERE pb.due_date <= \? AND pb.current_quantity > 0 AND \(\? = 0 OR s.warehouse_id = \?\)`
+
+	testCases := []struct {
+		name        string
+		warehouseId int
+		dbMock      func(m sqlmock.Sqlmock, warehouseId int)
+		expected    []models.ExpiringBatch
+		err         error
+	}{
+		{
+			name:        "success - batches in one warehouse",
+			warehouseId: 1,
+			dbMock: func(m sqlmock.Sqlmock, warehouseId int) {
+				b := expected[0]
+				rows := sqlmock.NewRows(columns).AddRow(b.ProductBatchId, b.BatchNumber, b.ProductId, b.ProductCode, b.ProductDescription,
+					b.SectionId, b.SectionNumber, b.WarehouseId, b.CurrentQuantity, b.DueDate, b.Status)
+				m.ExpectQuery(query).WithArgs(until, warehouseId, warehouseId).WillReturnRows(rows)
+			},
+			expected: expected,
+		},
+		{
+			name:        "success - nothing expiring",
+			warehouseId: 0,
+			dbMock: func(m sqlmock.Sqlmock, warehouseId int) {
+				m.ExpectQuery(query).WithArgs(until, warehouseId, warehouseId).WillReturnRows(sqlmock.NewRows(columns))
+			},
+			expected: []models.ExpiringBatch{},
+		},
+		{
+			name:        "error - db error",
+			warehouseId: 0,
+			dbMock: func(m sqlmock.Sqlmock, warehouseId int) {
+				m.ExpectQuery(query).WithArgs(until, warehouseId, warehouseId).WillReturnError(errors.New("db error"))
+			},
+			err: apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while retrieving the expiring product batches."),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+			repository := repo.NewProductBatchesRepository(db)
+
+			tc.dbMock(mock, tc.warehouseId)
+
+			result, err := repository.GetExpiring(context.Background(), until, tc.warehouseId)
+			if tc.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tc.err.Error(), err.Error())
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestProductBatchesRepository_QuarantineExpired(t *testing.T) {
+	now := time.Date(2025, 1, 8, 0, 0, 0, 0, time.UTC)
+	const selectQuery = `SELECT id FROM product_batches WHERE due_date <= \? AND status = 'available' ORDER BY id FOR UPDATE`
+	const historyQuery = `INSERT INTO batch_status_history \(product_batch_id, from_status, to_status, reason_code, actor, created_at\)\s+SELECT id, status, 'quarantined', 'expired', 'system', \? FROM product_batches WHERE due_date <= \? AND status = 'available'`
+	const updateQuery = `UPDATE product_batches SET status = 'quarantined', status_reason = 'expired', status_set_by = 'system', status_updated_at = \?\s+WHERE due_date <= \? AND status = 'available'`
+
+	testCases := []struct {
+		name     string
+		dbMock   func(m sqlmock.Sqlmock)
+		expected []int
+		errCode  string
+	}{
+		{
+			name: "success - expired batches quarantined",
+			dbMock: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectQuery(selectQuery).WithArgs(now).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(9))
+				m.ExpectExec(historyQuery).WithArgs(now, now).WillReturnResult(sqlmock.NewResult(0, 2))
+				m.ExpectExec(updateQuery).WithArgs(now, now).WillReturnResult(sqlmock.NewResult(0, 2))
+				m.ExpectCommit()
+			},
+			expected: []int{1, 9},
+		},
+		{
+			name: "success - nothing to do on a second run",
+			dbMock: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectQuery(selectQuery).WithArgs(now).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				m.ExpectRollback()
+			},
+			expected: []int{},
+		},
+		{
+			name: "error - update fails",
+			dbMock: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectQuery(selectQuery).WithArgs(now).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				m.ExpectExec(historyQuery).WithArgs(now, now).WillReturnResult(sqlmock.NewResult(0, 1))
+				m.ExpectExec(updateQuery).WithArgs(now, now).WillReturnError(errors.New("db error"))
+				m.ExpectRollback()
+			},
+			errCode: apperrors.CodeInternal,
+		},
+		{
+			name: "error - history insert fails",
+			dbMock: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectQuery(selectQuery).WithArgs(now).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				m.ExpectExec(historyQuery).WithArgs(now, now).WillReturnError(errors.New("db error"))
+				m.ExpectRollback()
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+			repository := repo.NewProductBatchesRepository(db)
+
+			tc.dbMock(mock)
+
+			result, err := repository.QuarantineExpired(context.Background(), now)
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
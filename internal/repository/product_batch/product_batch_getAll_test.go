@@ -15,6 +15,30 @@ import (
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
 )
 
+var (
+	reportSectionColumns = []string{"id", "section_number", "warehouse_id", "maximum_capacity", "total_quantity",
+		"held_quantity", "distinct_products", "batch_count", "earliest_due_date"}
+	reportLineColumns = []string{"section_id", "product_id", "product_code", "quantity", "batch_count"}
+)
+
+const reportLinesQuery = `SELECT p.section_id, p.product_id, pr.product_code, SUM\(p.current_quantity\), COUNT\(\*\)\s+FROM product_batches p (.+) WHERE p.status = 'available' AND p.current_quantity > 0 AND \(\? = 0 OR s.warehouse_id = \?\) AND \(\? = 0 OR s.id = \?\)`
+
+func addReportSectionRow(rows *sqlmock.Rows, rp models.ReportProduct) *sqlmock.Rows {
+	var due any
+	if rp.EarliestDueDate != nil {
+		due = *rp.EarliestDueDate
+	}
+	return rows.AddRow(rp.SectionId, rp.SectionNumber, rp.WarehouseId, rp.MaximumCapacity, rp.TotalQuantity,
+		rp.HeldQuantity, rp.DistinctProducts, rp.BatchCount, due)
+}
+
+func addReportLineRows(rows *sqlmock.Rows, rp models.ReportProduct) *sqlmock.Rows {
+	for _, l := range rp.Products {
+		rows.AddRow(rp.SectionId, l.ProductId, l.ProductCode, l.Quantity, l.BatchCount)
+	}
+	return rows
+}
+
 func TestProductBatchesRepository_GetReportProduct(t *testing.T) {
 	type arrange struct {
 		dbMock func(sqlmock.Sqlmock)
@@ -30,21 +54,23 @@ func TestProductBatchesRepository_GetReportProduct(t *testing.T) {
 		output  output
 	}
 
+	// the repository does not compute the fill percentage, the service does
 	expected := testhelpers.DummyReportProductsList()
+	expected[0].FillPercentage = 0
 
-	const query = `SELECT s.id, s.section_number, SUM\(p.current_quantity\) FROM product_batches p INNER JOIN sections s on p.section_id = s.id\s+GROUP BY p.section_id`
+	const query = `SELECT s.id, s.section_number, s.warehouse_id, s.maximum_capacity, (.+) FROM sections s LEFT JOIN product_batches p ON p.section_id = s.id\s+WHERE \(\? = 0 OR s.warehouse_id = \?\)\s+GROUP BY s.id`
 
 	testCases := []testCase{
 		{
-			name: "success - returns products report",
+			name: "success - returns products report, empty sections included",
 			arrange: arrange{
 				dbMock: func(m sqlmock.Sqlmock) {
-					rows := sqlmock.NewRows([]string{
-						"id", "section_number", "sum",
-					}).
-						AddRow(expected[0].SectionId, expected[0].SectionNumber, expected[0].ProductsCount).
-						AddRow(expected[1].SectionId, expected[1].SectionNumber, expected[1].ProductsCount)
-					m.ExpectQuery(query).WillReturnRows(rows)
+					rows := sqlmock.NewRows(reportSectionColumns)
+					addReportSectionRow(rows, expected[0])
+					addReportSectionRow(rows, expected[1])
+					m.ExpectQuery(query).WithArgs(0, 0).WillReturnRows(rows)
+					m.ExpectQuery(reportLinesQuery).WithArgs(0, 0, 0, 0).
+						WillReturnRows(addReportLineRows(sqlmock.NewRows(reportLineColumns), expected[0]))
 				},
 			},
 			output: output{
@@ -57,8 +83,8 @@ func TestProductBatchesRepository_GetReportProduct(t *testing.T) {
 			name: "success - empty report",
 			arrange: arrange{
 				dbMock: func(m sqlmock.Sqlmock) {
-					rows := sqlmock.NewRows([]string{"id", "section_number", "sum"})
-					m.ExpectQuery(query).WillReturnRows(rows)
+					rows := sqlmock.NewRows(reportSectionColumns)
+					m.ExpectQuery(query).WithArgs(0, 0).WillReturnRows(rows)
 				},
 			},
 			output: output{
@@ -71,7 +97,7 @@ func TestProductBatchesRepository_GetReportProduct(t *testing.T) {
 			name: "error in query (db error)",
 			arrange: arrange{
 				dbMock: func(m sqlmock.Sqlmock) {
-					m.ExpectQuery(query).WillReturnError(errors.New("internal error"))
+					m.ExpectQuery(query).WithArgs(0, 0).WillReturnError(errors.New("internal error"))
 				},
 			},
 			output: output{
@@ -85,9 +111,9 @@ func TestProductBatchesRepository_GetReportProduct(t *testing.T) {
 			arrange: arrange{
 				dbMock: func(m sqlmock.Sqlmock) {
 					// Provoke scan error: put wrong type in one column
-					rows := sqlmock.NewRows([]string{"id", "section_number", "sum"}).
-						AddRow("bad", 7, 42)
-					m.ExpectQuery(query).WillReturnRows(rows)
+					rows := sqlmock.NewRows(reportSectionColumns).
+						AddRow("bad", 7, 1, 100, 42, 0, 1, 1, nil)
+					m.ExpectQuery(query).WithArgs(0, 0).WillReturnRows(rows)
 				},
 			},
 			output: output{
@@ -100,10 +126,23 @@ func TestProductBatchesRepository_GetReportProduct(t *testing.T) {
 			name: "error in rows.Err() after loop",
 			arrange: arrange{
 				dbMock: func(m sqlmock.Sqlmock) {
-					rows := sqlmock.NewRows([]string{"id", "section_number", "sum"}).
-						AddRow(expected[0].SectionId, expected[0].SectionNumber, expected[0].ProductsCount)
+					rows := addReportSectionRow(sqlmock.NewRows(reportSectionColumns), expected[0])
 					rows.RowError(0, sql.ErrConnDone)
-					m.ExpectQuery(query).WillReturnRows(rows)
+					m.ExpectQuery(query).WithArgs(0, 0).WillReturnRows(rows)
+				},
+			},
+			output: output{
+				expected:      nil,
+				expectedError: true,
+				err:           apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while retrieving the product report."),
+			},
+		},
+		{
+			name: "error in product lines query",
+			arrange: arrange{
+				dbMock: func(m sqlmock.Sqlmock) {
+					m.ExpectQuery(query).WithArgs(0, 0).WillReturnRows(addReportSectionRow(sqlmock.NewRows(reportSectionColumns), expected[0]))
+					m.ExpectQuery(reportLinesQuery).WithArgs(0, 0, 0, 0).WillReturnError(errors.New("internal error"))
 				},
 			},
 			output: output{
@@ -123,7 +162,7 @@ func TestProductBatchesRepository_GetReportProduct(t *testing.T) {
 
 			tc.arrange.dbMock(mock)
 
-			result, err := repository.GetReportProduct(context.Background())
+			result, err := repository.GetReportProduct(context.Background(), 0)
 			if tc.output.expectedError {
 				require.Error(t, err)
 				require.Equal(t, tc.output.err.Error(), err.Error())
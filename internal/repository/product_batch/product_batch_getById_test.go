@@ -33,18 +33,19 @@ func TestProductBatchesRepository_GetReportProductById(t *testing.T) {
 	}
 
 	dummy := testhelpers.DummyReportProduct()
+	dummy.FillPercentage = 0
+	empty := models.ReportProduct{SectionId: 30, SectionNumber: 9, WarehouseId: 2, MaximumCapacity: 50, Products: []models.ReportProductLine{}}
 
-	const query = `SELECT s.id, s.section_number, SUM\(p.current_quantity\) FROM product_batches p INNER JOIN sections s on p.section_id = s.id\s+WHERE p.section_id = \?\s+GROUP BY p.section_id`
+	const query = `SELECT s.id, s.section_number, s.warehouse_id, s.maximum_capacity, (.+) FROM sections s LEFT JOIN product_batches p ON p.section_id = s.id\s+WHERE s.id = \?\s+GROUP BY s.id`
 
 	testCases := []testCase{
 		{
 			name: "success - returns product report",
 			arrange: arrange{
 				dbMock: func(m sqlmock.Sqlmock) {
-					rows := sqlmock.NewRows([]string{
-						"id", "section_number", "sum",
-					}).AddRow(dummy.SectionId, dummy.SectionNumber, dummy.ProductsCount)
-					m.ExpectQuery(query).WithArgs(10).WillReturnRows(rows)
+					m.ExpectQuery(query).WithArgs(10).WillReturnRows(addReportSectionRow(sqlmock.NewRows(reportSectionColumns), dummy))
+					m.ExpectQuery(reportLinesQuery).WithArgs(0, 0, 10, 10).
+						WillReturnRows(addReportLineRows(sqlmock.NewRows(reportLineColumns), dummy))
 				},
 			},
 			input: input{id: 10},
@@ -54,6 +55,21 @@ func TestProductBatchesRepository_GetReportProductById(t *testing.T) {
 				err:           nil,
 			},
 		},
+		{
+			name: "success - section without batches reports zeros",
+			arrange: arrange{
+				dbMock: func(m sqlmock.Sqlmock) {
+					m.ExpectQuery(query).WithArgs(30).WillReturnRows(addReportSectionRow(sqlmock.NewRows(reportSectionColumns), empty))
+					m.ExpectQuery(reportLinesQuery).WithArgs(0, 0, 30, 30).WillReturnRows(sqlmock.NewRows(reportLineColumns))
+				},
+			},
+			input: input{id: 30},
+			output: output{
+				expected:      &empty,
+				expectedError: false,
+				err:           nil,
+			},
+		},
 		{
 			name: "not found - returns custom not found error",
 			arrange: arrange{
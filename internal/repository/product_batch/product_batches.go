@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
+
 	"github.com/go-sql-driver/mysql"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
 
@@ -12,15 +14,51 @@ import (
 )
 
 const (
-	queryCreateProductBatch    = `INSERT INTO product_batches (batch_number,current_quantity,current_temperature,due_date,initial_quantity,manufacturing_date,manufacturing_hour,minimum_temperature,product_id,section_id) VALUES (?,?,?,?,?,?,?,?,?,?)`
-	queryGetReportProductsById = `SELECT s.id, s.section_number, SUM(p.current_quantity) FROM product_batches p INNER JOIN sections s on p.section_id = s.id  WHERE p.section_id = ? GROUP BY p.section_id`
-	queryGetProductsReport     = `SELECT s.id, s.section_number, SUM(p.current_quantity) FROM product_batches p INNER JOIN sections s on p.section_id = s.id  GROUP BY p.section_id`
+	queryCreateProductBatch   = `INSERT INTO product_batches (batch_number,current_quantity,current_temperature,due_date,initial_quantity,manufacturing_date,manufacturing_hour,minimum_temperature,product_id,section_id) VALUES (?,?,?,?,?,?,?,?,?,?)`
+	queryReportSectionColumns = `SELECT s.id, s.section_number, s.warehouse_id, s.maximum_capacity,
+		COALESCE(SUM(CASE WHEN p.status = 'available' THEN p.current_quantity ELSE 0 END), 0),
+		COALESCE(SUM(CASE WHEN p.status IN ('on_hold', 'quarantined') THEN p.current_quantity ELSE 0 END), 0),
+		COUNT(DISTINCT CASE WHEN p.status = 'available' AND p.current_quantity > 0 THEN p.product_id END),
+		COUNT(CASE WHEN p.status = 'available' AND p.current_quantity > 0 THEN p.id END),
+		MIN(CASE WHEN p.status = 'available' AND p.current_quantity > 0 THEN p.due_date END)
+		FROM sections s LEFT JOIN product_batches p ON p.section_id = s.id`
+	queryGetReportProductsById = queryReportSectionColumns + `
+		WHERE s.id = ?
+		GROUP BY s.id, s.section_number, s.warehouse_id, s.maximum_capacity`
+	queryGetProductsReport = queryReportSectionColumns + `
+		WHERE (? = 0 OR s.warehouse_id = ?)
+		GROUP BY s.id, s.section_number, s.warehouse_id, s.maximum_capacity
+		ORDER BY s.id`
+	queryGetReportProductLines = `SELECT p.section_id, p.product_id, pr.product_code, SUM(p.current_quantity), COUNT(*)
+		FROM product_batches p INNER JOIN products pr ON pr.id = p.product_id INNER JOIN sections s ON s.id = p.section_id
+		WHERE p.status = 'available' AND p.current_quantity > 0 AND (? = 0 OR s.warehouse_id = ?) AND (? = 0 OR s.id = ?)
+		GROUP BY p.section_id, p.product_id, pr.product_code
+		ORDER BY p.section_id, p.product_id`
+	queryGetExpiring = `SELECT pb.id, pb.batch_number, pb.product_id, p.product_code, p.description, pb.section_id, s.section_number, s.warehouse_id, pb.current_quantity, pb.due_date, pb.status
+		FROM product_batches pb INNER JOIN products p ON p.id = pb.product_id INNER JOIN sections s ON s.id = pb.section_id
+		WHERE pb.due_date <= ? AND pb.current_quantity > 0 AND (? = 0 OR s.warehouse_id = ?)
+		ORDER BY pb.due_date ASC, pb.id ASC`
+	queryExpiredAvailableForUpdate = `SELECT id FROM product_batches WHERE due_date <= ? AND status = 'available' ORDER BY id FOR UPDATE`
+	queryQuarantineHistory         = `INSERT INTO batch_status_history (product_batch_id, from_status, to_status, reason_code, actor, created_at)
+		SELECT id, status, 'quarantined', 'expired', 'system', ? FROM product_batches WHERE due_date <= ? AND status = 'available'`
+	queryQuarantineExpired = `UPDATE product_batches SET status = 'quarantined', status_reason = 'expired', status_set_by = 'system', status_updated_at = ?
+		WHERE due_date <= ? AND status = 'available'`
+	queryCreateReceiptMovement = `INSERT INTO stock_movements (product_batch_id, movement_type, quantity, reason, actor) VALUES (?, 'receipt', ?, 'batch created', 'system')`
+	querySectionCapacityAdd    = `UPDATE sections SET current_capacity = current_capacity + ? WHERE id = ?`
 )
 
 // CreateProductBatches inserts a new product batch into the database and returns the created batch.
+// The batch, the receipt movement that opens its stock ledger and the growth of the section current_capacity
+// are written in the same transaction, as receiving does.
 // Returns error if a duplicate batch number or invalid foreign keys are provided.
 func (r *productBatchesRepository) CreateProductBatches(ctx context.Context, proBa models.ProductBatches) (*models.ProductBatches, error) {
-	result, err := r.mysql.ExecContext(ctx, queryCreateProductBatch, proBa.BatchNumber, proBa.CurrentQuantity, proBa.CurrentTemperature, proBa.DueDate, proBa.InitialQuantity, proBa.ManufacturingDate, proBa.ManufacturingHour, proBa.MinimumTemperature, proBa.ProductId, proBa.SectionId)
+	tx, err := r.mysql.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while creating the Product Batch.")
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, queryCreateProductBatch, proBa.BatchNumber, proBa.CurrentQuantity, proBa.CurrentTemperature, proBa.DueDate, proBa.InitialQuantity, proBa.ManufacturingDate, proBa.ManufacturingHour, proBa.MinimumTemperature, proBa.ProductId, proBa.SectionId)
 
 	if err != nil {
 		var mysqlErr *mysql.MySQLError
@@ -41,27 +79,48 @@ func (r *productBatchesRepository) CreateProductBatches(ctx context.Context, pro
 
 	proBa.Id = int(id)
 
+	if _, err := tx.ExecContext(ctx, queryCreateReceiptMovement, proBa.Id, proBa.CurrentQuantity); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while creating the Product Batch.")
+	}
+
+	if _, err := tx.ExecContext(ctx, querySectionCapacityAdd, proBa.CurrentQuantity, proBa.SectionId); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while creating the Product Batch.")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while creating the Product Batch.")
+	}
+
 	return &proBa, nil
 }
 
-// GetReportProductById returns product report data by section id, including section info and sum of current quantities.
+// GetReportProductById returns the stock report of one section, with zero values when it holds no batches.
 // Returns error if the section is not found.
 func (r *productBatchesRepository) GetReportProductById(ctx context.Context, id int) (*models.ReportProduct, error) {
-	var pr models.ReportProduct
-	err := r.mysql.QueryRowContext(ctx, queryGetReportProductsById, id).Scan(&pr.SectionId, &pr.SectionNumber, &pr.ProductsCount)
+	pr, err := scanReportSection(r.mysql.QueryRowContext(ctx, queryGetReportProductsById, id))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "The section you are looking for does not exist.")
 		}
 		return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while retrieving the product report.")
 	}
-	return &pr, nil
+
+	lines, err := r.getReportProductLines(ctx, 0, id)
+	if err != nil {
+		return nil, err
+	}
+	pr.Products = lines[pr.SectionId]
+	if pr.Products == nil {
+		pr.Products = []models.ReportProductLine{}
+	}
+	return pr, nil
 }
 
-// GetReportProduct returns a report for all sections, each with section info and sum of current quantities.
+// GetReportProduct returns the stock report of every section, optionally restricted to one warehouse
+// (warehouseId 0 means every warehouse). Sections without batches are included with zero values.
 // Returns error if there was a problem during the query.
-func (r *productBatchesRepository) GetReportProduct(ctx context.Context) ([]models.ReportProduct, error) {
-	rows, err := r.mysql.QueryContext(ctx, queryGetProductsReport)
+func (r *productBatchesRepository) GetReportProduct(ctx context.Context, warehouseId int) ([]models.ReportProduct, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryGetProductsReport, warehouseId, warehouseId)
 	if err != nil {
 		return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while retrieving the products report.")
 	}
@@ -70,16 +129,137 @@ func (r *productBatchesRepository) GetReportProduct(ctx context.Context) ([]mode
 	productReport := make([]models.ReportProduct, 0)
 
 	for rows.Next() {
-		var rp models.ReportProduct
-		if err := rows.Scan(&rp.SectionId, &rp.SectionNumber, &rp.ProductsCount); err != nil {
+		rp, err := scanReportSection(rows)
+		if err != nil {
 			return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while retrieving the product report.")
 		}
-		productReport = append(productReport, rp)
+		productReport = append(productReport, *rp)
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while retrieving the product report.")
 	}
+	if len(productReport) == 0 {
+		return productReport, nil
+	}
+
+	lines, err := r.getReportProductLines(ctx, warehouseId, 0)
+	if err != nil {
+		return nil, err
+	}
+	for i := range productReport {
+		productReport[i].Products = lines[productReport[i].SectionId]
+		if productReport[i].Products == nil {
+			productReport[i].Products = []models.ReportProductLine{}
+		}
+	}
 	return productReport, nil
+}
+
+// getReportProductLines returns the available stock per product, keyed by section id.
+// A zero warehouseId or sectionId disables that filter.
+func (r *productBatchesRepository) getReportProductLines(ctx context.Context, warehouseId, sectionId int) (map[int][]models.ReportProductLine, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryGetReportProductLines, warehouseId, warehouseId, sectionId, sectionId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while retrieving the product report.")
+	}
+	defer rows.Close()
+
+	lines := make(map[int][]models.ReportProductLine)
+	for rows.Next() {
+		var sectionId int
+		var l models.ReportProductLine
+		if err := rows.Scan(&sectionId, &l.ProductId, &l.ProductCode, &l.Quantity, &l.BatchCount); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while retrieving the product report.")
+		}
+		lines[sectionId] = append(lines[sectionId], l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while retrieving the product report.")
+	}
+	return lines, nil
+}
+
+// scanReportSection reads one row of the section report queries.
+func scanReportSection(row interface{ Scan(dest ...any) error }) (*models.ReportProduct, error) {
+	var rp models.ReportProduct
+	var maximumCapacity sql.NullInt64
+	var earliestDueDate sql.NullTime
+	if err := row.Scan(&rp.SectionId, &rp.SectionNumber, &rp.WarehouseId, &maximumCapacity, &rp.TotalQuantity,
+		&rp.HeldQuantity, &rp.DistinctProducts, &rp.BatchCount, &earliestDueDate); err != nil {
+		return nil, err
+	}
+	rp.ProductsCount = rp.TotalQuantity
+	rp.MaximumCapacity = int(maximumCapacity.Int64)
+	if earliestDueDate.Valid {
+		rp.EarliestDueDate = &earliestDueDate.Time
+	}
+	return &rp, nil
+}
+
+// GetExpiring returns the batches with stock left whose due date is not after until,
+// optionally restricted to the sections of one warehouse (warehouseId 0 means every warehouse).
+func (r *productBatchesRepository) GetExpiring(ctx context.Context, until time.Time, warehouseId int) ([]models.ExpiringBatch, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryGetExpiring, until, warehouseId, warehouseId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while retrieving the expiring product batches.")
+	}
+	defer rows.Close()
+
+	batches := make([]models.ExpiringBatch, 0)
+	for rows.Next() {
+		var b models.ExpiringBatch
+		if err := rows.Scan(&b.ProductBatchId, &b.BatchNumber, &b.ProductId, &b.ProductCode, &b.ProductDescription, &b.SectionId,
+			&b.SectionNumber, &b.WarehouseId, &b.CurrentQuantity, &b.DueDate, &b.Status); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while retrieving the expiring product batches.")
+		}
+		batches = append(batches, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while retrieving the expiring product batches.")
+	}
+	return batches, nil
+}
+
+// QuarantineExpired moves every available batch due at or before now into quarantine and returns their ids.
+// Each change is recorded in the status history with the expired reason code and the system actor.
+// Batches already quarantined are left alone, so running it twice has no further effect.
+func (r *productBatchesRepository) QuarantineExpired(ctx context.Context, now time.Time) ([]int, error) {
+	tx, err := r.mysql.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while quarantining expired product batches.")
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, queryExpiredAvailableForUpdate, now)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while quarantining expired product batches.")
+	}
+	ids := make([]int, 0)
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while quarantining expired product batches.")
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while quarantining expired product batches.")
+	}
+	if len(ids) == 0 {
+		return ids, nil
+	}
 
+	if _, err := tx.ExecContext(ctx, queryQuarantineHistory, now, now); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while quarantining expired product batches.")
+	}
+	if _, err := tx.ExecContext(ctx, queryQuarantineExpired, now, now); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while quarantining expired product batches.")
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while quarantining expired product batches.")
+	}
+	return ids, nil
 }
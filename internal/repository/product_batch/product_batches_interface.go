@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"time"
+
 	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
 )
 
@@ -10,7 +12,9 @@ import (
 type ProductBatchesRepository interface {
 	CreateProductBatches(ctx context.Context, proBa models.ProductBatches) (*models.ProductBatches, error)
 	GetReportProductById(ctx context.Context, id int) (*models.ReportProduct, error)
-	GetReportProduct(ctx context.Context) ([]models.ReportProduct, error)
+	GetReportProduct(ctx context.Context, warehouseId int) ([]models.ReportProduct, error)
+	GetExpiring(ctx context.Context, until time.Time, warehouseId int) ([]models.ExpiringBatch, error)
+	QuarantineExpired(ctx context.Context, now time.Time) ([]int, error)
 }
 
 // productBatchesRepository is the implementation of ProductBatchesRepository using MySQL.
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	buyerModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_record"
+)
+
+// marginDimension holds the SQL expressions that key and name the groups of a margin report,
+// with the join they need beyond the order, its details and the product.
+type marginDimension struct {
+	key  string
+	name string
+	join string
+}
+
+var marginDimensions = map[string]marginDimension{
+	models.GroupByProduct: {
+		key:  "CAST(p.id AS CHAR)",
+		name: "p.description",
+	},
+	models.GroupBySeller: {
+		key:  "CAST(p.seller_id AS CHAR)",
+		name: "COALESCE(s.company_name, '')",
+		join: "LEFT JOIN sellers s ON s.id = p.seller_id",
+	},
+	models.GroupByProductType: {
+		key:  "CAST(p.product_type_id AS CHAR)",
+		name: "COALESCE(pt.description, '')",
+		join: "LEFT JOIN products_types pt ON pt.id = p.product_type_id",
+	},
+	models.GroupByBuyer: {
+		key:  "CAST(po.buyer_id AS CHAR)",
+		name: "COALESCE(CONCAT(b.first_name, ' ', b.last_name), '')",
+		join: "LEFT JOIN buyers b ON b.id = po.buyer_id",
+	},
+	models.GroupByMonth: {
+		key:  "DATE_FORMAT(po.order_date, '%Y-%m')",
+		name: "''",
+	},
+}
+
+// marginReportQuery prices every order detail at the product record of its product that was in effect
+// on the order date: the latest one updated on or before it. Details of orders placed before the first
+// record of the product fall back to the record they were ordered with.
+func marginReportQuery(groupBy string) string {
+	d := marginDimensions[groupBy]
+	order := "revenue DESC, group_key"
+	if groupBy == models.GroupByMonth {
+		order = "group_key"
+	}
+	return `
+		SELECT
+			` + d.key + ` AS group_key,
+			` + d.name + ` AS group_name,
+			COUNT(DISTINCT po.id) AS orders,
+			COALESCE(SUM(od.quantity), 0) AS units,
+			COALESCE(SUM(od.quantity * pr.sale_price), 0) AS revenue,
+			COALESCE(SUM(od.quantity * pr.purchase_price), 0) AS cost
+		FROM order_details od
+		INNER JOIN purchase_orders po ON po.id = od.purchase_order_id
+		INNER JOIN product_records lr ON lr.id = od.product_record_id
+		INNER JOIN products p ON p.id = lr.product_id
+		INNER JOIN product_records pr ON pr.id = COALESCE((
+			SELECT vr.id FROM product_records vr
+			WHERE vr.product_id = lr.product_id AND vr.last_update_date <= po.order_date
+			ORDER BY vr.last_update_date DESC, vr.id DESC
+			LIMIT 1), lr.id)
+		` + d.join + `
+		WHERE po.order_date >= ? AND po.order_date < ? AND po.order_status_id <> ?
+		GROUP BY group_key, group_name
+		ORDER BY ` + order
+}
+
+func (r *productRecordMySQLRepository) GetMarginReport(ctx context.Context, filter models.MarginReportFilter) ([]models.MarginReportLine, error) {
+	if _, ok := marginDimensions[filter.GroupBy]; !ok {
+		return nil, apperrors.NewAppError(apperrors.CodeBadRequest, "unsupported margin report grouping").
+			WithDetail("group_by", filter.GroupBy)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, productRecordQueryTimeout)
+	defer cancel()
+
+	lines := make([]models.MarginReportLine, 0)
+	if err := r.db.SelectContext(ctx, &lines, marginReportQuery(filter.GroupBy),
+		filter.From, filter.To.AddDate(0, 0, 1), buyerModels.OrderStatusCancelled); err != nil {
+		return nil, apperrors.Wrap(err, "failed to get margin report")
+	}
+	return lines, nil
+}
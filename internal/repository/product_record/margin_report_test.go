@@ -0,0 +1,110 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_record"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+var marginCols = []string{"group_key", "group_name", "orders", "units", "revenue", "cost"}
+
+/*
+Scenarios:
+  - by_product       – lines priced at the record valid on the order date, ordered by revenue
+  - by_month         – months in calendar order
+  - unknown_grouping – rejected before querying
+  - db_error         – query failure → INTERNAL_ERROR
+*/
+func TestProductRecordRepository_GetMarginReport(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	end := to.AddDate(0, 0, 1)
+
+	tests := []struct {
+		name      string
+		groupBy   string
+		mockSetup func(sqlmock.Sqlmock)
+		want      []models.MarginReportLine
+		wantApp   string // expected AppError.Code ("" == success)
+	}{
+		{
+			name:    "by_product",
+			groupBy: models.GroupByProduct,
+			mockSetup: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(marginCols).
+					AddRow("2", "Yogurt", 3, 12, 240.5, 150.25).
+					AddRow("1", "Milk", 1, 4, 20.0, 12.0)
+				m.ExpectQuery(`CAST\(p.id AS CHAR\) AS group_key.*vr.last_update_date <= po.order_date.*ORDER BY revenue DESC`).
+					WithArgs(from, end, 3).
+					WillReturnRows(rows)
+			},
+			want: []models.MarginReportLine{
+				{Key: "2", Name: "Yogurt", Orders: 3, Units: 12, Revenue: 240.5, Cost: 150.25},
+				{Key: "1", Name: "Milk", Orders: 1, Units: 4, Revenue: 20, Cost: 12},
+			},
+		},
+		{
+			name:    "by_month",
+			groupBy: models.GroupByMonth,
+			mockSetup: func(m sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(marginCols).AddRow("2025-01", "", 2, 5, 50.0, 30.0)
+				m.ExpectQuery(`DATE_FORMAT\(po.order_date, '%Y-%m'\) AS group_key.*ORDER BY group_key`).
+					WithArgs(from, end, 3).
+					WillReturnRows(rows)
+			},
+			want: []models.MarginReportLine{
+				{Key: "2025-01", Orders: 2, Units: 5, Revenue: 50, Cost: 30},
+			},
+		},
+		{
+			name:      "unknown_grouping",
+			groupBy:   "warehouse",
+			mockSetup: func(_ sqlmock.Sqlmock) {},
+			wantApp:   apperrors.CodeBadRequest,
+		},
+		{
+			name:    "db_error",
+			groupBy: models.GroupBySeller,
+			mockSetup: func(m sqlmock.Sqlmock) {
+				m.ExpectQuery("LEFT JOIN sellers s").
+					WithArgs(from, end, 3).
+					WillReturnError(errors.New("db down"))
+			},
+			wantApp: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo, mock, cleanup := testhelpers.NewProductRecordRepoMock(t)
+			defer cleanup()
+
+			tc.mockSetup(mock)
+
+			lines, err := repo.GetMarginReport(context.Background(),
+				models.MarginReportFilter{GroupBy: tc.groupBy, From: from, To: to})
+
+			if tc.wantApp != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantApp)
+				require.Nil(t, lines)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, lines)
+		})
+	}
+}
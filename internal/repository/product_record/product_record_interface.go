@@ -9,4 +9,5 @@ import (
 type ProductRecordRepository interface {
 	Create(ctx context.Context, record models.ProductRecord) (models.ProductRecord, error)
 	GetRecordsReport(ctx context.Context, productID int) ([]models.ProductRecordReport, error)
+	GetMarginReport(ctx context.Context, filter models.MarginReportFilter) ([]models.MarginReportLine, error)
 }
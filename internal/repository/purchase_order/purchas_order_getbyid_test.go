@@ -27,9 +27,9 @@ func TestPurchaseOrderRepository_GetByID(t *testing.T) {
 		{
 			name: "success",
 			setup: func(mock sqlmock.Sqlmock) {
-				row := sqlmock.NewRows([]string{"id", "order_number", "order_date", "tracking_code", "buyer_id", "product_record_id"}).
-					AddRow(1, "PO-001", "2023-01-15 00:00:00", "TRACK001", 101, 201)
-				mock.ExpectQuery("SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id FROM purchase_orders WHERE id = \\?").
+				row := sqlmock.NewRows([]string{"id", "order_number", "order_date", "tracking_code", "buyer_id", "product_record_id", "delivery_address_id", "delivery_address", "delivery_locality_id"}).
+					AddRow(1, "PO-001", "2023-01-15 00:00:00", "TRACK001", 101, 201, nil, nil, nil)
+				mock.ExpectQuery("SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id, delivery_address_id, delivery_address, delivery_locality_id FROM purchase_orders WHERE id = \\?").
 					WithArgs(1).
 					WillReturnRows(row)
 			},
@@ -43,10 +43,29 @@ func TestPurchaseOrderRepository_GetByID(t *testing.T) {
 			}(),
 			wantErr: false,
 		},
+		{
+			name: "success - with delivery snapshot",
+			setup: func(mock sqlmock.Sqlmock) {
+				row := sqlmock.NewRows([]string{"id", "order_number", "order_date", "tracking_code", "buyer_id", "product_record_id", "delivery_address_id", "delivery_address", "delivery_locality_id"}).
+					AddRow(2, "PO-002", "2023-02-20 00:00:00", "TRACK002", 102, 202, 7, "Av. Corrientes 1234", "1001")
+				mock.ExpectQuery("SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id, delivery_address_id, delivery_address, delivery_locality_id FROM purchase_orders WHERE id = \\?").
+					WithArgs(2).
+					WillReturnRows(row)
+			},
+			argID: 2,
+			want: func() *models.PurchaseOrder {
+				v := testhelpers.PurchaseOrderDummyMap[2]
+				v.DeliveryAddressID = testhelpers.IntPtr(7)
+				v.DeliveryAddress = testhelpers.StringPtr("Av. Corrientes 1234")
+				v.DeliveryLocalityID = testhelpers.StringPtr("1001")
+				return &v
+			}(),
+			wantErr: false,
+		},
 		{
 			name: "error - not found",
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id FROM purchase_orders WHERE id = \\?").
+				mock.ExpectQuery("SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id, delivery_address_id, delivery_address, delivery_locality_id FROM purchase_orders WHERE id = \\?").
 					WithArgs(999).
 					WillReturnError(sql.ErrNoRows)
 			},
@@ -57,7 +76,7 @@ func TestPurchaseOrderRepository_GetByID(t *testing.T) {
 		{
 			name: "error - db failure",
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id FROM purchase_orders WHERE id = \\?").
+				mock.ExpectQuery("SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id, delivery_address_id, delivery_address, delivery_locality_id FROM purchase_orders WHERE id = \\?").
 					WithArgs(1).
 					WillReturnError(errors.New("db error"))
 			},
@@ -93,6 +112,9 @@ func TestPurchaseOrderRepository_GetByID(t *testing.T) {
 				require.Equal(t, tt.want.BuyerID, got.BuyerID)
 				require.Equal(t, tt.want.ProductRecordID, got.ProductRecordID)
 				require.WithinDuration(t, tt.want.OrderDate, got.OrderDate, time.Second)
+				require.Equal(t, tt.want.DeliveryAddressID, got.DeliveryAddressID)
+				require.Equal(t, tt.want.DeliveryAddress, got.DeliveryAddress)
+				require.Equal(t, tt.want.DeliveryLocalityID, got.DeliveryLocalityID)
 			}
 			require.NoError(t, mock.ExpectationsWereMet())
 		})
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+)
+
+const (
+	queryBuyerOrders = `SELECT po.id, po.order_number, po.order_date, po.tracking_code, COALESCE(os.description, ''),
+			po.delivery_address, po.delivery_locality_id
+		FROM purchase_orders po LEFT JOIN order_status os ON os.id = po.order_status_id
+		WHERE po.buyer_id = ? AND po.order_date >= ? AND po.order_date < ?
+		ORDER BY po.order_date DESC, po.id DESC
+		LIMIT ? OFFSET ?`
+	queryOrderLines = `SELECT od.id, od.purchase_order_id, pr.id, pr.product_id, COALESCE(p.description, ''),
+			COALESCE(od.quantity, 0), COALESCE(pr.sale_price, 0)
+		FROM order_details od
+		INNER JOIN product_records pr ON pr.id = od.product_record_id
+		LEFT JOIN products p ON p.id = pr.product_id
+		WHERE od.purchase_order_id IN (%s)
+		ORDER BY od.purchase_order_id, od.id`
+	queryPeriodTotals = `SELECT DATE_FORMAT(po.order_date, ?) AS period, COUNT(DISTINCT po.id),
+			COALESCE(SUM(od.quantity), 0), COALESCE(SUM(od.quantity * pr.sale_price), 0)
+		FROM purchase_orders po
+		LEFT JOIN order_details od ON od.purchase_order_id = po.id
+		LEFT JOIN product_records pr ON pr.id = od.product_record_id
+		WHERE po.buyer_id = ? AND po.order_date >= ? AND po.order_date < ?
+		GROUP BY period
+		ORDER BY period`
+)
+
+// periodFormats maps each period to the MySQL date format naming it.
+var periodFormats = map[string]string{
+	models.PeriodDay:   "%Y-%m-%d",
+	models.PeriodMonth: "%Y-%m",
+	models.PeriodYear:  "%Y",
+}
+
+func (r *purchaseOrderRepository) ExistsBuyer(ctx context.Context, buyerID int) bool {
+	return r.recordExists(ctx, queryCheckBuyerExists, buyerID)
+}
+
+// FindBuyerOrders returns the page of the orders of the buyer placed in the filter range, newest first.
+// The lines of the orders are left empty.
+func (r *purchaseOrderRepository) FindBuyerOrders(ctx context.Context, filter models.PurchaseHistoryFilter) ([]models.PurchaseHistoryOrder, error) {
+	rows, err := r.db.QueryContext(ctx, queryBuyerOrders, filter.BuyerID, filter.From, filter.To.AddDate(0, 0, 1),
+		filter.PageSize, (filter.Page-1)*filter.PageSize)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying buyer purchase orders")
+	}
+	defer rows.Close()
+
+	orders := make([]models.PurchaseHistoryOrder, 0)
+	for rows.Next() {
+		var o models.PurchaseHistoryOrder
+		if err := rows.Scan(&o.ID, &o.OrderNumber, &o.OrderDate, &o.TrackingCode, &o.Status,
+			&o.DeliveryAddress, &o.DeliveryLocalityID); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning buyer purchase order")
+		}
+		orders = append(orders, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating buyer purchase orders")
+	}
+	return orders, nil
+}
+
+// FindOrderLines returns the order details of the orders, priced at the sale price of their product record.
+func (r *purchaseOrderRepository) FindOrderLines(ctx context.Context, orderIDs []int) ([]models.PurchaseHistoryLine, error) {
+	if len(orderIDs) == 0 {
+		return []models.PurchaseHistoryLine{}, nil
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(orderIDs)), ",")
+	args := make([]any, len(orderIDs))
+	for i, id := range orderIDs {
+		args[i] = id
+	}
+
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(queryOrderLines, placeholders), args...)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying order lines")
+	}
+	defer rows.Close()
+
+	lines := make([]models.PurchaseHistoryLine, 0)
+	for rows.Next() {
+		var l models.PurchaseHistoryLine
+		if err := rows.Scan(&l.OrderDetailID, &l.PurchaseOrderID, &l.ProductRecordID, &l.ProductID, &l.Description,
+			&l.Quantity, &l.UnitPrice); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning order line")
+		}
+		lines = append(lines, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating order lines")
+	}
+	return lines, nil
+}
+
+// FindPeriodTotals sums every order of the buyer placed in the filter range, grouped by the filter period.
+// Orders without details count with no items and no amount.
+func (r *purchaseOrderRepository) FindPeriodTotals(ctx context.Context, filter models.PurchaseHistoryFilter) ([]models.PeriodTotal, error) {
+	rows, err := r.db.QueryContext(ctx, queryPeriodTotals, periodFormats[filter.Period], filter.BuyerID,
+		filter.From, filter.To.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying purchase totals")
+	}
+	defer rows.Close()
+
+	totals := make([]models.PeriodTotal, 0)
+	for rows.Next() {
+		var t models.PeriodTotal
+		if err := rows.Scan(&t.Period, &t.Orders, &t.Items, &t.Amount); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning purchase totals")
+		}
+		totals = append(totals, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating purchase totals")
+	}
+	return totals, nil
+}
@@ -22,14 +22,15 @@ func NewPurchaseOrderRepository(db *sql.DB) PurchaseOrderRepository {
 
 const (
 	queryPurchaseOrderCreate = `INSERT INTO purchase_orders 
-		(order_number, order_date, tracking_code, buyer_id, product_record_id) 
-		VALUES (?, ?, ?, ?, ?)`
+		(order_number, order_date, tracking_code, buyer_id, product_record_id,
+		delivery_address_id, delivery_address, delivery_locality_id) 
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
 
 	queryPurchaseOrderGetAll = `SELECT id, order_number, order_date, tracking_code, buyer_id, 
-		product_record_id FROM purchase_orders`
+		product_record_id, delivery_address_id, delivery_address, delivery_locality_id FROM purchase_orders`
 
 	queryPurchaseOrderGetByID = `SELECT id, order_number, order_date, tracking_code, buyer_id, 
-		product_record_id FROM purchase_orders WHERE id = ?`
+		product_record_id, delivery_address_id, delivery_address, delivery_locality_id FROM purchase_orders WHERE id = ?`
 
 	queryCheckProductRecordExists = `SELECT EXISTS(SELECT 1 FROM product_records WHERE id = ?)`
 	queryCheckBuyerExists         = `SELECT EXISTS(SELECT 1 FROM buyers WHERE id = ?)`
@@ -67,6 +68,9 @@ func (r *purchaseOrderRepository) Create(ctx context.Context, po models.Purchase
 		po.TrackingCode,
 		po.BuyerID,
 		po.ProductRecordID,
+		po.DeliveryAddressID,
+		po.DeliveryAddress,
+		po.DeliveryLocalityID,
 	)
 	if err != nil {
 		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
@@ -108,6 +112,9 @@ func (r *purchaseOrderRepository) GetAll(ctx context.Context) ([]models.Purchase
 			&po.TrackingCode,
 			&po.BuyerID,
 			&po.ProductRecordID,
+			&po.DeliveryAddressID,
+			&po.DeliveryAddress,
+			&po.DeliveryLocalityID,
 		)
 		if err != nil {
 			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning purchase order")
@@ -140,6 +147,9 @@ func (r *purchaseOrderRepository) GetByID(ctx context.Context, id int) (*models.
 		&po.TrackingCode,
 		&po.BuyerID,
 		&po.ProductRecordID,
+		&po.DeliveryAddressID,
+		&po.DeliveryAddress,
+		&po.DeliveryLocalityID,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
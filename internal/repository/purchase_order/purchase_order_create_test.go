@@ -42,7 +42,7 @@ func TestPurchaseOrderRepository_Create(t *testing.T) {
 
 				// Mock para la creación
 				mock.ExpectExec("INSERT INTO purchase_orders").
-					WithArgs("PO-001", sqlmock.AnyArg(), "TRACK001", 101, 201).
+					WithArgs("PO-001", sqlmock.AnyArg(), "TRACK001", 101, 201, nil, nil, nil).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			arg:     testhelpers.PurchaseOrderDummyMap[1],
@@ -127,7 +127,7 @@ func TestPurchaseOrderRepository_Create(t *testing.T) {
 					WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 
 				mock.ExpectExec("INSERT INTO purchase_orders").
-					WithArgs("PO-001", sqlmock.AnyArg(), "TRACK001", 101, 201).
+					WithArgs("PO-001", sqlmock.AnyArg(), "TRACK001", 101, 201, nil, nil, nil).
 					WillReturnError(errors.New("db error"))
 			},
 			arg:            testhelpers.PurchaseOrderDummyMap[1],
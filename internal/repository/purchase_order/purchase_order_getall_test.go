@@ -25,10 +25,10 @@ func TestPurchaseOrderRepository_GetAll(t *testing.T) {
 		{
 			name: "success - multiple orders",
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "order_number", "order_date", "tracking_code", "buyer_id", "product_record_id"}).
-					AddRow(1, "PO-001", "2023-01-15 00:00:00", "TRACK001", 101, 201).
-					AddRow(2, "PO-002", "2023-02-20 00:00:00", "TRACK002", 102, 202)
-				mock.ExpectQuery("SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id FROM purchase_orders").
+				rows := sqlmock.NewRows([]string{"id", "order_number", "order_date", "tracking_code", "buyer_id", "product_record_id", "delivery_address_id", "delivery_address", "delivery_locality_id"}).
+					AddRow(1, "PO-001", "2023-01-15 00:00:00", "TRACK001", 101, 201, nil, nil, nil).
+					AddRow(2, "PO-002", "2023-02-20 00:00:00", "TRACK002", 102, 202, nil, nil, nil)
+				mock.ExpectQuery("SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id, delivery_address_id, delivery_address, delivery_locality_id FROM purchase_orders").
 					WillReturnRows(rows)
 			},
 			want: []models.PurchaseOrder{
@@ -40,8 +40,8 @@ func TestPurchaseOrderRepository_GetAll(t *testing.T) {
 		{
 			name: "success - no orders",
 			setup: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "order_number", "order_date", "tracking_code", "buyer_id", "product_record_id"})
-				mock.ExpectQuery("SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id FROM purchase_orders").
+				rows := sqlmock.NewRows([]string{"id", "order_number", "order_date", "tracking_code", "buyer_id", "product_record_id", "delivery_address_id", "delivery_address", "delivery_locality_id"})
+				mock.ExpectQuery("SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id, delivery_address_id, delivery_address, delivery_locality_id FROM purchase_orders").
 					WillReturnRows(rows)
 			},
 			want:    []models.PurchaseOrder{},
@@ -50,7 +50,7 @@ func TestPurchaseOrderRepository_GetAll(t *testing.T) {
 		{
 			name: "error - db failure",
 			setup: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id FROM purchase_orders").
+				mock.ExpectQuery("SELECT id, order_number, order_date, tracking_code, buyer_id, product_record_id, delivery_address_id, delivery_address, delivery_locality_id FROM purchase_orders").
 					WillReturnError(errors.New("db error"))
 			},
 			wantErr:        true,
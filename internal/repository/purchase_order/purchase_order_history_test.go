@@ -0,0 +1,141 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/purchase_order"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func historyFilter() models.PurchaseHistoryFilter {
+	return models.PurchaseHistoryFilter{
+		BuyerID:  101,
+		From:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+		Period:   models.PeriodMonth,
+		Page:     2,
+		PageSize: 10,
+	}
+}
+
+func TestPurchaseOrderRepository_FindBuyerOrders(t *testing.T) {
+	query := regexp.QuoteMeta(`FROM purchase_orders po LEFT JOIN order_status os ON os.id = po.order_status_id
+		WHERE po.buyer_id = ? AND po.order_date >= ? AND po.order_date < ?
+		ORDER BY po.order_date DESC, po.id DESC
+		LIMIT ? OFFSET ?`)
+	columns := []string{"id", "order_number", "order_date", "tracking_code", "status", "delivery_address", "delivery_locality_id"}
+	placed := time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC)
+
+	t.Run("success - to is included and the page is offset", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		mock.ExpectQuery(query).
+			WithArgs(101, historyFilter().From, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), 10, 10).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(11, "PO-011", placed, "TRACK011", "confirmed", "Av. Corrientes 1234", "1001").
+				AddRow(10, "PO-010", placed, "TRACK010", "pending", nil, nil))
+		repo := repository.NewPurchaseOrderRepository(db)
+
+		orders, err := repo.FindBuyerOrders(context.Background(), historyFilter())
+
+		require.NoError(t, err)
+		require.Equal(t, []models.PurchaseHistoryOrder{
+			{ID: 11, OrderNumber: "PO-011", OrderDate: placed, TrackingCode: "TRACK011", Status: "confirmed",
+				DeliveryAddress: testhelpers.StringPtr("Av. Corrientes 1234"), DeliveryLocalityID: testhelpers.StringPtr("1001")},
+			{ID: 10, OrderNumber: "PO-010", OrderDate: placed, TrackingCode: "TRACK010", Status: "pending"},
+		}, orders)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - query failed", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		mock.ExpectQuery(query).WillReturnError(errors.New("connection reset"))
+		repo := repository.NewPurchaseOrderRepository(db)
+
+		orders, err := repo.FindBuyerOrders(context.Background(), historyFilter())
+
+		testhelpers.RequireAppErr(t, err, apperrors.CodeInternal)
+		require.Nil(t, orders)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPurchaseOrderRepository_FindOrderLines(t *testing.T) {
+	query := regexp.QuoteMeta(`WHERE od.purchase_order_id IN (?,?)
+		ORDER BY od.purchase_order_id, od.id`)
+	columns := []string{"id", "purchase_order_id", "product_record_id", "product_id", "description", "quantity", "sale_price"}
+
+	t.Run("success", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		mock.ExpectQuery(query).WithArgs(10, 11).WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, 10, 201, 5, "Yogurt", 3, 2.5).
+			AddRow(2, 11, 202, 6, "Milk", 1, 1.2))
+		repo := repository.NewPurchaseOrderRepository(db)
+
+		lines, err := repo.FindOrderLines(context.Background(), []int{10, 11})
+
+		require.NoError(t, err)
+		require.Equal(t, []models.PurchaseHistoryLine{
+			{OrderDetailID: 1, PurchaseOrderID: 10, ProductRecordID: 201, ProductID: 5, Description: "Yogurt", Quantity: 3, UnitPrice: 2.5},
+			{OrderDetailID: 2, PurchaseOrderID: 11, ProductRecordID: 202, ProductID: 6, Description: "Milk", Quantity: 1, UnitPrice: 1.2},
+		}, lines)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - no orders skips the query", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		repo := repository.NewPurchaseOrderRepository(db)
+
+		lines, err := repo.FindOrderLines(context.Background(), nil)
+
+		require.NoError(t, err)
+		require.Empty(t, lines)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPurchaseOrderRepository_FindPeriodTotals(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT DATE_FORMAT(po.order_date, ?) AS period`)
+	columns := []string{"period", "orders", "items", "amount"}
+
+	tests := []struct {
+		name   string
+		period string
+		format string
+	}{
+		{name: "by day", period: models.PeriodDay, format: "%Y-%m-%d"},
+		{name: "by month", period: models.PeriodMonth, format: "%Y-%m"},
+		{name: "by year", period: models.PeriodYear, format: "%Y"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := historyFilter()
+			filter.Period = tt.period
+			mock, db := testhelpers.CreateMockDB()
+			defer db.Close()
+			mock.ExpectQuery(query).
+				WithArgs(tt.format, 101, filter.From, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)).
+				WillReturnRows(sqlmock.NewRows(columns).AddRow("2025", 2, 4, 8.7))
+			repo := repository.NewPurchaseOrderRepository(db)
+
+			totals, err := repo.FindPeriodTotals(context.Background(), filter)
+
+			require.NoError(t, err)
+			require.Equal(t, []models.PeriodTotal{{Period: "2025", Orders: 2, Items: 4, Amount: 8.7}}, totals)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -14,4 +14,8 @@ type PurchaseOrderRepository interface {
 	ExistsOrderNumber(ctx context.Context, orderNumber string) bool
 	GetCountByBuyer(ctx context.Context, buyerID int) ([]models.BuyerWithPurchaseCount, error)
 	GetAllWithPurchaseCount(ctx context.Context) ([]models.BuyerWithPurchaseCount, error)
+	ExistsBuyer(ctx context.Context, buyerID int) bool
+	FindBuyerOrders(ctx context.Context, filter models.PurchaseHistoryFilter) ([]models.PurchaseHistoryOrder, error)
+	FindOrderLines(ctx context.Context, orderIDs []int) ([]models.PurchaseHistoryLine, error)
+	FindPeriodTotals(ctx context.Context, filter models.PurchaseHistoryFilter) ([]models.PeriodTotal, error)
 }
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/recall"
+)
+
+const (
+	queryRecallBatchesForUpdate = `SELECT id, batch_number, status FROM product_batches WHERE id IN (%s) ORDER BY id FOR UPDATE`
+	queryRecallProductBatchIds  = `SELECT id FROM product_batches WHERE product_id = ? AND status <> 'disposed' ORDER BY id`
+	queryRecallCreate           = `INSERT INTO recalls (note, actor, created_at) VALUES (?, ?, ?)`
+	queryRecallBatchCreate      = `INSERT INTO recall_batches (recall_id, product_batch_id, previous_status, held) VALUES (?, ?, ?, ?)`
+	queryRecallFindById         = `SELECT id, note, actor, created_at FROM recalls WHERE id = ?`
+	queryRecallFindBatches      = `SELECT rb.product_batch_id, pb.batch_number, rb.previous_status, rb.held
+		FROM recall_batches rb INNER JOIN product_batches pb ON pb.id = rb.product_batch_id
+		WHERE rb.recall_id = ? ORDER BY rb.product_batch_id`
+	// The lineage starts from the requested batches and follows every transfer out of them, so batches
+	// split off a recalled batch, and the ones split off those, are recalled as well.
+	queryRecallBatchLineage = `WITH RECURSIVE lineage (id) AS (
+			SELECT id FROM product_batches WHERE id IN (%s)
+			UNION
+			SELECT bt.destination_batch_id FROM batch_transfers bt INNER JOIN lineage l ON l.id = bt.source_batch_id
+		)
+		SELECT id FROM lineage ORDER BY id`
+)
+
+// FindBatchesForUpdate locks the batches in id order and returns their current status as PreviousStatus.
+// Ids that do not exist are simply missing from the result.
+func (r *recallRepository) FindBatchesForUpdate(ctx context.Context, exec Executor, batchIds []int) ([]models.RecallBatch, error) {
+	if len(batchIds) == 0 {
+		return []models.RecallBatch{}, nil
+	}
+	placeholders, args := inArgs(batchIds)
+	rows, err := exec.QueryContext(ctx, fmt.Sprintf(queryRecallBatchesForUpdate, placeholders), args...)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying recalled batches")
+	}
+	defer rows.Close()
+
+	batches := make([]models.RecallBatch, 0, len(batchIds))
+	for rows.Next() {
+		var b models.RecallBatch
+		if err := rows.Scan(&b.ProductBatchId, &b.BatchNumber, &b.PreviousStatus); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning recalled batch")
+		}
+		batches = append(batches, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating recalled batches")
+	}
+	return batches, nil
+}
+
+// FindBatchLineage returns the batches with every batch transferred out of them, recursively, in id order.
+// Ids that do not exist are simply missing from the result.
+func (r *recallRepository) FindBatchLineage(ctx context.Context, exec Executor, batchIds []int) ([]int, error) {
+	if len(batchIds) == 0 {
+		return []int{}, nil
+	}
+	placeholders, args := inArgs(batchIds)
+	return queryIds(ctx, exec, fmt.Sprintf(queryRecallBatchLineage, placeholders), args...)
+}
+
+// FindProductBatchIds returns the ids of the product batches still in stock or held, in id order.
+func (r *recallRepository) FindProductBatchIds(ctx context.Context, exec Executor, productId int) ([]int, error) {
+	return queryIds(ctx, exec, queryRecallProductBatchIds, productId)
+}
+
+// CreateRecall inserts the recall header and returns it with its generated id.
+func (r *recallRepository) CreateRecall(ctx context.Context, exec Executor, rc models.Recall) (*models.Recall, error) {
+	res, err := exec.ExecContext(ctx, queryRecallCreate, rc.Note, rc.Actor, rc.CreatedAt)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error creating recall")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	rc.Id = int(id)
+	return &rc, nil
+}
+
+// CreateRecallBatch records the batch with the status it had before the recall.
+func (r *recallRepository) CreateRecallBatch(ctx context.Context, exec Executor, recallId int, b models.RecallBatch) error {
+	if _, err := exec.ExecContext(ctx, queryRecallBatchCreate, recallId, b.ProductBatchId, b.PreviousStatus, b.Held); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error creating recall batch")
+	}
+	return nil
+}
+
+// FindById returns the recall header.
+// Returns a not found error if the recall does not exist.
+func (r *recallRepository) FindById(ctx context.Context, id int) (*models.Recall, error) {
+	var rc models.Recall
+	err := r.mysql.QueryRowContext(ctx, queryRecallFindById, id).Scan(&rc.Id, &rc.Note, &rc.Actor, &rc.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "recall not found")
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying recall")
+	}
+	return &rc, nil
+}
+
+// FindBatches returns the batches of the recall in id order.
+func (r *recallRepository) FindBatches(ctx context.Context, recallId int) ([]models.RecallBatch, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryRecallFindBatches, recallId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying recall batches")
+	}
+	defer rows.Close()
+
+	batches := make([]models.RecallBatch, 0)
+	for rows.Next() {
+		var b models.RecallBatch
+		if err := rows.Scan(&b.ProductBatchId, &b.BatchNumber, &b.PreviousStatus, &b.Held); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning recall batch")
+		}
+		batches = append(batches, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating recall batches")
+	}
+	return batches, nil
+}
+
+// queryIds runs a query returning product batch ids and scans them.
+func queryIds(ctx context.Context, exec Executor, query string, args ...any) ([]int, error) {
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying product batches")
+	}
+	defer rows.Close()
+
+	ids := make([]int, 0)
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning product batch")
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating product batches")
+	}
+	return ids, nil
+}
+
+// inArgs returns the placeholders and arguments of an IN list of ids.
+func inArgs(ids []int) (string, []any) {
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	return strings.TrimSuffix(strings.Repeat("?,", len(ids)), ","), args
+}
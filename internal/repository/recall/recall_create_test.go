@@ -0,0 +1,123 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/recall"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/recall"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestRecallRepository_CreateRecall(t *testing.T) {
+	query := regexp.QuoteMeta(`INSERT INTO recalls (note, actor, created_at) VALUES (?, ?, ?)`)
+	recall := models.Recall{Note: "listeria", Actor: "qa.lead", CreatedAt: time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)}
+
+	testCases := []struct {
+		name    string
+		dbMock  func() (sqlmock.Sqlmock, *sql.DB)
+		errCode string
+	}{
+		{
+			name: "success",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WithArgs(recall.Note, recall.Actor, recall.CreatedAt).WillReturnResult(sqlmock.NewResult(4, 1))
+				return mock, db
+			},
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+		{
+			name: "error - last insert id",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WillReturnResult(sqlmock.NewErrorResult(errors.New("no id")))
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewRecallRepository(db)
+
+			result, err := repo.CreateRecall(context.Background(), db, recall)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				want := recall
+				want.Id = 4
+				require.Equal(t, &want, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRecallRepository_CreateRecallBatch(t *testing.T) {
+	query := regexp.QuoteMeta(`INSERT INTO recall_batches (recall_id, product_batch_id, previous_status, held) VALUES (?, ?, ?, ?)`)
+	batch := models.RecallBatch{ProductBatchId: 1, BatchNumber: 101, PreviousStatus: batchModels.BatchStatusAvailable, Held: true}
+
+	testCases := []struct {
+		name    string
+		dbMock  func() (sqlmock.Sqlmock, *sql.DB)
+		errCode string
+	}{
+		{
+			name: "success",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WithArgs(4, 1, batchModels.BatchStatusAvailable, true).WillReturnResult(sqlmock.NewResult(0, 1))
+				return mock, db
+			},
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WithArgs(4, 1, batchModels.BatchStatusAvailable, true).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewRecallRepository(db)
+
+			err := repo.CreateRecallBatch(context.Background(), db, 4, batch)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
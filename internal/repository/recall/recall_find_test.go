@@ -0,0 +1,318 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/recall"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/recall"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestRecallRepository_FindBatchesForUpdate(t *testing.T) {
+	columns := []string{"id", "batch_number", "status"}
+	query := regexp.QuoteMeta(`SELECT id, batch_number, status FROM product_batches WHERE id IN (?,?,?) ORDER BY id FOR UPDATE`)
+
+	testCases := []struct {
+		name     string
+		ids      []int
+		dbMock   func() (sqlmock.Sqlmock, *sql.DB)
+		expected []models.RecallBatch
+		errCode  string
+	}{
+		{
+			name: "success - existing batches locked in id order",
+			ids:  []int{1, 2, 99},
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1, 2, 99).WillReturnRows(sqlmock.NewRows(columns).
+					AddRow(1, 101, batchModels.BatchStatusAvailable).
+					AddRow(2, 102, batchModels.BatchStatusQuarantined))
+				return mock, db
+			},
+			expected: []models.RecallBatch{
+				{ProductBatchId: 1, BatchNumber: 101, PreviousStatus: batchModels.BatchStatusAvailable},
+				{ProductBatchId: 2, BatchNumber: 102, PreviousStatus: batchModels.BatchStatusQuarantined},
+			},
+		},
+		{
+			name: "success - no ids runs no query",
+			ids:  []int{},
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				return testhelpers.CreateMockDB()
+			},
+			expected: []models.RecallBatch{},
+		},
+		{
+			name: "error - database error",
+			ids:  []int{1, 2, 99},
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1, 2, 99).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+		{
+			name: "error - scan error",
+			ids:  []int{1, 2, 99},
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1, 2, 99).WillReturnRows(sqlmock.NewRows(columns).
+					AddRow("x", 101, batchModels.BatchStatusAvailable))
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewRecallRepository(db)
+
+			result, err := repo.FindBatchesForUpdate(context.Background(), db, tc.ids)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRecallRepository_FindBatchLineage(t *testing.T) {
+	const query = `WITH RECURSIVE lineage \(id\) AS \(\s+SELECT id FROM product_batches WHERE id IN \(\?,\?\)\s+UNION\s+` +
+		`SELECT bt.destination_batch_id FROM batch_transfers bt INNER JOIN lineage l ON l.id = bt.source_batch_id\s+\)\s+SELECT id FROM lineage ORDER BY id`
+
+	testCases := []struct {
+		name     string
+		ids      []int
+		dbMock   func() (sqlmock.Sqlmock, *sql.DB)
+		expected []int
+		errCode  string
+	}{
+		{
+			name: "success - batches with the ones split off them",
+			ids:  []int{1, 3},
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1, 3).WillReturnRows(sqlmock.NewRows([]string{"id"}).
+					AddRow(1).AddRow(3).AddRow(7).AddRow(9))
+				return mock, db
+			},
+			expected: []int{1, 3, 7, 9},
+		},
+		{
+			name: "success - no ids runs no query",
+			ids:  []int{},
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				return testhelpers.CreateMockDB()
+			},
+			expected: []int{},
+		},
+		{
+			name: "error - database error",
+			ids:  []int{1, 3},
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1, 3).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewRecallRepository(db)
+
+			result, err := repo.FindBatchLineage(context.Background(), db, tc.ids)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRecallRepository_FindProductBatchIds(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT id FROM product_batches WHERE product_id = ? AND status <> 'disposed' ORDER BY id`)
+
+	testCases := []struct {
+		name     string
+		dbMock   func() (sqlmock.Sqlmock, *sql.DB)
+		expected []int
+		errCode  string
+	}{
+		{
+			name: "success - batches not disposed",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(22).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(3))
+				return mock, db
+			},
+			expected: []int{1, 3},
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(22).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewRecallRepository(db)
+
+			result, err := repo.FindProductBatchIds(context.Background(), db, 22)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRecallRepository_FindById(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT id, note, actor, created_at FROM recalls WHERE id = ?`)
+	createdAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		dbMock  func() (sqlmock.Sqlmock, *sql.DB)
+		errCode string
+	}{
+		{
+			name: "success - recall found",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id", "note", "actor", "created_at"}).
+					AddRow(1, "listeria", "qa.lead", createdAt))
+				return mock, db
+			},
+		},
+		{
+			name: "error - recall not found",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1).WillReturnError(sql.ErrNoRows)
+				return mock, db
+			},
+			errCode: apperrors.CodeNotFound,
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewRecallRepository(db)
+
+			result, err := repo.FindById(context.Background(), 1)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, &models.Recall{Id: 1, Note: "listeria", Actor: "qa.lead", CreatedAt: createdAt}, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRecallRepository_FindBatches(t *testing.T) {
+	columns := []string{"product_batch_id", "batch_number", "previous_status", "held"}
+	const query = `SELECT rb.product_batch_id, (.+) FROM recall_batches rb INNER JOIN product_batches pb ON pb.id = rb.product_batch_id\s+WHERE rb.recall_id = \? ORDER BY rb.product_batch_id`
+
+	testCases := []struct {
+		name     string
+		dbMock   func() (sqlmock.Sqlmock, *sql.DB)
+		expected []models.RecallBatch
+		errCode  string
+	}{
+		{
+			name: "success - held and kept batches",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows(columns).
+					AddRow(1, 101, batchModels.BatchStatusAvailable, true).
+					AddRow(2, 102, batchModels.BatchStatusQuarantined, false))
+				return mock, db
+			},
+			expected: []models.RecallBatch{
+				{ProductBatchId: 1, BatchNumber: 101, PreviousStatus: batchModels.BatchStatusAvailable, Held: true},
+				{ProductBatchId: 2, BatchNumber: 102, PreviousStatus: batchModels.BatchStatusQuarantined},
+			},
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewRecallRepository(db)
+
+			result, err := repo.FindBatches(context.Background(), 1)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
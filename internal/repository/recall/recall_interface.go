@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/database"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/recall"
+)
+
+// RecallRepository defines the data operations on recalls.
+type RecallRepository interface {
+	// FindBatchesForUpdate returns the current status of the given batches, locking them until the transaction ends.
+	FindBatchesForUpdate(ctx context.Context, exec Executor, batchIds []int) ([]models.RecallBatch, error)
+
+	// FindBatchLineage returns the given batches together with every batch transferred out of them, recursively.
+	FindBatchLineage(ctx context.Context, exec Executor, batchIds []int) ([]int, error)
+
+	// FindProductBatchIds returns the ids of the batches of a product that were not disposed.
+	FindProductBatchIds(ctx context.Context, exec Executor, productId int) ([]int, error)
+
+	// CreateRecall inserts a recall and returns it with its generated id.
+	CreateRecall(ctx context.Context, exec Executor, r models.Recall) (*models.Recall, error)
+
+	// CreateRecallBatch records a batch as part of a recall.
+	CreateRecallBatch(ctx context.Context, exec Executor, recallId int, b models.RecallBatch) error
+
+	// FindById returns a recall without its batches and buyers.
+	FindById(ctx context.Context, id int) (*models.Recall, error)
+
+	// FindBatches returns the batches included in a recall.
+	FindBatches(ctx context.Context, recallId int) ([]models.RecallBatch, error)
+
+	database.Transactor
+}
+
+// Executor runs SQL statements either on the connection or inside a transaction.
+type Executor = database.Executor
+
+// recallRepository implements RecallRepository using MySQL.
+type recallRepository struct {
+	database.MySQLTransactor
+	mysql *sql.DB
+}
+
+// NewRecallRepository returns a new RecallRepository using the given MySQL connection.
+func NewRecallRepository(mysql *sql.DB) RecallRepository {
+	return &recallRepository{
+		MySQLTransactor: database.NewMySQLTransactor(mysql),
+		mysql:           mysql,
+	}
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	inboundOrderModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inbound_order"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/receiving"
+)
+
+const (
+	queryOrderNumberExists = `SELECT COUNT(1) FROM inbound_orders WHERE order_number = ?`
+	queryEmployeeWarehouse = `SELECT warehouse_id FROM employees WHERE id = ?`
+	querySectionForUpdate  = `SELECT id, warehouse_id, current_capacity, maximum_capacity FROM sections WHERE id = ? FOR UPDATE`
+	queryBatchCreate       = `INSERT INTO product_batches (batch_number, current_quantity, current_temperature, due_date, initial_quantity, manufacturing_date, manufacturing_hour, minimum_temperature, product_id, section_id)
+		VALUES (?, 0, ?, ?, ?, ?, ?, ?, ?, ?)`
+	querySectionCapacityAdd = `UPDATE sections SET current_capacity = current_capacity + ? WHERE id = ?`
+	queryInboundOrderCreate = `INSERT INTO inbound_orders (order_date, order_number, employee_id, product_batch_id, warehouse_id, asn_id) VALUES (?, ?, ?, ?, ?, ?)`
+)
+
+// ExistsOrderNumber reports whether the order number is taken.
+func (r *receivingRepository) ExistsOrderNumber(ctx context.Context, exec Executor, orderNumber string) (bool, error) {
+	var count int
+	if err := exec.QueryRowContext(ctx, queryOrderNumberExists, orderNumber).Scan(&count); err != nil {
+		return false, apperrors.NewAppError(apperrors.CodeInternal, "error querying inbound orders")
+	}
+	return count > 0, nil
+}
+
+// FindEmployeeWarehouse returns the warehouse of the employee.
+// Returns a not found error if the employee does not exist.
+func (r *receivingRepository) FindEmployeeWarehouse(ctx context.Context, exec Executor, employeeId int) (int, error) {
+	var warehouseId int
+	if err := exec.QueryRowContext(ctx, queryEmployeeWarehouse, employeeId).Scan(&warehouseId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, apperrors.NewAppError(apperrors.CodeNotFound, "employee not found").WithDetail("employee_id", employeeId)
+		}
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error querying employee")
+	}
+	return warehouseId, nil
+}
+
+// FindSectionForUpdate returns the section and locks its row.
+// Returns a not found error if the section does not exist.
+func (r *receivingRepository) FindSectionForUpdate(ctx context.Context, exec Executor, sectionId int) (*models.ReceivingSection, error) {
+	var s models.ReceivingSection
+	err := exec.QueryRowContext(ctx, querySectionForUpdate, sectionId).Scan(&s.Id, &s.WarehouseId, &s.CurrentCapacity, &s.MaximumCapacity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "section not found").WithDetail("section_id", sectionId)
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying section")
+	}
+	return &s, nil
+}
+
+// CreateBatch inserts the batch with a zero current quantity.
+// Returns a conflict error if the batch number is taken and a not found error if the product does not exist.
+func (r *receivingRepository) CreateBatch(ctx context.Context, exec Executor, b batchModels.ProductBatches) (int, error) {
+	res, err := exec.ExecContext(ctx, queryBatchCreate, b.BatchNumber, b.CurrentTemperature, b.DueDate, b.InitialQuantity,
+		b.ManufacturingDate, b.ManufacturingHour, b.MinimumTemperature, b.ProductId, b.SectionId)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
+			switch mysqlErr.Number {
+			case 1062:
+				return 0, apperrors.NewAppError(apperrors.CodeConflict, "batch number already exists").
+					WithDetail("batch_number", b.BatchNumber)
+			case 1452:
+				return 0, apperrors.NewAppError(apperrors.CodeNotFound, "product not found").
+					WithDetail("product_id", b.ProductId)
+			}
+		}
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error creating product batch")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	return int(id), nil
+}
+
+// AddSectionCapacity updates the section current_capacity by delta.
+func (r *receivingRepository) AddSectionCapacity(ctx context.Context, exec Executor, sectionId int, delta int) error {
+	if _, err := exec.ExecContext(ctx, querySectionCapacityAdd, delta, sectionId); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating section capacity")
+	}
+	return nil
+}
+
+// CreateInboundOrder inserts the inbound order.
+func (r *receivingRepository) CreateInboundOrder(ctx context.Context, exec Executor, o inboundOrderModels.InboundOrder) (*inboundOrderModels.InboundOrder, error) {
+	res, err := exec.ExecContext(ctx, queryInboundOrderCreate, o.OrderDate, o.OrderNumber, o.EmployeeID, o.ProductBatchID, o.WarehouseID, o.AsnID)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error creating inbound order")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	o.ID = int(id)
+	return &o, nil
+}
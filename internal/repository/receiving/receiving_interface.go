@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/database"
+	inboundOrderModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inbound_order"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/receiving"
+)
+
+// ReceivingRepository defines the data operations needed to receive a product batch into a warehouse.
+type ReceivingRepository interface {
+	// ExistsOrderNumber reports whether an inbound order already uses the order number.
+	ExistsOrderNumber(ctx context.Context, exec Executor, orderNumber string) (bool, error)
+
+	// FindEmployeeWarehouse returns the id of the warehouse an employee works at.
+	FindEmployeeWarehouse(ctx context.Context, exec Executor, employeeId int) (int, error)
+
+	// FindSectionForUpdate returns a section, locking it until the transaction ends.
+	FindSectionForUpdate(ctx context.Context, exec Executor, sectionId int) (*models.ReceivingSection, error)
+
+	// CreateBatch inserts the batch with no stock and returns its id. Its stock is posted afterwards through the ledger.
+	CreateBatch(ctx context.Context, exec Executor, b batchModels.ProductBatches) (int, error)
+
+	// AddSectionCapacity adds delta to a section current_capacity.
+	AddSectionCapacity(ctx context.Context, exec Executor, sectionId int, delta int) error
+
+	// CreateInboundOrder inserts the inbound order and returns it with its generated id.
+	CreateInboundOrder(ctx context.Context, exec Executor, o inboundOrderModels.InboundOrder) (*inboundOrderModels.InboundOrder, error)
+
+	database.Transactor
+}
+
+// Executor runs SQL statements either on the connection or inside a transaction.
+type Executor = database.Executor
+
+// receivingRepository implements ReceivingRepository using MySQL.
+type receivingRepository struct {
+	database.MySQLTransactor
+	mysql *sql.DB
+}
+
+// NewReceivingRepository returns a new ReceivingRepository using the given MySQL connection.
+func NewReceivingRepository(mysql *sql.DB) ReceivingRepository {
+	return &receivingRepository{
+		MySQLTransactor: database.NewMySQLTransactor(mysql),
+		mysql:           mysql,
+	}
+}
@@ -0,0 +1,90 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/mappers"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/receiving"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestReceivingRepository_CreateBatch(t *testing.T) {
+	const query = `INSERT INTO product_batches \(batch_number, current_quantity, (.+)\)\s+VALUES \(\?, 0, (.+)\)`
+	batch := mappers.RequestToProductBatch(testhelpers.DummyPostProductBatch(1))
+
+	testCases := []struct {
+		name     string
+		dbMock   func() (sqlmock.Sqlmock, *sql.DB)
+		expected int
+		errCode  string
+	}{
+		{
+			name: "success - batch created without stock",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectBegin()
+				mock.ExpectExec(query).WithArgs(batch.BatchNumber, batch.CurrentTemperature, batch.DueDate, batch.InitialQuantity,
+					batch.ManufacturingDate, batch.ManufacturingHour, batch.MinimumTemperature, batch.ProductId, batch.SectionId).
+					WillReturnResult(sqlmock.NewResult(9, 1))
+				return mock, db
+			},
+			expected: 9,
+		},
+		{
+			name: "error - batch number taken",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectBegin()
+				mock.ExpectExec(query).WillReturnError(&mysql.MySQLError{Number: 1062})
+				return mock, db
+			},
+			errCode: apperrors.CodeConflict,
+		},
+		{
+			name: "error - product missing",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectBegin()
+				mock.ExpectExec(query).WillReturnError(&mysql.MySQLError{Number: 1452})
+				return mock, db
+			},
+			errCode: apperrors.CodeNotFound,
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectBegin()
+				mock.ExpectExec(query).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewReceivingRepository(db)
+			tx, err := repo.BeginTx(context.Background())
+			require.NoError(t, err)
+
+			id, err := repo.CreateBatch(context.Background(), tx, batch)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, id)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/replenishment"
+)
+
+const (
+	queryReorderPointColumns  = `SELECT id, product_id, warehouse_id, reorder_point, target_quantity FROM reorder_points`
+	queryReorderPointFindAll  = queryReorderPointColumns + ` WHERE (? = 0 OR warehouse_id = ?) AND (? = 0 OR product_id = ?) ORDER BY id`
+	queryReorderPointFindById = queryReorderPointColumns + ` WHERE id = ?`
+	queryReorderPointCreate   = `INSERT INTO reorder_points (product_id, warehouse_id, reorder_point, target_quantity) VALUES (?, ?, ?, ?)`
+	queryReorderPointUpdate   = `UPDATE reorder_points SET reorder_point = ?, target_quantity = ? WHERE id = ?`
+	queryReorderPointDelete   = `DELETE FROM reorder_points WHERE id = ?`
+	// On hand only counts stock that can be sold: available batches not past their due date.
+	// Demand is what orders took from the warehouse through allocations that were not released.
+	queryStockLevels = `SELECT rp.id, rp.product_id, rp.warehouse_id, rp.reorder_point, rp.target_quantity,
+			p.product_code, w.warehouse_code, p.seller_id, se.company_name,
+			COALESCE((SELECT SUM(pb.current_quantity) FROM product_batches pb INNER JOIN sections s ON s.id = pb.section_id
+				WHERE pb.product_id = rp.product_id AND s.warehouse_id = rp.warehouse_id AND pb.status = 'available' AND pb.due_date > ?), 0),
+			COALESCE((SELECT SUM(sa.quantity) FROM stock_allocations sa INNER JOIN product_batches pb ON pb.id = sa.product_batch_id
+				INNER JOIN sections s ON s.id = pb.section_id
+				WHERE pb.product_id = rp.product_id AND s.warehouse_id = rp.warehouse_id AND sa.status <> 'released' AND sa.created_at >= ?), 0)
+		FROM reorder_points rp
+		INNER JOIN products p ON p.id = rp.product_id
+		INNER JOIN sellers se ON se.id = p.seller_id
+		INNER JOIN warehouse w ON w.id = rp.warehouse_id
+		WHERE (? = 0 OR rp.warehouse_id = ?) AND (? = 0 OR p.seller_id = ?)
+		ORDER BY p.seller_id, rp.product_id, rp.warehouse_id`
+)
+
+// FindAll returns the reorder points in id order; zero filters are ignored.
+func (r *replenishmentRepository) FindAll(ctx context.Context, warehouseId int, productId int) ([]models.ReorderPoint, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryReorderPointFindAll, warehouseId, warehouseId, productId, productId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying reorder points")
+	}
+	defer rows.Close()
+
+	points := make([]models.ReorderPoint, 0)
+	for rows.Next() {
+		var p models.ReorderPoint
+		if err := rows.Scan(&p.Id, &p.ProductId, &p.WarehouseId, &p.ReorderPoint, &p.TargetQuantity); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning reorder point")
+		}
+		points = append(points, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating reorder points")
+	}
+	return points, nil
+}
+
+// FindById returns the reorder point.
+// Returns a not found error if it does not exist.
+func (r *replenishmentRepository) FindById(ctx context.Context, id int) (*models.ReorderPoint, error) {
+	var p models.ReorderPoint
+	err := r.mysql.QueryRowContext(ctx, queryReorderPointFindById, id).
+		Scan(&p.Id, &p.ProductId, &p.WarehouseId, &p.ReorderPoint, &p.TargetQuantity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "reorder point not found")
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying reorder point")
+	}
+	return &p, nil
+}
+
+// Create inserts the reorder point.
+// Returns a conflict error if the product already has one at the warehouse, and a not found
+// error if the product or the warehouse does not exist.
+func (r *replenishmentRepository) Create(ctx context.Context, p models.ReorderPoint) (*models.ReorderPoint, error) {
+	res, err := r.mysql.ExecContext(ctx, queryReorderPointCreate, p.ProductId, p.WarehouseId, p.ReorderPoint, p.TargetQuantity)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
+			switch mysqlErr.Number {
+			case 1062:
+				return nil, apperrors.NewAppError(apperrors.CodeConflict, "the product already has a reorder point at this warehouse").
+					WithDetail("product_id", p.ProductId).
+					WithDetail("warehouse_id", p.WarehouseId)
+			case 1452:
+				return nil, apperrors.NewAppError(apperrors.CodeNotFound, "product or warehouse not found").
+					WithDetail("product_id", p.ProductId).
+					WithDetail("warehouse_id", p.WarehouseId)
+			}
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error creating reorder point")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	p.Id = int(id)
+	return &p, nil
+}
+
+// Update stores the reorder point levels.
+func (r *replenishmentRepository) Update(ctx context.Context, p models.ReorderPoint) error {
+	if _, err := r.mysql.ExecContext(ctx, queryReorderPointUpdate, p.ReorderPoint, p.TargetQuantity, p.Id); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating reorder point")
+	}
+	return nil
+}
+
+// Delete removes the reorder point.
+// Returns a not found error if it does not exist.
+func (r *replenishmentRepository) Delete(ctx context.Context, id int) error {
+	res, err := r.mysql.ExecContext(ctx, queryReorderPointDelete, id)
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error deleting reorder point")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error getting rows affected")
+	}
+	if n == 0 {
+		return apperrors.NewAppError(apperrors.CodeNotFound, "reorder point not found")
+	}
+	return nil
+}
+
+// FindStockLevels returns the reorder points with their stock and demand, ordered by seller, product and warehouse.
+func (r *replenishmentRepository) FindStockLevels(ctx context.Context, now time.Time, since time.Time, warehouseId int, sellerId int) ([]models.StockLevel, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryStockLevels, now, since, warehouseId, warehouseId, sellerId, sellerId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying stock levels")
+	}
+	defer rows.Close()
+
+	levels := make([]models.StockLevel, 0)
+	for rows.Next() {
+		var l models.StockLevel
+		if err := rows.Scan(&l.Id, &l.ProductId, &l.WarehouseId, &l.ReorderPoint.ReorderPoint, &l.TargetQuantity,
+			&l.ProductCode, &l.WarehouseCode, &l.SellerId, &l.CompanyName, &l.OnHand, &l.Demand); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning stock level")
+		}
+		levels = append(levels, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating stock levels")
+	}
+	return levels, nil
+}
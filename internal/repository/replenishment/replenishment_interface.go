@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/replenishment"
+)
+
+// ReplenishmentRepository defines the data operations of reorder points and the stock levels they are checked against.
+type ReplenishmentRepository interface {
+	// FindAll returns the reorder points, optionally filtered by warehouse and product.
+	FindAll(ctx context.Context, warehouseId int, productId int) ([]models.ReorderPoint, error)
+
+	// FindById returns a reorder point.
+	FindById(ctx context.Context, id int) (*models.ReorderPoint, error)
+
+	// Create inserts a reorder point and returns it with its generated id.
+	Create(ctx context.Context, p models.ReorderPoint) (*models.ReorderPoint, error)
+
+	// Update stores the levels of a reorder point.
+	Update(ctx context.Context, p models.ReorderPoint) error
+
+	// Delete removes a reorder point.
+	Delete(ctx context.Context, id int) error
+
+	// FindStockLevels returns every reorder point with the stock on hand at now and the demand allocated since.
+	FindStockLevels(ctx context.Context, now time.Time, since time.Time, warehouseId int, sellerId int) ([]models.StockLevel, error)
+}
+
+// replenishmentRepository implements ReplenishmentRepository using MySQL.
+type replenishmentRepository struct {
+	mysql *sql.DB
+}
+
+// NewReplenishmentRepository returns a new ReplenishmentRepository using the given MySQL connection.
+func NewReplenishmentRepository(mysql *sql.DB) ReplenishmentRepository {
+	return &replenishmentRepository{
+		mysql: mysql,
+	}
+}
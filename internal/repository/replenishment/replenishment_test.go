@@ -0,0 +1,149 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/replenishment"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/replenishment"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestReplenishmentRepository_Create(t *testing.T) {
+	const query = `INSERT INTO reorder_points \(product_id, warehouse_id, reorder_point, target_quantity\) VALUES \(\?, \?, \?, \?\)`
+	point := testhelpers.DummyReorderPoint(0)
+
+	testCases := []struct {
+		name     string
+		dbMock   func() (sqlmock.Sqlmock, *sql.DB)
+		expected *models.ReorderPoint
+		errCode  string
+	}{
+		{
+			name: "success",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WithArgs(1, 1, 20, 100).WillReturnResult(sqlmock.NewResult(7, 1))
+				return mock, db
+			},
+			expected: &models.ReorderPoint{Id: 7, ProductId: 1, WarehouseId: 1, ReorderPoint: 20, TargetQuantity: 100},
+		},
+		{
+			name: "error - duplicate product and warehouse",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WillReturnError(&mysql.MySQLError{Number: 1062})
+				return mock, db
+			},
+			errCode: apperrors.CodeConflict,
+		},
+		{
+			name: "error - product or warehouse missing",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WillReturnError(&mysql.MySQLError{Number: 1452})
+				return mock, db
+			},
+			errCode: apperrors.CodeNotFound,
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewReplenishmentRepository(db)
+
+			result, err := repo.Create(context.Background(), point)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestReplenishmentRepository_FindStockLevels(t *testing.T) {
+	columns := []string{"id", "product_id", "warehouse_id", "reorder_point", "target_quantity",
+		"product_code", "warehouse_code", "seller_id", "company_name", "on_hand", "demand"}
+	const query = `SELECT rp.id, rp.product_id, (.+) FROM reorder_points rp (.+) WHERE \(\? = 0 OR rp.warehouse_id = \?\) AND \(\? = 0 OR p.seller_id = \?\)`
+	level := testhelpers.DummyStockLevel(3, 1, 12, 40)
+
+	testCases := []struct {
+		name     string
+		dbMock   func() (sqlmock.Sqlmock, *sql.DB)
+		expected []models.StockLevel
+		errCode  string
+	}{
+		{
+			name: "success",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 0, 0, 3, 3).WillReturnRows(sqlmock.NewRows(columns).
+					AddRow(level.Id, level.ProductId, level.WarehouseId, level.ReorderPoint.ReorderPoint, level.TargetQuantity,
+						level.ProductCode, level.WarehouseCode, level.SellerId, level.CompanyName, level.OnHand, level.Demand))
+				return mock, db
+			},
+			expected: []models.StockLevel{level},
+		},
+		{
+			name: "error - scan fails",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows(columns).
+					AddRow("bad", 1, 1, 20, 100, "P001", "WH001", 3, "Acme", 0, 0))
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewReplenishmentRepository(db)
+
+			now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+			result, err := repo.FindStockLevels(context.Background(), now, now.AddDate(0, 0, -30), 0, 3)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/routing"
+)
+
+const (
+	queryDepot     = `SELECT id, latitude, longitude FROM warehouse WHERE id = ?`
+	queryPlace     = `SELECT id, name, latitude, longitude FROM localities WHERE id = ?`
+	queryShipments = `SELECT s.id, s.tracking_code, l.id, l.name, l.latitude, l.longitude
+		FROM shipments s
+		INNER JOIN localities l ON l.id = s.destination_locality_id
+		WHERE s.warehouse_id = ? AND s.carrier_id = ? AND s.status <> 'delivered'
+		AND s.promised_at >= ? AND s.promised_at < ?
+		ORDER BY l.id, s.id`
+)
+
+// FindDepot returns the warehouse with its coordinates, which are nil when not set.
+// Returns a not found error if it does not exist.
+func (r *routingRepository) FindDepot(ctx context.Context, warehouseId int) (*models.Depot, error) {
+	var d models.Depot
+	var latitude, longitude sql.NullFloat64
+	if err := r.mysql.QueryRowContext(ctx, queryDepot, warehouseId).Scan(&d.WarehouseId, &latitude, &longitude); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "warehouse not found").WithDetail("warehouse_id", warehouseId)
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying warehouse")
+	}
+	d.Latitude, d.Longitude = nullableFloat(latitude), nullableFloat(longitude)
+	return &d, nil
+}
+
+// FindPlace returns the locality with its coordinates, which are nil when not set.
+// Returns a not found error if it does not exist.
+func (r *routingRepository) FindPlace(ctx context.Context, localityId string) (*models.Place, error) {
+	var p models.Place
+	var latitude, longitude sql.NullFloat64
+	if err := r.mysql.QueryRowContext(ctx, queryPlace, localityId).Scan(&p.LocalityId, &p.LocalityName, &latitude, &longitude); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "locality not found").WithDetail("locality_id", localityId)
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying locality")
+	}
+	p.Latitude, p.Longitude = nullableFloat(latitude), nullableFloat(longitude)
+	return &p, nil
+}
+
+// FindShipments returns the shipments ordered by destination locality, so those going to the same place are adjacent.
+func (r *routingRepository) FindShipments(ctx context.Context, warehouseId, carrierId int, from, to time.Time) ([]models.RouteShipment, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryShipments, warehouseId, carrierId, from, to)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying shipments")
+	}
+	defer rows.Close()
+
+	shipments := make([]models.RouteShipment, 0)
+	for rows.Next() {
+		var s models.RouteShipment
+		var latitude, longitude sql.NullFloat64
+		if err := rows.Scan(&s.ShipmentId, &s.TrackingCode, &s.LocalityId, &s.LocalityName, &latitude, &longitude); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning shipment")
+		}
+		s.Latitude, s.Longitude = nullableFloat(latitude), nullableFloat(longitude)
+		shipments = append(shipments, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating shipments")
+	}
+	return shipments, nil
+}
+
+func nullableFloat(v sql.NullFloat64) *float64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Float64
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/routing"
+)
+
+// RoutingRepository defines the data operations route planning reads: where warehouses and localities are,
+// and which shipments a carrier has to deliver.
+type RoutingRepository interface {
+	// FindDepot returns the coordinates of a warehouse.
+	FindDepot(ctx context.Context, warehouseId int) (*models.Depot, error)
+
+	// FindPlace returns the coordinates of a locality.
+	FindPlace(ctx context.Context, localityId string) (*models.Place, error)
+
+	// FindShipments returns the undelivered shipments of a carrier leaving a warehouse that are promised within [from, to).
+	FindShipments(ctx context.Context, warehouseId, carrierId int, from, to time.Time) ([]models.RouteShipment, error)
+}
+
+// routingRepository implements RoutingRepository using MySQL.
+type routingRepository struct {
+	mysql *sql.DB
+}
+
+// NewRoutingRepository returns a new RoutingRepository using the given MySQL connection.
+func NewRoutingRepository(mysql *sql.DB) RoutingRepository {
+	return &routingRepository{
+		mysql: mysql,
+	}
+}
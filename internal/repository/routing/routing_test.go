@@ -0,0 +1,141 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/routing"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/routing"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestRoutingRepository_FindDepot(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT id, latitude, longitude FROM warehouse WHERE id = ?`)
+
+	testCases := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		err     error
+		want    *models.Depot
+		errCode string
+	}{
+		{
+			name: "success",
+			rows: sqlmock.NewRows([]string{"id", "latitude", "longitude"}).AddRow(1, -34.603722, -58.381592),
+			want: &models.Depot{WarehouseId: 1, Latitude: testhelpers.Float64Ptr(-34.603722), Longitude: testhelpers.Float64Ptr(-58.381592)},
+		},
+		{
+			name: "success - no coordinates",
+			rows: sqlmock.NewRows([]string{"id", "latitude", "longitude"}).AddRow(1, nil, nil),
+			want: &models.Depot{WarehouseId: 1},
+		},
+		{
+			name:    "error - not found",
+			rows:    sqlmock.NewRows([]string{"id", "latitude", "longitude"}),
+			errCode: apperrors.CodeNotFound,
+		},
+		{
+			name:    "error - query fails",
+			err:     errors.New("db down"),
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := testhelpers.CreateMockDB()
+			defer db.Close()
+			expect := mock.ExpectQuery(query).WithArgs(1)
+			if tc.err != nil {
+				expect.WillReturnError(tc.err)
+			} else {
+				expect.WillReturnRows(tc.rows)
+			}
+			repo := repository.NewRoutingRepository(db)
+
+			depot, err := repo.FindDepot(context.Background(), 1)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.want, depot)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRoutingRepository_FindPlace(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT id, name, latitude, longitude FROM localities WHERE id = ?`)
+
+	t.Run("success", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		mock.ExpectQuery(query).WithArgs("1000").WillReturnRows(
+			sqlmock.NewRows([]string{"id", "name", "latitude", "longitude"}).AddRow("1000", "Buenos Aires", -34.603722, nil))
+		repo := repository.NewRoutingRepository(db)
+
+		place, err := repo.FindPlace(context.Background(), "1000")
+
+		require.NoError(t, err)
+		require.Equal(t, &models.Place{LocalityId: "1000", LocalityName: "Buenos Aires", Latitude: testhelpers.Float64Ptr(-34.603722)}, place)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		mock.ExpectQuery(query).WithArgs("9999").WillReturnRows(sqlmock.NewRows([]string{"id", "name", "latitude", "longitude"}))
+		repo := repository.NewRoutingRepository(db)
+
+		_, err := repo.FindPlace(context.Background(), "9999")
+
+		testhelpers.RequireAppErr(t, err, apperrors.CodeNotFound)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRoutingRepository_FindShipments(t *testing.T) {
+	query := regexp.QuoteMeta(`WHERE s.warehouse_id = ? AND s.carrier_id = ? AND s.status <> 'delivered'`)
+	from := time.Date(2025, 6, 2, 0, 0, 0, 0, time.Local)
+	to := from.AddDate(0, 0, 1)
+	columns := []string{"id", "tracking_code", "locality_id", "name", "latitude", "longitude"}
+
+	t.Run("success", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		mock.ExpectQuery(query).WithArgs(1, 2, from, to).WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(10, "TRK-10", "1000", "Buenos Aires", -34.603722, -58.381592).
+			AddRow(11, "TRK-11", "3000", "Unmapped", nil, nil))
+		repo := repository.NewRoutingRepository(db)
+
+		shipments, err := repo.FindShipments(context.Background(), 1, 2, from, to)
+
+		require.NoError(t, err)
+		require.Equal(t, []models.RouteShipment{
+			{ShipmentId: 10, TrackingCode: "TRK-10", Place: models.Place{LocalityId: "1000", LocalityName: "Buenos Aires",
+				Latitude: testhelpers.Float64Ptr(-34.603722), Longitude: testhelpers.Float64Ptr(-58.381592)}},
+			{ShipmentId: 11, TrackingCode: "TRK-11", Place: models.Place{LocalityId: "3000", LocalityName: "Unmapped"}},
+		}, shipments)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - query fails", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		mock.ExpectQuery(query).WithArgs(1, 2, from, to).WillReturnError(errors.New("db down"))
+		repo := repository.NewRoutingRepository(db)
+
+		_, err := repo.FindShipments(context.Background(), 1, 2, from, to)
+
+		testhelpers.RequireAppErr(t, err, apperrors.CodeInternal)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,319 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipment"
+)
+
+const (
+	queryShipmentOrdersForUpdate = `SELECT po.id, po.order_status_id, so.shipment_id
+		FROM purchase_orders po LEFT JOIN shipment_orders so ON so.purchase_order_id = po.id
+		WHERE po.id IN (%s) ORDER BY po.id FOR UPDATE`
+	queryShipmentCreate = `INSERT INTO shipments (tracking_code, carrier_id, warehouse_id, destination_locality_id, status, promised_at,
+		max_temperature, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	queryShipmentAddOrder    = `INSERT INTO shipment_orders (shipment_id, purchase_order_id) VALUES (?, ?)`
+	queryShipmentStampOrders = `UPDATE purchase_orders po INNER JOIN shipment_orders so ON so.purchase_order_id = po.id
+		SET po.tracking_code = ? WHERE so.shipment_id = ?`
+	queryShipmentEventCreate = `INSERT INTO shipment_events (shipment_id, status, location, description, occurred_at, temperature,
+		external_event_id, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	queryShipmentEventExists  = `SELECT EXISTS(SELECT 1 FROM shipment_events WHERE shipment_id = ? AND external_event_id = ?)`
+	queryShipmentUpdateStatus = `UPDATE shipments SET status = ?, updated_at = ? WHERE id = ?`
+	queryShipmentColumns      = `SELECT id, tracking_code, carrier_id, warehouse_id, destination_locality_id, status, promised_at,
+		max_temperature, created_at, updated_at FROM shipments`
+	queryShipmentFindAll        = queryShipmentColumns + ` WHERE (? = 0 OR carrier_id = ?) AND (? = '' OR status = ?) ORDER BY created_at DESC, id DESC`
+	queryShipmentByTrackingCode = queryShipmentColumns + ` WHERE tracking_code = ?`
+	queryShipmentForUpdate      = queryShipmentByTrackingCode + ` FOR UPDATE`
+	queryShipmentOrderIds       = `SELECT purchase_order_id FROM shipment_orders WHERE shipment_id = ? ORDER BY purchase_order_id`
+	queryShipmentEvents         = `SELECT id, shipment_id, status, location, description, occurred_at, temperature, external_event_id,
+		created_at FROM shipment_events WHERE shipment_id = ? ORDER BY occurred_at, id`
+	queryShipmentPerformance = `SELECT s.id, s.carrier_id, c.cid, c.company_name, s.promised_at, s.created_at,
+		(SELECT MIN(e.occurred_at) FROM shipment_events e WHERE e.shipment_id = s.id AND e.status = 'picked_up'),
+		(SELECT MAX(e.occurred_at) FROM shipment_events e WHERE e.shipment_id = s.id AND e.status = 'delivered'),
+		(SELECT COUNT(*) FROM shipment_events e WHERE e.shipment_id = s.id AND e.status = 'failed'),
+		s.max_temperature IS NOT NULL,
+		(SELECT COUNT(*) FROM shipment_events e WHERE e.shipment_id = s.id AND e.temperature > s.max_temperature)
+		FROM shipments s INNER JOIN carriers c ON c.id = s.carrier_id
+		WHERE s.created_at >= ? AND s.created_at < ? AND (? = 0 OR s.carrier_id = ?)
+		ORDER BY s.carrier_id, s.id`
+)
+
+// FindOrdersForUpdate locks the purchase orders in id order.
+// Ids that do not exist are simply missing from the result.
+func (r *shipmentRepository) FindOrdersForUpdate(ctx context.Context, exec Executor, orderIds []int) ([]models.ShipmentOrder, error) {
+	if len(orderIds) == 0 {
+		return []models.ShipmentOrder{}, nil
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(orderIds)), ",")
+	args := make([]any, len(orderIds))
+	for i, id := range orderIds {
+		args[i] = id
+	}
+
+	rows, err := exec.QueryContext(ctx, fmt.Sprintf(queryShipmentOrdersForUpdate, placeholders), args...)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying purchase orders")
+	}
+	defer rows.Close()
+
+	orders := make([]models.ShipmentOrder, 0, len(orderIds))
+	for rows.Next() {
+		var o models.ShipmentOrder
+		var shipmentId sql.NullInt64
+		if err := rows.Scan(&o.Id, &o.OrderStatusId, &shipmentId); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning purchase order")
+		}
+		if shipmentId.Valid {
+			id := int(shipmentId.Int64)
+			o.ShipmentId = &id
+		}
+		orders = append(orders, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating purchase orders")
+	}
+	return orders, nil
+}
+
+// Create inserts the shipment.
+// Returns a conflict error if the tracking code is taken and a not found error if the carrier,
+// warehouse or destination locality does not exist.
+func (r *shipmentRepository) Create(ctx context.Context, exec Executor, s models.Shipment) (int, error) {
+	res, err := exec.ExecContext(ctx, queryShipmentCreate, s.TrackingCode, s.CarrierId, s.WarehouseId, s.DestinationLocalityId,
+		s.Status, s.PromisedAt, s.MaxTemperature, s.CreatedAt, s.UpdatedAt)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
+			switch mysqlErr.Number {
+			case 1062:
+				return 0, apperrors.NewAppError(apperrors.CodeConflict, "tracking_code already exists").
+					WithDetail("tracking_code", s.TrackingCode)
+			case 1452:
+				return 0, apperrors.NewAppError(apperrors.CodeNotFound, "carrier, warehouse or locality not found").
+					WithDetail("carrier_id", s.CarrierId).
+					WithDetail("warehouse_id", s.WarehouseId).
+					WithDetail("destination_locality_id", s.DestinationLocalityId)
+			}
+		}
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error creating shipment")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	return int(id), nil
+}
+
+// AddOrder links the purchase order to the shipment.
+// Returns a conflict error if another shipment already carries the order.
+func (r *shipmentRepository) AddOrder(ctx context.Context, exec Executor, shipmentId int, orderId int) error {
+	if _, err := exec.ExecContext(ctx, queryShipmentAddOrder, shipmentId, orderId); err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1062 {
+			return apperrors.NewAppError(apperrors.CodeConflict, "purchase order already shipped").
+				WithDetail("purchase_order_id", orderId)
+		}
+		return apperrors.NewAppError(apperrors.CodeInternal, "error adding purchase order to shipment")
+	}
+	return nil
+}
+
+// StampTrackingCode sets the tracking_code of the purchase orders the shipment carries.
+func (r *shipmentRepository) StampTrackingCode(ctx context.Context, exec Executor, shipmentId int, trackingCode string) error {
+	if _, err := exec.ExecContext(ctx, queryShipmentStampOrders, trackingCode, shipmentId); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating purchase order tracking code")
+	}
+	return nil
+}
+
+// CreateEvent inserts the event.
+// Returns a conflict error if the shipment already has an event with the same external id.
+func (r *shipmentRepository) CreateEvent(ctx context.Context, exec Executor, e models.Event) (int, error) {
+	res, err := exec.ExecContext(ctx, queryShipmentEventCreate, e.ShipmentId, e.Status, e.Location, e.Description, e.OccurredAt,
+		e.Temperature, e.ExternalEventId, e.CreatedAt)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1062 {
+			return 0, apperrors.NewAppError(apperrors.CodeConflict, "shipment event already recorded").
+				WithDetail("external_event_id", *e.ExternalEventId)
+		}
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error creating shipment event")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	return int(id), nil
+}
+
+// ExistsExternalEvent reports whether the shipment already has the event with the external id.
+func (r *shipmentRepository) ExistsExternalEvent(ctx context.Context, exec Executor, shipmentId int, externalEventId string) (bool, error) {
+	var exists bool
+	if err := exec.QueryRowContext(ctx, queryShipmentEventExists, shipmentId, externalEventId).Scan(&exists); err != nil {
+		return false, apperrors.NewAppError(apperrors.CodeInternal, "error querying shipment event")
+	}
+	return exists, nil
+}
+
+// UpdateStatus stores the status and update time of the shipment.
+func (r *shipmentRepository) UpdateStatus(ctx context.Context, exec Executor, s models.Shipment) error {
+	if _, err := exec.ExecContext(ctx, queryShipmentUpdateStatus, s.Status, s.UpdatedAt, s.Id); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating shipment")
+	}
+	return nil
+}
+
+// FindAll returns the shipments matching the filter, latest first.
+func (r *shipmentRepository) FindAll(ctx context.Context, filter models.ShipmentFilter) ([]models.Shipment, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryShipmentFindAll, filter.CarrierId, filter.CarrierId, filter.Status, filter.Status)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying shipments")
+	}
+	defer rows.Close()
+
+	shipments := make([]models.Shipment, 0)
+	for rows.Next() {
+		s, err := scanShipment(rows)
+		if err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning shipment")
+		}
+		shipments = append(shipments, *s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating shipments")
+	}
+	return shipments, nil
+}
+
+// FindByTrackingCode returns the shipment.
+// Returns a not found error if no shipment has the tracking code.
+func (r *shipmentRepository) FindByTrackingCode(ctx context.Context, trackingCode string) (*models.Shipment, error) {
+	return findShipment(r.mysql.QueryRowContext(ctx, queryShipmentByTrackingCode, trackingCode), trackingCode)
+}
+
+// FindByTrackingCodeForUpdate returns the shipment and locks its row.
+// Returns a not found error if no shipment has the tracking code.
+func (r *shipmentRepository) FindByTrackingCodeForUpdate(ctx context.Context, exec Executor, trackingCode string) (*models.Shipment, error) {
+	return findShipment(exec.QueryRowContext(ctx, queryShipmentForUpdate, trackingCode), trackingCode)
+}
+
+// FindOrderIds returns the purchase order ids of the shipment in id order.
+func (r *shipmentRepository) FindOrderIds(ctx context.Context, shipmentId int) ([]int, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryShipmentOrderIds, shipmentId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying shipment orders")
+	}
+	defer rows.Close()
+
+	ids := make([]int, 0)
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning shipment order")
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating shipment orders")
+	}
+	return ids, nil
+}
+
+// FindEvents returns the events of the shipment, oldest first.
+func (r *shipmentRepository) FindEvents(ctx context.Context, shipmentId int) ([]models.Event, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryShipmentEvents, shipmentId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying shipment events")
+	}
+	defer rows.Close()
+
+	events := make([]models.Event, 0)
+	for rows.Next() {
+		var e models.Event
+		var temperature sql.NullFloat64
+		var externalEventId sql.NullString
+		if err := rows.Scan(&e.Id, &e.ShipmentId, &e.Status, &e.Location, &e.Description, &e.OccurredAt, &temperature,
+			&externalEventId, &e.CreatedAt); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning shipment event")
+		}
+		if temperature.Valid {
+			e.Temperature = &temperature.Float64
+		}
+		if externalEventId.Valid {
+			e.ExternalEventId = &externalEventId.String
+		}
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating shipment events")
+	}
+	return events, nil
+}
+
+// FindPerformance returns the shipments created in the period, by carrier, with their pickup and delivery
+// times, failed attempts and temperature excursions.
+func (r *shipmentRepository) FindPerformance(ctx context.Context, filter models.PerformanceFilter) ([]models.ShipmentPerformance, error) {
+	to := filter.To.AddDate(0, 0, 1)
+	rows, err := r.mysql.QueryContext(ctx, queryShipmentPerformance, filter.From, to, filter.CarrierId, filter.CarrierId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying carrier performance")
+	}
+	defer rows.Close()
+
+	shipments := make([]models.ShipmentPerformance, 0)
+	for rows.Next() {
+		var p models.ShipmentPerformance
+		var promisedAt, pickedUpAt, deliveredAt sql.NullTime
+		if err := rows.Scan(&p.ShipmentId, &p.CarrierId, &p.Cid, &p.CompanyName, &promisedAt, &p.CreatedAt, &pickedUpAt,
+			&deliveredAt, &p.FailedAttempts, &p.ColdChain, &p.Excursions); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning carrier performance")
+		}
+		if promisedAt.Valid {
+			p.PromisedAt = &promisedAt.Time
+		}
+		if pickedUpAt.Valid {
+			p.PickedUpAt = &pickedUpAt.Time
+		}
+		if deliveredAt.Valid {
+			p.DeliveredAt = &deliveredAt.Time
+		}
+		shipments = append(shipments, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating carrier performance")
+	}
+	return shipments, nil
+}
+
+// findShipment scans a single shipment row, mapping a missing row to a not found error.
+func findShipment(row *sql.Row, trackingCode string) (*models.Shipment, error) {
+	s, err := scanShipment(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "shipment not found").
+				WithDetail("tracking_code", trackingCode)
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying shipment")
+	}
+	return s, nil
+}
+
+func scanShipment(row interface{ Scan(dest ...any) error }) (*models.Shipment, error) {
+	var s models.Shipment
+	var promisedAt sql.NullTime
+	var maxTemperature sql.NullFloat64
+	if err := row.Scan(&s.Id, &s.TrackingCode, &s.CarrierId, &s.WarehouseId, &s.DestinationLocalityId, &s.Status,
+		&promisedAt, &maxTemperature, &s.CreatedAt, &s.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if promisedAt.Valid {
+		s.PromisedAt = &promisedAt.Time
+	}
+	if maxTemperature.Valid {
+		s.MaxTemperature = &maxTemperature.Float64
+	}
+	return &s, nil
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/database"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipment"
+)
+
+// ShipmentRepository defines the data operations of shipments, the purchase orders they carry and their events.
+type ShipmentRepository interface {
+	// FindOrdersForUpdate returns the purchase orders with the shipment already carrying them, if any, and locks them.
+	FindOrdersForUpdate(ctx context.Context, exec Executor, orderIds []int) ([]models.ShipmentOrder, error)
+
+	// Create inserts a shipment and returns its generated id.
+	Create(ctx context.Context, exec Executor, s models.Shipment) (int, error)
+
+	// AddOrder links a purchase order to a shipment.
+	AddOrder(ctx context.Context, exec Executor, shipmentId int, orderId int) error
+
+	// StampTrackingCode copies the tracking code of a shipment onto the purchase orders it carries.
+	StampTrackingCode(ctx context.Context, exec Executor, shipmentId int, trackingCode string) error
+
+	// CreateEvent inserts a shipment event and returns its generated id.
+	CreateEvent(ctx context.Context, exec Executor, e models.Event) (int, error)
+
+	// ExistsExternalEvent reports whether a shipment already has the carrier event with the given id.
+	ExistsExternalEvent(ctx context.Context, exec Executor, shipmentId int, externalEventId string) (bool, error)
+
+	// UpdateStatus stores the status of a shipment.
+	UpdateStatus(ctx context.Context, exec Executor, s models.Shipment) error
+
+	// FindAll returns the shipments matching the filter, latest first.
+	FindAll(ctx context.Context, filter models.ShipmentFilter) ([]models.Shipment, error)
+
+	// FindByTrackingCode returns a shipment.
+	FindByTrackingCode(ctx context.Context, trackingCode string) (*models.Shipment, error)
+
+	// FindByTrackingCodeForUpdate returns a shipment, locking it until the transaction ends.
+	FindByTrackingCodeForUpdate(ctx context.Context, exec Executor, trackingCode string) (*models.Shipment, error)
+
+	// FindOrderIds returns the ids of the purchase orders a shipment carries.
+	FindOrderIds(ctx context.Context, shipmentId int) ([]int, error)
+
+	// FindEvents returns the events of a shipment in the order they happened.
+	FindEvents(ctx context.Context, shipmentId int) ([]models.Event, error)
+
+	// FindPerformance returns what the carrier performance report needs about each shipment of the period.
+	FindPerformance(ctx context.Context, filter models.PerformanceFilter) ([]models.ShipmentPerformance, error)
+
+	database.Transactor
+}
+
+// Executor runs SQL statements either on the connection or inside a transaction.
+type Executor = database.Executor
+
+// shipmentRepository implements ShipmentRepository using MySQL.
+type shipmentRepository struct {
+	database.MySQLTransactor
+	mysql *sql.DB
+}
+
+// NewShipmentRepository returns a new ShipmentRepository using the given MySQL connection.
+func NewShipmentRepository(mysql *sql.DB) ShipmentRepository {
+	return &shipmentRepository{
+		MySQLTransactor: database.NewMySQLTransactor(mysql),
+		mysql:           mysql,
+	}
+}
@@ -0,0 +1,235 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/shipment"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipment"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+var shipmentColumns = []string{"id", "tracking_code", "carrier_id", "warehouse_id", "destination_locality_id", "status",
+	"promised_at", "max_temperature", "created_at", "updated_at"}
+
+func TestShipmentRepository_FindOrdersForUpdate(t *testing.T) {
+	query := regexp.QuoteMeta(`WHERE po.id IN (?,?) ORDER BY po.id FOR UPDATE`)
+
+	mock, db := testhelpers.CreateMockDB()
+	defer db.Close()
+	mock.ExpectQuery(query).WithArgs(7, 8).WillReturnRows(sqlmock.NewRows([]string{"id", "order_status_id", "shipment_id"}).
+		AddRow(7, 2, nil).
+		AddRow(8, 2, 3))
+	repo := repository.NewShipmentRepository(db)
+
+	orders, err := repo.FindOrdersForUpdate(context.Background(), db, []int{7, 8})
+
+	require.NoError(t, err)
+	require.Equal(t, []models.ShipmentOrder{
+		{Id: 7, OrderStatusId: 2},
+		{Id: 8, OrderStatusId: 2, ShipmentId: testhelpers.IntPtr(3)},
+	}, orders)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestShipmentRepository_Create(t *testing.T) {
+	query := regexp.QuoteMeta(`INSERT INTO shipments (tracking_code, carrier_id, warehouse_id, destination_locality_id, status, promised_at,
+		max_temperature, created_at, updated_at)`)
+	shipment := testhelpers.DummyShipment(models.StatusCreated)
+
+	testCases := []struct {
+		name    string
+		dbMock  func() (sqlmock.Sqlmock, *sql.DB)
+		errCode string
+	}{
+		{
+			name: "success",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WithArgs(shipment.TrackingCode, 2, 1, "1001", "created", nil, nil, shipment.CreatedAt, shipment.UpdatedAt).
+					WillReturnResult(sqlmock.NewResult(4, 1))
+				return mock, db
+			},
+		},
+		{
+			name: "error - tracking code taken",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WillReturnError(&mysql.MySQLError{Number: 1062})
+				return mock, db
+			},
+			errCode: apperrors.CodeConflict,
+		},
+		{
+			name: "error - carrier missing",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WillReturnError(&mysql.MySQLError{Number: 1452})
+				return mock, db
+			},
+			errCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewShipmentRepository(db)
+
+			id, err := repo.Create(context.Background(), db, shipment)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 4, id)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestShipmentRepository_AddOrder(t *testing.T) {
+	query := regexp.QuoteMeta(`INSERT INTO shipment_orders (shipment_id, purchase_order_id) VALUES (?, ?)`)
+
+	mock, db := testhelpers.CreateMockDB()
+	defer db.Close()
+	mock.ExpectExec(query).WithArgs(4, 7).WillReturnError(&mysql.MySQLError{Number: 1062})
+	repo := repository.NewShipmentRepository(db)
+
+	err := repo.AddOrder(context.Background(), db, 4, 7)
+
+	testhelpers.RequireAppErr(t, err, apperrors.CodeConflict)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestShipmentRepository_FindByTrackingCode(t *testing.T) {
+	query := regexp.QuoteMeta(`FROM shipments WHERE tracking_code = ?`)
+	shipment := testhelpers.DummyShipment(models.StatusInTransit)
+
+	testCases := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		errCode string
+	}{
+		{
+			name: "success",
+			rows: sqlmock.NewRows(shipmentColumns).AddRow(4, shipment.TrackingCode, 2, 1, "1001", "in_transit", nil,
+				nil, shipment.CreatedAt, shipment.UpdatedAt),
+		},
+		{
+			name:    "error - not found",
+			rows:    sqlmock.NewRows(shipmentColumns),
+			errCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := testhelpers.CreateMockDB()
+			defer db.Close()
+			mock.ExpectQuery(query).WithArgs(shipment.TrackingCode).WillReturnRows(tc.rows)
+			repo := repository.NewShipmentRepository(db)
+
+			result, err := repo.FindByTrackingCode(context.Background(), shipment.TrackingCode)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, &shipment, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestShipmentRepository_CreateEvent(t *testing.T) {
+	query := regexp.QuoteMeta(`INSERT INTO shipment_events (shipment_id, status, location, description, occurred_at, temperature,
+		external_event_id, created_at)`)
+	eventId := "ev-1"
+	event := models.Event{ShipmentId: 4, Status: models.StatusDelivered, ExternalEventId: &eventId}
+
+	testCases := []struct {
+		name    string
+		dbMock  func() (sqlmock.Sqlmock, *sql.DB)
+		errCode string
+	}{
+		{
+			name: "success",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WithArgs(4, "delivered", "", "", event.OccurredAt, nil, &eventId, event.CreatedAt).
+					WillReturnResult(sqlmock.NewResult(3, 1))
+				return mock, db
+			},
+		},
+		{
+			name: "error - carrier event already recorded",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WillReturnError(&mysql.MySQLError{Number: 1062})
+				return mock, db
+			},
+			errCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewShipmentRepository(db)
+
+			id, err := repo.CreateEvent(context.Background(), db, event)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 3, id)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestShipmentRepository_FindPerformance(t *testing.T) {
+	query := regexp.QuoteMeta(`WHERE s.created_at >= ? AND s.created_at < ? AND (? = 0 OR s.carrier_id = ?)`)
+	from := time.Date(2025, 6, 1, 0, 0, 0, 0, time.Local)
+	to := time.Date(2025, 6, 30, 0, 0, 0, 0, time.Local)
+	createdAt := time.Date(2025, 6, 2, 9, 0, 0, 0, time.Local)
+	deliveredAt := time.Date(2025, 6, 3, 15, 0, 0, 0, time.Local)
+	columns := []string{"id", "carrier_id", "cid", "company_name", "promised_at", "created_at", "picked_up_at", "delivered_at",
+		"failed", "cold_chain", "excursions"}
+
+	mock, db := testhelpers.CreateMockDB()
+	defer db.Close()
+	mock.ExpectQuery(query).WithArgs(from, time.Date(2025, 7, 1, 0, 0, 0, 0, time.Local), 2, 2).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(4, 2, "CID-2", "Carrier", nil, createdAt, nil, deliveredAt, 1, true, 2).
+			AddRow(5, 2, "CID-2", "Carrier", deliveredAt, createdAt, createdAt, nil, 0, false, 0))
+	repo := repository.NewShipmentRepository(db)
+
+	shipments, err := repo.FindPerformance(context.Background(), models.PerformanceFilter{From: from, To: to, CarrierId: 2})
+
+	require.NoError(t, err)
+	require.Len(t, shipments, 2)
+	require.Equal(t, models.ShipmentPerformance{
+		ShipmentId: 4, CarrierId: 2, Cid: "CID-2", CompanyName: "Carrier", CreatedAt: createdAt,
+		DeliveredAt: &deliveredAt, FailedAttempts: 1, ColdChain: true, Excursions: 2,
+	}, shipments[0])
+	require.Equal(t, &deliveredAt, shipments[1].PromisedAt)
+	require.Equal(t, &createdAt, shipments[1].PickedUpAt)
+	require.Nil(t, shipments[1].DeliveredAt)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipping"
+)
+
+const (
+	queryCoverageColumns = `SELECT a.id, a.carrier_id, a.locality_id, a.province_id, a.country_id, a.transit_days, a.created_at,
+		c.cid, c.company_name, b.id, b.max_weight, b.max_volume, b.price
+		FROM carrier_coverage_areas a
+		INNER JOIN carriers c ON c.id = a.carrier_id
+		LEFT JOIN carrier_rate_bands b ON b.coverage_area_id = a.id`
+	queryCoverageOrder     = ` ORDER BY a.carrier_id, a.id, b.max_weight, b.max_volume, b.id`
+	queryCoverageFindAll   = queryCoverageColumns + ` WHERE (? = 0 OR a.carrier_id = ?)` + queryCoverageOrder
+	queryCoverageFindById  = queryCoverageColumns + ` WHERE a.id = ?` + queryCoverageOrder
+	queryCoverageFindPlace = queryCoverageColumns + ` WHERE a.locality_id = ? OR a.province_id = ? OR a.country_id = ?` + queryCoverageOrder
+	queryCoverageExists    = `SELECT EXISTS(SELECT 1 FROM carrier_coverage_areas WHERE carrier_id = ?
+		AND locality_id <=> ? AND province_id <=> ? AND country_id <=> ?)`
+	queryCoverageCreate = `INSERT INTO carrier_coverage_areas (carrier_id, locality_id, province_id, country_id, transit_days, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)`
+	queryRateBandCreate  = `INSERT INTO carrier_rate_bands (coverage_area_id, max_weight, max_volume, price) VALUES (?, ?, ?, ?)`
+	queryRateBandsDelete = `DELETE FROM carrier_rate_bands WHERE coverage_area_id = ?`
+	queryCoverageDelete  = `DELETE FROM carrier_coverage_areas WHERE id = ?`
+	queryPlace           = `SELECT l.id, l.province_id, p.country_id FROM localities l
+		INNER JOIN provinces p ON p.id = l.province_id WHERE l.id = ?`
+	queryWarehousePlace = `SELECT l.id, l.province_id, p.country_id FROM warehouse w
+		INNER JOIN localities l ON l.id = w.locality_id
+		INNER JOIN provinces p ON p.id = l.province_id WHERE w.id = ?`
+	queryProductMeasures = `SELECT id, COALESCE(net_weight, 0), COALESCE(width, 0), COALESCE(height, 0), COALESCE(length, 0)
+		FROM products WHERE id IN (%s) ORDER BY id`
+)
+
+// FindAreas returns the coverage areas ordered by carrier, optionally of a single carrier.
+func (r *shippingRepository) FindAreas(ctx context.Context, filter models.CoverageFilter) ([]models.CoverageArea, error) {
+	coverage, err := r.queryCoverage(ctx, queryCoverageFindAll, filter.CarrierId, filter.CarrierId)
+	if err != nil {
+		return nil, err
+	}
+	areas := make([]models.CoverageArea, len(coverage))
+	for i, c := range coverage {
+		areas[i] = c.CoverageArea
+	}
+	return areas, nil
+}
+
+// FindAreaById returns the coverage area.
+// Returns a not found error if it does not exist.
+func (r *shippingRepository) FindAreaById(ctx context.Context, id int) (*models.CoverageArea, error) {
+	coverage, err := r.queryCoverage(ctx, queryCoverageFindById, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(coverage) == 0 {
+		return nil, apperrors.NewAppError(apperrors.CodeNotFound, "coverage area not found").WithDetail("id", id)
+	}
+	return &coverage[0].CoverageArea, nil
+}
+
+// ExistsArea compares the three area columns null-safely, as only one of them is set.
+func (r *shippingRepository) ExistsArea(ctx context.Context, exec Executor, a models.CoverageArea) (bool, error) {
+	var exists bool
+	if err := exec.QueryRowContext(ctx, queryCoverageExists, a.CarrierId, a.LocalityId, a.ProvinceId, a.CountryId).Scan(&exists); err != nil {
+		return false, apperrors.NewAppError(apperrors.CodeInternal, "error checking coverage area")
+	}
+	return exists, nil
+}
+
+// CreateArea inserts the coverage area.
+// Returns a not found error if the carrier or the locality, province or country does not exist.
+func (r *shippingRepository) CreateArea(ctx context.Context, exec Executor, a models.CoverageArea) (int, error) {
+	res, err := exec.ExecContext(ctx, queryCoverageCreate, a.CarrierId, a.LocalityId, a.ProvinceId, a.CountryId, a.TransitDays, a.CreatedAt)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1452 {
+			return 0, apperrors.NewAppError(apperrors.CodeNotFound, "carrier or area not found").WithDetail("carrier_id", a.CarrierId)
+		}
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error creating coverage area")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error getting coverage area id")
+	}
+	return int(id), nil
+}
+
+// CreateBand inserts the rate band.
+// Returns a conflict error if the area already has a band with the same limits.
+func (r *shippingRepository) CreateBand(ctx context.Context, exec Executor, b models.RateBand) (int, error) {
+	res, err := exec.ExecContext(ctx, queryRateBandCreate, b.CoverageAreaId, b.MaxWeight, b.MaxVolume, b.Price)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1062 {
+			return 0, apperrors.NewAppError(apperrors.CodeConflict, "rate band already exists").
+				WithDetail("max_weight", b.MaxWeight).
+				WithDetail("max_volume", b.MaxVolume)
+		}
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error creating rate band")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error getting rate band id")
+	}
+	return int(id), nil
+}
+
+func (r *shippingRepository) DeleteBands(ctx context.Context, exec Executor, areaId int) error {
+	if _, err := exec.ExecContext(ctx, queryRateBandsDelete, areaId); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error deleting rate bands")
+	}
+	return nil
+}
+
+// DeleteArea removes the coverage area.
+// Returns a not found error if it does not exist.
+func (r *shippingRepository) DeleteArea(ctx context.Context, exec Executor, id int) error {
+	res, err := exec.ExecContext(ctx, queryCoverageDelete, id)
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error deleting coverage area")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error getting rows affected")
+	}
+	if n == 0 {
+		return apperrors.NewAppError(apperrors.CodeNotFound, "coverage area not found").WithDetail("id", id)
+	}
+	return nil
+}
+
+// FindCoverage returns the areas naming the locality, its province or its country.
+func (r *shippingRepository) FindCoverage(ctx context.Context, place models.Place) ([]models.CarrierCoverage, error) {
+	return r.queryCoverage(ctx, queryCoverageFindPlace, place.LocalityId, place.ProvinceId, place.CountryId)
+}
+
+// FindPlace returns the locality.
+// Returns a not found error if it does not exist.
+func (r *shippingRepository) FindPlace(ctx context.Context, localityId string) (*models.Place, error) {
+	var p models.Place
+	if err := r.mysql.QueryRowContext(ctx, queryPlace, localityId).Scan(&p.LocalityId, &p.ProvinceId, &p.CountryId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "locality not found").WithDetail("locality_id", localityId)
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying locality")
+	}
+	return &p, nil
+}
+
+// FindWarehousePlace returns where the warehouse is.
+// Returns a not found error if it does not exist.
+func (r *shippingRepository) FindWarehousePlace(ctx context.Context, warehouseId int) (*models.Place, error) {
+	var p models.Place
+	if err := r.mysql.QueryRowContext(ctx, queryWarehousePlace, warehouseId).Scan(&p.LocalityId, &p.ProvinceId, &p.CountryId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "warehouse not found").WithDetail("warehouse_id", warehouseId)
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying warehouse")
+	}
+	return &p, nil
+}
+
+// FindProductMeasures returns the products in id order, counting missing measures as zero.
+// Ids that do not exist are simply missing from the result.
+func (r *shippingRepository) FindProductMeasures(ctx context.Context, productIds []int) ([]models.ProductMeasures, error) {
+	if len(productIds) == 0 {
+		return []models.ProductMeasures{}, nil
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(productIds)), ",")
+	args := make([]any, len(productIds))
+	for i, id := range productIds {
+		args[i] = id
+	}
+
+	rows, err := r.mysql.QueryContext(ctx, fmt.Sprintf(queryProductMeasures, placeholders), args...)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying products")
+	}
+	defer rows.Close()
+
+	measures := make([]models.ProductMeasures, 0, len(productIds))
+	for rows.Next() {
+		var m models.ProductMeasures
+		if err := rows.Scan(&m.ProductId, &m.NetWeight, &m.Width, &m.Height, &m.Length); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning product")
+		}
+		measures = append(measures, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating products")
+	}
+	return measures, nil
+}
+
+// queryCoverage runs a coverage query and folds the rate band rows into their areas.
+func (r *shippingRepository) queryCoverage(ctx context.Context, query string, args ...any) ([]models.CarrierCoverage, error) {
+	rows, err := r.mysql.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying coverage areas")
+	}
+	defer rows.Close()
+
+	coverage := make([]models.CarrierCoverage, 0)
+	for rows.Next() {
+		var c models.CarrierCoverage
+		var localityId sql.NullString
+		var provinceId, countryId, bandId sql.NullInt64
+		var maxWeight, maxVolume, price sql.NullFloat64
+		if err := rows.Scan(&c.Id, &c.CarrierId, &localityId, &provinceId, &countryId, &c.TransitDays, &c.CreatedAt,
+			&c.Cid, &c.CompanyName, &bandId, &maxWeight, &maxVolume, &price); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning coverage area")
+		}
+		if n := len(coverage); n == 0 || coverage[n-1].Id != c.Id {
+			if localityId.Valid {
+				c.LocalityId = &localityId.String
+			}
+			if provinceId.Valid {
+				id := int(provinceId.Int64)
+				c.ProvinceId = &id
+			}
+			if countryId.Valid {
+				id := int(countryId.Int64)
+				c.CountryId = &id
+			}
+			c.RateBands = []models.RateBand{}
+			coverage = append(coverage, c)
+		}
+		if bandId.Valid {
+			area := &coverage[len(coverage)-1]
+			area.RateBands = append(area.RateBands, models.RateBand{
+				Id:             int(bandId.Int64),
+				CoverageAreaId: area.Id,
+				MaxWeight:      maxWeight.Float64,
+				MaxVolume:      maxVolume.Float64,
+				Price:          price.Float64,
+			})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating coverage areas")
+	}
+	return coverage, nil
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/database"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipping"
+)
+
+// ShippingRepository defines the data operations of carrier coverage areas, their rate bands,
+// and what quoting a shipment reads about places and products.
+type ShippingRepository interface {
+	// FindAreas returns the coverage areas matching the filter, with their rate bands.
+	FindAreas(ctx context.Context, filter models.CoverageFilter) ([]models.CoverageArea, error)
+
+	// FindAreaById returns a coverage area with its rate bands.
+	FindAreaById(ctx context.Context, id int) (*models.CoverageArea, error)
+
+	// ExistsArea reports whether the carrier already covers the same locality, province or country.
+	ExistsArea(ctx context.Context, exec Executor, a models.CoverageArea) (bool, error)
+
+	// CreateArea inserts a coverage area and returns its id.
+	CreateArea(ctx context.Context, exec Executor, a models.CoverageArea) (int, error)
+
+	// CreateBand inserts a rate band of a coverage area and returns its id.
+	CreateBand(ctx context.Context, exec Executor, b models.RateBand) (int, error)
+
+	// DeleteBands removes the rate bands of a coverage area.
+	DeleteBands(ctx context.Context, exec Executor, areaId int) error
+
+	// DeleteArea removes a coverage area.
+	DeleteArea(ctx context.Context, exec Executor, id int) error
+
+	// FindCoverage returns every coverage area, with its carrier and rate bands, that includes the place.
+	FindCoverage(ctx context.Context, place models.Place) ([]models.CarrierCoverage, error)
+
+	// FindPlace returns a locality with its province and country.
+	FindPlace(ctx context.Context, localityId string) (*models.Place, error)
+
+	// FindWarehousePlace returns the locality, province and country of a warehouse.
+	FindWarehousePlace(ctx context.Context, warehouseId int) (*models.Place, error)
+
+	// FindProductMeasures returns the weight and dimensions of the given products.
+	FindProductMeasures(ctx context.Context, productIds []int) ([]models.ProductMeasures, error)
+
+	database.Transactor
+}
+
+// Executor runs SQL statements either on the connection or inside a transaction.
+type Executor = database.Executor
+
+// shippingRepository implements ShippingRepository using MySQL.
+type shippingRepository struct {
+	database.MySQLTransactor
+	mysql *sql.DB
+}
+
+// NewShippingRepository returns a new ShippingRepository using the given MySQL connection.
+func NewShippingRepository(mysql *sql.DB) ShippingRepository {
+	return &shippingRepository{
+		MySQLTransactor: database.NewMySQLTransactor(mysql),
+		mysql:           mysql,
+	}
+}
@@ -0,0 +1,170 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/shipping"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipping"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+var coverageColumns = []string{"id", "carrier_id", "locality_id", "province_id", "country_id", "transit_days", "created_at",
+	"cid", "company_name", "band_id", "max_weight", "max_volume", "price"}
+
+func TestShippingRepository_FindCoverage(t *testing.T) {
+	query := regexp.QuoteMeta(`WHERE a.locality_id = ? OR a.province_id = ? OR a.country_id = ?`)
+	createdAt := time.Date(2025, 6, 2, 9, 0, 0, 0, time.Local)
+	place := models.Place{LocalityId: "1001", ProvinceId: 2, CountryId: 1}
+
+	mock, db := testhelpers.CreateMockDB()
+	defer db.Close()
+	mock.ExpectQuery(query).WithArgs("1001", 2, 1).WillReturnRows(sqlmock.NewRows(coverageColumns).
+		AddRow(10, 2, nil, nil, 1, 2, createdAt, "CID-2", "Carrier", 100, 5.0, 5000.0, 500.0).
+		AddRow(10, 2, nil, nil, 1, 2, createdAt, "CID-2", "Carrier", 101, 20.0, 5000.0, 700.0).
+		AddRow(11, 3, "1001", nil, nil, 1, createdAt, "CID-3", "Carrier", nil, nil, nil, nil))
+	repo := repository.NewShippingRepository(db)
+
+	coverage, err := repo.FindCoverage(context.Background(), place)
+
+	require.NoError(t, err)
+	require.Len(t, coverage, 2)
+	require.Equal(t, 1, *coverage[0].CountryId)
+	require.Nil(t, coverage[0].LocalityId)
+	require.Equal(t, []models.RateBand{
+		{Id: 100, CoverageAreaId: 10, MaxWeight: 5, MaxVolume: 5000, Price: 500},
+		{Id: 101, CoverageAreaId: 10, MaxWeight: 20, MaxVolume: 5000, Price: 700},
+	}, coverage[0].RateBands)
+	require.Equal(t, "1001", *coverage[1].LocalityId)
+	require.Equal(t, "CID-3", coverage[1].Cid)
+	require.Empty(t, coverage[1].RateBands)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestShippingRepository_FindPlace(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT l.id, l.province_id, p.country_id FROM localities l`)
+
+	testCases := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		want    *models.Place
+		errCode string
+	}{
+		{
+			name: "success",
+			rows: sqlmock.NewRows([]string{"id", "province_id", "country_id"}).AddRow("1001", 2, 1),
+			want: &models.Place{LocalityId: "1001", ProvinceId: 2, CountryId: 1},
+		},
+		{
+			name:    "error - locality not found",
+			rows:    sqlmock.NewRows([]string{"id", "province_id", "country_id"}),
+			errCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := testhelpers.CreateMockDB()
+			defer db.Close()
+			mock.ExpectQuery(query).WithArgs("1001").WillReturnRows(tc.rows)
+			repo := repository.NewShippingRepository(db)
+
+			place, err := repo.FindPlace(context.Background(), "1001")
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, place)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.want, place)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestShippingRepository_CreateArea(t *testing.T) {
+	query := regexp.QuoteMeta(`INSERT INTO carrier_coverage_areas (carrier_id, locality_id, province_id, country_id, transit_days, created_at)`)
+	area := models.CoverageArea{CarrierId: 2, ProvinceId: testhelpers.IntPtr(2), TransitDays: 3}
+
+	testCases := []struct {
+		name    string
+		dbMock  func() (sqlmock.Sqlmock, *sql.DB)
+		errCode string
+	}{
+		{
+			name: "success",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WithArgs(2, area.LocalityId, area.ProvinceId, area.CountryId, 3, area.CreatedAt).
+					WillReturnResult(sqlmock.NewResult(7, 1))
+				return mock, db
+			},
+		},
+		{
+			name: "error - carrier or province not found",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WillReturnError(&mysql.MySQLError{Number: 1452})
+				return mock, db
+			},
+			errCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewShippingRepository(db)
+
+			id, err := repo.CreateArea(context.Background(), db, area)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 7, id)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestShippingRepository_DeleteArea(t *testing.T) {
+	query := regexp.QuoteMeta(`DELETE FROM carrier_coverage_areas WHERE id = ?`)
+
+	testCases := []struct {
+		name    string
+		rows    int64
+		errCode string
+	}{
+		{name: "success", rows: 1},
+		{name: "error - not found", rows: 0, errCode: apperrors.CodeNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := testhelpers.CreateMockDB()
+			defer db.Close()
+			mock.ExpectExec(query).WithArgs(7).WillReturnResult(sqlmock.NewResult(0, tc.rows))
+			repo := repository.NewShippingRepository(db)
+
+			err := repo.DeleteArea(context.Background(), db, 7)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
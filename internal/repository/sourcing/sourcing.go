@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/sourcing"
+)
+
+const (
+	queryStock = `SELECT w.id, w.warehouse_code, w.latitude, w.longitude, b.product_id, SUM(b.current_quantity)
+		FROM product_batches b
+		INNER JOIN sections s ON s.id = b.section_id
+		INNER JOIN warehouse w ON w.id = s.warehouse_id
+		WHERE b.product_id IN (%s) AND b.current_quantity > 0 AND b.due_date > NOW() AND b.status = 'available'
+		GROUP BY w.id, w.warehouse_code, w.latitude, w.longitude, b.product_id
+		ORDER BY w.id, b.product_id`
+)
+
+// FindStock counts the same batches confirming an order allocates from: available, not expired and not empty.
+// Warehouses holding none of the products are missing from the result.
+func (r *sourcingRepository) FindStock(ctx context.Context, productIds []int) ([]models.WarehouseStock, error) {
+	if len(productIds) == 0 {
+		return []models.WarehouseStock{}, nil
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(productIds)), ",")
+	args := make([]any, len(productIds))
+	for i, id := range productIds {
+		args[i] = id
+	}
+
+	rows, err := r.mysql.QueryContext(ctx, fmt.Sprintf(queryStock, placeholders), args...)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying warehouse stock")
+	}
+	defer rows.Close()
+
+	stock := make([]models.WarehouseStock, 0)
+	for rows.Next() {
+		var s models.WarehouseStock
+		var latitude, longitude sql.NullFloat64
+		if err := rows.Scan(&s.WarehouseId, &s.WarehouseCode, &latitude, &longitude, &s.ProductId, &s.Available); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning warehouse stock")
+		}
+		if latitude.Valid && longitude.Valid {
+			s.Latitude, s.Longitude = &latitude.Float64, &longitude.Float64
+		}
+		stock = append(stock, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating warehouse stock")
+	}
+	return stock, nil
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/sourcing"
+)
+
+// SourcingRepository defines the data operations choosing warehouses to fill an order reads.
+type SourcingRepository interface {
+	// FindStock returns, per warehouse, the stock of the given products that can still be allocated.
+	FindStock(ctx context.Context, productIds []int) ([]models.WarehouseStock, error)
+}
+
+// sourcingRepository implements SourcingRepository using MySQL.
+type sourcingRepository struct {
+	mysql *sql.DB
+}
+
+// NewSourcingRepository returns a new SourcingRepository using the given MySQL connection.
+func NewSourcingRepository(mysql *sql.DB) SourcingRepository {
+	return &sourcingRepository{
+		mysql: mysql,
+	}
+}
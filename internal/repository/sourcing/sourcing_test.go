@@ -0,0 +1,62 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/sourcing"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/sourcing"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestSourcingRepository_FindStock(t *testing.T) {
+	query := regexp.QuoteMeta(`WHERE b.product_id IN (?,?) AND b.current_quantity > 0`)
+	columns := []string{"id", "warehouse_code", "latitude", "longitude", "product_id", "available"}
+
+	t.Run("success", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		mock.ExpectQuery(query).WithArgs(7, 8).WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(1, "W1", -34.603722, -58.381592, 7, 10).
+			AddRow(2, "W2", nil, nil, 8, 3))
+		repo := repository.NewSourcingRepository(db)
+
+		stock, err := repo.FindStock(context.Background(), []int{7, 8})
+
+		require.NoError(t, err)
+		require.Equal(t, []models.WarehouseStock{
+			{WarehouseId: 1, WarehouseCode: "W1", Latitude: testhelpers.Float64Ptr(-34.603722), Longitude: testhelpers.Float64Ptr(-58.381592), ProductId: 7, Available: 10},
+			{WarehouseId: 2, WarehouseCode: "W2", ProductId: 8, Available: 3},
+		}, stock)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - no products", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		repo := repository.NewSourcingRepository(db)
+
+		stock, err := repo.FindStock(context.Background(), []int{})
+
+		require.NoError(t, err)
+		require.Empty(t, stock)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - query fails", func(t *testing.T) {
+		mock, db := testhelpers.CreateMockDB()
+		defer db.Close()
+		mock.ExpectQuery(query).WithArgs(7, 8).WillReturnError(errors.New("db down"))
+		repo := repository.NewSourcingRepository(db)
+
+		_, err := repo.FindStock(context.Background(), []int{7, 8})
+
+		testhelpers.RequireAppErr(t, err, apperrors.CodeInternal)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+)
+
+const (
+	queryBatchQuantityApply = `UPDATE product_batches SET current_quantity = current_quantity + ? WHERE id = ? AND current_quantity + ? >= 0`
+	queryMovementCreate     = `INSERT INTO stock_movements (product_batch_id, movement_type, quantity, reason, actor, created_at) VALUES (?, ?, ?, ?, ?, ?)`
+	queryMovementsByBatch   = `SELECT id, product_batch_id, movement_type, quantity, reason, actor, created_at FROM stock_movements
+		WHERE product_batch_id = ? ORDER BY created_at ASC, id ASC`
+	queryBatchExists = `SELECT EXISTS(SELECT 1 FROM product_batches WHERE id = ?)`
+	queryDrift       = `SELECT pb.id, pb.batch_number, pb.current_quantity, COALESCE(SUM(sm.quantity), 0) AS ledger_balance
+		FROM product_batches pb LEFT JOIN stock_movements sm ON sm.product_batch_id = pb.id
+		GROUP BY pb.id, pb.batch_number, pb.current_quantity
+		HAVING pb.current_quantity <> ledger_balance
+		ORDER BY pb.id`
+)
+
+// Append applies the movement quantity to the batch and stores the movement.
+// Returns an out of stock error if a negative movement would leave the batch below zero,
+// and a not found error if a positive movement targets a batch that does not exist.
+func (r *stockMovementRepository) Append(ctx context.Context, exec Executor, m models.StockMovement) (*models.StockMovement, error) {
+	res, err := exec.ExecContext(ctx, queryBatchQuantityApply, m.Quantity, m.ProductBatchId, m.Quantity)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error updating batch quantity")
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error updating batch quantity")
+	}
+	if rows == 0 {
+		if m.Quantity < 0 {
+			return nil, apperrors.NewAppError(apperrors.CodeOutOfStock, "product batch does not have enough stock").
+				WithDetail("product_batch_id", m.ProductBatchId)
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeNotFound, "product batch not found")
+	}
+
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = time.Now().UTC()
+	}
+	res, err = exec.ExecContext(ctx, queryMovementCreate, m.ProductBatchId, m.MovementType, m.Quantity, m.Reason, m.Actor, m.CreatedAt)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error creating stock movement")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	m.Id = int(id)
+	return &m, nil
+}
+
+// FindByBatch returns the ledger of a product batch, oldest movement first.
+func (r *stockMovementRepository) FindByBatch(ctx context.Context, batchId int) ([]models.StockMovement, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryMovementsByBatch, batchId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying stock movements")
+	}
+	defer rows.Close()
+
+	movements := make([]models.StockMovement, 0)
+	for rows.Next() {
+		var m models.StockMovement
+		if err := rows.Scan(&m.Id, &m.ProductBatchId, &m.MovementType, &m.Quantity, &m.Reason, &m.Actor, &m.CreatedAt); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning stock movement")
+		}
+		movements = append(movements, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating stock movements")
+	}
+	return movements, nil
+}
+
+// BatchExists reports whether the product batch exists.
+func (r *stockMovementRepository) BatchExists(ctx context.Context, batchId int) (bool, error) {
+	var exists bool
+	if err := r.mysql.QueryRowContext(ctx, queryBatchExists, batchId).Scan(&exists); err != nil {
+		return false, apperrors.NewAppError(apperrors.CodeInternal, "error checking product batch")
+	}
+	return exists, nil
+}
+
+// FindDrift compares every batch current_quantity with the sum of its movements
+// and returns the batches where they differ.
+func (r *stockMovementRepository) FindDrift(ctx context.Context) ([]models.BatchDrift, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryDrift)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error reconciling stock ledger")
+	}
+	defer rows.Close()
+
+	drifts := make([]models.BatchDrift, 0)
+	for rows.Next() {
+		var d models.BatchDrift
+		if err := rows.Scan(&d.ProductBatchId, &d.BatchNumber, &d.CurrentQuantity, &d.LedgerBalance); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning batch drift")
+		}
+		drifts = append(drifts, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating batch drift")
+	}
+	return drifts, nil
+}
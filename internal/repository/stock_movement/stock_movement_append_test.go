@@ -0,0 +1,107 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestStockMovementRepository_Append(t *testing.T) {
+	type testCase struct {
+		name     string
+		quantity int
+		dbMock   func(quantity int) (sqlmock.Sqlmock, *sql.DB)
+		errCode  string
+	}
+
+	const updateRegex = `UPDATE product_batches SET current_quantity = current_quantity \+ \?`
+	const insertRegex = `INSERT INTO stock_movements`
+
+	testCases := []testCase{
+		{
+			name:     "success - negative movement takes stock out",
+			quantity: -5,
+			dbMock: func(quantity int) (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(updateRegex).
+					WithArgs(quantity, 1, quantity).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(insertRegex).
+					WithArgs(1, models.MovementTypeAllocation, quantity, "test movement", models.ActorSystem, sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(9, 1))
+				return mock, db
+			},
+		},
+		{
+			name:     "error - batch would go below zero",
+			quantity: -5,
+			dbMock: func(quantity int) (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(updateRegex).
+					WithArgs(quantity, 1, quantity).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				return mock, db
+			},
+			errCode: apperrors.CodeOutOfStock,
+		},
+		{
+			name:     "error - positive movement on unknown batch",
+			quantity: 5,
+			dbMock: func(quantity int) (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(updateRegex).
+					WithArgs(quantity, 1, quantity).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				return mock, db
+			},
+			errCode: apperrors.CodeNotFound,
+		},
+		{
+			name:     "error - inserting the movement fails",
+			quantity: 5,
+			dbMock: func(quantity int) (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(updateRegex).
+					WithArgs(quantity, 1, quantity).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(insertRegex).
+					WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock(tc.quantity)
+			defer db.Close()
+			repo := repository.NewStockMovementRepository(db)
+
+			result, err := repo.Append(context.Background(), db, models.StockMovement{
+				ProductBatchId: 1,
+				MovementType:   models.MovementTypeAllocation,
+				Quantity:       tc.quantity,
+				Reason:         "test movement",
+				Actor:          models.ActorSystem,
+			})
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 9, result.Id)
+				require.False(t, result.CreatedAt.IsZero())
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
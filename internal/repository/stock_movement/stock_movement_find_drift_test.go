@@ -0,0 +1,75 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestStockMovementRepository_FindDrift(t *testing.T) {
+	type testCase struct {
+		name     string
+		dbMock   func() (sqlmock.Sqlmock, *sql.DB)
+		expected []models.BatchDrift
+		errCode  string
+	}
+
+	const query = `SELECT pb.id, pb.batch_number, pb.current_quantity, COALESCE\(SUM\(sm.quantity\), 0\) AS ledger_balance`
+	columns := []string{"id", "batch_number", "current_quantity", "ledger_balance"}
+
+	testCases := []testCase{
+		{
+			name: "success - drifted batches",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows(columns).AddRow(2, 102, 20, 25))
+				return mock, db
+			},
+			expected: []models.BatchDrift{{ProductBatchId: 2, BatchNumber: 102, CurrentQuantity: 20, LedgerBalance: 25}},
+		},
+		{
+			name: "success - ledger in sync",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows(columns))
+				return mock, db
+			},
+			expected: []models.BatchDrift{},
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewStockMovementRepository(db)
+
+			result, err := repo.FindDrift(context.Background())
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
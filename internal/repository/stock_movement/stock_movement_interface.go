@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/database"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+)
+
+// StockMovementRepository defines the data operations of the stock ledger.
+type StockMovementRepository interface {
+	// Append records a movement and applies its quantity to the batch current_quantity,
+	// keeping the column equal to the ledger's running balance.
+	Append(ctx context.Context, exec Executor, m models.StockMovement) (*models.StockMovement, error)
+
+	// FindByBatch returns the movements of a product batch, oldest first.
+	FindByBatch(ctx context.Context, batchId int) ([]models.StockMovement, error)
+
+	// BatchExists reports whether a product batch with the given id exists.
+	BatchExists(ctx context.Context, batchId int) (bool, error)
+
+	// FindDrift returns the batches whose current_quantity differs from the sum of their movements.
+	FindDrift(ctx context.Context) ([]models.BatchDrift, error)
+}
+
+// Executor runs SQL statements either on the connection or inside a transaction.
+type Executor = database.Executor
+
+// stockMovementRepository implements StockMovementRepository using MySQL.
+type stockMovementRepository struct {
+	mysql *sql.DB
+}
+
+// NewStockMovementRepository returns a new StockMovementRepository using the given MySQL connection.
+func NewStockMovementRepository(mysql *sql.DB) StockMovementRepository {
+	return &stockMovementRepository{
+		mysql: mysql,
+	}
+}
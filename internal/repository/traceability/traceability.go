@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	buyerModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/traceability"
+)
+
+const (
+	queryTraceBatch = `SELECT pb.id, pb.batch_number, pb.product_id, p.product_code, pb.status, pb.manufacturing_date, pb.due_date
+		FROM product_batches pb INNER JOIN products p ON p.id = pb.product_id WHERE pb.id = ?`
+	// Orders with allocations are linked to the exact batches they took stock from, including the batches
+	// transferred out of the traced one, recursively. Orders without allocations are linked to every batch
+	// of their product that was on the shelf on the order date; batches split off share the product and
+	// dates of their source, so only the traced batch is matched.
+	queryTraceBatchOrders = `WITH RECURSIVE lineage (id) AS (
+			SELECT id FROM product_batches WHERE id = ?
+			UNION
+			SELECT bt.destination_batch_id FROM batch_transfers bt INNER JOIN lineage l ON l.id = bt.source_batch_id
+		)
+		SELECT po.id, po.order_number, po.order_date, b.id, b.id_card_number, b.first_name, b.last_name, src.quantity, src.link
+		FROM (
+			SELECT sa.purchase_order_id AS order_id, SUM(sa.quantity) AS quantity, 'allocation' AS link
+			FROM stock_allocations sa WHERE sa.product_batch_id IN (SELECT id FROM lineage) AND sa.status <> 'released'
+			GROUP BY sa.purchase_order_id
+			UNION ALL
+			SELECT po2.id, NULL, 'product'
+			FROM product_batches pb INNER JOIN product_records pr ON pr.product_id = pb.product_id
+			INNER JOIN purchase_orders po2 ON po2.product_record_id = pr.id
+			WHERE pb.id = ? AND po2.order_date BETWEEN pb.manufacturing_date AND pb.due_date AND po2.order_status_id <> ?
+			AND NOT EXISTS (SELECT 1 FROM stock_allocations sa2 WHERE sa2.purchase_order_id = po2.id)
+		) src
+		INNER JOIN purchase_orders po ON po.id = src.order_id
+		INNER JOIN buyers b ON b.id = po.buyer_id
+		ORDER BY po.order_date ASC, po.id ASC`
+	queryTraceOrder = `SELECT po.id, po.order_number, po.order_date, po.buyer_id, pr.product_id
+		FROM purchase_orders po INNER JOIN product_records pr ON pr.id = po.product_record_id WHERE po.id = ?`
+	queryTraceOrderOrigins = `SELECT pb.id, pb.batch_number, pb.product_id, src.quantity, src.link,
+			io.id, io.order_number, io.order_date, e.id, e.first_name, e.last_name, w.id, w.warehouse_code
+		FROM (
+			SELECT sa.product_batch_id AS batch_id, SUM(sa.quantity) AS quantity, 'allocation' AS link
+			FROM stock_allocations sa WHERE sa.purchase_order_id = ? AND sa.status <> 'released' GROUP BY sa.product_batch_id
+			UNION ALL
+			SELECT pb2.id, NULL, 'product'
+			FROM purchase_orders po INNER JOIN product_records pr ON pr.id = po.product_record_id
+			INNER JOIN product_batches pb2 ON pb2.product_id = pr.product_id
+			WHERE po.id = ? AND po.order_date BETWEEN pb2.manufacturing_date AND pb2.due_date AND po.order_status_id <> ?
+			AND NOT EXISTS (SELECT 1 FROM stock_allocations sa2 WHERE sa2.purchase_order_id = po.id)
+		) src
+		INNER JOIN product_batches pb ON pb.id = src.batch_id
+		LEFT JOIN inbound_orders io ON io.product_batch_id = pb.id
+		LEFT JOIN employees e ON e.id = io.employee_id
+		LEFT JOIN warehouse w ON w.id = io.warehouse_id
+		ORDER BY pb.id ASC, io.id ASC`
+)
+
+// FindBatch returns the batch with its product code.
+// Returns a not found error if the batch does not exist.
+func (r *traceabilityRepository) FindBatch(ctx context.Context, batchId int) (*models.TracedBatch, error) {
+	var b models.TracedBatch
+	err := r.mysql.QueryRowContext(ctx, queryTraceBatch, batchId).
+		Scan(&b.Id, &b.BatchNumber, &b.ProductId, &b.ProductCode, &b.Status, &b.ManufacturingDate, &b.DueDate)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "product batch not found")
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying product batch")
+	}
+	return &b, nil
+}
+
+// FindBatchOrders returns the orders linked to the batch or to the batches transferred out of it, oldest first.
+// Cancelled orders are left out.
+func (r *traceabilityRepository) FindBatchOrders(ctx context.Context, batchId int) ([]models.TracedOrder, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryTraceBatchOrders, batchId, batchId, buyerModels.OrderStatusCancelled)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying batch orders")
+	}
+	defer rows.Close()
+
+	orders := make([]models.TracedOrder, 0)
+	for rows.Next() {
+		var o models.TracedOrder
+		var quantity sql.NullInt64
+		if err := rows.Scan(&o.PurchaseOrderId, &o.OrderNumber, &o.OrderDate, &o.BuyerId, &o.CardNumberId,
+			&o.FirstName, &o.LastName, &quantity, &o.Link); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning batch order")
+		}
+		o.Quantity = nullIntPtr(quantity)
+		orders = append(orders, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating batch orders")
+	}
+	return orders, nil
+}
+
+// FindOrder returns the purchase order with the product it was placed for.
+// Returns a not found error if the order does not exist.
+func (r *traceabilityRepository) FindOrder(ctx context.Context, orderId int) (*models.TracedPurchaseOrder, error) {
+	var o models.TracedPurchaseOrder
+	err := r.mysql.QueryRowContext(ctx, queryTraceOrder, orderId).
+		Scan(&o.Id, &o.OrderNumber, &o.OrderDate, &o.BuyerId, &o.ProductId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "purchase order not found")
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying purchase order")
+	}
+	return &o, nil
+}
+
+// FindOrderOrigins returns the batches linked to the order joined with their inbound orders, ordered by batch.
+// A cancelled order is linked to no batch.
+func (r *traceabilityRepository) FindOrderOrigins(ctx context.Context, orderId int) ([]models.BatchOrigin, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryTraceOrderOrigins, orderId, orderId, buyerModels.OrderStatusCancelled)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying order batches")
+	}
+	defer rows.Close()
+
+	origins := make([]models.BatchOrigin, 0)
+	for rows.Next() {
+		var o models.BatchOrigin
+		var quantity, inboundId, employeeId, warehouseId sql.NullInt64
+		var inboundNumber, firstName, lastName, warehouseCode sql.NullString
+		var inboundDate sql.NullTime
+		if err := rows.Scan(&o.ProductBatchId, &o.BatchNumber, &o.ProductId, &quantity, &o.Link,
+			&inboundId, &inboundNumber, &inboundDate, &employeeId, &firstName, &lastName, &warehouseId, &warehouseCode); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning order batch")
+		}
+		o.Quantity = nullIntPtr(quantity)
+		o.InboundOrderId = nullIntPtr(inboundId)
+		o.EmployeeId = nullIntPtr(employeeId)
+		o.WarehouseId = nullIntPtr(warehouseId)
+		o.InboundOrderNumber = nullStringPtr(inboundNumber)
+		o.EmployeeFirstName = nullStringPtr(firstName)
+		o.EmployeeLastName = nullStringPtr(lastName)
+		o.WarehouseCode = nullStringPtr(warehouseCode)
+		if inboundDate.Valid {
+			o.InboundOrderDate = &inboundDate.Time
+		}
+		origins = append(origins, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating order batches")
+	}
+	return origins, nil
+}
+
+func nullIntPtr(v sql.NullInt64) *int {
+	if !v.Valid {
+		return nil
+	}
+	i := int(v.Int64)
+	return &i
+}
+
+func nullStringPtr(v sql.NullString) *string {
+	if !v.Valid {
+		return nil
+	}
+	return &v.String
+}
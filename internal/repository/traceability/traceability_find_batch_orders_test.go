@@ -0,0 +1,80 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/traceability"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	buyerModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/traceability"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestTraceabilityRepository_FindBatchOrders(t *testing.T) {
+	columns := []string{"id", "order_number", "order_date", "buyer_id", "id_card_number", "first_name", "last_name", "quantity", "link"}
+	const query = `WITH RECURSIVE lineage (.+) SELECT po.id, (.+) FROM \(\s+SELECT sa.purchase_order_id (.+) UNION ALL (.+)\) src\s+INNER JOIN purchase_orders po ON po.id = src.order_id\s+INNER JOIN buyers b ON b.id = po.buyer_id`
+	allocated := testhelpers.DummyTracedOrder(1, 10, models.LinkAllocation)
+	matched := testhelpers.DummyTracedOrder(2, 20, models.LinkProduct)
+
+	testCases := []struct {
+		name     string
+		dbMock   func() (sqlmock.Sqlmock, *sql.DB)
+		expected []models.TracedOrder
+		errCode  string
+	}{
+		{
+			name: "success - allocation and product links",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1, 1, buyerModels.OrderStatusCancelled).WillReturnRows(sqlmock.NewRows(columns).
+					AddRow(allocated.PurchaseOrderId, allocated.OrderNumber, allocated.OrderDate, allocated.BuyerId, allocated.CardNumberId,
+						allocated.FirstName, allocated.LastName, 10, allocated.Link).
+					AddRow(matched.PurchaseOrderId, matched.OrderNumber, matched.OrderDate, matched.BuyerId, matched.CardNumberId,
+						matched.FirstName, matched.LastName, nil, matched.Link))
+				return mock, db
+			},
+			expected: []models.TracedOrder{allocated, matched},
+		},
+		{
+			name: "success - batch never ordered",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1, 1, buyerModels.OrderStatusCancelled).WillReturnRows(sqlmock.NewRows(columns))
+				return mock, db
+			},
+			expected: []models.TracedOrder{},
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1, 1, buyerModels.OrderStatusCancelled).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewTraceabilityRepository(db)
+
+			result, err := repo.FindBatchOrders(context.Background(), 1)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
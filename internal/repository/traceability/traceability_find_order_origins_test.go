@@ -0,0 +1,72 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/traceability"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	buyerModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/traceability"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestTraceabilityRepository_FindOrderOrigins(t *testing.T) {
+	columns := []string{"id", "batch_number", "product_id", "quantity", "link", "inbound_order_id", "order_number", "order_date",
+		"employee_id", "first_name", "last_name", "warehouse_id", "warehouse_code"}
+	const query = `SELECT pb.id, (.+) FROM \(\s+SELECT sa.product_batch_id (.+) UNION ALL (.+) AND po.order_status_id <> \?(.+)\) src\s+INNER JOIN product_batches pb ON pb.id = src.batch_id`
+	received := testhelpers.DummyBatchOrigin(1, testhelpers.IntPtr(5))
+	unreceived := testhelpers.DummyBatchOrigin(2, nil)
+
+	testCases := []struct {
+		name     string
+		dbMock   func() (sqlmock.Sqlmock, *sql.DB)
+		expected []models.BatchOrigin
+		errCode  string
+	}{
+		{
+			name: "success - batches with and without inbound orders",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(7, 7, buyerModels.OrderStatusCancelled).WillReturnRows(sqlmock.NewRows(columns).
+					AddRow(received.ProductBatchId, received.BatchNumber, received.ProductId, 10, received.Link,
+						5, *received.InboundOrderNumber, *received.InboundOrderDate, 3, "Juan", "Perez", 1, "WH001").
+					AddRow(unreceived.ProductBatchId, unreceived.BatchNumber, unreceived.ProductId, 10, unreceived.Link,
+						nil, nil, nil, nil, nil, nil, nil, nil))
+				return mock, db
+			},
+			expected: []models.BatchOrigin{received, unreceived},
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(7, 7, buyerModels.OrderStatusCancelled).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewTraceabilityRepository(db)
+
+			result, err := repo.FindOrderOrigins(context.Background(), 7)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/traceability"
+)
+
+// TraceabilityRepository walks the links between product batches, purchase orders, buyers and inbound orders.
+type TraceabilityRepository interface {
+	// FindBatch returns the batch a forward trace starts from.
+	FindBatch(ctx context.Context, batchId int) (*models.TracedBatch, error)
+
+	// FindBatchOrders returns the purchase orders, with their buyers, that received product from the batch
+	// or from the batches transferred out of it.
+	FindBatchOrders(ctx context.Context, batchId int) ([]models.TracedOrder, error)
+
+	// FindOrder returns the purchase order a backward trace starts from.
+	FindOrder(ctx context.Context, orderId int) (*models.TracedPurchaseOrder, error)
+
+	// FindOrderOrigins returns the batches that served the order, one row per inbound order that received each batch.
+	FindOrderOrigins(ctx context.Context, orderId int) ([]models.BatchOrigin, error)
+}
+
+// traceabilityRepository implements TraceabilityRepository using MySQL.
+type traceabilityRepository struct {
+	mysql *sql.DB
+}
+
+// NewTraceabilityRepository returns a new TraceabilityRepository using the given MySQL connection.
+func NewTraceabilityRepository(mysql *sql.DB) TraceabilityRepository {
+	return &traceabilityRepository{
+		mysql: mysql,
+	}
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/transfer"
+)
+
+const (
+	queryBatchForUpdate = `SELECT id, batch_number, current_quantity, current_temperature, due_date, manufacturing_date, manufacturing_hour, minimum_temperature, product_id, section_id,
+			status, status_reason, status_set_by, status_updated_at,
+			COALESCE((SELECT SUM(a.quantity) FROM stock_allocations a WHERE a.product_batch_id = product_batches.id AND a.status = 'reserved'), 0)
+		FROM product_batches WHERE id = ? FOR UPDATE`
+	querySectionForUpdate = `SELECT id, current_capacity, maximum_capacity, current_temperature, warehouse_id FROM sections WHERE id = ? FOR UPDATE`
+	queryBatchMove        = `UPDATE product_batches SET section_id = ? WHERE id = ?`
+	queryBatchSplitCreate = `INSERT INTO product_batches (batch_number, current_quantity, current_temperature, due_date, initial_quantity, manufacturing_date, manufacturing_hour, minimum_temperature, product_id, section_id,
+			status, status_reason, status_set_by, status_updated_at)
+		VALUES (?, 0, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	queryNextBatchNumber    = `SELECT COALESCE(MAX(batch_number), 0) + 1 FROM product_batches FOR UPDATE`
+	querySectionCapacityAdd = `UPDATE sections SET current_capacity = current_capacity + ? WHERE id = ?`
+	queryTransferCreate     = `INSERT INTO batch_transfers (source_batch_id, destination_batch_id, from_section_id, to_section_id, from_warehouse_id, to_warehouse_id, quantity, reason, actor, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	queryTransfersByBatch = `SELECT id, source_batch_id, destination_batch_id, from_section_id, to_section_id, from_warehouse_id, to_warehouse_id, quantity, reason, actor, created_at
+		FROM batch_transfers WHERE source_batch_id = ? OR destination_batch_id = ? ORDER BY created_at ASC, id ASC`
+)
+
+// FindBatchForUpdate returns the batch and locks its row.
+// Returns a not found error if the batch does not exist.
+func (r *transferRepository) FindBatchForUpdate(ctx context.Context, exec Executor, batchId int) (*models.TransferBatch, error) {
+	var b models.TransferBatch
+	var reason, setBy sql.NullString
+	var updatedAt sql.NullTime
+	err := exec.QueryRowContext(ctx, queryBatchForUpdate, batchId).Scan(&b.Id, &b.BatchNumber, &b.CurrentQuantity, &b.CurrentTemperature, &b.DueDate,
+		&b.ManufacturingDate, &b.ManufacturingHour, &b.MinimumTemperature, &b.ProductId, &b.SectionId, &b.Status, &reason, &setBy, &updatedAt,
+		&b.ReservedQuantity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "product batch not found")
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying product batch")
+	}
+	if reason.Valid {
+		b.StatusReason = &reason.String
+	}
+	if setBy.Valid {
+		b.StatusSetBy = &setBy.String
+	}
+	if updatedAt.Valid {
+		b.StatusUpdatedAt = &updatedAt.Time
+	}
+	return &b, nil
+}
+
+// FindSectionForUpdate returns the section and locks its row.
+// Returns a not found error if the section does not exist.
+func (r *transferRepository) FindSectionForUpdate(ctx context.Context, exec Executor, sectionId int) (*models.TransferSection, error) {
+	var s models.TransferSection
+	err := exec.QueryRowContext(ctx, querySectionForUpdate, sectionId).Scan(&s.Id, &s.CurrentCapacity, &s.MaximumCapacity, &s.CurrentTemperature, &s.WarehouseId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "section not found").WithDetail("section_id", sectionId)
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying section")
+	}
+	return &s, nil
+}
+
+// MoveBatch points the batch to its new section.
+func (r *transferRepository) MoveBatch(ctx context.Context, exec Executor, batchId int, sectionId int) error {
+	if _, err := exec.ExecContext(ctx, queryBatchMove, sectionId, batchId); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error moving product batch")
+	}
+	return nil
+}
+
+// CreateSplitBatch inserts the batch split off a partial transfer, with the status of its source, and returns its id.
+func (r *transferRepository) CreateSplitBatch(ctx context.Context, exec Executor, b models.TransferBatch, initialQuantity int) (int, error) {
+	res, err := exec.ExecContext(ctx, queryBatchSplitCreate, b.BatchNumber, b.CurrentTemperature, b.DueDate, initialQuantity,
+		b.ManufacturingDate, b.ManufacturingHour, b.MinimumTemperature, b.ProductId, b.SectionId,
+		b.Status, b.StatusReason, b.StatusSetBy, b.StatusUpdatedAt)
+	if err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error creating split product batch")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	return int(id), nil
+}
+
+// NextBatchNumber returns the highest batch number in use plus one.
+func (r *transferRepository) NextBatchNumber(ctx context.Context, exec Executor) (int, error) {
+	var next int
+	if err := exec.QueryRowContext(ctx, queryNextBatchNumber).Scan(&next); err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "error getting next batch number")
+	}
+	return next, nil
+}
+
+// AddSectionCapacity adds delta to the section current_capacity.
+func (r *transferRepository) AddSectionCapacity(ctx context.Context, exec Executor, sectionId int, delta int) error {
+	if _, err := exec.ExecContext(ctx, querySectionCapacityAdd, delta, sectionId); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error updating section capacity")
+	}
+	return nil
+}
+
+// CreateTransfer inserts the audit record of a transfer and returns it with its generated id.
+func (r *transferRepository) CreateTransfer(ctx context.Context, exec Executor, t models.BatchTransfer) (*models.BatchTransfer, error) {
+	if t.CreatedAt.IsZero() {
+		t.CreatedAt = time.Now().UTC()
+	}
+	res, err := exec.ExecContext(ctx, queryTransferCreate, t.SourceBatchId, t.DestinationBatchId, t.FromSectionId, t.ToSectionId,
+		t.FromWarehouseId, t.ToWarehouseId, t.Quantity, t.Reason, t.Actor, t.CreatedAt)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error creating batch transfer")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	t.Id = int(id)
+	return &t, nil
+}
+
+// FindByBatch returns the transfers where the batch was the source or the destination, oldest first.
+func (r *transferRepository) FindByBatch(ctx context.Context, batchId int) ([]models.BatchTransfer, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryTransfersByBatch, batchId, batchId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying batch transfers")
+	}
+	defer rows.Close()
+
+	transfers := make([]models.BatchTransfer, 0)
+	for rows.Next() {
+		var t models.BatchTransfer
+		if err := rows.Scan(&t.Id, &t.SourceBatchId, &t.DestinationBatchId, &t.FromSectionId, &t.ToSectionId,
+			&t.FromWarehouseId, &t.ToWarehouseId, &t.Quantity, &t.Reason, &t.Actor, &t.CreatedAt); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning batch transfer")
+		}
+		transfers = append(transfers, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating batch transfers")
+	}
+	return transfers, nil
+}
@@ -0,0 +1,77 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/transfer"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestTransferRepository_FindBatchForUpdate(t *testing.T) {
+	expected := testhelpers.DummyTransferBatch()
+	expected.ReservedQuantity = 8
+	columns := []string{"id", "batch_number", "current_quantity", "current_temperature", "due_date", "manufacturing_date",
+		"manufacturing_hour", "minimum_temperature", "product_id", "section_id", "status", "status_reason", "status_set_by", "status_updated_at",
+		"reserved_quantity"}
+	const query = `SELECT (.+) FROM product_batches WHERE id = \? FOR UPDATE`
+
+	testCases := []struct {
+		name    string
+		dbMock  func() (sqlmock.Sqlmock, *sql.DB)
+		errCode string
+	}{
+		{
+			name: "success - batch found",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1).WillReturnRows(sqlmock.NewRows(columns).AddRow(
+					expected.Id, expected.BatchNumber, expected.CurrentQuantity, expected.CurrentTemperature, expected.DueDate,
+					expected.ManufacturingDate, expected.ManufacturingHour, expected.MinimumTemperature, expected.ProductId, expected.SectionId,
+					expected.Status, nil, nil, nil, expected.ReservedQuantity))
+				return mock, db
+			},
+		},
+		{
+			name: "error - batch not found",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1).WillReturnError(sql.ErrNoRows)
+				return mock, db
+			},
+			errCode: apperrors.CodeNotFound,
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WithArgs(1).WillReturnError(sql.ErrConnDone)
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewTransferRepository(db)
+
+			result, err := repo.FindBatchForUpdate(context.Background(), db, 1)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, &expected, result)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/database"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/transfer"
+)
+
+// TransferRepository defines the data operations needed to move product batches between sections.
+type TransferRepository interface {
+	// FindBatchForUpdate returns a product batch, locking it until the transaction ends.
+	FindBatchForUpdate(ctx context.Context, exec Executor, batchId int) (*models.TransferBatch, error)
+
+	// FindSectionForUpdate returns a section, locking it until the transaction ends.
+	FindSectionForUpdate(ctx context.Context, exec Executor, sectionId int) (*models.TransferSection, error)
+
+	// MoveBatch changes the section a product batch is stored in.
+	MoveBatch(ctx context.Context, exec Executor, batchId int, sectionId int) error
+
+	// CreateSplitBatch inserts a new empty batch copied from b with the given batch number,
+	// section and initial quantity, keeping its status. Its stock is posted afterwards through the ledger.
+	CreateSplitBatch(ctx context.Context, exec Executor, b models.TransferBatch, initialQuantity int) (int, error)
+
+	// NextBatchNumber returns the batch number a split batch should take.
+	NextBatchNumber(ctx context.Context, exec Executor) (int, error)
+
+	// AddSectionCapacity adds delta (which may be negative) to a section current_capacity.
+	AddSectionCapacity(ctx context.Context, exec Executor, sectionId int, delta int) error
+
+	// CreateTransfer stores the audit record of a transfer.
+	CreateTransfer(ctx context.Context, exec Executor, t models.BatchTransfer) (*models.BatchTransfer, error)
+
+	// FindByBatch returns the transfers a batch took part in, as source or destination.
+	FindByBatch(ctx context.Context, batchId int) ([]models.BatchTransfer, error)
+
+	database.Transactor
+}
+
+// Executor runs SQL statements either on the connection or inside a transaction.
+type Executor = database.Executor
+
+// transferRepository implements TransferRepository using MySQL.
+type transferRepository struct {
+	database.MySQLTransactor
+	mysql *sql.DB
+}
+
+// NewTransferRepository returns a new TransferRepository using the given MySQL connection.
+func NewTransferRepository(mysql *sql.DB) TransferRepository {
+	return &transferRepository{
+		MySQLTransactor: database.NewMySQLTransactor(mysql),
+		mysql:           mysql,
+	}
+}
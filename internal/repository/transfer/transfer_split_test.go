@@ -0,0 +1,176 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/transfer"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestTransferRepository_CreateSplitBatch(t *testing.T) {
+	const query = `INSERT INTO product_batches \((.+), status, status_reason, status_set_by, status_updated_at\) VALUES \(\?, 0, (.+)\)`
+	batch := testhelpers.DummyTransferBatch()
+	batch.BatchNumber = 102
+	batch.SectionId = 2
+	batch.Status = batchModels.BatchStatusQuarantined
+	batch.StatusReason = testhelpers.Ptr("temperature excursion")
+	batch.StatusSetBy = testhelpers.Ptr("qa.lead")
+	batch.StatusUpdatedAt = testhelpers.Ptr(time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC))
+	args := []driver.Value{batch.BatchNumber, batch.CurrentTemperature, batch.DueDate, 20, batch.ManufacturingDate, batch.ManufacturingHour,
+		batch.MinimumTemperature, batch.ProductId, batch.SectionId, batch.Status, *batch.StatusReason, *batch.StatusSetBy, *batch.StatusUpdatedAt}
+
+	testCases := []struct {
+		name    string
+		dbMock  func() (sqlmock.Sqlmock, *sql.DB)
+		errCode string
+	}{
+		{
+			name: "success - split inherits the source status",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WithArgs(args...).WillReturnResult(sqlmock.NewResult(9, 1))
+				return mock, db
+			},
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WithArgs(args...).WillReturnError(errors.New("db error"))
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+		{
+			name: "error - last insert id",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WithArgs(args...).WillReturnResult(sqlmock.NewErrorResult(errors.New("no id")))
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewTransferRepository(db)
+
+			id, err := repo.CreateSplitBatch(context.Background(), db, batch, 20)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Zero(t, id)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 9, id)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTransferRepository_NextBatchNumber(t *testing.T) {
+	query := regexp.QuoteMeta(`SELECT COALESCE(MAX(batch_number), 0) + 1 FROM product_batches FOR UPDATE`)
+
+	testCases := []struct {
+		name     string
+		dbMock   func() (sqlmock.Sqlmock, *sql.DB)
+		expected int
+		errCode  string
+	}{
+		{
+			name: "success",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"next"}).AddRow(102))
+				return mock, db
+			},
+			expected: 102,
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectQuery(query).WillReturnError(errors.New("db error"))
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewTransferRepository(db)
+
+			next, err := repo.NextBatchNumber(context.Background(), db)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expected, next)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestTransferRepository_AddSectionCapacity(t *testing.T) {
+	query := regexp.QuoteMeta(`UPDATE sections SET current_capacity = current_capacity + ? WHERE id = ?`)
+
+	testCases := []struct {
+		name    string
+		dbMock  func() (sqlmock.Sqlmock, *sql.DB)
+		errCode string
+	}{
+		{
+			name: "success",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WithArgs(-30, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+				return mock, db
+			},
+		},
+		{
+			name: "error - database error",
+			dbMock: func() (sqlmock.Sqlmock, *sql.DB) {
+				mock, db := testhelpers.CreateMockDB()
+				mock.ExpectExec(query).WithArgs(-30, 1).WillReturnError(errors.New("db error"))
+				return mock, db
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock, db := tc.dbMock()
+			defer db.Close()
+			repo := repository.NewTransferRepository(db)
+
+			err := repo.AddSectionCapacity(context.Background(), db, 1, -30)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
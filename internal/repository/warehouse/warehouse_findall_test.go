@@ -41,10 +41,10 @@ func TestWarehouseMySQL_FindAll(t *testing.T) {
 
 					rows := sqlmock.NewRows([]string{
 						"id", "warehouse_code", "address", "minimum_temperature", "minimum_capacity",
-						"telephone", "locality_id",
+						"telephone", "locality_id", "latitude", "longitude",
 					}).
-						AddRow(1, "WH001", "123 Main St", 10.5, 1000, "5551234567", "LOC001").
-						AddRow(2, "WH002", "456 Elm St", 15.5, 2000, "5555678901", "LOC002")
+						AddRow(1, "WH001", "123 Main St", 10.5, 1000, "5551234567", "LOC001", nil, nil).
+						AddRow(2, "WH002", "456 Elm St", 15.5, 2000, "5555678901", "LOC002", nil, nil)
 
 					mock.ExpectQuery("SELECT (.+) FROM warehouse").
 						WillReturnRows(rows)
@@ -68,7 +68,7 @@ func TestWarehouseMySQL_FindAll(t *testing.T) {
 
 					rows := sqlmock.NewRows([]string{
 						"id", "warehouse_code", "address", "minimum_temperature", "minimum_capacity",
-						"telephone", "locality_id",
+						"telephone", "locality_id", "latitude", "longitude",
 					})
 
 					mock.ExpectQuery("SELECT (.+) FROM warehouse").
@@ -114,8 +114,8 @@ func TestWarehouseMySQL_FindAll(t *testing.T) {
 					// Row con datos corruptos que causará error en Scan
 					rows := sqlmock.NewRows([]string{
 						"id", "warehouse_code", "address", "minimum_temperature",
-						"minimum_capacity", "telephone", "locality_id",
-					}).AddRow("invalid_id", "WH001", "123 Main St", 10.5, 1000, "5551234567", "LOC001")
+						"minimum_capacity", "telephone", "locality_id", "latitude", "longitude",
+					}).AddRow("invalid_id", "WH001", "123 Main St", 10.5, 1000, "5551234567", "LOC001", nil, nil)
 
 					mock.ExpectQuery("SELECT (.+) FROM warehouse").
 						WillReturnRows(rows)
@@ -139,9 +139,9 @@ func TestWarehouseMySQL_FindAll(t *testing.T) {
 
 					rows := sqlmock.NewRows([]string{
 						"id", "warehouse_code", "address", "minimum_temperature",
-						"minimum_capacity", "telephone", "locality_id",
+						"minimum_capacity", "telephone", "locality_id", "latitude", "longitude",
 					}).
-						AddRow(1, "WH001", "123 Main St", 10.5, 1000, "5551234567", "LOC001").
+						AddRow(1, "WH001", "123 Main St", 10.5, 1000, "5551234567", "LOC001", nil, nil).
 						RowError(0, sql.ErrConnDone)
 
 					mock.ExpectQuery("SELECT (.+) FROM warehouse").
@@ -42,8 +42,8 @@ func TestWarehouseMySQL_FindById(t *testing.T) {
 
 					rows := sqlmock.NewRows([]string{
 						"id", "warehouse_code", "address", "minimum_temperature", "minimum_capacity",
-						"telephone", "locality_id",
-					}).AddRow(1, "WH001", "123 Main St", 10.5, 1000, "5551234567", "LOC001")
+						"telephone", "locality_id", "latitude", "longitude",
+					}).AddRow(1, "WH001", "123 Main St", 10.5, 1000, "5551234567", "LOC001", nil, nil)
 
 					mock.ExpectQuery("SELECT (.+) FROM warehouse WHERE id = ?").
 						WithArgs(1).
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/warehouse_exception"
+)
+
+const (
+	queryExceptionsByEmployee = `SELECT id, employee_id, warehouse_id, reason, created_at FROM employee_warehouse_exceptions WHERE employee_id = ? ORDER BY warehouse_id`
+	queryExceptionCreate      = `INSERT INTO employee_warehouse_exceptions (employee_id, warehouse_id, reason, created_at) VALUES (?, ?, ?, ?)`
+	queryExceptionDelete      = `DELETE FROM employee_warehouse_exceptions WHERE employee_id = ? AND warehouse_id = ?`
+	queryExceptionExists      = `SELECT COUNT(1) FROM employee_warehouse_exceptions WHERE employee_id = ? AND warehouse_id = ?`
+)
+
+// FindByEmployee returns the exceptions of the employee ordered by warehouse.
+func (r *warehouseExceptionRepository) FindByEmployee(ctx context.Context, employeeId int) ([]models.WarehouseException, error) {
+	rows, err := r.mysql.QueryContext(ctx, queryExceptionsByEmployee, employeeId)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying warehouse exceptions")
+	}
+	defer rows.Close()
+
+	exceptions := make([]models.WarehouseException, 0)
+	for rows.Next() {
+		var e models.WarehouseException
+		if err := rows.Scan(&e.Id, &e.EmployeeId, &e.WarehouseId, &e.Reason, &e.CreatedAt); err != nil {
+			return nil, apperrors.NewAppError(apperrors.CodeInternal, "error scanning warehouse exception")
+		}
+		exceptions = append(exceptions, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error after iterating warehouse exceptions")
+	}
+	return exceptions, nil
+}
+
+// Create inserts the exception.
+// Returns a conflict error if the employee already holds one for the warehouse, and a not found
+// error if the employee or the warehouse does not exist.
+func (r *warehouseExceptionRepository) Create(ctx context.Context, e models.WarehouseException) (*models.WarehouseException, error) {
+	res, err := r.mysql.ExecContext(ctx, queryExceptionCreate, e.EmployeeId, e.WarehouseId, e.Reason, e.CreatedAt)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
+			switch mysqlErr.Number {
+			case 1062:
+				return nil, apperrors.NewAppError(apperrors.CodeConflict, "the employee already has an exception for this warehouse").
+					WithDetail("employee_id", e.EmployeeId).
+					WithDetail("warehouse_id", e.WarehouseId)
+			case 1452:
+				return nil, apperrors.NewAppError(apperrors.CodeNotFound, "employee or warehouse not found").
+					WithDetail("employee_id", e.EmployeeId).
+					WithDetail("warehouse_id", e.WarehouseId)
+			}
+		}
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error creating warehouse exception")
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "error getting last insert id")
+	}
+	e.Id = int(id)
+	return &e, nil
+}
+
+// Delete removes the exception.
+// Returns a not found error if the employee holds none for the warehouse.
+func (r *warehouseExceptionRepository) Delete(ctx context.Context, employeeId int, warehouseId int) error {
+	res, err := r.mysql.ExecContext(ctx, queryExceptionDelete, employeeId, warehouseId)
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error deleting warehouse exception")
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "error getting rows affected")
+	}
+	if n == 0 {
+		return apperrors.NewAppError(apperrors.CodeNotFound, "warehouse exception not found").
+			WithDetail("employee_id", employeeId).
+			WithDetail("warehouse_id", warehouseId)
+	}
+	return nil
+}
+
+// Exists reports whether the exception is on the list.
+func (r *warehouseExceptionRepository) Exists(ctx context.Context, employeeId int, warehouseId int) (bool, error) {
+	var count int
+	if err := r.mysql.QueryRowContext(ctx, queryExceptionExists, employeeId, warehouseId).Scan(&count); err != nil {
+		return false, apperrors.NewAppError(apperrors.CodeInternal, "error querying warehouse exceptions")
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/warehouse_exception"
+)
+
+// WarehouseExceptionRepository defines the data operations of the employee cross-warehouse exception list.
+type WarehouseExceptionRepository interface {
+	// FindByEmployee returns the warehouses an employee may work at besides their own.
+	FindByEmployee(ctx context.Context, employeeId int) ([]models.WarehouseException, error)
+
+	// Create inserts an exception and returns it with its generated id.
+	Create(ctx context.Context, e models.WarehouseException) (*models.WarehouseException, error)
+
+	// Delete removes the exception of an employee for a warehouse.
+	Delete(ctx context.Context, employeeId int, warehouseId int) error
+
+	// Exists reports whether an employee holds an exception for a warehouse.
+	Exists(ctx context.Context, employeeId int, warehouseId int) (bool, error)
+}
+
+// warehouseExceptionRepository implements WarehouseExceptionRepository using MySQL.
+type warehouseExceptionRepository struct {
+	mysql *sql.DB
+}
+
+// NewWarehouseExceptionRepository returns a new WarehouseExceptionRepository using the given MySQL connection.
+func NewWarehouseExceptionRepository(mysql *sql.DB) WarehouseExceptionRepository {
+	return &warehouseExceptionRepository{
+		mysql: mysql,
+	}
+}
@@ -0,0 +1,18 @@
+package router
+
+import (
+	"github.com/go-chi/chi/v5"
+	allocationHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/allocation"
+	traceabilityHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/traceability"
+)
+
+func MountAllocationRoutes(api chi.Router, hd *allocationHandler.AllocationHandler, hdTrace *traceabilityHandler.TraceabilityHandler) {
+	api.Route("/purchaseOrders/{id}", func(r chi.Router) {
+		r.Post("/confirm", hd.Confirm)
+		r.Post("/cancel", hd.Cancel)
+		r.Get("/allocations", hd.FindByOrder)
+
+		// Backward traceability
+		r.Get("/trace", hdTrace.TraceOrder)
+	})
+}
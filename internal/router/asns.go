@@ -0,0 +1,18 @@
+package router
+
+import (
+	"github.com/go-chi/chi/v5"
+	asnHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/asn"
+)
+
+func MountAsnRoutes(api chi.Router, hd *asnHandler.AsnHandler) {
+	api.Route("/asns", func(r chi.Router) {
+		r.Get("/", hd.FindAll)
+		r.Post("/", hd.Create)
+		r.Get("/discrepancies", hd.DiscrepancyReport)
+		r.Get("/{id}", hd.FindById)
+		r.Post("/{id}/arrive", hd.Arrive)
+		r.Post("/{id}/complete", hd.Complete)
+		r.Post("/{id}/close", hd.Close)
+	})
+}
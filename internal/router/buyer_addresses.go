@@ -0,0 +1,16 @@
+package router
+
+import (
+	"github.com/go-chi/chi/v5"
+	buyerAddressHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/buyer_address"
+)
+
+func MountBuyerAddressRoutes(api chi.Router, hd *buyerAddressHandler.BuyerAddressHandler) {
+	api.Route("/buyers/{id}/addresses", func(r chi.Router) {
+		r.Get("/", hd.FindAll)
+		r.Post("/", hd.Create)
+		r.Get("/{addressId}", hd.FindById)
+		r.Patch("/{addressId}", hd.Update)
+		r.Delete("/{addressId}", hd.Delete)
+	})
+}
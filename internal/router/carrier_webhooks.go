@@ -0,0 +1,12 @@
+package router
+
+import (
+	"github.com/go-chi/chi/v5"
+	carrierWebhookHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/carrier_webhook"
+)
+
+func MountCarrierWebhookRoutes(api chi.Router, hd *carrierWebhookHandler.CarrierWebhookHandler) {
+	api.Route("/webhooks/carriers", func(r chi.Router) {
+		r.Post("/{cid}", hd.Receive)
+	})
+}
@@ -9,4 +9,4 @@ func MountCarryRoutes(api chi.Router, hd *handler.CarryHandler) {
 	api.Route("/carries", func(r chi.Router) {
 		r.Post("/", hd.Create)
 	})
-}
\ No newline at end of file
+}
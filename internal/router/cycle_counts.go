@@ -0,0 +1,17 @@
+package router
+
+import (
+	"github.com/go-chi/chi/v5"
+	cycleCountHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/cycle_count"
+)
+
+func MountCycleCountRoutes(api chi.Router, hd *cycleCountHandler.CycleCountHandler) {
+	api.Route("/cycleCounts", func(r chi.Router) {
+		r.Get("/", hd.FindAll)
+		r.Post("/", hd.Create)
+		r.Get("/{id}", hd.FindById)
+		r.Post("/{id}/counts", hd.RecordCounts)
+		r.Post("/{id}/approve", hd.Approve)
+		r.Post("/{id}/cancel", hd.Cancel)
+	})
+}
@@ -0,0 +1,20 @@
+package router
+
+import (
+	"github.com/go-chi/chi/v5"
+	dockHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/dock"
+)
+
+func MountDockRoutes(api chi.Router, hd *dockHandler.DockHandler) {
+	api.Route("/docks", func(r chi.Router) {
+		r.Get("/", hd.FindDocks)
+		r.Post("/", hd.CreateDock)
+	})
+	api.Route("/dockAppointments", func(r chi.Router) {
+		r.Post("/", hd.Book)
+		r.Get("/{id}", hd.FindAppointmentById)
+		r.Post("/{id}/cancel", hd.Cancel)
+		r.Post("/{id}/missed", hd.MarkMissed)
+		r.Post("/{id}/arrival", hd.RecordArrival)
+	})
+}
@@ -3,15 +3,19 @@ package router
 import (
 	"github.com/go-chi/chi/v5"
 	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/employee"
+	warehouseExceptionHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/warehouse_exception"
 )
 
 // Monta las rutas relacionadas con la entidad Employee en el router API principal.
-func MountEmployeeRoutes(api chi.Router, hd *handler.EmployeeHandler) {
+func MountEmployeeRoutes(api chi.Router, hd *handler.EmployeeHandler, hdException *warehouseExceptionHandler.WarehouseExceptionHandler) {
 	api.Route("/employees", func(r chi.Router) {
 		r.Get("/", hd.GetAll)
 		r.Get("/{id}", hd.GetByID)
 		r.Post("/", hd.Create)
 		r.Patch("/{id}", hd.Update)
 		r.Delete("/{id}", hd.Delete)
+		r.Get("/{id}/warehouseExceptions", hdException.FindByEmployee)
+		r.Post("/{id}/warehouseExceptions", hdException.Create)
+		r.Delete("/{id}/warehouseExceptions/{warehouseId}", hdException.Delete)
 	})
 }
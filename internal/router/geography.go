@@ -11,5 +11,6 @@ func MountGeographyRoutes(api chi.Router, hdGeography *geographyHandler.Geograph
 		r.Post("/", hdGeography.Create)
 		r.Get("/reportSellers", hdGeography.CountSellersByLocality)
 		r.Get("/reportCarries", hdCarry.ReportCarries)
+		r.Put("/{id}/coordinates", hdGeography.SetCoordinates)
 	})
 }
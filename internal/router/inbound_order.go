@@ -3,12 +3,14 @@ package router
 import (
 	"github.com/go-chi/chi/v5"
 	handler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/inbound_order"
+	receivingHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/receiving"
 )
 
 // Monta las rutas relacionadas con la entidad Inbound_Orders en el router API principal.
-func MountInboundOrderRoutes(api chi.Router, hd *handler.InboundOrderHandler) {
+func MountInboundOrderRoutes(api chi.Router, hd *handler.InboundOrderHandler, hdReceiving *receivingHandler.ReceivingHandler) {
 	api.Route("/inboundOrders", func(r chi.Router) {
 		r.Post("/", hd.Create)
+		r.Post("/receive", hdReceiving.Receive)
 	})
 	api.Get("/employees/reportInboundOrders", hd.Report)
 }
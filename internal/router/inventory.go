@@ -0,0 +1,12 @@
+package router
+
+import (
+	"github.com/go-chi/chi/v5"
+	inventoryHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/inventory"
+)
+
+func MountInventoryRoutes(api chi.Router, hd *inventoryHandler.InventoryHandler) {
+	api.Route("/inventory", func(r chi.Router) {
+		r.Get("/", hd.FindOnHand)
+	})
+}
@@ -0,0 +1,19 @@
+package router
+
+import (
+	"github.com/go-chi/chi/v5"
+	pickingHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/picking"
+)
+
+func MountPickingRoutes(api chi.Router, hd *pickingHandler.PickingHandler) {
+	api.Route("/pickWaves", func(r chi.Router) {
+		r.Get("/", hd.FindWaves)
+		r.Post("/", hd.CreateWave)
+		r.Get("/{id}", hd.FindWave)
+	})
+	api.Route("/pickLists", func(r chi.Router) {
+		r.Get("/{id}", hd.FindList)
+		r.Post("/{id}/confirm", hd.Confirm)
+	})
+	api.Get("/pickShortages", hd.Shortages)
+}
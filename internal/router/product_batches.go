@@ -2,11 +2,31 @@ package router
 
 import (
 	"github.com/go-chi/chi/v5"
+	batchStatusHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/batch_status"
 	productBatchHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/product_batch"
+	stockMovementHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/stock_movement"
+	traceabilityHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/traceability"
+	transferHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/transfer"
 )
 
-func MountProductBatchesRoutes(api chi.Router, hd *productBatchHandler.ProductBatchesHandler) {
+func MountProductBatchesRoutes(api chi.Router, hd *productBatchHandler.ProductBatchesHandler, hdMovement *stockMovementHandler.StockMovementHandler, hdTransfer *transferHandler.TransferHandler, hdStatus *batchStatusHandler.BatchStatusHandler, hdTrace *traceabilityHandler.TraceabilityHandler) {
 	api.Route("/productBatches", func(r chi.Router) {
 		r.Post("/", hd.CreateProductBatches)
+		r.Get("/expiring", hd.GetExpiring)
+
+		// Stock ledger
+		r.Get("/{id}/movements", hdMovement.FindByBatch)
+
+		// Transfers between sections
+		r.Post("/{id}/transfers", hdTransfer.Transfer)
+		r.Get("/{id}/transfers", hdTransfer.FindByBatch)
+
+		// Quality holds
+		r.Get("/{id}/status", hdStatus.FindStatus)
+		r.Post("/{id}/hold", hdStatus.Hold)
+		r.Post("/{id}/release", hdStatus.Release)
+
+		// Forward traceability
+		r.Get("/{id}/trace", hdTrace.TraceBatch)
 	})
 }
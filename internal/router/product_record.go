@@ -8,5 +8,6 @@ import (
 func MountProductRecordRoutes(api chi.Router, hd *productRecordHandler.ProductRecordHandler) {
 	api.Route("/productRecords", func(r chi.Router) {
 		r.Post("/", hd.Create)
+		r.Get("/marginReport", hd.GetMarginReport)
 	})
 }
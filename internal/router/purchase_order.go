@@ -11,4 +11,7 @@ func MountPurchaseOrderRoutes(r chi.Router, h *purchaseOrderHandler.PurchaseOrde
 
 	// Ruta para el reporte
 	r.Get("/buyers/reportPurchaseOrders", h.GetReport)
+
+	// Historial de compras de un buyer
+	r.Get("/buyers/{id}/purchaseOrders", h.History)
 }
@@ -0,0 +1,13 @@
+package router
+
+import (
+	"github.com/go-chi/chi/v5"
+	recallHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/recall"
+)
+
+func MountRecallRoutes(api chi.Router, hd *recallHandler.RecallHandler) {
+	api.Route("/recalls", func(r chi.Router) {
+		r.Post("/", hd.Create)
+		r.Get("/{id}", hd.FindById)
+	})
+}
@@ -0,0 +1,16 @@
+package router
+
+import (
+	"github.com/go-chi/chi/v5"
+	replenishmentHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/replenishment"
+)
+
+func MountReplenishmentRoutes(api chi.Router, hd *replenishmentHandler.ReplenishmentHandler) {
+	api.Route("/reorderPoints", func(r chi.Router) {
+		r.Get("/", hd.FindAll)
+		r.Post("/", hd.Create)
+		r.Patch("/{id}", hd.Update)
+		r.Delete("/{id}", hd.Delete)
+	})
+	api.Get("/replenishment", hd.Report)
+}
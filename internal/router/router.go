@@ -3,18 +3,39 @@ package router
 import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	allocationHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/allocation"
+	asnHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/asn"
+	batchStatusHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/batch_status"
 	buyerHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/buyer"
+	buyerAddressHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/buyer_address"
+	carrierWebhookHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/carrier_webhook"
 	carryHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/carry"
+	cycleCountHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/cycle_count"
+	dockHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/dock"
 	empHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/employee"
 	geographyHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/geography"
 	inbHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/inbound_order"
+	inventoryHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/inventory"
+	occupancyHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/occupancy"
+	pickingHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/picking"
 	productHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/product"
 	productBatchHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/product_batch"
 	ProductRecordHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/product_record"
 	purchaseOrderHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/purchase_order"
+	recallHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/recall"
+	receivingHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/receiving"
+	replenishmentHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/replenishment"
+	routingHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/routing"
 	sectionHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/section"
 	sellerHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/seller"
+	shipmentHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/shipment"
+	shippingHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/shipping"
+	sourcingHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/sourcing"
+	stockMovementHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/stock_movement"
+	traceabilityHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/traceability"
+	transferHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/transfer"
 	warehouseHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/warehouse"
+	warehouseExceptionHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/warehouse_exception"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/httputil"
 )
 
@@ -31,6 +52,27 @@ func NewAPIRouter(
 	hdInboundOrder *inbHandler.InboundOrderHandler,
 	hdCarry *carryHandler.CarryHandler,
 	hdProductRecord *ProductRecordHandler.ProductRecordHandler,
+	hdAllocation *allocationHandler.AllocationHandler,
+	hdStockMovement *stockMovementHandler.StockMovementHandler,
+	hdTransfer *transferHandler.TransferHandler,
+	hdBatchStatus *batchStatusHandler.BatchStatusHandler,
+	hdTraceability *traceabilityHandler.TraceabilityHandler,
+	hdRecall *recallHandler.RecallHandler,
+	hdOccupancy *occupancyHandler.OccupancyHandler,
+	hdInventory *inventoryHandler.InventoryHandler,
+	hdCycleCount *cycleCountHandler.CycleCountHandler,
+	hdReplenishment *replenishmentHandler.ReplenishmentHandler,
+	hdReceiving *receivingHandler.ReceivingHandler,
+	hdWarehouseException *warehouseExceptionHandler.WarehouseExceptionHandler,
+	hdAsn *asnHandler.AsnHandler,
+	hdDock *dockHandler.DockHandler,
+	hdPicking *pickingHandler.PickingHandler,
+	hdShipment *shipmentHandler.ShipmentHandler,
+	hdCarrierWebhook *carrierWebhookHandler.CarrierWebhookHandler,
+	hdShipping *shippingHandler.ShippingHandler,
+	hdRouting *routingHandler.RoutingHandler,
+	hdSourcing *sourcingHandler.SourcingHandler,
+	hdBuyerAddress *buyerAddressHandler.BuyerAddressHandler,
 ) *chi.Mux {
 	root := chi.NewRouter()
 	root.Use(middleware.Logger, middleware.Recoverer)
@@ -42,15 +84,29 @@ func NewAPIRouter(
 		MountProductRoutes(api, hdProduct, hdProductRecord)
 		MountSectionRoutes(api, hdSection, hdProductBatches)
 		MountBuyerRoutes(api, hdBuyer)
-		MountWarehouseRoutes(api, hdWarehouse)
+		MountWarehouseRoutes(api, hdWarehouse, hdOccupancy, hdDock)
 		MountSellerRoutes(api, hdSeller)
-		MountEmployeeRoutes(api, hdEmployee)
-		MountProductBatchesRoutes(api, hdProductBatches)
+		MountEmployeeRoutes(api, hdEmployee, hdWarehouseException)
+		MountProductBatchesRoutes(api, hdProductBatches, hdStockMovement, hdTransfer, hdBatchStatus, hdTraceability)
 		MountPurchaseOrderRoutes(api, hdPurchaseOrder)
 		MountCarryRoutes(api, hdCarry)
 		MountGeographyRoutes(api, hdGeography, hdCarry)
-		MountInboundOrderRoutes(api, hdInboundOrder)
+		MountInboundOrderRoutes(api, hdInboundOrder, hdReceiving)
 		MountProductRecordRoutes(api, hdProductRecord)
+		MountAllocationRoutes(api, hdAllocation, hdTraceability)
+		MountRecallRoutes(api, hdRecall)
+		MountInventoryRoutes(api, hdInventory)
+		MountCycleCountRoutes(api, hdCycleCount)
+		MountReplenishmentRoutes(api, hdReplenishment)
+		MountAsnRoutes(api, hdAsn)
+		MountDockRoutes(api, hdDock)
+		MountPickingRoutes(api, hdPicking)
+		MountShipmentRoutes(api, hdShipment)
+		MountCarrierWebhookRoutes(api, hdCarrierWebhook)
+		MountShippingRoutes(api, hdShipping)
+		MountRoutingRoutes(api, hdRouting)
+		MountSourcingRoutes(api, hdSourcing)
+		MountBuyerAddressRoutes(api, hdBuyerAddress)
 	})
 
 	return root
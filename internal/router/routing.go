@@ -0,0 +1,11 @@
+package router
+
+import (
+	"github.com/go-chi/chi/v5"
+	routingHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/routing"
+)
+
+func MountRoutingRoutes(api chi.Router, hd *routingHandler.RoutingHandler) {
+	api.Get("/routePlan", hd.Plan)
+	api.Get("/distance", hd.Distance)
+}
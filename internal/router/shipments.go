@@ -0,0 +1,16 @@
+package router
+
+import (
+	"github.com/go-chi/chi/v5"
+	shipmentHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/shipment"
+)
+
+func MountShipmentRoutes(api chi.Router, hd *shipmentHandler.ShipmentHandler) {
+	api.Route("/shipments", func(r chi.Router) {
+		r.Get("/", hd.FindAll)
+		r.Post("/", hd.Create)
+		r.Get("/{tracking_code}", hd.FindByTrackingCode)
+		r.Post("/{tracking_code}/events", hd.RecordEvent)
+	})
+	api.Get("/carrierPerformance", hd.Performance)
+}
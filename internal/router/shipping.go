@@ -0,0 +1,16 @@
+package router
+
+import (
+	"github.com/go-chi/chi/v5"
+	shippingHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/shipping"
+)
+
+func MountShippingRoutes(api chi.Router, hd *shippingHandler.ShippingHandler) {
+	api.Route("/coverageAreas", func(r chi.Router) {
+		r.Get("/", hd.FindAreas)
+		r.Post("/", hd.CreateArea)
+		r.Get("/{id}", hd.FindAreaById)
+		r.Delete("/{id}", hd.DeleteArea)
+	})
+	api.Post("/shipping/quote", hd.Quote)
+}
@@ -0,0 +1,10 @@
+package router
+
+import (
+	"github.com/go-chi/chi/v5"
+	sourcingHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/sourcing"
+)
+
+func MountSourcingRoutes(api chi.Router, hd *sourcingHandler.SourcingHandler) {
+	api.Post("/sourcing/plan", hd.Plan)
+}
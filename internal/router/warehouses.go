@@ -2,13 +2,19 @@ package router
 
 import (
 	"github.com/go-chi/chi/v5"
+	dockHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/dock"
+	occupancyHandler "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/occupancy"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/handler/warehouse"
 )
 
-func MountWarehouseRoutes(api chi.Router, hd *handler.WarehouseHandler) {
+func MountWarehouseRoutes(api chi.Router, hd *handler.WarehouseHandler, hdOccupancy *occupancyHandler.OccupancyHandler,
+	hdDock *dockHandler.DockHandler) {
 	api.Route("/warehouses", func(r chi.Router) {
 		r.Get("/", hd.FindAll)
+		r.Get("/occupancy", hdOccupancy.FindAll)
 		r.Get("/{id}", hd.FindById)
+		r.Get("/{id}/occupancy", hdOccupancy.FindById)
+		r.Get("/{id}/dockSchedule", hdDock.DaySchedule)
 		r.Post("/", hd.Create)
 		r.Patch("/{id}", hd.Update)
 		r.Delete("/{id}", hd.Delete)
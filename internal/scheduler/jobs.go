@@ -0,0 +1,48 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	carrierWebhookService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/carrier_webhook"
+	productBatchService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/product_batch"
+)
+
+// QuarantineExpiredBatchesJob flags expired product batches as quarantined once a day.
+// Batches already quarantined are skipped, so running it again the same day changes nothing.
+func QuarantineExpiredBatchesJob(sv productBatchService.ProductBatchesService, logger *log.Logger) Job {
+	return Job{
+		Name:     "quarantine-expired-batches",
+		Interval: 24 * time.Hour,
+		Run: func(ctx context.Context) error {
+			ids, err := sv.QuarantineExpired(ctx)
+			if err != nil {
+				return err
+			}
+			if len(ids) == 0 {
+				logger.Print("quarantine-expired-batches: no expired batches to quarantine")
+				return nil
+			}
+			logger.Printf("quarantine-expired-batches: quarantined %d batches: %v", len(ids), ids)
+			return nil
+		},
+	}
+}
+
+// PurgeWebhookNoncesJob removes, every hour, the carrier webhook nonces old enough that a request
+// reusing them would already be rejected for its timestamp.
+func PurgeWebhookNoncesJob(sv carrierWebhookService.CarrierWebhookService, logger *log.Logger) Job {
+	return Job{
+		Name:     "purge-webhook-nonces",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			n, err := sv.PurgeNonces(ctx)
+			if err != nil {
+				return err
+			}
+			logger.Printf("purge-webhook-nonces: removed %d nonces", n)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,60 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Job is a task the scheduler runs periodically.
+// Run must be idempotent: it runs once when the scheduler starts and then every Interval.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler runs background jobs on a fixed interval until its context is cancelled.
+type Scheduler struct {
+	jobs   []Job
+	logger *log.Logger
+}
+
+// NewScheduler creates an empty Scheduler that reports job failures to logger.
+func NewScheduler(logger *log.Logger) *Scheduler {
+	return &Scheduler{
+		logger: logger,
+	}
+}
+
+// Add registers a job. Jobs added after Start are not run.
+func (s *Scheduler) Add(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Start launches every registered job in its own goroutine and returns immediately.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		go s.loop(ctx, job)
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.run(ctx, job)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	if err := job.Run(ctx); err != nil {
+		s.logger.Printf("scheduler: job %s failed: %v", job.Name, err)
+	}
+}
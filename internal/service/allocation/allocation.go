@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+	buyerModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+	sourcingModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/sourcing"
+	movementModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+)
+
+// Confirm reserves the stock of every order line across the product batches, earliest due date first.
+// With a delivery locality the sourcing plan first picks the warehouses, and each line is reserved from the batches
+// of the warehouses the plan ships its product from, in plan order.
+// The whole confirmation runs in one transaction: if any line cannot be fully served,
+// nothing is reserved and an OUT_OF_STOCK error is returned. Orders with no lines, or a line without
+// a positive quantity, fail validation.
+func (s *allocationService) Confirm(ctx context.Context, orderId int, req models.ConfirmRequest) (*models.AllocationResponse, error) {
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	statusId, err := s.rp.GetOrderStatusForUpdate(ctx, tx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	if statusId != buyerModels.OrderStatusPending {
+		err = apperrors.NewAppError(apperrors.CodeConflict, "only pending purchase orders can be confirmed")
+		return nil, err
+	}
+
+	lines, err := s.rp.FindOrderLines(ctx, tx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		err = apperrors.NewAppError(apperrors.CodeValidationError, "purchase order has no order details to allocate")
+		return nil, err
+	}
+	for _, line := range lines {
+		if line.Quantity <= 0 {
+			err = apperrors.NewAppError(apperrors.CodeValidationError, "order detail quantity must be greater than 0").
+				WithDetail("order_detail_id", line.OrderDetailId)
+			return nil, err
+		}
+	}
+
+	var plan *sourcingModels.SourcingPlan
+	var quotas map[int][]sourcingQuota
+	if req.DeliveryLocalityId != nil {
+		plan, err = s.sourceOrder(ctx, *req.DeliveryLocalityId, lines)
+		if err != nil {
+			return nil, err
+		}
+		quotas = planQuotas(plan)
+	}
+
+	allocations := make([]models.StockAllocation, 0)
+	for _, line := range lines {
+		var created []models.StockAllocation
+		if plan == nil {
+			created, err = s.allocateLine(ctx, tx, orderId, line)
+		} else {
+			created, err = s.allocateSourcedLine(ctx, tx, orderId, line, quotas[line.ProductId])
+		}
+		if err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, created...)
+	}
+
+	if err = s.rp.UpdateOrderStatus(ctx, tx, orderId, buyerModels.OrderStatusConfirmed); err != nil {
+		return nil, err
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+
+	return &models.AllocationResponse{
+		PurchaseOrderId: orderId,
+		OrderStatusId:   buyerModels.OrderStatusConfirmed,
+		Allocations:     allocations,
+		Sourcing:        plan,
+	}, nil
+}
+
+// Cancel releases the reserved stock of a purchase order and marks it as cancelled.
+// Pending orders have nothing reserved and are simply cancelled.
+func (s *allocationService) Cancel(ctx context.Context, orderId int) (*models.AllocationResponse, error) {
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	statusId, err := s.rp.GetOrderStatusForUpdate(ctx, tx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	if statusId != buyerModels.OrderStatusPending && statusId != buyerModels.OrderStatusConfirmed {
+		err = apperrors.NewAppError(apperrors.CodeConflict, "only pending or confirmed purchase orders can be cancelled")
+		return nil, err
+	}
+
+	reserved, err := s.rp.FindReservedByOrder(ctx, tx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range reserved {
+		_, err = s.ledger.Append(ctx, tx, movementModels.StockMovement{
+			ProductBatchId: a.ProductBatchId,
+			MovementType:   movementModels.MovementTypeAllocation,
+			Quantity:       a.Quantity,
+			Reason:         fmt.Sprintf("purchase order %d cancelled", orderId),
+			Actor:          movementModels.ActorSystem,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err = s.rp.ReleaseAllocations(ctx, tx, orderId); err != nil {
+		return nil, err
+	}
+
+	if err = s.rp.UpdateOrderStatus(ctx, tx, orderId, buyerModels.OrderStatusCancelled); err != nil {
+		return nil, err
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+
+	released := make([]models.StockAllocation, 0, len(reserved))
+	for _, a := range reserved {
+		a.Status = models.AllocationStatusReleased
+		released = append(released, a)
+	}
+
+	return &models.AllocationResponse{
+		PurchaseOrderId: orderId,
+		OrderStatusId:   buyerModels.OrderStatusCancelled,
+		Allocations:     released,
+	}, nil
+}
+
+// FindByOrder returns the allocations of a purchase order.
+func (s *allocationService) FindByOrder(ctx context.Context, orderId int) ([]models.StockAllocation, error) {
+	return s.rp.FindByOrder(ctx, orderId)
+}
+
+// allocateLine reserves the quantity of one order line from the product batches, earliest due date first.
+func (s *allocationService) allocateLine(ctx context.Context, tx *sql.Tx, orderId int, line models.OrderLine) ([]models.StockAllocation, error) {
+	batches, err := s.rp.FindAvailableBatchesForUpdate(ctx, tx, line.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	return s.reserve(ctx, tx, orderId, line, batches, line.Quantity)
+}
+
+// sourcingQuota is what the sourcing plan ships of a product from one warehouse and is not yet reserved.
+type sourcingQuota struct {
+	warehouseId int
+	quantity    int
+}
+
+// sourceOrder plans the warehouses shipping the order lines to the delivery locality.
+// Returns an OUT_OF_STOCK error listing the shortages when the warehouses together cannot fill the order.
+func (s *allocationService) sourceOrder(ctx context.Context, localityId string, lines []models.OrderLine) (*sourcingModels.SourcingPlan, error) {
+	req := sourcingModels.SourcingRequest{DeliveryLocalityId: localityId, Lines: make([]sourcingModels.SourcingLine, len(lines))}
+	for i, l := range lines {
+		req.Lines[i] = sourcingModels.SourcingLine{ProductId: l.ProductId, Quantity: l.Quantity}
+	}
+	plan, err := s.sourcing.Plan(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if !plan.Fulfillable {
+		return nil, apperrors.NewAppError(apperrors.CodeOutOfStock, "not enough stock in the warehouses to fill the order").
+			WithDetail("shortages", plan.Shortages)
+	}
+	return plan, nil
+}
+
+// planQuotas lists, per product, the warehouses the plan ships it from and how much, in plan order.
+func planQuotas(plan *sourcingModels.SourcingPlan) map[int][]sourcingQuota {
+	quotas := make(map[int][]sourcingQuota)
+	for _, sh := range plan.Shipments {
+		for _, l := range sh.Lines {
+			quotas[l.ProductId] = append(quotas[l.ProductId], sourcingQuota{warehouseId: sh.WarehouseId, quantity: l.Quantity})
+		}
+	}
+	return quotas
+}
+
+// allocateSourcedLine reserves the quantity of one order line from the warehouses of its product's quotas,
+// using up each quota before moving to the next so lines of the same product share them.
+func (s *allocationService) allocateSourcedLine(ctx context.Context, tx *sql.Tx, orderId int, line models.OrderLine, quotas []sourcingQuota) ([]models.StockAllocation, error) {
+	created := make([]models.StockAllocation, 0)
+	need := line.Quantity
+	for i := range quotas {
+		take := min(need, quotas[i].quantity)
+		if take == 0 {
+			continue
+		}
+		batches, err := s.rp.FindWarehouseBatchesForUpdate(ctx, tx, line.ProductId, quotas[i].warehouseId)
+		if err != nil {
+			return nil, err
+		}
+		reserved, err := s.reserve(ctx, tx, orderId, line, batches, take)
+		if err != nil {
+			return nil, err
+		}
+		created = append(created, reserved...)
+		quotas[i].quantity -= take
+		need -= take
+	}
+	return created, nil
+}
+
+// reserve takes quantity for the order line from the batches, earliest due date first,
+// posting each reservation to the ledger.
+func (s *allocationService) reserve(ctx context.Context, tx *sql.Tx, orderId int, line models.OrderLine, batches []models.BatchStock, quantity int) ([]models.StockAllocation, error) {
+	plan, available := PlanFEFO(batches, quantity)
+	if available < quantity {
+		return nil, apperrors.NewAppError(apperrors.CodeOutOfStock, "not enough stock to allocate the order line").
+			WithDetail("order_detail_id", line.OrderDetailId).
+			WithDetail("product_id", line.ProductId).
+			WithDetail("requested", quantity).
+			WithDetail("available", available)
+	}
+
+	created := make([]models.StockAllocation, 0, len(plan))
+	for _, p := range plan {
+		_, err := s.ledger.Append(ctx, tx, movementModels.StockMovement{
+			ProductBatchId: p.ProductBatchId,
+			MovementType:   movementModels.MovementTypeAllocation,
+			Quantity:       -p.Quantity,
+			Reason:         fmt.Sprintf("purchase order %d confirmed", orderId),
+			Actor:          movementModels.ActorSystem,
+		})
+		if err != nil {
+			return nil, err
+		}
+		a, err := s.rp.CreateAllocation(ctx, tx, models.StockAllocation{
+			PurchaseOrderId: orderId,
+			OrderDetailId:   line.OrderDetailId,
+			ProductBatchId:  p.ProductBatchId,
+			Quantity:        p.Quantity,
+			Status:          models.AllocationStatusReserved,
+		})
+		if err != nil {
+			return nil, err
+		}
+		created = append(created, *a)
+	}
+	return created, nil
+}
+
+// BatchPick is the quantity PlanFEFO takes from one batch.
+type BatchPick struct {
+	ProductBatchId int
+	Quantity       int
+}
+
+// PlanFEFO walks the batches in the given order (expected earliest due date first)
+// and takes stock from each until quantity is covered.
+// Returns the picks and the total quantity they add up to, which is lower than quantity when stock is short.
+func PlanFEFO(batches []models.BatchStock, quantity int) ([]BatchPick, int) {
+	picks := make([]BatchPick, 0)
+	taken := 0
+	for _, b := range batches {
+		if taken == quantity {
+			break
+		}
+		if b.CurrentQuantity <= 0 {
+			continue
+		}
+		take := min(b.CurrentQuantity, quantity-taken)
+		picks = append(picks, BatchPick{ProductBatchId: b.ProductBatchId, Quantity: take})
+		taken += take
+	}
+	return picks, taken
+}
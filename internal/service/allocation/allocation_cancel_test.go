@@ -0,0 +1,97 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/allocation"
+	stockMovementRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/allocation"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/allocation"
+	sourcingMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/sourcing"
+	movementMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+	buyerModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+	movementModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestAllocationService_Cancel(t *testing.T) {
+	testCases := []struct {
+		name            string
+		status          int
+		reserved        []models.StockAllocation
+		wantIncreased   map[int]int
+		wantAllocations int
+		errCode         string
+	}{
+		{
+			name:   "success - confirmed order gives stock back",
+			status: buyerModels.OrderStatusConfirmed,
+			reserved: []models.StockAllocation{
+				testhelpers.DummyStockAllocation(1, 1, 4),
+				testhelpers.DummyStockAllocation(2, 2, 2),
+			},
+			wantIncreased:   map[int]int{1: 4, 2: 2},
+			wantAllocations: 2,
+		},
+		{
+			name:            "success - pending order has nothing reserved",
+			status:          buyerModels.OrderStatusPending,
+			reserved:        []models.StockAllocation{},
+			wantIncreased:   map[int]int{},
+			wantAllocations: 0,
+		},
+		{
+			name:          "error - order already cancelled",
+			status:        buyerModels.OrderStatusCancelled,
+			wantIncreased: map[int]int{},
+			errCode:       apperrors.CodeConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			increased := map[int]int{}
+			var newStatus int
+			repoMock := &mocks.AllocationRepositoryMock{
+				FuncGetOrderStatusForUpdate: func(ctx context.Context, exec repository.Executor, orderId int) (int, error) {
+					return tc.status, nil
+				},
+				FuncFindReservedByOrder: func(ctx context.Context, exec repository.Executor, orderId int) ([]models.StockAllocation, error) {
+					return tc.reserved, nil
+				},
+				FuncUpdateOrderStatus: func(ctx context.Context, exec repository.Executor, orderId int, statusId int) error {
+					newStatus = statusId
+					return nil
+				},
+			}
+			ledgerMock := &movementMocks.StockMovementRepositoryMock{
+				FuncAppend: func(ctx context.Context, exec stockMovementRepository.Executor, m movementModels.StockMovement) (*movementModels.StockMovement, error) {
+					increased[m.ProductBatchId] += m.Quantity
+					return &m, nil
+				},
+			}
+			svc := service.NewAllocationService(repoMock, ledgerMock, &sourcingMocks.SourcingServiceMock{})
+
+			result, err := svc.Cancel(context.Background(), 1)
+
+			require.Equal(t, tc.wantIncreased, increased)
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, buyerModels.OrderStatusCancelled, newStatus)
+			require.Equal(t, buyerModels.OrderStatusCancelled, result.OrderStatusId)
+			require.Len(t, result.Allocations, tc.wantAllocations)
+			for _, a := range result.Allocations {
+				require.Equal(t, models.AllocationStatusReleased, a.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/allocation"
+	stockMovementRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/allocation"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/allocation"
+	sourcingMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/sourcing"
+	movementMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+	buyerModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+	sourcingModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/sourcing"
+	movementModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestAllocationService_Confirm(t *testing.T) {
+	type output struct {
+		allocations   []models.StockAllocation
+		decreased     map[int]int
+		expectedError bool
+		errCode       string
+		rolledBack    bool
+	}
+	type testCase struct {
+		name     string
+		mockRepo func(decreased map[int]int, rolledBack *bool) *mocks.AllocationRepositoryMock
+		output   output
+	}
+
+	baseMock := func(decreased map[int]int, rolledBack *bool) *mocks.AllocationRepositoryMock {
+		nextId := 0
+		return &mocks.AllocationRepositoryMock{
+			FuncGetOrderStatusForUpdate: func(ctx context.Context, exec repository.Executor, orderId int) (int, error) {
+				return buyerModels.OrderStatusPending, nil
+			},
+			FuncFindOrderLines: func(ctx context.Context, exec repository.Executor, orderId int) ([]models.OrderLine, error) {
+				return []models.OrderLine{testhelpers.DummyOrderLine(1, 7, 6)}, nil
+			},
+			FuncFindAvailableBatchesForUpdate: func(ctx context.Context, exec repository.Executor, productId int) ([]models.BatchStock, error) {
+				return testhelpers.DummyBatchStocks(), nil
+			},
+			FuncCreateAllocation: func(ctx context.Context, exec repository.Executor, a models.StockAllocation) (*models.StockAllocation, error) {
+				nextId++
+				a.Id = nextId
+				return &a, nil
+			},
+		}
+	}
+
+	testCases := []testCase{
+		{
+			name: "success - takes earliest due date batch first",
+			mockRepo: func(decreased map[int]int, rolledBack *bool) *mocks.AllocationRepositoryMock {
+				return baseMock(decreased, rolledBack)
+			},
+			output: output{
+				allocations: []models.StockAllocation{
+					{Id: 1, PurchaseOrderId: 1, OrderDetailId: 1, ProductBatchId: 1, Quantity: 4, Status: models.AllocationStatusReserved},
+					{Id: 2, PurchaseOrderId: 1, OrderDetailId: 1, ProductBatchId: 2, Quantity: 2, Status: models.AllocationStatusReserved},
+				},
+				decreased: map[int]int{1: 4, 2: 2},
+			},
+		},
+		{
+			name: "error - not enough stock returns out of stock and rolls back",
+			mockRepo: func(decreased map[int]int, rolledBack *bool) *mocks.AllocationRepositoryMock {
+				m := baseMock(decreased, rolledBack)
+				m.FuncFindOrderLines = func(ctx context.Context, exec repository.Executor, orderId int) ([]models.OrderLine, error) {
+					return []models.OrderLine{testhelpers.DummyOrderLine(1, 7, 20)}, nil
+				}
+				return m
+			},
+			output: output{
+				decreased:     map[int]int{},
+				expectedError: true,
+				errCode:       apperrors.CodeOutOfStock,
+				rolledBack:    true,
+			},
+		},
+		{
+			name: "error - order is not pending",
+			mockRepo: func(decreased map[int]int, rolledBack *bool) *mocks.AllocationRepositoryMock {
+				m := baseMock(decreased, rolledBack)
+				m.FuncGetOrderStatusForUpdate = func(ctx context.Context, exec repository.Executor, orderId int) (int, error) {
+					return buyerModels.OrderStatusConfirmed, nil
+				}
+				return m
+			},
+			output: output{
+				decreased:     map[int]int{},
+				expectedError: true,
+				errCode:       apperrors.CodeConflict,
+				rolledBack:    true,
+			},
+		},
+		{
+			name: "error - order without lines",
+			mockRepo: func(decreased map[int]int, rolledBack *bool) *mocks.AllocationRepositoryMock {
+				m := baseMock(decreased, rolledBack)
+				m.FuncFindOrderLines = func(ctx context.Context, exec repository.Executor, orderId int) ([]models.OrderLine, error) {
+					return []models.OrderLine{}, nil
+				}
+				return m
+			},
+			output: output{
+				decreased:     map[int]int{},
+				expectedError: true,
+				errCode:       apperrors.CodeValidationError,
+				rolledBack:    true,
+			},
+		},
+		{
+			name: "error - line without quantity",
+			mockRepo: func(decreased map[int]int, rolledBack *bool) *mocks.AllocationRepositoryMock {
+				m := baseMock(decreased, rolledBack)
+				m.FuncFindOrderLines = func(ctx context.Context, exec repository.Executor, orderId int) ([]models.OrderLine, error) {
+					return []models.OrderLine{testhelpers.DummyOrderLine(1, 7, 6), testhelpers.DummyOrderLine(2, 8, 0)}, nil
+				}
+				return m
+			},
+			output: output{
+				decreased:     map[int]int{},
+				expectedError: true,
+				errCode:       apperrors.CodeValidationError,
+				rolledBack:    true,
+			},
+		},
+		{
+			name: "error - order not found",
+			mockRepo: func(decreased map[int]int, rolledBack *bool) *mocks.AllocationRepositoryMock {
+				m := baseMock(decreased, rolledBack)
+				m.FuncGetOrderStatusForUpdate = func(ctx context.Context, exec repository.Executor, orderId int) (int, error) {
+					return 0, apperrors.NewAppError(apperrors.CodeNotFound, "purchase order not found")
+				}
+				return m
+			},
+			output: output{
+				decreased:     map[int]int{},
+				expectedError: true,
+				errCode:       apperrors.CodeNotFound,
+				rolledBack:    true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			decreased := map[int]int{}
+			rolledBack := false
+			repoMock := tc.mockRepo(decreased, &rolledBack)
+			repoMock.FuncRollbackTx = func(tx *sql.Tx) error {
+				rolledBack = true
+				return nil
+			}
+			ledgerMock := &movementMocks.StockMovementRepositoryMock{
+				FuncAppend: func(ctx context.Context, exec stockMovementRepository.Executor, m movementModels.StockMovement) (*movementModels.StockMovement, error) {
+					require.Equal(t, movementModels.MovementTypeAllocation, m.MovementType)
+					decreased[m.ProductBatchId] -= m.Quantity
+					return &m, nil
+				},
+			}
+			svc := service.NewAllocationService(repoMock, ledgerMock, &sourcingMocks.SourcingServiceMock{})
+
+			result, err := svc.Confirm(context.Background(), 1, models.ConfirmRequest{})
+
+			require.Equal(t, tc.output.rolledBack, rolledBack)
+			if tc.output.expectedError {
+				require.Error(t, err)
+				testhelpers.RequireAppErr(t, err, tc.output.errCode)
+				require.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, buyerModels.OrderStatusConfirmed, result.OrderStatusId)
+			require.Equal(t, tc.output.allocations, result.Allocations)
+			require.Equal(t, tc.output.decreased, decreased)
+		})
+	}
+}
+
+func TestAllocationService_ConfirmSourced(t *testing.T) {
+	// The plan ships product 7 from warehouses 1 and 2 and product 8 from warehouse 2. Two lines of product 7
+	// share its quotas: the first uses up warehouse 1 and takes the rest from warehouse 2.
+	locality := "1000"
+	lines := []models.OrderLine{testhelpers.DummyOrderLine(1, 7, 6), testhelpers.DummyOrderLine(2, 7, 3), testhelpers.DummyOrderLine(3, 8, 2)}
+	split := &sourcingModels.SourcingPlan{
+		DeliveryLocalityId: locality, Fulfillable: true, Split: true,
+		Shipments: []sourcingModels.SourcedShipment{
+			{WarehouseId: 1, Lines: []sourcingModels.SourcingLine{{ProductId: 7, Quantity: 5}}},
+			{WarehouseId: 2, Lines: []sourcingModels.SourcingLine{{ProductId: 7, Quantity: 4}, {ProductId: 8, Quantity: 2}}},
+		},
+		Shortages: []sourcingModels.Shortage{},
+	}
+	batches := map[[2]int][]models.BatchStock{
+		{7, 1}: {{ProductBatchId: 10, CurrentQuantity: 5}},
+		{7, 2}: {{ProductBatchId: 20, CurrentQuantity: 10}},
+		{8, 2}: {{ProductBatchId: 30, CurrentQuantity: 2}},
+	}
+
+	testCases := []struct {
+		name            string
+		plan            *sourcingModels.SourcingPlan
+		planErr         error
+		wantAllocations []models.StockAllocation
+		wantErrCode     string
+	}{
+		{
+			name: "success - reserves from the planned warehouses",
+			plan: split,
+			wantAllocations: []models.StockAllocation{
+				{Id: 1, PurchaseOrderId: 1, OrderDetailId: 1, ProductBatchId: 10, Quantity: 5, Status: models.AllocationStatusReserved},
+				{Id: 2, PurchaseOrderId: 1, OrderDetailId: 1, ProductBatchId: 20, Quantity: 1, Status: models.AllocationStatusReserved},
+				{Id: 3, PurchaseOrderId: 1, OrderDetailId: 2, ProductBatchId: 20, Quantity: 3, Status: models.AllocationStatusReserved},
+				{Id: 4, PurchaseOrderId: 1, OrderDetailId: 3, ProductBatchId: 30, Quantity: 2, Status: models.AllocationStatusReserved},
+			},
+		},
+		{
+			name: "error - warehouses short of stock",
+			plan: &sourcingModels.SourcingPlan{
+				DeliveryLocalityId: locality,
+				Shipments:          []sourcingModels.SourcedShipment{},
+				Shortages:          []sourcingModels.Shortage{{ProductId: 8, Requested: 2, Available: 0}},
+			},
+			wantErrCode: apperrors.CodeOutOfStock,
+		},
+		{
+			name:        "error - delivery locality without coordinates",
+			planErr:     apperrors.NewAppError(apperrors.CodeConflict, "delivery locality has no coordinates"),
+			wantErrCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nextId := 0
+			rolledBack := false
+			repoMock := &mocks.AllocationRepositoryMock{
+				FuncGetOrderStatusForUpdate: func(ctx context.Context, exec repository.Executor, orderId int) (int, error) {
+					return buyerModels.OrderStatusPending, nil
+				},
+				FuncFindOrderLines: func(ctx context.Context, exec repository.Executor, orderId int) ([]models.OrderLine, error) {
+					return lines, nil
+				},
+				FuncFindWarehouseBatchesForUpdate: func(ctx context.Context, exec repository.Executor, productId int, warehouseId int) ([]models.BatchStock, error) {
+					return batches[[2]int{productId, warehouseId}], nil
+				},
+				FuncCreateAllocation: func(ctx context.Context, exec repository.Executor, a models.StockAllocation) (*models.StockAllocation, error) {
+					nextId++
+					a.Id = nextId
+					return &a, nil
+				},
+				FuncRollbackTx: func(tx *sql.Tx) error {
+					rolledBack = true
+					return nil
+				},
+			}
+			sourcingMock := &sourcingMocks.SourcingServiceMock{
+				FuncPlan: func(ctx context.Context, req sourcingModels.SourcingRequest) (*sourcingModels.SourcingPlan, error) {
+					require.Equal(t, locality, req.DeliveryLocalityId)
+					require.Len(t, req.Lines, 3)
+					return tc.plan, tc.planErr
+				},
+			}
+			ledgerMock := &movementMocks.StockMovementRepositoryMock{
+				FuncAppend: func(ctx context.Context, exec stockMovementRepository.Executor, m movementModels.StockMovement) (*movementModels.StockMovement, error) {
+					return &m, nil
+				},
+			}
+			svc := service.NewAllocationService(repoMock, ledgerMock, sourcingMock)
+
+			result, err := svc.Confirm(context.Background(), 1, models.ConfirmRequest{DeliveryLocalityId: &locality})
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.True(t, rolledBack)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, rolledBack)
+			require.Equal(t, tc.wantAllocations, result.Allocations)
+			require.Equal(t, tc.plan, result.Sourcing)
+		})
+	}
+}
+
+func TestPlanFEFO(t *testing.T) {
+	testCases := []struct {
+		name          string
+		quantity      int
+		wantPicks     []service.BatchPick
+		wantAvailable int
+	}{
+		{
+			name:          "served by first batch",
+			quantity:      3,
+			wantPicks:     []service.BatchPick{{ProductBatchId: 1, Quantity: 3}},
+			wantAvailable: 3,
+		},
+		{
+			name:          "spans two batches",
+			quantity:      9,
+			wantPicks:     []service.BatchPick{{ProductBatchId: 1, Quantity: 4}, {ProductBatchId: 2, Quantity: 5}},
+			wantAvailable: 9,
+		},
+		{
+			name:          "short of stock",
+			quantity:      30,
+			wantPicks:     []service.BatchPick{{ProductBatchId: 1, Quantity: 4}, {ProductBatchId: 2, Quantity: 10}},
+			wantAvailable: 14,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			picks, available := service.PlanFEFO(testhelpers.DummyBatchStocks(), tc.quantity)
+			require.Equal(t, tc.wantPicks, picks)
+			require.Equal(t, tc.wantAvailable, available)
+		})
+	}
+}
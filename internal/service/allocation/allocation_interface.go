@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/allocation"
+	stockMovementRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	sourcingService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/sourcing"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+)
+
+// AllocationService reserves and releases batch stock for purchase orders.
+type AllocationService interface {
+	// Confirm reserves stock First-Expired-First-Out for every line of a pending purchase order
+	// and marks the order as confirmed. Given a delivery locality, the stock comes from the nearest warehouses.
+	Confirm(ctx context.Context, orderId int, req models.ConfirmRequest) (*models.AllocationResponse, error)
+
+	// Cancel gives the reserved stock of a purchase order back to its batches
+	// and marks the order as cancelled.
+	Cancel(ctx context.Context, orderId int) (*models.AllocationResponse, error)
+
+	// FindByOrder returns every allocation made for a purchase order.
+	FindByOrder(ctx context.Context, orderId int) ([]models.StockAllocation, error)
+}
+
+// allocationService implements AllocationService using a repository.
+// Stock changes are posted through the stock ledger so every reservation leaves a movement behind,
+// and the sourcing service picks the warehouses when the order is confirmed for a delivery locality.
+type allocationService struct {
+	rp       repository.AllocationRepository
+	ledger   stockMovementRepository.StockMovementRepository
+	sourcing sourcingService.SourcingService
+}
+
+// NewAllocationService creates a new AllocationService using the provided repositories and sourcing service.
+func NewAllocationService(rp repository.AllocationRepository, ledger stockMovementRepository.StockMovementRepository, sourcing sourcingService.SourcingService) AllocationService {
+	return &allocationService{
+		rp:       rp,
+		ledger:   ledger,
+		sourcing: sourcing,
+	}
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/asn"
+)
+
+// transitions lists, for each status an ASN can be moved to by hand, the statuses it can come from.
+// An ASN goes from arriving to receiving on its own when the first batch is received against it;
+// it can be completed straight from arriving when nothing showed up.
+var transitions = map[string][]string{
+	models.StatusArriving: {models.StatusScheduled},
+	models.StatusReceived: {models.StatusArriving, models.StatusReceiving},
+	models.StatusClosed:   {models.StatusReceived},
+}
+
+// Create stores the ASN and its lines in one transaction.
+// Returns a validation error if a product does not belong to the seller.
+func (s *asnService) Create(ctx context.Context, req models.PostAsn) (*models.AsnDetail, error) {
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	asn, err := s.rp.Create(ctx, tx, models.Asn{
+		AsnNumber:   req.AsnNumber,
+		SellerId:    req.SellerId,
+		WarehouseId: req.WarehouseId,
+		Status:      models.StatusScheduled,
+		ExpectedAt:  req.ExpectedAt,
+		CreatedAt:   time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range req.Lines {
+		if err = s.rp.CreateLine(ctx, tx, asn.Id, req.SellerId, l); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+	return s.FindById(ctx, asn.Id)
+}
+
+// FindAll returns the ASNs matching the filter, latest expected first; zero filters are ignored.
+func (s *asnService) FindAll(ctx context.Context, filter models.AsnFilter) ([]models.Asn, error) {
+	return s.rp.FindAll(ctx, filter)
+}
+
+// FindById returns the ASN with its lines.
+// Returns a not found error if the ASN does not exist.
+func (s *asnService) FindById(ctx context.Context, id int) (*models.AsnDetail, error) {
+	asn, err := s.rp.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := s.rp.FindLines(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &models.AsnDetail{Asn: *asn, Lines: lines}, nil
+}
+
+// Arrive marks a scheduled ASN as arriving, opening it for receiving.
+// Returns a not found error if the ASN does not exist and a conflict error if it is not scheduled.
+func (s *asnService) Arrive(ctx context.Context, id int) (*models.AsnDetail, error) {
+	return s.transition(ctx, id, models.StatusArriving)
+}
+
+// Complete marks an arriving or receiving ASN as received; from then on it counts in the discrepancy report.
+// Returns a not found error if the ASN does not exist and a conflict error if it is not arriving or receiving.
+func (s *asnService) Complete(ctx context.Context, id int) (*models.AsnDetail, error) {
+	return s.transition(ctx, id, models.StatusReceived)
+}
+
+// Close closes a received ASN once its discrepancies are settled.
+// Returns a not found error if the ASN does not exist and a conflict error if it is not received.
+func (s *asnService) Close(ctx context.Context, id int) (*models.AsnDetail, error) {
+	return s.transition(ctx, id, models.StatusClosed)
+}
+
+// transition locks the ASN, checks it can move to the given status and stamps the matching timestamp.
+// Returns a conflict error if the ASN is not in a status the move is allowed from.
+func (s *asnService) transition(ctx context.Context, id int, to string) (*models.AsnDetail, error) {
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	asn, err := s.rp.FindForUpdate(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canMove(asn.Status, to) {
+		err = apperrors.NewAppError(apperrors.CodeConflict, "asn cannot move to the requested status").
+			WithDetail("asn_id", id).
+			WithDetail("status", asn.Status).
+			WithDetail("requested", to)
+		return nil, err
+	}
+
+	now := time.Now()
+	asn.Status = to
+	switch to {
+	case models.StatusArriving:
+		asn.ArrivedAt = &now
+	case models.StatusReceived:
+		asn.ReceivedAt = &now
+	case models.StatusClosed:
+		asn.ClosedAt = &now
+	}
+	if err = s.rp.UpdateStatus(ctx, tx, *asn); err != nil {
+		return nil, err
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+	return s.FindById(ctx, id)
+}
+
+func canMove(from, to string) bool {
+	for _, allowed := range transitions[to] {
+		if allowed == from {
+			return true
+		}
+	}
+	return false
+}
+
+// DiscrepancyReport groups the lines of completed ASNs by seller. Lines come ordered by seller,
+// so they are grouped as they are read. Short and over quantities are summed per line, so an
+// overage on one product does not hide a shortage on another.
+func (s *asnService) DiscrepancyReport(ctx context.Context, sellerId int, warehouseId int) ([]models.SupplierDiscrepancy, error) {
+	lines, err := s.rp.FindDiscrepancyLines(ctx, sellerId, warehouseId)
+	if err != nil {
+		return nil, err
+	}
+
+	report := make([]models.SupplierDiscrepancy, 0)
+	filled := 0
+	lastAsn := 0
+	for _, l := range lines {
+		if len(report) == 0 || report[len(report)-1].SellerId != l.SellerId {
+			closeFillRate(report, filled)
+			filled, lastAsn = 0, 0
+			report = append(report, models.SupplierDiscrepancy{
+				SellerId:    l.SellerId,
+				CompanyName: l.CompanyName,
+				Lines:       make([]models.DiscrepancyLine, 0),
+			})
+		}
+		seller := &report[len(report)-1]
+		if l.AsnId != lastAsn {
+			seller.AsnCount++
+			lastAsn = l.AsnId
+		}
+		seller.ExpectedQuantity += l.ExpectedQuantity
+		seller.ReceivedQuantity += l.ReceivedQuantity
+		filled += min(l.ReceivedQuantity, l.ExpectedQuantity)
+		if l.Variance == 0 {
+			continue
+		}
+		if l.Variance < 0 {
+			seller.ShortQuantity -= l.Variance
+		} else {
+			seller.OverQuantity += l.Variance
+		}
+		seller.DiscrepantLines++
+		seller.Lines = append(seller.Lines, l)
+	}
+	closeFillRate(report, filled)
+	return report, nil
+}
+
+// closeFillRate sets the fill rate of the last seller in the report, rounded to two decimals.
+func closeFillRate(report []models.SupplierDiscrepancy, filled int) {
+	if len(report) == 0 {
+		return
+	}
+	seller := &report[len(report)-1]
+	if seller.ExpectedQuantity > 0 {
+		seller.FillRate = math.Round(float64(filled)/float64(seller.ExpectedQuantity)*10000) / 100
+	}
+}
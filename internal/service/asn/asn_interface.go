@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/asn"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/asn"
+)
+
+// AsnService manages expected deliveries and reports how sellers' deliveries matched them.
+type AsnService interface {
+	// Create schedules an expected delivery with its lines.
+	Create(ctx context.Context, req models.PostAsn) (*models.AsnDetail, error)
+
+	// FindAll returns the ASNs matching the filter.
+	FindAll(ctx context.Context, filter models.AsnFilter) ([]models.Asn, error)
+
+	// FindById returns an ASN with its lines.
+	FindById(ctx context.Context, id int) (*models.AsnDetail, error)
+
+	// Arrive marks a scheduled delivery as arrived at the warehouse.
+	Arrive(ctx context.Context, id int) (*models.AsnDetail, error)
+
+	// Complete marks the receiving of a delivery as done.
+	Complete(ctx context.Context, id int) (*models.AsnDetail, error)
+
+	// Close closes a received delivery once its discrepancies are settled.
+	Close(ctx context.Context, id int) (*models.AsnDetail, error)
+
+	// DiscrepancyReport summarises per seller the differences between expected and received quantities.
+	DiscrepancyReport(ctx context.Context, sellerId int, warehouseId int) ([]models.SupplierDiscrepancy, error)
+}
+
+// asnService implements AsnService using a repository.
+type asnService struct {
+	rp repository.AsnRepository
+}
+
+// NewAsnService creates a new AsnService using the provided repository.
+func NewAsnService(rp repository.AsnRepository) AsnService {
+	return &asnService{
+		rp: rp,
+	}
+}
@@ -0,0 +1,219 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/asn"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/asn"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/asn"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/asn"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestAsnService_Create(t *testing.T) {
+	testCases := []struct {
+		name        string
+		lineErr     error
+		wantErrCode string
+		wantLines   int
+	}{
+		{
+			name:      "success - asn and lines stored",
+			wantLines: 2,
+		},
+		{
+			name:        "error - product of another seller",
+			lineErr:     apperrors.NewAppError(apperrors.CodeValidationError, "product not found for the seller"),
+			wantErrCode: apperrors.CodeValidationError,
+			wantLines:   1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rolledBack, committed bool
+			var lines []models.PostAsnLine
+			rp := &mocks.AsnRepositoryMock{
+				FuncCreate: func(ctx context.Context, exec repository.Executor, a models.Asn) (*models.Asn, error) {
+					require.Equal(t, models.StatusScheduled, a.Status)
+					a.Id = 7
+					return &a, nil
+				},
+				FuncCreateLine: func(ctx context.Context, exec repository.Executor, asnId int, sellerId int, l models.PostAsnLine) error {
+					require.Equal(t, 7, asnId)
+					require.Equal(t, 3, sellerId)
+					lines = append(lines, l)
+					return tc.lineErr
+				},
+				FuncFindById: func(ctx context.Context, id int) (*models.Asn, error) {
+					a := testhelpers.DummyAsn(models.StatusScheduled)
+					return &a, nil
+				},
+				FuncCommitTx: func(tx *sql.Tx) error {
+					committed = true
+					return nil
+				},
+				FuncRollbackTx: func(tx *sql.Tx) error {
+					rolledBack = true
+					return nil
+				},
+			}
+			svc := service.NewAsnService(rp)
+
+			result, err := svc.Create(context.Background(), testhelpers.DummyPostAsn())
+
+			require.Len(t, lines, tc.wantLines)
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, result)
+				require.True(t, rolledBack)
+				require.False(t, committed)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, committed)
+			require.False(t, rolledBack)
+			require.Equal(t, 7, result.Id)
+		})
+	}
+}
+
+func TestAsnService_Transitions(t *testing.T) {
+	testCases := []struct {
+		name        string
+		from        string
+		move        func(svc service.AsnService) (*models.AsnDetail, error)
+		wantStatus  string
+		wantErrCode string
+	}{
+		{
+			name:       "success - scheduled asn arrives",
+			from:       models.StatusScheduled,
+			move:       func(svc service.AsnService) (*models.AsnDetail, error) { return svc.Arrive(context.Background(), 7) },
+			wantStatus: models.StatusArriving,
+		},
+		{
+			name:       "success - receiving asn completed",
+			from:       models.StatusReceiving,
+			move:       func(svc service.AsnService) (*models.AsnDetail, error) { return svc.Complete(context.Background(), 7) },
+			wantStatus: models.StatusReceived,
+		},
+		{
+			name:       "success - arriving asn completed with nothing received",
+			from:       models.StatusArriving,
+			move:       func(svc service.AsnService) (*models.AsnDetail, error) { return svc.Complete(context.Background(), 7) },
+			wantStatus: models.StatusReceived,
+		},
+		{
+			name:       "success - received asn closed",
+			from:       models.StatusReceived,
+			move:       func(svc service.AsnService) (*models.AsnDetail, error) { return svc.Close(context.Background(), 7) },
+			wantStatus: models.StatusClosed,
+		},
+		{
+			name:        "error - scheduled asn cannot be completed",
+			from:        models.StatusScheduled,
+			move:        func(svc service.AsnService) (*models.AsnDetail, error) { return svc.Complete(context.Background(), 7) },
+			wantErrCode: apperrors.CodeConflict,
+		},
+		{
+			name:        "error - receiving asn cannot be closed",
+			from:        models.StatusReceiving,
+			move:        func(svc service.AsnService) (*models.AsnDetail, error) { return svc.Close(context.Background(), 7) },
+			wantErrCode: apperrors.CodeConflict,
+		},
+		{
+			name:        "error - closed asn cannot arrive again",
+			from:        models.StatusClosed,
+			move:        func(svc service.AsnService) (*models.AsnDetail, error) { return svc.Arrive(context.Background(), 7) },
+			wantErrCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rolledBack bool
+			var updated *models.Asn
+			rp := &mocks.AsnRepositoryMock{
+				FuncFindForUpdate: func(ctx context.Context, exec repository.Executor, id int) (*models.Asn, error) {
+					a := testhelpers.DummyAsn(tc.from)
+					return &a, nil
+				},
+				FuncUpdateStatus: func(ctx context.Context, exec repository.Executor, a models.Asn) error {
+					updated = &a
+					return nil
+				},
+				FuncFindById: func(ctx context.Context, id int) (*models.Asn, error) {
+					return updated, nil
+				},
+				FuncRollbackTx: func(tx *sql.Tx) error {
+					rolledBack = true
+					return nil
+				},
+			}
+			svc := service.NewAsnService(rp)
+
+			result, err := tc.move(svc)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, result)
+				require.Nil(t, updated)
+				require.True(t, rolledBack)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantStatus, result.Status)
+			switch tc.wantStatus {
+			case models.StatusArriving:
+				require.NotNil(t, updated.ArrivedAt)
+			case models.StatusReceived:
+				require.NotNil(t, updated.ReceivedAt)
+			case models.StatusClosed:
+				require.NotNil(t, updated.ClosedAt)
+			}
+		})
+	}
+}
+
+func TestAsnService_DiscrepancyReport(t *testing.T) {
+	rp := &mocks.AsnRepositoryMock{
+		FuncFindDiscrepancyLines: func(ctx context.Context, sellerId int, warehouseId int) ([]models.DiscrepancyLine, error) {
+			return []models.DiscrepancyLine{
+				testhelpers.DummyDiscrepancyLine(3, 7, 22, 100, 90),
+				testhelpers.DummyDiscrepancyLine(3, 7, 23, 40, 40),
+				testhelpers.DummyDiscrepancyLine(3, 8, 22, 60, 70),
+				testhelpers.DummyDiscrepancyLine(5, 9, 30, 20, 20),
+				testhelpers.DummyDiscrepancyLine(6, 10, 31, 0, 5),
+			}, nil
+		},
+	}
+	svc := service.NewAsnService(rp)
+
+	report, err := svc.DiscrepancyReport(context.Background(), 0, 0)
+
+	require.NoError(t, err)
+	require.Len(t, report, 3)
+
+	require.Equal(t, 3, report[0].SellerId)
+	require.Equal(t, 2, report[0].AsnCount)
+	require.Equal(t, 200, report[0].ExpectedQuantity)
+	require.Equal(t, 200, report[0].ReceivedQuantity)
+	require.Equal(t, 10, report[0].ShortQuantity)
+	require.Equal(t, 10, report[0].OverQuantity)
+	require.Equal(t, 2, report[0].DiscrepantLines)
+	require.Len(t, report[0].Lines, 2)
+	require.Equal(t, 95.0, report[0].FillRate)
+
+	require.Equal(t, 5, report[1].SellerId)
+	require.Equal(t, 100.0, report[1].FillRate)
+	require.Empty(t, report[1].Lines)
+
+	require.Equal(t, 6, report[2].SellerId)
+	require.Equal(t, 5, report[2].OverQuantity)
+	require.Equal(t, 0.0, report[2].FillRate)
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/batch_status"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/batch_status"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	movementModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+)
+
+// transitions lists the statuses each status can move to. Disposed is final.
+var transitions = map[string]map[string]bool{
+	batchModels.BatchStatusAvailable: {
+		batchModels.BatchStatusOnHold:      true,
+		batchModels.BatchStatusQuarantined: true,
+		batchModels.BatchStatusDisposed:    true,
+	},
+	batchModels.BatchStatusOnHold: {
+		batchModels.BatchStatusAvailable:   true,
+		batchModels.BatchStatusQuarantined: true,
+		batchModels.BatchStatusDisposed:    true,
+	},
+	batchModels.BatchStatusQuarantined: {
+		batchModels.BatchStatusDisposed: true,
+	},
+}
+
+// Hold places an available batch on hold.
+func (s *batchStatusService) Hold(ctx context.Context, batchId int, req models.PostStatusChange) (*models.StatusChange, error) {
+	return s.SetStatus(ctx, batchId, batchModels.BatchStatusOnHold, req)
+}
+
+// Release makes a batch on hold available again. The status is checked on the locked batch, so a batch
+// quarantined or disposed in the meantime is never released.
+func (s *batchStatusService) Release(ctx context.Context, batchId int, req models.PostStatusChange) (*models.StatusChange, error) {
+	return s.setStatus(ctx, batchId, batchModels.BatchStatusOnHold, batchModels.BatchStatusAvailable, req)
+}
+
+// SetStatus moves the batch to status in its own transaction.
+func (s *batchStatusService) SetStatus(ctx context.Context, batchId int, status string, req models.PostStatusChange) (*models.StatusChange, error) {
+	return s.setStatus(ctx, batchId, "", status, req)
+}
+
+// setStatus moves the batch to status in its own transaction, from the status required by from when set.
+func (s *batchStatusService) setStatus(ctx context.Context, batchId int, from, status string, req models.PostStatusChange) (*models.StatusChange, error) {
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	change, err := s.applyStatus(ctx, tx, batchId, from, status, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+
+	return change, nil
+}
+
+// ApplyStatus locks the batch, checks the transition and stores the new status with the reason code
+// and actor, appending the change to the batch history. It runs on exec so callers can change several
+// batches in one transaction.
+// Taking a batch out of available releases its open reservations and returns the units to its
+// current quantity, so the orders come up short at picking instead of picking a blocked batch.
+// Disposing a batch then writes off its remaining quantity through the stock ledger and takes it
+// out of its section capacity.
+func (s *batchStatusService) ApplyStatus(ctx context.Context, exec repository.Executor, batchId int, status string, req models.PostStatusChange) (*models.StatusChange, error) {
+	return s.applyStatus(ctx, exec, batchId, "", status, req)
+}
+
+// applyStatus is ApplyStatus for a batch that must currently be in the from status, unless from is empty.
+func (s *batchStatusService) applyStatus(ctx context.Context, exec repository.Executor, batchId int, from, status string, req models.PostStatusChange) (*models.StatusChange, error) {
+	current, err := s.rp.FindStatusForUpdate(ctx, exec, batchId)
+	if err != nil {
+		return nil, err
+	}
+	if from != "" && current.Status != from {
+		return nil, apperrors.NewAppError(apperrors.CodeConflict, fmt.Sprintf("product batch is not %s", from)).
+			WithDetail("product_batch_id", batchId).
+			WithDetail("status", current.Status)
+	}
+	if err := validateTransition(current.Status, status); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := s.rp.UpdateStatus(ctx, exec, batchId, status, req.ReasonCode, req.Actor, now); err != nil {
+		return nil, err
+	}
+
+	stock := current.CurrentQuantity
+	if status != batchModels.BatchStatusAvailable {
+		reserved, err := s.releaseReservations(ctx, exec, batchId, status, req)
+		if err != nil {
+			return nil, err
+		}
+		stock += reserved
+	}
+
+	if status == batchModels.BatchStatusDisposed && stock > 0 {
+		_, err := s.ledger.Append(ctx, exec, movementModels.StockMovement{
+			ProductBatchId: batchId,
+			MovementType:   movementModels.MovementTypeDisposal,
+			Quantity:       -stock,
+			Reason:         fmt.Sprintf("batch disposed: %s", req.ReasonCode),
+			Actor:          req.Actor,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := s.rp.AddSectionCapacity(ctx, exec, current.SectionId, -stock); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.rp.CreateChange(ctx, exec, models.StatusChange{
+		ProductBatchId: batchId,
+		FromStatus:     current.Status,
+		ToStatus:       status,
+		ReasonCode:     req.ReasonCode,
+		Note:           req.Note,
+		Actor:          req.Actor,
+		CreatedAt:      now,
+	})
+}
+
+// releaseReservations releases the reserved allocations of a batch moving to status, returning their
+// units to the batch through the stock ledger, and reports how many units were released.
+func (s *batchStatusService) releaseReservations(ctx context.Context, exec repository.Executor, batchId int, status string, req models.PostStatusChange) (int, error) {
+	reserved, err := s.rp.FindReservedForUpdate(ctx, exec, batchId)
+	if err != nil || reserved == 0 {
+		return 0, err
+	}
+	_, err = s.ledger.Append(ctx, exec, movementModels.StockMovement{
+		ProductBatchId: batchId,
+		MovementType:   movementModels.MovementTypeAllocation,
+		Quantity:       reserved,
+		Reason:         fmt.Sprintf("batch %s: %s", status, req.ReasonCode),
+		Actor:          req.Actor,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := s.rp.ReleaseReservations(ctx, exec, batchId); err != nil {
+		return 0, err
+	}
+	return reserved, nil
+}
+
+// FindStatus returns the current status of a batch and its history, oldest change first.
+func (s *batchStatusService) FindStatus(ctx context.Context, batchId int) (*models.BatchStatusDetail, error) {
+	current, err := s.rp.FindStatus(ctx, batchId)
+	if err != nil {
+		return nil, err
+	}
+	history, err := s.rp.FindChanges(ctx, batchId)
+	if err != nil {
+		return nil, err
+	}
+	return &models.BatchStatusDetail{
+		BatchStatus: *current,
+		History:     history,
+	}, nil
+}
+
+// validateTransition returns a conflict error when a batch cannot move from one status to the other.
+func validateTransition(from, to string) error {
+	if from == to {
+		return apperrors.NewAppError(apperrors.CodeConflict, fmt.Sprintf("product batch is already %s", to))
+	}
+	if !transitions[from][to] {
+		return apperrors.NewAppError(apperrors.CodeConflict, "product batch status change is not allowed").
+			WithDetail("from_status", from).
+			WithDetail("to_status", to)
+	}
+	return nil
+}
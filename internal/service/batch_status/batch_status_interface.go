@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/batch_status"
+	stockMovementRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/batch_status"
+)
+
+// BatchStatusService manages the quality status of product batches.
+type BatchStatusService interface {
+	// Hold places an available batch on hold.
+	Hold(ctx context.Context, batchId int, req models.PostStatusChange) (*models.StatusChange, error)
+
+	// Release makes a batch on hold available again.
+	Release(ctx context.Context, batchId int, req models.PostStatusChange) (*models.StatusChange, error)
+
+	// SetStatus moves a batch to the given status if the transition is allowed.
+	SetStatus(ctx context.Context, batchId int, status string, req models.PostStatusChange) (*models.StatusChange, error)
+
+	// ApplyStatus moves a batch to the given status inside the caller's transaction.
+	ApplyStatus(ctx context.Context, exec repository.Executor, batchId int, status string, req models.PostStatusChange) (*models.StatusChange, error)
+
+	// FindStatus returns the current status of a batch and its history.
+	FindStatus(ctx context.Context, batchId int) (*models.BatchStatusDetail, error)
+}
+
+// batchStatusService implements BatchStatusService using a repository and the stock ledger.
+type batchStatusService struct {
+	rp     repository.BatchStatusRepository
+	ledger stockMovementRepository.StockMovementRepository
+}
+
+// NewBatchStatusService creates a new BatchStatusService using the provided repositories.
+func NewBatchStatusService(rp repository.BatchStatusRepository, ledger stockMovementRepository.StockMovementRepository) BatchStatusService {
+	return &batchStatusService{
+		rp:     rp,
+		ledger: ledger,
+	}
+}
@@ -0,0 +1,257 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/batch_status"
+	stockMovementRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/batch_status"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/batch_status"
+	movementMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/batch_status"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	movementModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestBatchStatusService_SetStatus(t *testing.T) {
+	type recorded struct {
+		updated    string
+		change     *models.StatusChange
+		ledger     []movementModels.StockMovement
+		released   bool
+		capacity   map[int]int
+		rolledBack bool
+	}
+	testCases := []struct {
+		name        string
+		current     string
+		target      string
+		reserved    int
+		appendErr   error
+		wantErrCode string
+		check       func(t *testing.T, rec recorded)
+	}{
+		{
+			name:    "success - available batch placed on hold",
+			current: batchModels.BatchStatusAvailable,
+			target:  batchModels.BatchStatusOnHold,
+			check: func(t *testing.T, rec recorded) {
+				require.Equal(t, batchModels.BatchStatusOnHold, rec.updated)
+				require.Equal(t, batchModels.BatchStatusAvailable, rec.change.FromStatus)
+				require.Equal(t, batchModels.BatchStatusOnHold, rec.change.ToStatus)
+				require.Equal(t, "qa.lead", rec.change.Actor)
+				require.Empty(t, rec.ledger)
+				require.False(t, rec.released)
+				require.Empty(t, rec.capacity)
+			},
+		},
+		{
+			name:     "success - hold releases the open reservations",
+			current:  batchModels.BatchStatusAvailable,
+			target:   batchModels.BatchStatusOnHold,
+			reserved: 10,
+			check: func(t *testing.T, rec recorded) {
+				require.True(t, rec.released)
+				require.Len(t, rec.ledger, 1)
+				require.Equal(t, movementModels.MovementTypeAllocation, rec.ledger[0].MovementType)
+				require.Equal(t, 10, rec.ledger[0].Quantity)
+				require.Empty(t, rec.capacity)
+			},
+		},
+		{
+			name:    "success - disposing writes off the remaining quantity",
+			current: batchModels.BatchStatusQuarantined,
+			target:  batchModels.BatchStatusDisposed,
+			check: func(t *testing.T, rec recorded) {
+				require.Len(t, rec.ledger, 1)
+				require.Equal(t, movementModels.MovementTypeDisposal, rec.ledger[0].MovementType)
+				require.Equal(t, -40, rec.ledger[0].Quantity)
+				require.Equal(t, map[int]int{3: -40}, rec.capacity)
+			},
+		},
+		{
+			name:     "success - disposing releases the reservations and writes off every unit",
+			current:  batchModels.BatchStatusAvailable,
+			target:   batchModels.BatchStatusDisposed,
+			reserved: 10,
+			check: func(t *testing.T, rec recorded) {
+				require.True(t, rec.released)
+				require.Len(t, rec.ledger, 2)
+				require.Equal(t, movementModels.MovementTypeAllocation, rec.ledger[0].MovementType)
+				require.Equal(t, 10, rec.ledger[0].Quantity)
+				require.Equal(t, movementModels.MovementTypeDisposal, rec.ledger[1].MovementType)
+				require.Equal(t, -50, rec.ledger[1].Quantity)
+				require.Equal(t, map[int]int{3: -50}, rec.capacity)
+			},
+		},
+		{
+			name:        "error - already in the target status",
+			current:     batchModels.BatchStatusOnHold,
+			target:      batchModels.BatchStatusOnHold,
+			wantErrCode: apperrors.CodeConflict,
+			check: func(t *testing.T, rec recorded) {
+				require.True(t, rec.rolledBack)
+				require.Empty(t, rec.updated)
+			},
+		},
+		{
+			name:        "error - disposed is final",
+			current:     batchModels.BatchStatusDisposed,
+			target:      batchModels.BatchStatusAvailable,
+			wantErrCode: apperrors.CodeConflict,
+		},
+		{
+			name:        "error - quarantined cannot be made available",
+			current:     batchModels.BatchStatusQuarantined,
+			target:      batchModels.BatchStatusAvailable,
+			wantErrCode: apperrors.CodeConflict,
+		},
+		{
+			name:        "error - quarantined cannot go on hold",
+			current:     batchModels.BatchStatusQuarantined,
+			target:      batchModels.BatchStatusOnHold,
+			wantErrCode: apperrors.CodeConflict,
+		},
+		{
+			name:        "error - ledger fails on disposal",
+			current:     batchModels.BatchStatusAvailable,
+			target:      batchModels.BatchStatusDisposed,
+			appendErr:   apperrors.NewAppError(apperrors.CodeInternal, "error appending stock movement"),
+			wantErrCode: apperrors.CodeInternal,
+			check: func(t *testing.T, rec recorded) {
+				require.True(t, rec.rolledBack)
+				require.Nil(t, rec.change)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rec recorded
+			rp := &mocks.BatchStatusRepositoryMock{
+				FuncFindStatusForUpdate: func(ctx context.Context, exec repository.Executor, batchId int) (*models.BatchStatus, error) {
+					s := testhelpers.DummyBatchStatus(tc.current)
+					return &s, nil
+				},
+				FuncUpdateStatus: func(ctx context.Context, exec repository.Executor, batchId int, status string, reasonCode string, actor string, at time.Time) error {
+					rec.updated = status
+					return nil
+				},
+				FuncCreateChange: func(ctx context.Context, exec repository.Executor, c models.StatusChange) (*models.StatusChange, error) {
+					c.Id = 1
+					rec.change = &c
+					return &c, nil
+				},
+				FuncFindReservedForUpdate: func(ctx context.Context, exec repository.Executor, batchId int) (int, error) {
+					return tc.reserved, nil
+				},
+				FuncReleaseReservations: func(ctx context.Context, exec repository.Executor, batchId int) error {
+					rec.released = true
+					return nil
+				},
+				FuncAddSectionCapacity: func(ctx context.Context, exec repository.Executor, sectionId int, delta int) error {
+					if rec.capacity == nil {
+						rec.capacity = map[int]int{}
+					}
+					rec.capacity[sectionId] += delta
+					return nil
+				},
+				FuncRollbackTx: func(tx *sql.Tx) error {
+					rec.rolledBack = true
+					return nil
+				},
+			}
+			ledger := &movementMocks.StockMovementRepositoryMock{
+				FuncAppend: func(ctx context.Context, exec stockMovementRepository.Executor, m movementModels.StockMovement) (*movementModels.StockMovement, error) {
+					if tc.appendErr != nil {
+						return nil, tc.appendErr
+					}
+					rec.ledger = append(rec.ledger, m)
+					return &m, nil
+				},
+			}
+			sv := service.NewBatchStatusService(rp, ledger)
+
+			res, err := sv.SetStatus(context.Background(), 1, tc.target, testhelpers.DummyPostStatusChange())
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+			}
+			if tc.check != nil {
+				tc.check(t, rec)
+			}
+		})
+	}
+}
+
+func TestBatchStatusService_Release(t *testing.T) {
+	testCases := []struct {
+		name        string
+		current     string
+		findErr     error
+		wantErrCode string
+	}{
+		{name: "success - batch on hold released", current: batchModels.BatchStatusOnHold},
+		{name: "error - quarantined batch cannot be released", current: batchModels.BatchStatusQuarantined, wantErrCode: apperrors.CodeConflict},
+		{name: "error - disposed batch cannot be released", current: batchModels.BatchStatusDisposed, wantErrCode: apperrors.CodeConflict},
+		{name: "error - available batch is not on hold", current: batchModels.BatchStatusAvailable, wantErrCode: apperrors.CodeConflict},
+		{name: "error - batch not found", findErr: apperrors.NewAppError(apperrors.CodeNotFound, "product batch not found"), wantErrCode: apperrors.CodeNotFound},
+		{name: "error - unexpected failure", findErr: errors.New("db down"), wantErrCode: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			updated := false
+			rp := &mocks.BatchStatusRepositoryMock{
+				// an unlocked read still sees the batch on hold; only the locked read is authoritative
+				FuncFindStatus: func(ctx context.Context, batchId int) (*models.BatchStatus, error) {
+					s := testhelpers.DummyBatchStatus(batchModels.BatchStatusOnHold)
+					return &s, nil
+				},
+				FuncFindStatusForUpdate: func(ctx context.Context, exec repository.Executor, batchId int) (*models.BatchStatus, error) {
+					if tc.findErr != nil {
+						return nil, tc.findErr
+					}
+					s := testhelpers.DummyBatchStatus(tc.current)
+					return &s, nil
+				},
+				FuncUpdateStatus: func(ctx context.Context, exec repository.Executor, batchId int, status string, reasonCode string, actor string, at time.Time) error {
+					updated = true
+					return nil
+				},
+				FuncCreateChange: func(ctx context.Context, exec repository.Executor, c models.StatusChange) (*models.StatusChange, error) {
+					return &c, nil
+				},
+			}
+			sv := service.NewBatchStatusService(rp, &movementMocks.StockMovementRepositoryMock{})
+
+			req := testhelpers.DummyPostStatusChange()
+			req.ReasonCode = models.ReasonInspectionPassed
+			res, err := sv.Release(context.Background(), 1, req)
+
+			switch {
+			case tc.wantErrCode != "":
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, res)
+				require.False(t, updated)
+			case tc.findErr != nil:
+				require.ErrorIs(t, err, tc.findErr)
+			default:
+				require.NoError(t, err)
+				require.Equal(t, batchModels.BatchStatusAvailable, res.ToStatus)
+				require.Equal(t, models.ReasonInspectionPassed, res.ReasonCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer_address"
+)
+
+// FindAll returns a not found error if the buyer does not exist.
+func (s *buyerAddressService) FindAll(ctx context.Context, buyerId int) ([]models.Address, error) {
+	exists, err := s.rp.BuyerExists(ctx, buyerId)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, apperrors.NewAppError(apperrors.CodeNotFound, "buyer not found").WithDetail("buyer_id", buyerId)
+	}
+	return s.rp.FindByBuyer(ctx, buyerId)
+}
+
+func (s *buyerAddressService) FindById(ctx context.Context, buyerId int, id int) (*models.Address, error) {
+	return s.rp.FindById(ctx, buyerId, id)
+}
+
+// Create adds the address in one transaction holding the buyer row, so two addresses added at
+// the same time cannot both become the default. The first address of the buyer is always the
+// default, and a new default takes the flag from the previous one.
+// Returns a not found error if the buyer or the locality does not exist.
+func (s *buyerAddressService) Create(ctx context.Context, buyerId int, req models.PostAddress) (*models.Address, error) {
+	if err := s.checkLocality(ctx, req.LocalityId); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	if err = s.rp.LockBuyer(ctx, tx, buyerId); err != nil {
+		return nil, err
+	}
+	count, err := s.rp.CountByBuyer(ctx, tx, buyerId)
+	if err != nil {
+		return nil, err
+	}
+	isDefault := req.IsDefault || count == 0
+	if isDefault && count > 0 {
+		if err = s.rp.ClearDefault(ctx, tx, buyerId); err != nil {
+			return nil, err
+		}
+	}
+
+	id, err := s.rp.Create(ctx, tx, models.Address{
+		BuyerId:    buyerId,
+		Label:      req.Label,
+		Address:    req.Address,
+		LocalityId: req.LocalityId,
+		IsDefault:  isDefault,
+		CreatedAt:  time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+	return s.rp.FindById(ctx, buyerId, id)
+}
+
+// Update applies the non-nil fields in one transaction holding the buyer row.
+// Returns a not found error if the address or the new locality does not exist, and a conflict
+// error when unsetting the default flag, since a buyer with addresses always keeps a default.
+func (s *buyerAddressService) Update(ctx context.Context, buyerId int, id int, req models.PatchAddress) (*models.Address, error) {
+	if req.LocalityId != nil {
+		if err := s.checkLocality(ctx, *req.LocalityId); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	if err = s.rp.LockBuyer(ctx, tx, buyerId); err != nil {
+		return nil, err
+	}
+	address, err := s.rp.FindForUpdate(ctx, tx, buyerId, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.IsDefault != nil && *req.IsDefault != address.IsDefault {
+		if !*req.IsDefault {
+			err = apperrors.NewAppError(apperrors.CodeConflict, "the default address cannot be unset, make another address the default instead").
+				WithDetail("address_id", id)
+			return nil, err
+		}
+		if err = s.rp.ClearDefault(ctx, tx, buyerId); err != nil {
+			return nil, err
+		}
+		address.IsDefault = true
+	}
+	if req.Label != nil {
+		address.Label = *req.Label
+	}
+	if req.Address != nil {
+		address.Address = *req.Address
+	}
+	if req.LocalityId != nil {
+		address.LocalityId = *req.LocalityId
+	}
+
+	if err = s.rp.Update(ctx, tx, *address); err != nil {
+		return nil, err
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+	return s.rp.FindById(ctx, buyerId, id)
+}
+
+// Delete removes the address in one transaction holding the buyer row. When the default is
+// removed, the oldest remaining address of the buyer becomes the default.
+// Purchase orders keep the snapshot of the address they were delivered to.
+func (s *buyerAddressService) Delete(ctx context.Context, buyerId int, id int) error {
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	if err = s.rp.LockBuyer(ctx, tx, buyerId); err != nil {
+		return err
+	}
+	address, err := s.rp.FindForUpdate(ctx, tx, buyerId, id)
+	if err != nil {
+		return err
+	}
+	if err = s.rp.Delete(ctx, tx, buyerId, id); err != nil {
+		return err
+	}
+
+	if address.IsDefault {
+		var next *models.Address
+		next, err = s.rp.FindOldest(ctx, tx, buyerId)
+		if err != nil {
+			return err
+		}
+		if next != nil {
+			next.IsDefault = true
+			if err = s.rp.Update(ctx, tx, *next); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+	return nil
+}
+
+// checkLocality returns a not found error if the locality does not exist.
+func (s *buyerAddressService) checkLocality(ctx context.Context, localityId string) error {
+	l, _ := s.rpGeo.FindLocalityById(ctx, localityId)
+	if l == nil {
+		return apperrors.NewAppError(apperrors.CodeNotFound, "locality not found").WithDetail("locality_id", localityId)
+	}
+	return nil
+}
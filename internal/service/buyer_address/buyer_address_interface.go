@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/buyer_address"
+	geographyRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/geography"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer_address"
+)
+
+// BuyerAddressService manages the delivery addresses of buyers and which of them is the default.
+type BuyerAddressService interface {
+	// FindAll returns the addresses of a buyer.
+	FindAll(ctx context.Context, buyerId int) ([]models.Address, error)
+
+	// FindById returns an address of a buyer.
+	FindById(ctx context.Context, buyerId int, id int) (*models.Address, error)
+
+	// Create adds an address to a buyer.
+	Create(ctx context.Context, buyerId int, req models.PostAddress) (*models.Address, error)
+
+	// Update changes an address of a buyer, possibly making it the default.
+	Update(ctx context.Context, buyerId int, id int, req models.PatchAddress) (*models.Address, error)
+
+	// Delete removes an address of a buyer.
+	Delete(ctx context.Context, buyerId int, id int) error
+}
+
+// buyerAddressService implements BuyerAddressService using a repository,
+// and the geography repository to check the localities of the addresses.
+type buyerAddressService struct {
+	rp    repository.BuyerAddressRepository
+	rpGeo geographyRepository.GeographyRepository
+}
+
+// NewBuyerAddressService creates a new BuyerAddressService using the provided repositories.
+func NewBuyerAddressService(rp repository.BuyerAddressRepository, rpGeo geographyRepository.GeographyRepository) BuyerAddressService {
+	return &buyerAddressService{
+		rp:    rp,
+		rpGeo: rpGeo,
+	}
+}
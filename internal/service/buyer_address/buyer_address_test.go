@@ -0,0 +1,341 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/buyer_address"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/buyer_address"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/buyer_address"
+	geographyMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/geography"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer_address"
+	geographyModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/geography"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+// geographyWith returns a geography repository mock knowing only locality 1001.
+func geographyWith() *geographyMocks.GeographyRepositoryMock {
+	return &geographyMocks.GeographyRepositoryMock{
+		FuncFindLocalityById: func(ctx context.Context, id string) (*geographyModels.Locality, error) {
+			if id != "1001" {
+				return nil, apperrors.NewAppError(apperrors.CodeNotFound, "The locality you are looking for does not exist.")
+			}
+			return &geographyModels.Locality{Id: id, Name: "Palermo"}, nil
+		},
+	}
+}
+
+func TestBuyerAddressService_FindAll(t *testing.T) {
+	t.Run("error - buyer missing", func(t *testing.T) {
+		rp := &mocks.BuyerAddressRepositoryMock{
+			FuncFindByBuyer: func(ctx context.Context, buyerId int) ([]models.Address, error) {
+				t.Fatal("addresses listed for a missing buyer")
+				return nil, nil
+			},
+		}
+		svc := service.NewBuyerAddressService(rp, geographyWith())
+
+		result, err := svc.FindAll(context.Background(), 999)
+
+		testhelpers.RequireAppErr(t, err, apperrors.CodeNotFound)
+		require.Nil(t, result)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		rp := &mocks.BuyerAddressRepositoryMock{
+			FuncBuyerExists: func(ctx context.Context, buyerId int) (bool, error) {
+				return true, nil
+			},
+			FuncFindByBuyer: func(ctx context.Context, buyerId int) ([]models.Address, error) {
+				return []models.Address{testhelpers.DummyAddress(1, true)}, nil
+			},
+		}
+		svc := service.NewBuyerAddressService(rp, geographyWith())
+
+		result, err := svc.FindAll(context.Background(), 101)
+
+		require.NoError(t, err)
+		require.Equal(t, []models.Address{testhelpers.DummyAddress(1, true)}, result)
+	})
+}
+
+func TestBuyerAddressService_Create(t *testing.T) {
+	testCases := []struct {
+		name        string
+		req         models.PostAddress
+		existing    int
+		lockErr     error
+		wantDefault bool
+		wantCleared bool
+		wantErrCode string
+	}{
+		{
+			name:        "success - first address becomes the default",
+			req:         models.PostAddress{Address: "Av. Corrientes 1234", LocalityId: "1001"},
+			wantDefault: true,
+		},
+		{
+			name:     "success - further address keeps the current default",
+			req:      models.PostAddress{Address: "Av. Corrientes 1234", LocalityId: "1001"},
+			existing: 2,
+		},
+		{
+			name:        "success - new default takes the flag from the previous one",
+			req:         models.PostAddress{Address: "Av. Corrientes 1234", LocalityId: "1001", IsDefault: true},
+			existing:    2,
+			wantDefault: true,
+			wantCleared: true,
+		},
+		{
+			name:        "error - locality missing",
+			req:         models.PostAddress{Address: "Av. Corrientes 1234", LocalityId: "9999"},
+			wantErrCode: apperrors.CodeNotFound,
+		},
+		{
+			name:        "error - buyer missing",
+			req:         models.PostAddress{Address: "Av. Corrientes 1234", LocalityId: "1001"},
+			lockErr:     apperrors.NewAppError(apperrors.CodeNotFound, "buyer not found"),
+			wantErrCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rolledBack, committed, cleared bool
+			var created *models.Address
+			rp := &mocks.BuyerAddressRepositoryMock{
+				FuncLockBuyer: func(ctx context.Context, exec repository.Executor, buyerId int) error {
+					return tc.lockErr
+				},
+				FuncCountByBuyer: func(ctx context.Context, exec repository.Executor, buyerId int) (int, error) {
+					return tc.existing, nil
+				},
+				FuncClearDefault: func(ctx context.Context, exec repository.Executor, buyerId int) error {
+					cleared = true
+					return nil
+				},
+				FuncCreate: func(ctx context.Context, exec repository.Executor, a models.Address) (int, error) {
+					created = &a
+					return 3, nil
+				},
+				FuncFindById: func(ctx context.Context, buyerId int, id int) (*models.Address, error) {
+					a := *created
+					a.Id = id
+					return &a, nil
+				},
+				FuncCommitTx: func(tx *sql.Tx) error {
+					committed = true
+					return nil
+				},
+				FuncRollbackTx: func(tx *sql.Tx) error {
+					rolledBack = true
+					return nil
+				},
+			}
+			svc := service.NewBuyerAddressService(rp, geographyWith())
+
+			result, err := svc.Create(context.Background(), 101, tc.req)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, result)
+				require.Nil(t, created)
+				require.False(t, committed)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, committed)
+			require.False(t, rolledBack)
+			require.Equal(t, 3, result.Id)
+			require.Equal(t, 101, result.BuyerId)
+			require.Equal(t, tc.wantDefault, result.IsDefault)
+			require.Equal(t, tc.wantCleared, cleared)
+		})
+	}
+}
+
+func TestBuyerAddressService_Update(t *testing.T) {
+	testCases := []struct {
+		name        string
+		current     models.Address
+		req         models.PatchAddress
+		want        models.Address
+		wantCleared bool
+		wantErrCode string
+	}{
+		{
+			name:    "success - fields changed",
+			current: testhelpers.DummyAddress(2, false),
+			req:     models.PatchAddress{Label: testhelpers.StringPtr("office"), Address: testhelpers.StringPtr("Florida 100")},
+			want: func() models.Address {
+				a := testhelpers.DummyAddress(2, false)
+				a.Label = "office"
+				a.Address = "Florida 100"
+				return a
+			}(),
+		},
+		{
+			name:        "success - made the default",
+			current:     testhelpers.DummyAddress(2, false),
+			req:         models.PatchAddress{IsDefault: boolPtr(true)},
+			want:        testhelpers.DummyAddress(2, true),
+			wantCleared: true,
+		},
+		{
+			name:    "success - already the default",
+			current: testhelpers.DummyAddress(1, true),
+			req:     models.PatchAddress{IsDefault: boolPtr(true)},
+			want:    testhelpers.DummyAddress(1, true),
+		},
+		{
+			name:        "error - default unset",
+			current:     testhelpers.DummyAddress(1, true),
+			req:         models.PatchAddress{IsDefault: boolPtr(false)},
+			wantErrCode: apperrors.CodeConflict,
+		},
+		{
+			name:        "error - locality missing",
+			current:     testhelpers.DummyAddress(2, false),
+			req:         models.PatchAddress{LocalityId: testhelpers.StringPtr("9999")},
+			wantErrCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rolledBack, committed, cleared bool
+			var updated *models.Address
+			rp := &mocks.BuyerAddressRepositoryMock{
+				FuncFindForUpdate: func(ctx context.Context, exec repository.Executor, buyerId int, id int) (*models.Address, error) {
+					a := tc.current
+					return &a, nil
+				},
+				FuncClearDefault: func(ctx context.Context, exec repository.Executor, buyerId int) error {
+					cleared = true
+					return nil
+				},
+				FuncUpdate: func(ctx context.Context, exec repository.Executor, a models.Address) error {
+					updated = &a
+					return nil
+				},
+				FuncFindById: func(ctx context.Context, buyerId int, id int) (*models.Address, error) {
+					return updated, nil
+				},
+				FuncCommitTx: func(tx *sql.Tx) error {
+					committed = true
+					return nil
+				},
+				FuncRollbackTx: func(tx *sql.Tx) error {
+					rolledBack = true
+					return nil
+				},
+			}
+			svc := service.NewBuyerAddressService(rp, geographyWith())
+
+			result, err := svc.Update(context.Background(), 101, tc.current.Id, tc.req)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, result)
+				require.Nil(t, updated)
+				require.False(t, committed)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, committed)
+			require.False(t, rolledBack)
+			require.Equal(t, tc.want, *result)
+			require.Equal(t, tc.wantCleared, cleared)
+		})
+	}
+}
+
+func TestBuyerAddressService_Delete(t *testing.T) {
+	testCases := []struct {
+		name         string
+		deleted      models.Address
+		oldest       *models.Address
+		wantPromoted *int
+	}{
+		{
+			name:    "success - other address deleted",
+			deleted: testhelpers.DummyAddress(2, false),
+		},
+		{
+			name:         "success - oldest remaining address becomes the default",
+			deleted:      testhelpers.DummyAddress(1, true),
+			oldest:       func() *models.Address { a := testhelpers.DummyAddress(2, false); return &a }(),
+			wantPromoted: testhelpers.IntPtr(2),
+		},
+		{
+			name:    "success - last address deleted",
+			deleted: testhelpers.DummyAddress(1, true),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var committed bool
+			var promoted *models.Address
+			rp := &mocks.BuyerAddressRepositoryMock{
+				FuncFindForUpdate: func(ctx context.Context, exec repository.Executor, buyerId int, id int) (*models.Address, error) {
+					a := tc.deleted
+					return &a, nil
+				},
+				FuncFindOldest: func(ctx context.Context, exec repository.Executor, buyerId int) (*models.Address, error) {
+					return tc.oldest, nil
+				},
+				FuncUpdate: func(ctx context.Context, exec repository.Executor, a models.Address) error {
+					promoted = &a
+					return nil
+				},
+				FuncCommitTx: func(tx *sql.Tx) error {
+					committed = true
+					return nil
+				},
+			}
+			svc := service.NewBuyerAddressService(rp, geographyWith())
+
+			err := svc.Delete(context.Background(), 101, tc.deleted.Id)
+
+			require.NoError(t, err)
+			require.True(t, committed)
+			if tc.wantPromoted == nil {
+				require.Nil(t, promoted)
+				return
+			}
+			require.Equal(t, *tc.wantPromoted, promoted.Id)
+			require.True(t, promoted.IsDefault)
+		})
+	}
+
+	t.Run("error - address missing", func(t *testing.T) {
+		var rolledBack bool
+		rp := &mocks.BuyerAddressRepositoryMock{
+			FuncFindForUpdate: func(ctx context.Context, exec repository.Executor, buyerId int, id int) (*models.Address, error) {
+				return nil, apperrors.NewAppError(apperrors.CodeNotFound, "buyer address not found")
+			},
+			FuncDelete: func(ctx context.Context, exec repository.Executor, buyerId int, id int) error {
+				t.Fatal("missing address deleted")
+				return nil
+			},
+			FuncRollbackTx: func(tx *sql.Tx) error {
+				rolledBack = true
+				return nil
+			},
+		}
+		svc := service.NewBuyerAddressService(rp, geographyWith())
+
+		err := svc.Delete(context.Background(), 101, 9)
+
+		testhelpers.RequireAppErr(t, err, apperrors.CodeNotFound)
+		require.True(t, rolledBack)
+	})
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
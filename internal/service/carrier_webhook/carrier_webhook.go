@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/carrier_webhook"
+	shipmentModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipment"
+)
+
+// signatureTolerance is how far the timestamp of a webhook request may be from now, either way.
+const signatureTolerance = 5 * time.Minute
+
+// nonceRetention is how long a nonce is remembered after the request carrying it arrived. A request signed
+// signatureTolerance ahead of the clock stays acceptable for twice the tolerance, so its nonce must outlive it.
+const nonceRetention = 2 * signatureTolerance
+
+// carrierStatuses maps the statuses carriers report, including the usual synonyms, to shipment statuses.
+var carrierStatuses = map[string]string{
+	"picked_up":        shipmentModels.StatusPickedUp,
+	"collected":        shipmentModels.StatusPickedUp,
+	"in_transit":       shipmentModels.StatusInTransit,
+	"out_for_delivery": shipmentModels.StatusInTransit,
+	"delivered":        shipmentModels.StatusDelivered,
+	"failed":           shipmentModels.StatusFailed,
+	"delivery_failed":  shipmentModels.StatusFailed,
+}
+
+// RotateSecret replaces the webhook secret of the carrier with a new random one and returns it.
+// The previous secret stops working immediately.
+func (s *carrierWebhookService) RotateSecret(ctx context.Context, cid string) (*models.WebhookSecret, error) {
+	creds, err := s.rp.FindCredentials(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to generate secret")
+	}
+
+	secret := models.WebhookSecret{
+		CarrierId: creds.CarrierId,
+		Cid:       cid,
+		Secret:    hex.EncodeToString(b),
+		CreatedAt: time.Now(),
+	}
+	if err := s.rp.SaveSecret(ctx, secret.CarrierId, secret.Secret, secret.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &secret, nil
+}
+
+// Receive authenticates the request and records its event, in one transaction with the nonce.
+//
+// The signature is the hex HMAC-SHA256, keyed with the carrier secret, of the timestamp (unix seconds),
+// the nonce and the raw body joined by dots. Requests outside signatureTolerance or reusing a nonce are
+// rejected as replays. A carrier delivering the same event again, under a new nonce, gets a duplicate
+// receipt and nothing is recorded twice. The shipment must belong to the carrier.
+func (s *carrierWebhookService) Receive(ctx context.Context, cid string, sig models.Signature, body []byte) (*models.Receipt, error) {
+	creds, err := s.rp.FindCredentials(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+	if creds.Secret == "" {
+		return nil, apperrors.NewAppError(apperrors.CodeUnauthorized, "webhook not configured for the carrier").
+			WithDetail("cid", cid)
+	}
+	now := time.Now()
+	if err := verifySignature(creds.Secret, sig, body, now); err != nil {
+		return nil, err
+	}
+
+	var payload models.Payload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeBadRequest, "invalid JSON payload")
+	}
+	if err := validators.ValidateWebhookPayload(payload); err != nil {
+		return nil, err
+	}
+	status, ok := carrierStatuses[payload.Status]
+	if !ok {
+		return nil, apperrors.NewAppError(apperrors.CodeValidationError, "unknown status").WithDetail("status", payload.Status)
+	}
+
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	if err = s.rp.CreateNonce(ctx, tx, creds.CarrierId, sig.Nonce, now); err != nil {
+		return nil, err
+	}
+	shipment, err := s.shipments.FindByTrackingCodeForUpdate(ctx, tx, payload.TrackingCode)
+	if err != nil {
+		return nil, err
+	}
+	if shipment.CarrierId != creds.CarrierId {
+		err = apperrors.NewAppError(apperrors.CodeNotFound, "shipment not found").
+			WithDetail("tracking_code", payload.TrackingCode)
+		return nil, err
+	}
+
+	receipt := &models.Receipt{TrackingCode: payload.TrackingCode, EventId: payload.EventId}
+	receipt.Duplicate, err = s.shipments.ExistsExternalEvent(ctx, tx, shipment.Id, payload.EventId)
+	if err != nil {
+		return nil, err
+	}
+	if !receipt.Duplicate {
+		if err = validators.ValidateShipmentTransition(*shipment, status); err != nil {
+			return nil, err
+		}
+		_, err = s.shipments.CreateEvent(ctx, tx, shipmentModels.Event{
+			ShipmentId:      shipment.Id,
+			Status:          status,
+			Location:        payload.Location,
+			Description:     payload.Description,
+			OccurredAt:      payload.OccurredAt,
+			Temperature:     payload.Temperature,
+			ExternalEventId: &payload.EventId,
+			CreatedAt:       now,
+		})
+		if err != nil {
+			return nil, err
+		}
+		shipment.Status = status
+		shipment.UpdatedAt = now
+		if err = s.shipments.UpdateStatus(ctx, tx, *shipment); err != nil {
+			return nil, err
+		}
+	}
+	receipt.Status = shipment.Status
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+	return receipt, nil
+}
+
+// PurgeNonces forgets the nonces received more than nonceRetention ago and returns how many were removed.
+func (s *carrierWebhookService) PurgeNonces(ctx context.Context) (int64, error) {
+	return s.rp.DeleteNoncesBefore(ctx, time.Now().Add(-nonceRetention))
+}
+
+// sign returns the signature of a webhook request, as carriers must compute it.
+func sign(secret, timestamp, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + nonce + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// verifySignature checks the request timestamp is within signatureTolerance of now and the signature
+// matches, comparing in constant time.
+func verifySignature(secret string, sig models.Signature, body []byte, now time.Time) error {
+	unix, err := strconv.ParseInt(sig.Timestamp, 10, 64)
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeUnauthorized, "invalid signature timestamp")
+	}
+	skew := now.Sub(time.Unix(unix, 0))
+	if skew > signatureTolerance || skew < -signatureTolerance {
+		return apperrors.NewAppError(apperrors.CodeUnauthorized, "signature timestamp outside the allowed window")
+	}
+	expected := sign(secret, sig.Timestamp, sig.Nonce, body)
+	if !hmac.Equal([]byte(expected), []byte(sig.Signature)) {
+		return apperrors.NewAppError(apperrors.CodeUnauthorized, "invalid signature")
+	}
+	return nil
+}
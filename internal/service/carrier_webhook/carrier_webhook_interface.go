@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/carrier_webhook"
+	shipmentRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/shipment"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/carrier_webhook"
+)
+
+// CarrierWebhookService receives shipment status events pushed by carriers.
+type CarrierWebhookService interface {
+	// RotateSecret generates a new webhook secret for the carrier with the given cid.
+	RotateSecret(ctx context.Context, cid string) (*models.WebhookSecret, error)
+
+	// Receive verifies a signed webhook request of a carrier and records the shipment event it carries.
+	Receive(ctx context.Context, cid string, sig models.Signature, body []byte) (*models.Receipt, error)
+
+	// PurgeNonces removes the nonces too old to be replayed and returns how many were removed.
+	PurgeNonces(ctx context.Context) (int64, error)
+}
+
+// carrierWebhookService implements CarrierWebhookService using a repository and the shipments.
+type carrierWebhookService struct {
+	rp        repository.CarrierWebhookRepository
+	shipments shipmentRepository.ShipmentRepository
+}
+
+// NewCarrierWebhookService creates a new CarrierWebhookService using the provided repositories.
+func NewCarrierWebhookService(rp repository.CarrierWebhookRepository, shipments shipmentRepository.ShipmentRepository) CarrierWebhookService {
+	return &carrierWebhookService{
+		rp:        rp,
+		shipments: shipments,
+	}
+}
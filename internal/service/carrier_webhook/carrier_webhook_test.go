@@ -0,0 +1,280 @@
+package service_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/carrier_webhook"
+	shipmentRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/shipment"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/carrier_webhook"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/carrier_webhook"
+	shipmentMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/shipment"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/carrier_webhook"
+	shipmentModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipment"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+const payload = `{"event_id":"ev-1","tracking_code":"TRK-ABCDEFGH23","status":"out_for_delivery","location":"Rosario hub","occurred_at":"2025-06-02T10:00:00Z"}`
+
+// signed builds the headers a carrier holding secret sends for body at the given time.
+func signed(secret string, at time.Time, nonce, body string) models.Signature {
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + nonce + "." + body))
+	return models.Signature{Timestamp: timestamp, Nonce: nonce, Signature: hex.EncodeToString(mac.Sum(nil))}
+}
+
+func TestCarrierWebhookService_Receive(t *testing.T) {
+	testCases := []struct {
+		name           string
+		secret         string
+		sig            models.Signature
+		body           string
+		nonceErr       error
+		shipment       shipmentModels.Shipment
+		duplicate      bool
+		wantErrCode    string
+		wantStatus     string
+		wantDuplicate  bool
+		wantNewEvent   bool
+		wantTxAttempts bool
+	}{
+		{
+			name:           "success - carrier status mapped and recorded",
+			secret:         "secret",
+			sig:            signed("secret", time.Now(), "n-1", payload),
+			body:           payload,
+			shipment:       testhelpers.DummyShipment(shipmentModels.StatusPickedUp),
+			wantStatus:     shipmentModels.StatusInTransit,
+			wantNewEvent:   true,
+			wantTxAttempts: true,
+		},
+		{
+			name:           "success - event delivered again is not recorded twice",
+			secret:         "secret",
+			sig:            signed("secret", time.Now(), "n-2", payload),
+			body:           payload,
+			shipment:       testhelpers.DummyShipment(shipmentModels.StatusInTransit),
+			duplicate:      true,
+			wantStatus:     shipmentModels.StatusInTransit,
+			wantDuplicate:  true,
+			wantTxAttempts: true,
+		},
+		{
+			name:        "error - signed with another secret",
+			secret:      "secret",
+			sig:         signed("other", time.Now(), "n-1", payload),
+			body:        payload,
+			wantErrCode: apperrors.CodeUnauthorized,
+		},
+		{
+			name:   "error - body altered after signing",
+			secret: "secret",
+			sig:    signed("secret", time.Now(), "n-1", payload),
+			body: `{"event_id":"ev-1","tracking_code":"TRK-ABCDEFGH23","status":"delivered",` +
+				`"occurred_at":"2025-06-02T10:00:00Z"}`,
+			wantErrCode: apperrors.CodeUnauthorized,
+		},
+		{
+			name:        "error - timestamp too old",
+			secret:      "secret",
+			sig:         signed("secret", time.Now().Add(-10*time.Minute), "n-1", payload),
+			body:        payload,
+			wantErrCode: apperrors.CodeUnauthorized,
+		},
+		{
+			name:        "error - webhook not configured",
+			sig:         signed("", time.Now(), "n-1", payload),
+			body:        payload,
+			wantErrCode: apperrors.CodeUnauthorized,
+		},
+		{
+			name:        "error - unknown status",
+			secret:      "secret",
+			sig:         signed("secret", time.Now(), "n-1", `{"event_id":"ev-1","tracking_code":"TRK-ABCDEFGH23","status":"lost","occurred_at":"2025-06-02T10:00:00Z"}`),
+			body:        `{"event_id":"ev-1","tracking_code":"TRK-ABCDEFGH23","status":"lost","occurred_at":"2025-06-02T10:00:00Z"}`,
+			wantErrCode: apperrors.CodeValidationError,
+		},
+		{
+			name:           "error - replayed nonce",
+			secret:         "secret",
+			sig:            signed("secret", time.Now(), "n-1", payload),
+			body:           payload,
+			nonceErr:       apperrors.NewAppError(apperrors.CodeConflict, "nonce already used"),
+			wantErrCode:    apperrors.CodeConflict,
+			wantTxAttempts: true,
+		},
+		{
+			name:   "error - shipment of another carrier",
+			secret: "secret",
+			sig:    signed("secret", time.Now(), "n-1", payload),
+			body:   payload,
+			shipment: func() shipmentModels.Shipment {
+				s := testhelpers.DummyShipment(shipmentModels.StatusPickedUp)
+				s.CarrierId = 9
+				return s
+			}(),
+			wantErrCode:    apperrors.CodeNotFound,
+			wantTxAttempts: true,
+		},
+		{
+			name:           "error - shipment already delivered",
+			secret:         "secret",
+			sig:            signed("secret", time.Now(), "n-1", payload),
+			body:           payload,
+			shipment:       testhelpers.DummyShipment(shipmentModels.StatusDelivered),
+			wantErrCode:    apperrors.CodeConflict,
+			wantTxAttempts: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var began, rolledBack, committed bool
+			var recorded *shipmentModels.Event
+			rp := &mocks.CarrierWebhookRepositoryMock{
+				FuncFindCredentials: func(ctx context.Context, cid string) (*models.Credentials, error) {
+					require.Equal(t, "CID-2", cid)
+					return &models.Credentials{CarrierId: 2, Secret: tc.secret}, nil
+				},
+				FuncCreateNonce: func(ctx context.Context, exec repository.Executor, carrierId int, nonce string, receivedAt time.Time) error {
+					require.Equal(t, 2, carrierId)
+					require.Equal(t, tc.sig.Nonce, nonce)
+					return tc.nonceErr
+				},
+				FuncBeginTx: func(ctx context.Context) (*sql.Tx, error) {
+					began = true
+					return &sql.Tx{}, nil
+				},
+				FuncCommitTx: func(tx *sql.Tx) error {
+					committed = true
+					return nil
+				},
+				FuncRollbackTx: func(tx *sql.Tx) error {
+					rolledBack = true
+					return nil
+				},
+			}
+			shipments := &shipmentMocks.ShipmentRepositoryMock{
+				FuncFindByTrackingCodeForUpdate: func(ctx context.Context, exec shipmentRepository.Executor, trackingCode string) (*shipmentModels.Shipment, error) {
+					s := tc.shipment
+					return &s, nil
+				},
+				FuncExistsExternalEvent: func(ctx context.Context, exec shipmentRepository.Executor, shipmentId int, externalEventId string) (bool, error) {
+					require.Equal(t, "ev-1", externalEventId)
+					return tc.duplicate, nil
+				},
+				FuncCreateEvent: func(ctx context.Context, exec shipmentRepository.Executor, e shipmentModels.Event) (int, error) {
+					recorded = &e
+					return 3, nil
+				},
+			}
+			svc := service.NewCarrierWebhookService(rp, shipments)
+
+			result, err := svc.Receive(context.Background(), "CID-2", tc.sig, []byte(tc.body))
+
+			require.Equal(t, tc.wantTxAttempts, began)
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, result)
+				require.Nil(t, recorded)
+				require.False(t, committed)
+				require.Equal(t, began, rolledBack)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, committed)
+			require.Equal(t, tc.wantStatus, result.Status)
+			require.Equal(t, tc.wantDuplicate, result.Duplicate)
+			if tc.wantNewEvent {
+				require.Equal(t, tc.wantStatus, recorded.Status)
+				require.Equal(t, "ev-1", *recorded.ExternalEventId)
+				require.Equal(t, "Rosario hub", recorded.Location)
+			} else {
+				require.Nil(t, recorded)
+			}
+		})
+	}
+}
+
+func TestCarrierWebhookService_RotateSecret(t *testing.T) {
+	var saved string
+	rp := &mocks.CarrierWebhookRepositoryMock{
+		FuncSaveSecret: func(ctx context.Context, carrierId int, secret string, createdAt time.Time) error {
+			require.Equal(t, 2, carrierId)
+			saved = secret
+			return nil
+		},
+	}
+	svc := service.NewCarrierWebhookService(rp, &shipmentMocks.ShipmentRepositoryMock{})
+
+	result, err := svc.RotateSecret(context.Background(), "CID-2")
+
+	require.NoError(t, err)
+	require.Len(t, result.Secret, 64)
+	require.Equal(t, saved, result.Secret)
+	require.Equal(t, "CID-2", result.Cid)
+}
+
+func TestCarrierWebhookService_PurgeNonces_KeepsReplayableNonces(t *testing.T) {
+	nonces := map[string]time.Time{}
+	rp := &mocks.CarrierWebhookRepositoryMock{
+		FuncFindCredentials: func(ctx context.Context, cid string) (*models.Credentials, error) {
+			return &models.Credentials{CarrierId: 2, Secret: "secret"}, nil
+		},
+		FuncCreateNonce: func(ctx context.Context, exec repository.Executor, carrierId int, nonce string, receivedAt time.Time) error {
+			if _, ok := nonces[nonce]; ok {
+				return apperrors.NewAppError(apperrors.CodeConflict, "nonce already used")
+			}
+			nonces[nonce] = receivedAt
+			return nil
+		},
+		FuncDeleteNoncesBefore: func(ctx context.Context, before time.Time) (int64, error) {
+			var n int64
+			for nonce, at := range nonces {
+				if at.Before(before) {
+					delete(nonces, nonce)
+					n++
+				}
+			}
+			return n, nil
+		},
+		FuncBeginTx: func(ctx context.Context) (*sql.Tx, error) {
+			return &sql.Tx{}, nil
+		},
+	}
+	shipments := &shipmentMocks.ShipmentRepositoryMock{
+		FuncFindByTrackingCodeForUpdate: func(ctx context.Context, exec shipmentRepository.Executor, trackingCode string) (*shipmentModels.Shipment, error) {
+			s := testhelpers.DummyShipment(shipmentModels.StatusPickedUp)
+			return &s, nil
+		},
+	}
+	svc := service.NewCarrierWebhookService(rp, shipments)
+
+	// a request signed four minutes ahead of the clock is accepted
+	_, err := svc.Receive(context.Background(), "CID-2", signed("secret", time.Now().Add(4*time.Minute), "n-1", payload), []byte(payload))
+	require.NoError(t, err)
+
+	// eight minutes later its timestamp is four minutes in the past and still within the window
+	nonces["n-1"] = nonces["n-1"].Add(-8 * time.Minute)
+	purged, err := svc.PurgeNonces(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, purged)
+
+	_, err = svc.Receive(context.Background(), "CID-2", signed("secret", time.Now().Add(-4*time.Minute), "n-1", payload), []byte(payload))
+	testhelpers.RequireAppErr(t, err, apperrors.CodeConflict)
+
+	// once the request can no longer pass the window its nonce is forgotten
+	nonces["n-1"] = nonces["n-1"].Add(-3 * time.Minute)
+	purged, err = svc.PurgeNonces(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, 1, purged)
+}
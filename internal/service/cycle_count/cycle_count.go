@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/cycle_count"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/cycle_count"
+	movementModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+)
+
+// Create locks the section, checks it has no active count and opens a new one with a line per batch.
+// Returns a conflict error if the section already has an active count or holds no batches.
+func (s *cycleCountService) Create(ctx context.Context, req models.PostCycleCount) (*models.CycleCountDetail, error) {
+	id, err := s.inTx(ctx, func(exec repository.Executor) (int, error) {
+		if err := s.rp.LockSection(ctx, exec, req.SectionId); err != nil {
+			return 0, err
+		}
+		active, err := s.rp.HasActiveCount(ctx, exec, req.SectionId)
+		if err != nil {
+			return 0, err
+		}
+		if active {
+			return 0, apperrors.NewAppError(apperrors.CodeConflict, "section already has an active cycle count").
+				WithDetail("section_id", req.SectionId)
+		}
+
+		now := time.Now()
+		count, err := s.rp.Create(ctx, exec, models.CycleCount{
+			SectionId: req.SectionId,
+			Status:    models.StatusOpen,
+			Note:      req.Note,
+			CreatedBy: req.Actor,
+			CreatedAt: now,
+		})
+		if err != nil {
+			return 0, err
+		}
+		lines, err := s.rp.CreateLines(ctx, exec, count.Id, req.SectionId)
+		if err != nil {
+			return 0, err
+		}
+		if lines == 0 {
+			return 0, apperrors.NewAppError(apperrors.CodeConflict, "section has no batches to count").
+				WithDetail("section_id", req.SectionId)
+		}
+		err = s.rp.CreateEvent(ctx, exec, models.CountEvent{
+			CycleCountId: count.Id,
+			ToStatus:     models.StatusOpen,
+			Note:         req.Note,
+			Actor:        req.Actor,
+			CreatedAt:    now,
+		})
+		return count.Id, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.FindById(ctx, id)
+}
+
+// FindAll returns the counts, newest first. A zero section id or empty status means no filter.
+func (s *cycleCountService) FindAll(ctx context.Context, sectionId int, status string) ([]models.CycleCount, error) {
+	return s.rp.FindAll(ctx, sectionId, status)
+}
+
+// FindById returns the count with its lines and history.
+// Returns a not found error if the count does not exist.
+func (s *cycleCountService) FindById(ctx context.Context, id int) (*models.CycleCountDetail, error) {
+	count, err := s.rp.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := s.rp.FindLines(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	history, err := s.rp.FindEvents(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &models.CycleCountDetail{
+		CycleCount: *count,
+		Lines:      lines,
+		History:    history,
+	}, nil
+}
+
+// RecordCounts stores the counted quantity of each line, taking the batch current_quantity plus its
+// reserved but unpicked units, which are still on the shelf, as the system quantity the variance is
+// computed against. A reason code is required for every variance.
+// Once every line is counted an open count moves to counted.
+func (s *cycleCountService) RecordCounts(ctx context.Context, id int, req models.PostCountedLines) (*models.CycleCountDetail, error) {
+	_, err := s.inTx(ctx, func(exec repository.Executor) (int, error) {
+		count, err := s.rp.FindForUpdate(ctx, exec, id)
+		if err != nil {
+			return 0, err
+		}
+		if count.Status != models.StatusOpen && count.Status != models.StatusCounted {
+			return 0, apperrors.NewAppError(apperrors.CodeConflict, "cycle count can no longer be counted").
+				WithDetail("cycle_count_id", id).
+				WithDetail("status", count.Status)
+		}
+		lines, err := s.rp.FindLinesForUpdate(ctx, exec, id)
+		if err != nil {
+			return 0, err
+		}
+		byBatch := make(map[int]int, len(lines))
+		for i, l := range lines {
+			byBatch[l.ProductBatchId] = i
+		}
+
+		now := time.Now()
+		for _, posted := range req.Lines {
+			i, ok := byBatch[posted.ProductBatchId]
+			if !ok {
+				return 0, apperrors.NewAppError(apperrors.CodeValidationError, "product batch is not part of the cycle count").
+					WithDetail("product_batch_id", posted.ProductBatchId)
+			}
+			l := &lines[i]
+			system := l.CurrentQuantity + l.ReservedQuantity
+			counted := *posted.CountedQuantity
+			variance := counted - system
+			if variance != 0 && posted.ReasonCode == "" {
+				return 0, apperrors.NewAppError(apperrors.CodeValidationError, "reason_code is required when the count differs from the system quantity").
+					WithDetail("product_batch_id", posted.ProductBatchId).
+					WithDetail("variance", variance)
+			}
+			l.SystemQuantity = &system
+			l.CountedQuantity = &counted
+			l.Variance = &variance
+			l.ReasonCode = nil
+			if posted.ReasonCode != "" {
+				reason := posted.ReasonCode
+				l.ReasonCode = &reason
+			}
+			l.CountedBy = &req.Actor
+			l.CountedAt = &now
+			if err := s.rp.UpdateLine(ctx, exec, *l); err != nil {
+				return 0, err
+			}
+		}
+
+		if count.Status == models.StatusOpen && allCounted(lines) {
+			if err := s.transition(ctx, exec, count, models.StatusCounted, "", req.Actor, now); err != nil {
+				return 0, err
+			}
+		}
+		return id, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.FindById(ctx, id)
+}
+
+// Approve approves a counted count. When any line has a large variance the first approval only moves
+// the count to pending second approval, and a different approver must approve it again. The final
+// approval posts every variance to the stock ledger as an adjustment with its reason code, and to the
+// current_capacity of the section.
+// Returns a conflict error if the count is not counted or pending second approval.
+func (s *cycleCountService) Approve(ctx context.Context, id int, req models.PostCountDecision) (*models.CycleCountDetail, error) {
+	_, err := s.inTx(ctx, func(exec repository.Executor) (int, error) {
+		count, err := s.rp.FindForUpdate(ctx, exec, id)
+		if err != nil {
+			return 0, err
+		}
+		if count.Status != models.StatusCounted && count.Status != models.StatusPendingSecondApproval {
+			return 0, apperrors.NewAppError(apperrors.CodeConflict, "cycle count is not ready for approval").
+				WithDetail("cycle_count_id", id).
+				WithDetail("status", count.Status)
+		}
+		lines, err := s.rp.FindLinesForUpdate(ctx, exec, id)
+		if err != nil {
+			return 0, err
+		}
+
+		now := time.Now()
+		if count.Status == models.StatusCounted {
+			count.ApprovedBy = &req.Actor
+			if hasLargeVariance(lines) {
+				return id, s.transition(ctx, exec, count, models.StatusPendingSecondApproval, req.Note, req.Actor, now)
+			}
+		} else {
+			if count.ApprovedBy != nil && *count.ApprovedBy == req.Actor {
+				return 0, apperrors.NewAppError(apperrors.CodeValidationError, "a large variance must be approved by a second, different approver").
+					WithDetail("cycle_count_id", id).
+					WithDetail("actor", req.Actor)
+			}
+			count.SecondApprovedBy = &req.Actor
+		}
+
+		if err := s.postAdjustments(ctx, exec, count, lines, req.Actor); err != nil {
+			return 0, err
+		}
+		count.ApprovedAt = &now
+		return id, s.transition(ctx, exec, count, models.StatusApproved, req.Note, req.Actor, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.FindById(ctx, id)
+}
+
+// Cancel discards a count that is not approved yet. No stock is adjusted.
+// Returns a conflict error if the count is already approved or cancelled.
+func (s *cycleCountService) Cancel(ctx context.Context, id int, req models.PostCountDecision) (*models.CycleCountDetail, error) {
+	_, err := s.inTx(ctx, func(exec repository.Executor) (int, error) {
+		count, err := s.rp.FindForUpdate(ctx, exec, id)
+		if err != nil {
+			return 0, err
+		}
+		if count.Status == models.StatusApproved || count.Status == models.StatusCancelled {
+			return 0, apperrors.NewAppError(apperrors.CodeConflict, fmt.Sprintf("cycle count is already %s", count.Status)).
+				WithDetail("cycle_count_id", id)
+		}
+		return id, s.transition(ctx, exec, count, models.StatusCancelled, req.Note, req.Actor, time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.FindById(ctx, id)
+}
+
+// postAdjustments appends one adjustment movement per line with a variance and applies the net
+// variance to the current_capacity of the counted section.
+// Returns a conflict error if stock moved since the count and the adjustment would leave a batch negative.
+func (s *cycleCountService) postAdjustments(ctx context.Context, exec repository.Executor, count *models.CycleCount, lines []models.CountLine, actor string) error {
+	net := 0
+	for _, l := range lines {
+		if l.Variance == nil || *l.Variance == 0 {
+			continue
+		}
+		if l.CurrentQuantity+*l.Variance < 0 {
+			return apperrors.NewAppError(apperrors.CodeConflict, "adjustment would leave the batch with negative stock").
+				WithDetail("product_batch_id", l.ProductBatchId).
+				WithDetail("current_quantity", l.CurrentQuantity).
+				WithDetail("variance", *l.Variance)
+		}
+		reason := models.ReasonOther
+		if l.ReasonCode != nil {
+			reason = *l.ReasonCode
+		}
+		_, err := s.ledger.Append(ctx, exec, movementModels.StockMovement{
+			ProductBatchId: l.ProductBatchId,
+			MovementType:   movementModels.MovementTypeAdjustment,
+			Quantity:       *l.Variance,
+			Reason:         fmt.Sprintf("cycle count %d: %s", count.Id, reason),
+			Actor:          actor,
+		})
+		if err != nil {
+			return err
+		}
+		net += *l.Variance
+	}
+	if net == 0 {
+		return nil
+	}
+	return s.rp.AddSectionCapacity(ctx, exec, count.SectionId, net)
+}
+
+// transition stores the new status of the count and records it in the history.
+func (s *cycleCountService) transition(ctx context.Context, exec repository.Executor, count *models.CycleCount, to, note, actor string, at time.Time) error {
+	from := count.Status
+	count.Status = to
+	if err := s.rp.UpdateStatus(ctx, exec, *count); err != nil {
+		return err
+	}
+	return s.rp.CreateEvent(ctx, exec, models.CountEvent{
+		CycleCountId: count.Id,
+		FromStatus:   &from,
+		ToStatus:     to,
+		Note:         note,
+		Actor:        actor,
+		CreatedAt:    at,
+	})
+}
+
+// inTx runs fn in a transaction, committing it when fn succeeds and rolling it back otherwise.
+func (s *cycleCountService) inTx(ctx context.Context, fn func(exec repository.Executor) (int, error)) (id int, err error) {
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	if id, err = fn(tx); err != nil {
+		return 0, err
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return 0, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+	return id, nil
+}
+
+func allCounted(lines []models.CountLine) bool {
+	for _, l := range lines {
+		if l.CountedQuantity == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func hasLargeVariance(lines []models.CountLine) bool {
+	for _, l := range lines {
+		if l.IsLargeVariance() {
+			return true
+		}
+	}
+	return false
+}
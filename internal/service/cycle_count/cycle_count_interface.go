@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/cycle_count"
+	stockMovementRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/cycle_count"
+)
+
+// CycleCountService runs physical counts of sections and turns approved variances into stock adjustments.
+type CycleCountService interface {
+	// Create opens a count for a section with one line per batch stored in it.
+	Create(ctx context.Context, req models.PostCycleCount) (*models.CycleCountDetail, error)
+
+	// FindAll returns the counts, optionally filtered by section and status.
+	FindAll(ctx context.Context, sectionId int, status string) ([]models.CycleCount, error)
+
+	// FindById returns a count with its lines and history.
+	FindById(ctx context.Context, id int) (*models.CycleCountDetail, error)
+
+	// RecordCounts stores counted quantities and their variances against the batches current quantity.
+	RecordCounts(ctx context.Context, id int, req models.PostCountedLines) (*models.CycleCountDetail, error)
+
+	// Approve approves a counted count, posting its variances to the stock ledger once every required approval is in.
+	Approve(ctx context.Context, id int, req models.PostCountDecision) (*models.CycleCountDetail, error)
+
+	// Cancel discards a count that has not been approved.
+	Cancel(ctx context.Context, id int, req models.PostCountDecision) (*models.CycleCountDetail, error)
+}
+
+// cycleCountService implements CycleCountService using a repository and the stock ledger.
+type cycleCountService struct {
+	rp     repository.CycleCountRepository
+	ledger stockMovementRepository.StockMovementRepository
+}
+
+// NewCycleCountService creates a new CycleCountService using the provided repositories.
+func NewCycleCountService(rp repository.CycleCountRepository, ledger stockMovementRepository.StockMovementRepository) CycleCountService {
+	return &cycleCountService{
+		rp:     rp,
+		ledger: ledger,
+	}
+}
@@ -0,0 +1,334 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/cycle_count"
+	stockMovementRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/cycle_count"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/cycle_count"
+	movementMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/cycle_count"
+	movementModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestCycleCountService_Create(t *testing.T) {
+	testCases := []struct {
+		name        string
+		active      bool
+		lines       int
+		wantErrCode string
+	}{
+		{name: "success - count opened", lines: 3},
+		{name: "error - section already has an active count", active: true, wantErrCode: apperrors.CodeConflict},
+		{name: "error - section without batches", lines: 0, wantErrCode: apperrors.CodeConflict},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var events []models.CountEvent
+			rolledBack := false
+			rp := &mocks.CycleCountRepositoryMock{
+				FuncHasActiveCount: func(ctx context.Context, exec repository.Executor, sectionId int) (bool, error) {
+					return tc.active, nil
+				},
+				FuncCreate: func(ctx context.Context, exec repository.Executor, c models.CycleCount) (*models.CycleCount, error) {
+					require.Equal(t, models.StatusOpen, c.Status)
+					c.Id = 1
+					return &c, nil
+				},
+				FuncCreateLines: func(ctx context.Context, exec repository.Executor, countId int, sectionId int) (int, error) {
+					return tc.lines, nil
+				},
+				FuncCreateEvent: func(ctx context.Context, exec repository.Executor, e models.CountEvent) error {
+					events = append(events, e)
+					return nil
+				},
+				FuncFindById: func(ctx context.Context, id int) (*models.CycleCount, error) {
+					c := testhelpers.DummyCycleCount(id, models.StatusOpen)
+					return &c, nil
+				},
+				FuncRollbackTx: func(tx *sql.Tx) error {
+					rolledBack = true
+					return nil
+				},
+			}
+			svc := service.NewCycleCountService(rp, &movementMocks.StockMovementRepositoryMock{})
+
+			result, err := svc.Create(context.Background(), models.PostCycleCount{SectionId: 3, Actor: "supervisor"})
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, result)
+				require.True(t, rolledBack)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 1, result.Id)
+			require.Len(t, events, 1)
+			require.Nil(t, events[0].FromStatus)
+			require.Equal(t, models.StatusOpen, events[0].ToStatus)
+		})
+	}
+}
+
+func TestCycleCountService_RecordCounts(t *testing.T) {
+	reserved := testhelpers.DummyCountLine(3, 30, nil, "")
+	reserved.ReservedQuantity = 20
+	reservedCounted := reserved
+	reservedCounted.SystemQuantity = testhelpers.IntPtr(50)
+	reservedCounted.CountedQuantity = testhelpers.IntPtr(50)
+	reservedCounted.Variance = testhelpers.IntPtr(0)
+
+	testCases := []struct {
+		name        string
+		status      string
+		lines       []models.CountLine
+		req         models.PostCountedLines
+		wantErrCode string
+		wantUpdated []models.CountLine
+		wantStatus  string
+	}{
+		{
+			name:   "success - every line counted moves the count to counted",
+			status: models.StatusOpen,
+			lines:  []models.CountLine{testhelpers.DummyCountLine(1, 50, nil, ""), testhelpers.DummyCountLine(2, 20, nil, "")},
+			req: testhelpers.DummyPostCountedLines(
+				models.PostCountedLine{ProductBatchId: 1, CountedQuantity: testhelpers.IntPtr(48), ReasonCode: models.ReasonDamaged},
+				models.PostCountedLine{ProductBatchId: 2, CountedQuantity: testhelpers.IntPtr(20)},
+			),
+			wantUpdated: []models.CountLine{
+				testhelpers.DummyCountLine(1, 50, testhelpers.IntPtr(48), models.ReasonDamaged),
+				testhelpers.DummyCountLine(2, 20, testhelpers.IntPtr(20), ""),
+			},
+			wantStatus: models.StatusCounted,
+		},
+		{
+			name:   "success - partial count stays open",
+			status: models.StatusOpen,
+			lines:  []models.CountLine{testhelpers.DummyCountLine(1, 50, nil, ""), testhelpers.DummyCountLine(2, 20, nil, "")},
+			req: testhelpers.DummyPostCountedLines(
+				models.PostCountedLine{ProductBatchId: 2, CountedQuantity: testhelpers.IntPtr(20)},
+			),
+			wantUpdated: []models.CountLine{testhelpers.DummyCountLine(2, 20, testhelpers.IntPtr(20), "")},
+		},
+		{
+			name:   "success - reserved units still on the shelf are no surplus",
+			status: models.StatusCounted,
+			lines:  []models.CountLine{reserved},
+			req: testhelpers.DummyPostCountedLines(
+				models.PostCountedLine{ProductBatchId: 3, CountedQuantity: testhelpers.IntPtr(50)},
+			),
+			wantUpdated: []models.CountLine{reservedCounted},
+		},
+		{
+			name:   "error - variance without reason code",
+			status: models.StatusOpen,
+			lines:  []models.CountLine{testhelpers.DummyCountLine(1, 50, nil, "")},
+			req: testhelpers.DummyPostCountedLines(
+				models.PostCountedLine{ProductBatchId: 1, CountedQuantity: testhelpers.IntPtr(40)},
+			),
+			wantErrCode: apperrors.CodeValidationError,
+		},
+		{
+			name:   "error - batch not in the count",
+			status: models.StatusCounted,
+			lines:  []models.CountLine{testhelpers.DummyCountLine(1, 50, nil, "")},
+			req: testhelpers.DummyPostCountedLines(
+				models.PostCountedLine{ProductBatchId: 9, CountedQuantity: testhelpers.IntPtr(1)},
+			),
+			wantErrCode: apperrors.CodeValidationError,
+		},
+		{
+			name:        "error - count awaiting second approval",
+			status:      models.StatusPendingSecondApproval,
+			req:         testhelpers.DummyPostCountedLines(),
+			wantErrCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var updated []models.CountLine
+			var statuses []string
+			rp := &mocks.CycleCountRepositoryMock{
+				FuncFindForUpdate: func(ctx context.Context, exec repository.Executor, id int) (*models.CycleCount, error) {
+					c := testhelpers.DummyCycleCount(id, tc.status)
+					return &c, nil
+				},
+				FuncFindLinesForUpdate: func(ctx context.Context, exec repository.Executor, countId int) ([]models.CountLine, error) {
+					return tc.lines, nil
+				},
+				FuncUpdateLine: func(ctx context.Context, exec repository.Executor, l models.CountLine) error {
+					l.CountedBy, l.CountedAt = nil, nil
+					updated = append(updated, l)
+					return nil
+				},
+				FuncUpdateStatus: func(ctx context.Context, exec repository.Executor, c models.CycleCount) error {
+					statuses = append(statuses, c.Status)
+					return nil
+				},
+				FuncFindById: func(ctx context.Context, id int) (*models.CycleCount, error) {
+					c := testhelpers.DummyCycleCount(id, tc.status)
+					return &c, nil
+				},
+			}
+			svc := service.NewCycleCountService(rp, &movementMocks.StockMovementRepositoryMock{})
+
+			result, err := svc.RecordCounts(context.Background(), 1, tc.req)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantUpdated, updated)
+			if tc.wantStatus == "" {
+				require.Empty(t, statuses)
+			} else {
+				require.Equal(t, []string{tc.wantStatus}, statuses)
+			}
+		})
+	}
+}
+
+func TestCycleCountService_Approve(t *testing.T) {
+	small := testhelpers.DummyCountLine(1, 100, testhelpers.IntPtr(95), models.ReasonMiscount)
+	large := testhelpers.DummyCountLine(2, 20, testhelpers.IntPtr(30), models.ReasonUnrecordedReceipt)
+	exact := testhelpers.DummyCountLine(3, 10, testhelpers.IntPtr(10), "")
+	moved := testhelpers.DummyCountLine(4, 10, testhelpers.IntPtr(2), models.ReasonTheft)
+	moved.CurrentQuantity = 5
+
+	testCases := []struct {
+		name          string
+		count         models.CycleCount
+		lines         []models.CountLine
+		actor         string
+		wantErrCode   string
+		wantStatus    string
+		wantMovements []int
+		wantCapacity  map[int]int
+	}{
+		{
+			name:          "success - small variances approved at once",
+			count:         testhelpers.DummyCycleCount(1, models.StatusCounted),
+			lines:         []models.CountLine{small, exact},
+			actor:         "manager",
+			wantStatus:    models.StatusApproved,
+			wantMovements: []int{-5},
+			wantCapacity:  map[int]int{3: -5},
+		},
+		{
+			name:       "success - large variance waits for a second approver",
+			count:      testhelpers.DummyCycleCount(1, models.StatusCounted),
+			lines:      []models.CountLine{small, large},
+			actor:      "manager",
+			wantStatus: models.StatusPendingSecondApproval,
+		},
+		{
+			name: "success - second approver posts the adjustments",
+			count: func() models.CycleCount {
+				c := testhelpers.DummyCycleCount(1, models.StatusPendingSecondApproval)
+				c.ApprovedBy = testhelpers.Ptr("manager")
+				return c
+			}(),
+			lines:         []models.CountLine{small, large},
+			actor:         "director",
+			wantStatus:    models.StatusApproved,
+			wantMovements: []int{-5, 10},
+			wantCapacity:  map[int]int{3: 5},
+		},
+		{
+			name: "error - same approver twice",
+			count: func() models.CycleCount {
+				c := testhelpers.DummyCycleCount(1, models.StatusPendingSecondApproval)
+				c.ApprovedBy = testhelpers.Ptr("manager")
+				return c
+			}(),
+			lines:       []models.CountLine{large},
+			actor:       "manager",
+			wantErrCode: apperrors.CodeValidationError,
+		},
+		{
+			name:        "error - count still open",
+			count:       testhelpers.DummyCycleCount(1, models.StatusOpen),
+			actor:       "manager",
+			wantErrCode: apperrors.CodeConflict,
+		},
+		{
+			name: "error - stock moved below the adjustment",
+			count: func() models.CycleCount {
+				c := testhelpers.DummyCycleCount(1, models.StatusPendingSecondApproval)
+				c.ApprovedBy = testhelpers.Ptr("manager")
+				return c
+			}(),
+			lines:       []models.CountLine{moved},
+			actor:       "director",
+			wantErrCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var saved []models.CycleCount
+			var movements []int
+			var capacity map[int]int
+			rp := &mocks.CycleCountRepositoryMock{
+				FuncFindForUpdate: func(ctx context.Context, exec repository.Executor, id int) (*models.CycleCount, error) {
+					c := tc.count
+					return &c, nil
+				},
+				FuncFindLinesForUpdate: func(ctx context.Context, exec repository.Executor, countId int) ([]models.CountLine, error) {
+					return tc.lines, nil
+				},
+				FuncUpdateStatus: func(ctx context.Context, exec repository.Executor, c models.CycleCount) error {
+					saved = append(saved, c)
+					return nil
+				},
+				FuncAddSectionCapacity: func(ctx context.Context, exec repository.Executor, sectionId int, delta int) error {
+					if capacity == nil {
+						capacity = map[int]int{}
+					}
+					capacity[sectionId] += delta
+					return nil
+				},
+				FuncFindById: func(ctx context.Context, id int) (*models.CycleCount, error) {
+					c := saved[len(saved)-1]
+					return &c, nil
+				},
+			}
+			ledger := &movementMocks.StockMovementRepositoryMock{
+				FuncAppend: func(ctx context.Context, exec stockMovementRepository.Executor, m movementModels.StockMovement) (*movementModels.StockMovement, error) {
+					require.Equal(t, movementModels.MovementTypeAdjustment, m.MovementType)
+					require.Equal(t, tc.actor, m.Actor)
+					movements = append(movements, m.Quantity)
+					return &m, nil
+				},
+			}
+			svc := service.NewCycleCountService(rp, ledger)
+
+			result, err := svc.Approve(context.Background(), 1, models.PostCountDecision{Actor: tc.actor})
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, result)
+				require.Empty(t, movements)
+				require.Empty(t, capacity)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantStatus, result.Status)
+			require.Equal(t, tc.wantMovements, movements)
+			require.Equal(t, tc.wantCapacity, capacity)
+			if tc.wantStatus == models.StatusApproved {
+				require.NotNil(t, result.ApprovedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/dock"
+)
+
+// FindDocks returns the docks ordered by warehouse and code; a zero warehouseId returns them all.
+func (s *dockService) FindDocks(ctx context.Context, warehouseId int) ([]models.Dock, error) {
+	return s.rp.FindDocks(ctx, warehouseId)
+}
+
+// CreateDock stores a dock of a warehouse.
+// Returns a conflict error if the warehouse already has a dock with the code and a not found error if the warehouse does not exist.
+func (s *dockService) CreateDock(ctx context.Context, req models.PostDock) (*models.Dock, error) {
+	return s.rp.CreateDock(ctx, req)
+}
+
+// Book reserves the slot in one transaction. The dock row is locked first, so two bookings
+// on the same dock are checked one after the other and cannot both take the slot.
+// Returns a validation error if the slot falls outside the dock opening hours or the ASN is
+// expected at another warehouse, and a conflict error if the slot overlaps another booking.
+func (s *dockService) Book(ctx context.Context, req models.PostAppointment) (*models.Appointment, error) {
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	dock, err := s.rp.FindDockForUpdate(ctx, tx, req.DockId)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateOpeningHours(*dock, req.StartsAt, req.EndsAt); err != nil {
+		return nil, err
+	}
+	if req.AsnId != nil {
+		var asnWarehouse int
+		asnWarehouse, err = s.rp.FindAsnWarehouse(ctx, tx, *req.AsnId)
+		if err != nil {
+			return nil, err
+		}
+		if asnWarehouse != dock.WarehouseId {
+			err = apperrors.NewAppError(apperrors.CodeValidationError, "asn is expected at another warehouse").
+				WithDetail("asn_id", *req.AsnId).
+				WithDetail("asn_warehouse_id", asnWarehouse).
+				WithDetail("warehouse_id", dock.WarehouseId)
+			return nil, err
+		}
+	}
+
+	overlapping, err := s.rp.CountOverlapping(ctx, tx, dock.Id, req.StartsAt, req.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	if overlapping > 0 {
+		err = apperrors.NewAppError(apperrors.CodeConflict, "dock already booked for the slot").
+			WithDetail("dock_id", dock.Id).
+			WithDetail("starts_at", req.StartsAt).
+			WithDetail("ends_at", req.EndsAt)
+		return nil, err
+	}
+
+	id, err := s.rp.CreateAppointment(ctx, tx, models.Appointment{
+		DockId:    dock.Id,
+		SellerId:  req.SellerId,
+		CarrierId: req.CarrierId,
+		AsnId:     req.AsnId,
+		StartsAt:  req.StartsAt,
+		EndsAt:    req.EndsAt,
+		Status:    models.AppointmentBooked,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+	return s.rp.FindAppointmentById(ctx, id)
+}
+
+// FindAppointmentById returns the appointment.
+// Returns a not found error if it does not exist.
+func (s *dockService) FindAppointmentById(ctx context.Context, id int) (*models.Appointment, error) {
+	return s.rp.FindAppointmentById(ctx, id)
+}
+
+// Cancel returns a conflict error unless the appointment is still booked.
+func (s *dockService) Cancel(ctx context.Context, id int) (*models.Appointment, error) {
+	return s.update(ctx, id, func(tx *sql.Tx, a *models.Appointment) error {
+		if a.Status != models.AppointmentBooked {
+			return notBooked(a)
+		}
+		a.Status = models.AppointmentCancelled
+		return nil
+	})
+}
+
+// MarkMissed returns a conflict error unless the appointment is booked and its slot is over.
+// A missed appointment no longer holds its slot, so the dock can be booked for it again.
+func (s *dockService) MarkMissed(ctx context.Context, id int) (*models.Appointment, error) {
+	return s.update(ctx, id, func(tx *sql.Tx, a *models.Appointment) error {
+		if a.Status != models.AppointmentBooked {
+			return notBooked(a)
+		}
+		if time.Now().Before(a.EndsAt) {
+			return apperrors.NewAppError(apperrors.CodeConflict, "the appointment slot is not over yet").
+				WithDetail("appointment_id", a.Id).
+				WithDetail("ends_at", a.EndsAt)
+		}
+		a.Status = models.AppointmentMissed
+		return nil
+	})
+}
+
+// RecordArrival links the inbound order to a booked or missed appointment. A booked appointment
+// becomes arrived; a missed one stays missed, so it still counts as missed once the delivery shows up.
+// Returns a validation error if the inbound order was received at another warehouse.
+func (s *dockService) RecordArrival(ctx context.Context, id int, req models.PostArrival) (*models.Appointment, error) {
+	arrivedAt := time.Now()
+	if req.ArrivedAt != nil {
+		arrivedAt = *req.ArrivedAt
+	}
+	return s.update(ctx, id, func(tx *sql.Tx, a *models.Appointment) error {
+		if (a.Status != models.AppointmentBooked && a.Status != models.AppointmentMissed) || a.InboundOrderId != nil {
+			return apperrors.NewAppError(apperrors.CodeConflict, "only booked or missed appointments without an arrival can record one").
+				WithDetail("appointment_id", a.Id).
+				WithDetail("status", a.Status)
+		}
+		orderWarehouse, err := s.rp.FindInboundOrderWarehouse(ctx, tx, req.InboundOrderId)
+		if err != nil {
+			return err
+		}
+		if orderWarehouse != a.WarehouseId {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "inbound order was received at another warehouse").
+				WithDetail("inbound_order_id", req.InboundOrderId).
+				WithDetail("inbound_order_warehouse_id", orderWarehouse).
+				WithDetail("warehouse_id", a.WarehouseId)
+		}
+		if a.Status == models.AppointmentBooked {
+			a.Status = models.AppointmentArrived
+		}
+		a.InboundOrderId = &req.InboundOrderId
+		a.ArrivedAt = &arrivedAt
+		return nil
+	})
+}
+
+// DaySchedule groups the appointments of the day under the dock they are booked on.
+// Appointments come ordered by dock and start time.
+func (s *dockService) DaySchedule(ctx context.Context, warehouseId int, day time.Time) (*models.DaySchedule, error) {
+	docks, err := s.rp.FindDocks(ctx, warehouseId)
+	if err != nil {
+		return nil, err
+	}
+	from := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	appointments, err := s.rp.FindAppointmentsBetween(ctx, warehouseId, from, from.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	byDock := make(map[int][]models.Appointment, len(docks))
+	for _, a := range appointments {
+		byDock[a.DockId] = append(byDock[a.DockId], a)
+	}
+	schedule := &models.DaySchedule{
+		WarehouseId: warehouseId,
+		Date:        from.Format(time.DateOnly),
+		Docks:       make([]models.DockDay, 0, len(docks)),
+	}
+	for _, d := range docks {
+		dockDay := models.DockDay{Dock: d, Appointments: byDock[d.Id]}
+		if dockDay.Appointments == nil {
+			dockDay.Appointments = make([]models.Appointment, 0)
+		}
+		schedule.Docks = append(schedule.Docks, dockDay)
+	}
+	return schedule, nil
+}
+
+// update locks the appointment, lets apply check and change it within the transaction and stores it.
+func (s *dockService) update(ctx context.Context, id int, apply func(tx *sql.Tx, a *models.Appointment) error) (*models.Appointment, error) {
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	appointment, err := s.rp.FindAppointmentForUpdate(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = apply(tx, appointment); err != nil {
+		return nil, err
+	}
+	if err = s.rp.UpdateAppointment(ctx, tx, *appointment); err != nil {
+		return nil, err
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+	return s.rp.FindAppointmentById(ctx, id)
+}
+
+func notBooked(a *models.Appointment) error {
+	return apperrors.NewAppError(apperrors.CodeConflict, "the appointment is no longer booked").
+		WithDetail("appointment_id", a.Id).
+		WithDetail("status", a.Status)
+}
+
+// validateOpeningHours checks the slot lies within one day of the dock opening hours,
+// read in the server's local time zone.
+func validateOpeningHours(dock models.Dock, startsAt, endsAt time.Time) error {
+	start, end := startsAt.In(time.Local), endsAt.In(time.Local)
+	startDay, endDay := start.Format(time.DateOnly), end.Format(time.DateOnly)
+	if startDay != endDay || minuteOfDay(start) < clockMinutes(dock.OpensAt) || minuteOfDay(end) > clockMinutes(dock.ClosesAt) {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "the slot is outside the dock opening hours").
+			WithDetail("dock_id", dock.Id).
+			WithDetail("opens_at", dock.OpensAt).
+			WithDetail("closes_at", dock.ClosesAt)
+	}
+	return nil
+}
+
+func minuteOfDay(t time.Time) int {
+	return t.Hour()*60 + t.Minute()
+}
+
+// clockMinutes converts an HH:MM clock time into minutes since midnight.
+func clockMinutes(clock string) int {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0
+	}
+	return minuteOfDay(t)
+}
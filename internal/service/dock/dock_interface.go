@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/dock"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/dock"
+)
+
+// DockService manages warehouse docks and the inbound delivery slots booked on them.
+type DockService interface {
+	// FindDocks returns the docks of a warehouse, or of every warehouse when warehouseId is zero.
+	FindDocks(ctx context.Context, warehouseId int) ([]models.Dock, error)
+
+	// CreateDock adds a dock to a warehouse.
+	CreateDock(ctx context.Context, req models.PostDock) (*models.Dock, error)
+
+	// Book reserves a slot on a dock for a seller or a carrier.
+	Book(ctx context.Context, req models.PostAppointment) (*models.Appointment, error)
+
+	// FindAppointmentById returns an appointment.
+	FindAppointmentById(ctx context.Context, id int) (*models.Appointment, error)
+
+	// Cancel calls off a booked appointment, freeing its slot.
+	Cancel(ctx context.Context, id int) (*models.Appointment, error)
+
+	// MarkMissed flags a booked appointment whose slot went by without the delivery.
+	MarkMissed(ctx context.Context, id int) (*models.Appointment, error)
+
+	// RecordArrival links the inbound order that arrived for an appointment.
+	RecordArrival(ctx context.Context, id int, req models.PostArrival) (*models.Appointment, error)
+
+	// DaySchedule returns the docks of a warehouse with their appointments for a day.
+	DaySchedule(ctx context.Context, warehouseId int, day time.Time) (*models.DaySchedule, error)
+}
+
+// dockService implements DockService using a repository.
+type dockService struct {
+	rp repository.DockRepository
+}
+
+// NewDockService creates a new DockService using the provided repository.
+func NewDockService(rp repository.DockRepository) DockService {
+	return &dockService{
+		rp: rp,
+	}
+}
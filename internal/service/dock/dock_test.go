@@ -0,0 +1,343 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/dock"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/dock"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/dock"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/dock"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func at(hour, minute int) time.Time {
+	return time.Date(2025, 6, 2, hour, minute, 0, 0, time.Local)
+}
+
+func TestDockService_Book(t *testing.T) {
+	testCases := []struct {
+		name         string
+		req          models.PostAppointment
+		overlapping  int
+		asnWarehouse int
+		wantErrCode  string
+		wantDetails  map[string]interface{}
+	}{
+		{
+			name: "success - slot within opening hours",
+			req:  testhelpers.DummyPostAppointment(at(9, 0), at(10, 0)),
+		},
+		{
+			name: "success - slot filling the whole day",
+			req:  testhelpers.DummyPostAppointment(at(8, 0), at(18, 0)),
+		},
+		{
+			name: "success - slot for an asn of the warehouse",
+			req: func() models.PostAppointment {
+				r := testhelpers.DummyPostAppointment(at(9, 0), at(10, 0))
+				r.AsnId = testhelpers.IntPtr(7)
+				return r
+			}(),
+			asnWarehouse: 1,
+		},
+		{
+			name:        "error - slot starts before the dock opens",
+			req:         testhelpers.DummyPostAppointment(at(7, 30), at(8, 30)),
+			wantErrCode: apperrors.CodeValidationError,
+		},
+		{
+			name:        "error - slot ends after the dock closes",
+			req:         testhelpers.DummyPostAppointment(at(17, 30), at(18, 30)),
+			wantErrCode: apperrors.CodeValidationError,
+		},
+		{
+			name:        "error - slot spans two days",
+			req:         testhelpers.DummyPostAppointment(at(9, 0), at(9, 0).AddDate(0, 0, 1)),
+			wantErrCode: apperrors.CodeValidationError,
+		},
+		{
+			name: "error - asn expected at another warehouse",
+			req: func() models.PostAppointment {
+				r := testhelpers.DummyPostAppointment(at(9, 0), at(10, 0))
+				r.AsnId = testhelpers.IntPtr(7)
+				return r
+			}(),
+			asnWarehouse: 2,
+			wantErrCode:  apperrors.CodeValidationError,
+			wantDetails:  map[string]interface{}{"asn_id": 7, "asn_warehouse_id": 2, "warehouse_id": 1},
+		},
+		{
+			name:        "error - double booking",
+			req:         testhelpers.DummyPostAppointment(at(9, 30), at(10, 30)),
+			overlapping: 1,
+			wantErrCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rolledBack, committed bool
+			var created *models.Appointment
+			rp := &mocks.DockRepositoryMock{
+				FuncFindDockForUpdate: func(ctx context.Context, exec repository.Executor, id int) (*models.Dock, error) {
+					d := testhelpers.DummyDock(id)
+					return &d, nil
+				},
+				FuncFindAsnWarehouse: func(ctx context.Context, exec repository.Executor, asnId int) (int, error) {
+					return tc.asnWarehouse, nil
+				},
+				FuncCountOverlapping: func(ctx context.Context, exec repository.Executor, dockId int, startsAt time.Time, endsAt time.Time) (int, error) {
+					require.Equal(t, tc.req.StartsAt, startsAt)
+					require.Equal(t, tc.req.EndsAt, endsAt)
+					return tc.overlapping, nil
+				},
+				FuncCreateAppointment: func(ctx context.Context, exec repository.Executor, a models.Appointment) (int, error) {
+					created = &a
+					return 11, nil
+				},
+				FuncFindAppointmentById: func(ctx context.Context, id int) (*models.Appointment, error) {
+					a := *created
+					a.Id = id
+					return &a, nil
+				},
+				FuncCommitTx: func(tx *sql.Tx) error {
+					committed = true
+					return nil
+				},
+				FuncRollbackTx: func(tx *sql.Tx) error {
+					rolledBack = true
+					return nil
+				},
+			}
+			svc := service.NewDockService(rp)
+
+			result, err := svc.Book(context.Background(), tc.req)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				if tc.wantDetails != nil {
+					var appErr *apperrors.AppError
+					require.True(t, errors.As(err, &appErr))
+					require.Equal(t, tc.wantDetails, appErr.Details)
+				}
+				require.Nil(t, result)
+				require.Nil(t, created)
+				require.True(t, rolledBack)
+				require.False(t, committed)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, committed)
+			require.False(t, rolledBack)
+			require.Equal(t, 11, result.Id)
+			require.Equal(t, models.AppointmentBooked, result.Status)
+			require.Equal(t, tc.req.AsnId, result.AsnId)
+		})
+	}
+}
+
+func TestDockService_RecordArrival(t *testing.T) {
+	testCases := []struct {
+		name           string
+		appointment    models.Appointment
+		orderWarehouse int
+		updateErr      error
+		wantErrCode    string
+		wantStatus     string
+	}{
+		{
+			name:           "success - booked appointment arrives",
+			appointment:    testhelpers.DummyAppointment(models.AppointmentBooked, at(9, 0)),
+			orderWarehouse: 1,
+			wantStatus:     models.AppointmentArrived,
+		},
+		{
+			name:           "success - missed appointment linked to the late delivery",
+			appointment:    testhelpers.DummyAppointment(models.AppointmentMissed, at(9, 0)),
+			orderWarehouse: 1,
+			wantStatus:     models.AppointmentMissed,
+		},
+		{
+			name:           "error - cancelled appointment",
+			appointment:    testhelpers.DummyAppointment(models.AppointmentCancelled, at(9, 0)),
+			orderWarehouse: 1,
+			wantErrCode:    apperrors.CodeConflict,
+		},
+		{
+			name: "error - missed appointment already linked",
+			appointment: func() models.Appointment {
+				a := testhelpers.DummyAppointment(models.AppointmentMissed, at(9, 0))
+				a.InboundOrderId = testhelpers.IntPtr(40)
+				return a
+			}(),
+			orderWarehouse: 1,
+			wantErrCode:    apperrors.CodeConflict,
+		},
+		{
+			name:           "error - inbound order of another warehouse",
+			appointment:    testhelpers.DummyAppointment(models.AppointmentBooked, at(9, 0)),
+			orderWarehouse: 2,
+			wantErrCode:    apperrors.CodeValidationError,
+		},
+		{
+			name:           "error - inbound order linked to another appointment",
+			appointment:    testhelpers.DummyAppointment(models.AppointmentBooked, at(9, 0)),
+			orderWarehouse: 1,
+			updateErr:      apperrors.NewAppError(apperrors.CodeConflict, "inbound order already linked to another appointment"),
+			wantErrCode:    apperrors.CodeConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rolledBack bool
+			var updated *models.Appointment
+			rp := &mocks.DockRepositoryMock{
+				FuncFindAppointmentForUpdate: func(ctx context.Context, exec repository.Executor, id int) (*models.Appointment, error) {
+					a := tc.appointment
+					return &a, nil
+				},
+				FuncFindInboundOrderWarehouse: func(ctx context.Context, exec repository.Executor, inboundOrderId int) (int, error) {
+					return tc.orderWarehouse, nil
+				},
+				FuncUpdateAppointment: func(ctx context.Context, exec repository.Executor, a models.Appointment) error {
+					if tc.updateErr != nil {
+						return tc.updateErr
+					}
+					updated = &a
+					return nil
+				},
+				FuncFindAppointmentById: func(ctx context.Context, id int) (*models.Appointment, error) {
+					return updated, nil
+				},
+				FuncRollbackTx: func(tx *sql.Tx) error {
+					rolledBack = true
+					return nil
+				},
+			}
+			svc := service.NewDockService(rp)
+
+			result, err := svc.RecordArrival(context.Background(), 11, models.PostArrival{
+				InboundOrderId: 41,
+				ArrivedAt:      testhelpers.Ptr(at(10, 30)),
+			})
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, result)
+				require.Nil(t, updated)
+				require.True(t, rolledBack)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantStatus, result.Status)
+			require.Equal(t, 41, *result.InboundOrderId)
+			require.Equal(t, at(10, 30), *result.ArrivedAt)
+		})
+	}
+}
+
+func TestDockService_CancelAndMarkMissed(t *testing.T) {
+	past := time.Now().Add(-3 * time.Hour).Truncate(time.Minute)
+	future := time.Now().Add(3 * time.Hour).Truncate(time.Minute)
+	testCases := []struct {
+		name        string
+		appointment models.Appointment
+		missed      bool
+		wantErrCode string
+		wantStatus  string
+	}{
+		{
+			name:        "success - cancel booked appointment",
+			appointment: testhelpers.DummyAppointment(models.AppointmentBooked, future),
+			wantStatus:  models.AppointmentCancelled,
+		},
+		{
+			name:        "error - cancel arrived appointment",
+			appointment: testhelpers.DummyAppointment(models.AppointmentArrived, past),
+			wantErrCode: apperrors.CodeConflict,
+		},
+		{
+			name:        "success - slot went by without the delivery",
+			appointment: testhelpers.DummyAppointment(models.AppointmentBooked, past),
+			missed:      true,
+			wantStatus:  models.AppointmentMissed,
+		},
+		{
+			name:        "error - slot not over yet",
+			appointment: testhelpers.DummyAppointment(models.AppointmentBooked, future),
+			missed:      true,
+			wantErrCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var updated *models.Appointment
+			rp := &mocks.DockRepositoryMock{
+				FuncFindAppointmentForUpdate: func(ctx context.Context, exec repository.Executor, id int) (*models.Appointment, error) {
+					a := tc.appointment
+					return &a, nil
+				},
+				FuncUpdateAppointment: func(ctx context.Context, exec repository.Executor, a models.Appointment) error {
+					updated = &a
+					return nil
+				},
+				FuncFindAppointmentById: func(ctx context.Context, id int) (*models.Appointment, error) {
+					return updated, nil
+				},
+			}
+			svc := service.NewDockService(rp)
+
+			var result *models.Appointment
+			var err error
+			if tc.missed {
+				result, err = svc.MarkMissed(context.Background(), 11)
+			} else {
+				result, err = svc.Cancel(context.Background(), 11)
+			}
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, updated)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantStatus, result.Status)
+		})
+	}
+}
+
+func TestDockService_DaySchedule(t *testing.T) {
+	var from, to time.Time
+	rp := &mocks.DockRepositoryMock{
+		FuncFindDocks: func(ctx context.Context, warehouseId int) ([]models.Dock, error) {
+			return []models.Dock{testhelpers.DummyDock(5), testhelpers.DummyDock(6)}, nil
+		},
+		FuncFindAppointmentsBetween: func(ctx context.Context, warehouseId int, f time.Time, tt time.Time) ([]models.Appointment, error) {
+			from, to = f, tt
+			second := testhelpers.DummyAppointment(models.AppointmentBooked, at(11, 0))
+			second.Id = 12
+			return []models.Appointment{testhelpers.DummyAppointment(models.AppointmentArrived, at(9, 0)), second}, nil
+		},
+	}
+	svc := service.NewDockService(rp)
+
+	schedule, err := svc.DaySchedule(context.Background(), 1, at(15, 45))
+
+	require.NoError(t, err)
+	require.Equal(t, at(0, 0), from)
+	require.Equal(t, at(0, 0).AddDate(0, 0, 1), to)
+	require.Equal(t, "2025-06-02", schedule.Date)
+	require.Len(t, schedule.Docks, 2)
+	require.Len(t, schedule.Docks[0].Appointments, 2)
+	require.Equal(t, 11, schedule.Docks[0].Appointments[0].Id)
+	require.NotNil(t, schedule.Docks[1].Appointments)
+	require.Empty(t, schedule.Docks[1].Appointments)
+}
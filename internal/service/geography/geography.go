@@ -31,7 +31,13 @@ func (s *geographyService) Create(ctx context.Context, gr models.RequestGeograph
 		return nil, err
 	}
 
-	locality, err := s.handleLocality(ctx, tx, *gr.Id, *gr.LocalityName, province.Id)
+	locality, err := s.handleLocality(ctx, tx, models.Locality{
+		Id:         *gr.Id,
+		Name:       *gr.LocalityName,
+		ProvinceId: province.Id,
+		Latitude:   gr.Latitude,
+		Longitude:  gr.Longitude,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -46,6 +52,8 @@ func (s *geographyService) Create(ctx context.Context, gr models.RequestGeograph
 		LocalityName: locality.Name,
 		ProvinceName: province.Name,
 		CountryName:  country.Name,
+		Latitude:     locality.Latitude,
+		Longitude:    locality.Longitude,
 	}, nil
 }
 
@@ -53,6 +61,20 @@ func (s *geographyService) CountSellersGroupedByLocality(ctx context.Context) ([
 	return s.rp.CountSellersGroupedByLocality(ctx)
 }
 
+// SetLocalityCoordinates places an existing locality on the map, replacing its previous coordinates.
+func (s *geographyService) SetLocalityCoordinates(ctx context.Context, id string, c models.RequestCoordinates) (*models.Locality, error) {
+	locality, err := s.rp.FindLocalityById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.rp.UpdateLocalityCoordinates(ctx, id, *c.Latitude, *c.Longitude); err != nil {
+		return nil, err
+	}
+	locality.Latitude = c.Latitude
+	locality.Longitude = c.Longitude
+	return locality, nil
+}
+
 func (s *geographyService) CountSellersByLocality(ctx context.Context, id string) (*models.ResponseLocalitySellers, error) {
 	resp, err := s.rp.CountSellersByLocality(ctx, id)
 	if err != nil {
@@ -94,15 +116,14 @@ func (s *geographyService) handleProvince(ctx context.Context, tx *sql.Tx, provi
 	return province, nil
 }
 
-func (s *geographyService) handleLocality(ctx context.Context, tx *sql.Tx, localityId string, localityName string, provinceId int) (*models.Locality, error) {
-	_, err := s.rp.FindLocalityById(ctx, localityId)
+func (s *geographyService) handleLocality(ctx context.Context, tx *sql.Tx, newLocality models.Locality) (*models.Locality, error) {
+	_, err := s.rp.FindLocalityById(ctx, newLocality.Id)
 
 	if err == nil {
 		return nil, apperrors.NewAppError(apperrors.CodeConflict, "locality already exists")
 	}
 
 	if apperrors.IsAppError(err, apperrors.CodeNotFound) {
-		newLocality := models.Locality{Id: localityId, Name: localityName, ProvinceId: provinceId}
 		locality, err := s.rp.CreateLocality(ctx, tx, newLocality)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,69 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/geography"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/geography"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/geography"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestGeographyService_SetLocalityCoordinates(t *testing.T) {
+	coordinates := models.RequestCoordinates{Latitude: testhelpers.Float64Ptr(-34.603722), Longitude: testhelpers.Float64Ptr(-58.381592)}
+
+	tests := []struct {
+		name        string
+		findErr     error
+		updateErr   error
+		wantErrCode string
+	}{
+		{name: "success"},
+		{
+			name:        "error - locality not found",
+			findErr:     apperrors.NewAppError(apperrors.CodeNotFound, "locality not found"),
+			wantErrCode: apperrors.CodeNotFound,
+		},
+		{
+			name:        "error - update fails",
+			updateErr:   apperrors.NewAppError(apperrors.CodeInternal, "error updating locality coordinates"),
+			wantErrCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated bool
+			rp := &mocks.GeographyRepositoryMock{
+				FuncFindLocalityById: func(ctx context.Context, id string) (*models.Locality, error) {
+					if tt.findErr != nil {
+						return nil, tt.findErr
+					}
+					return &models.Locality{Id: id, Name: "Buenos Aires", ProvinceId: 2}, nil
+				},
+				FuncUpdateLocalityCoordinates: func(ctx context.Context, id string, latitude float64, longitude float64) error {
+					updated = true
+					require.Equal(t, -34.603722, latitude)
+					require.Equal(t, -58.381592, longitude)
+					return tt.updateErr
+				},
+			}
+			sv := service.NewGeographyService(rp)
+
+			locality, err := sv.SetLocalityCoordinates(context.Background(), "1000", coordinates)
+
+			if tt.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tt.wantErrCode)
+				require.Equal(t, tt.findErr == nil, updated)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, &models.Locality{
+				Id: "1000", Name: "Buenos Aires", ProvinceId: 2, Latitude: coordinates.Latitude, Longitude: coordinates.Longitude,
+			}, locality)
+		})
+	}
+}
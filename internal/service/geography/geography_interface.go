@@ -11,6 +11,7 @@ type GeographyService interface {
 	Create(ctx context.Context, gr models.RequestGeography) (*models.ResponseGeography, error)
 	CountSellersByLocality(ctx context.Context, id string) (*models.ResponseLocalitySellers, error)
 	CountSellersGroupedByLocality(ctx context.Context) ([]models.ResponseLocalitySellers, error)
+	SetLocalityCoordinates(ctx context.Context, id string, c models.RequestCoordinates) (*models.Locality, error)
 }
 
 type geographyService struct {
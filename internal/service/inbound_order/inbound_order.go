@@ -7,6 +7,7 @@ import (
 	empRepo "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/employee"
 	inbRepo "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/inbound_order"
 	wRepo "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/warehouse"
+	exRepo "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/warehouse_exception"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
 	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inbound_order"
@@ -17,17 +18,20 @@ type InboundOrderDefault struct {
 	repo          inbRepo.InboundOrderRepository
 	employeeRepo  empRepo.EmployeeRepository
 	warehouseRepo wRepo.WarehouseRepository
+	exceptionRepo exRepo.WarehouseExceptionRepository
 }
 
 // Constructor del servicio de inbound orders
 func NewInboundOrderService(
 	r inbRepo.InboundOrderRepository,
 	er empRepo.EmployeeRepository,
-	wr wRepo.WarehouseRepository) *InboundOrderDefault {
+	wr wRepo.WarehouseRepository,
+	xr exRepo.WarehouseExceptionRepository) *InboundOrderDefault {
 	return &InboundOrderDefault{
 		repo:          r,
 		employeeRepo:  er,
 		warehouseRepo: wr,
+		exceptionRepo: xr,
 	}
 }
 
@@ -65,6 +69,27 @@ func (s *InboundOrderDefault) Create(ctx context.Context, o *models.InboundOrder
 	if warehouse == nil {
 		return nil, apperrors.NewAppError(apperrors.CodeConflict, "warehouse_id does not exist")
 	}
+	// Valida que el empleado pertenezca al warehouse, salvo que tenga una excepción para él
+	hasException := false
+	if emp.WarehouseID != o.WarehouseID {
+		hasException, err = s.exceptionRepo.Exists(ctx, o.EmployeeID, o.WarehouseID)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "failed checking employee warehouse exceptions")
+		}
+	}
+	if err := validators.ValidateEmployeeWarehouse(o.EmployeeID, emp.WarehouseID, o.WarehouseID, hasException); err != nil {
+		return nil, err
+	}
+	// Valida que el batch esté guardado en el warehouse indicado
+	inWarehouse, err := s.repo.BatchInWarehouse(ctx, o.ProductBatchID, o.WarehouseID)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "failed checking product batch warehouse")
+	}
+	if !inWarehouse {
+		return nil, apperrors.NewAppError(apperrors.CodeValidationError, "product_batch_id is not stored in warehouse_id").
+			WithDetail("product_batch_id", o.ProductBatchID).
+			WithDetail("warehouse_id", o.WarehouseID)
+	}
 	// Si pasa todas las validaciones, crea el inbound order
 	return s.repo.Create(ctx, o)
 }
@@ -9,6 +9,7 @@ import (
 	employeeMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/employee"
 	inboundOrderMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/inbound_order"
 	warehouseMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/warehouse"
+	exceptionMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/warehouse_exception"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
 	employeeModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/employee"
 	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inbound_order"
@@ -22,6 +23,7 @@ func TestInboundOrderService_Create(t *testing.T) {
 		repoMock      func() *inboundOrderMocks.InboundOrderRepositoryMock
 		employeeMock  func() *employeeMocks.EmployeeRepositoryMock
 		warehouseMock func() *warehouseMocks.WarehouseRepositoryMock
+		exceptionMock func() *exceptionMocks.WarehouseExceptionRepositoryMock
 		input         *models.InboundOrder
 		wantErrCode   string
 	}{
@@ -33,6 +35,9 @@ func TestInboundOrderService_Create(t *testing.T) {
 					MockExistsByOrderNumber: func(ctx context.Context, orderNumber string) (bool, error) {
 						return false, nil
 					},
+					MockBatchInWarehouse: func(ctx context.Context, productBatchID int, warehouseID int) (bool, error) {
+						return true, nil
+					},
 					MockCreate: func(ctx context.Context, o *models.InboundOrder) (*models.InboundOrder, error) {
 						o.ID = 1
 						return o, nil
@@ -223,12 +228,129 @@ func TestInboundOrderService_Create(t *testing.T) {
 			input:       testhelpers.CreateExpectedInboundOrder(0),
 			wantErrCode: "CONFLICT",
 		},
+		{
+			name: "employee from another warehouse",
+			// El empleado está asignado a otro warehouse y no tiene excepción.
+			repoMock: func() *inboundOrderMocks.InboundOrderRepositoryMock {
+				return &inboundOrderMocks.InboundOrderRepositoryMock{
+					MockExistsByOrderNumber: func(ctx context.Context, orderNumber string) (bool, error) { return false, nil },
+					MockBatchInWarehouse: func(ctx context.Context, productBatchID int, warehouseID int) (bool, error) {
+						return true, nil
+					},
+					MockCreate: func(ctx context.Context, o *models.InboundOrder) (*models.InboundOrder, error) {
+						o.ID = 1
+						return o, nil
+					},
+				}
+			},
+			employeeMock: func() *employeeMocks.EmployeeRepositoryMock {
+				return &employeeMocks.EmployeeRepositoryMock{
+					MockFindByID: func(ctx context.Context, id int) (*employeeModels.Employee, error) {
+						e := testhelpers.CreateTestEmployee()
+						e.ID = id
+						e.WarehouseID = 7
+						return &e, nil
+					},
+				}
+			},
+			warehouseMock: func() *warehouseMocks.WarehouseRepositoryMock {
+				return &warehouseMocks.WarehouseRepositoryMock{
+					FuncFindById: func(ctx context.Context, id int) (*warehouseModels.Warehouse, error) {
+						return &warehouseModels.Warehouse{Id: id}, nil
+					},
+				}
+			},
+			exceptionMock: func() *exceptionMocks.WarehouseExceptionRepositoryMock {
+				return &exceptionMocks.WarehouseExceptionRepositoryMock{
+					FuncExists: func(ctx context.Context, employeeId int, warehouseId int) (bool, error) {
+						return false, nil
+					},
+				}
+			},
+			input:       testhelpers.CreateExpectedInboundOrder(0),
+			wantErrCode: apperrors.CodeValidationError,
+		},
+		{
+			name: "roaming employee with exception",
+			// El empleado es de otro warehouse pero figura en la lista de excepciones.
+			repoMock: func() *inboundOrderMocks.InboundOrderRepositoryMock {
+				return &inboundOrderMocks.InboundOrderRepositoryMock{
+					MockExistsByOrderNumber: func(ctx context.Context, orderNumber string) (bool, error) { return false, nil },
+					MockBatchInWarehouse: func(ctx context.Context, productBatchID int, warehouseID int) (bool, error) {
+						return true, nil
+					},
+					MockCreate: func(ctx context.Context, o *models.InboundOrder) (*models.InboundOrder, error) {
+						o.ID = 1
+						return o, nil
+					},
+				}
+			},
+			employeeMock: func() *employeeMocks.EmployeeRepositoryMock {
+				return &employeeMocks.EmployeeRepositoryMock{
+					MockFindByID: func(ctx context.Context, id int) (*employeeModels.Employee, error) {
+						e := testhelpers.CreateTestEmployee()
+						e.ID = id
+						e.WarehouseID = 7
+						return &e, nil
+					},
+				}
+			},
+			warehouseMock: func() *warehouseMocks.WarehouseRepositoryMock {
+				return &warehouseMocks.WarehouseRepositoryMock{
+					FuncFindById: func(ctx context.Context, id int) (*warehouseModels.Warehouse, error) {
+						return &warehouseModels.Warehouse{Id: id}, nil
+					},
+				}
+			},
+			exceptionMock: func() *exceptionMocks.WarehouseExceptionRepositoryMock {
+				return &exceptionMocks.WarehouseExceptionRepositoryMock{
+					FuncExists: func(ctx context.Context, employeeId int, warehouseId int) (bool, error) {
+						return true, nil
+					},
+				}
+			},
+			input:       testhelpers.CreateExpectedInboundOrder(0),
+			wantErrCode: "",
+		},
+		{
+			name: "batch in another warehouse",
+			// El batch está guardado en una sección de otro warehouse, no se crea la orden.
+			repoMock: func() *inboundOrderMocks.InboundOrderRepositoryMock {
+				return &inboundOrderMocks.InboundOrderRepositoryMock{
+					MockExistsByOrderNumber: func(ctx context.Context, orderNumber string) (bool, error) { return false, nil },
+					MockBatchInWarehouse: func(ctx context.Context, productBatchID int, warehouseID int) (bool, error) {
+						return false, nil
+					},
+				}
+			},
+			employeeMock: func() *employeeMocks.EmployeeRepositoryMock {
+				return &employeeMocks.EmployeeRepositoryMock{
+					MockFindByID: func(ctx context.Context, id int) (*employeeModels.Employee, error) {
+						e := testhelpers.CreateTestEmployee()
+						e.ID = id
+						return &e, nil
+					},
+				}
+			},
+			warehouseMock: func() *warehouseMocks.WarehouseRepositoryMock {
+				return &warehouseMocks.WarehouseRepositoryMock{
+					FuncFindById: func(ctx context.Context, id int) (*warehouseModels.Warehouse, error) {
+						return &warehouseModels.Warehouse{Id: id}, nil
+					},
+				}
+			},
+			input:       testhelpers.CreateExpectedInboundOrder(0),
+			wantErrCode: apperrors.CodeValidationError,
+		},
 		{
 			name: "create repo error",
 			// Falla el insert en el repo (DB error)
 			repoMock: func() *inboundOrderMocks.InboundOrderRepositoryMock {
 				return &inboundOrderMocks.InboundOrderRepositoryMock{
 					MockExistsByOrderNumber: func(ctx context.Context, orderNumber string) (bool, error) { return false, nil },
+					MockBatchInWarehouse: func(ctx context.Context, productBatchID int, warehouseID int) (bool, error) {
+						return true, nil
+					},
 					MockCreate: func(ctx context.Context, o *models.InboundOrder) (*models.InboundOrder, error) {
 						return nil, apperrors.NewAppError("INTERNAL", "fail saving")
 					},
@@ -260,7 +382,11 @@ func TestInboundOrderService_Create(t *testing.T) {
 			repo := tc.repoMock()
 			empRepo := tc.employeeMock()
 			whRepo := tc.warehouseMock()
-			svc := service.NewInboundOrderService(repo, empRepo, whRepo)
+			exRepo := &exceptionMocks.WarehouseExceptionRepositoryMock{}
+			if tc.exceptionMock != nil {
+				exRepo = tc.exceptionMock()
+			}
+			svc := service.NewInboundOrderService(repo, empRepo, whRepo, exRepo)
 
 			res, err := svc.Create(context.Background(), tc.input)
 			if tc.wantErrCode == "" {
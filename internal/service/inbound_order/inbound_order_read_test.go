@@ -9,6 +9,7 @@ import (
 	employeeMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/employee"
 	inboundOrderMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/inbound_order"
 	warehouseMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/warehouse"
+	exceptionMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/warehouse_exception"
 	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inbound_order"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
 )
@@ -75,7 +76,8 @@ func TestInboundOrderService_Report(t *testing.T) {
 			repo := tc.repoMock()
 			empRepo := &employeeMocks.EmployeeRepositoryMock{}
 			whRepo := &warehouseMocks.WarehouseRepositoryMock{}
-			svc := service.NewInboundOrderService(repo, empRepo, whRepo)
+			exRepo := &exceptionMocks.WarehouseExceptionRepositoryMock{}
+			svc := service.NewInboundOrderService(repo, empRepo, whRepo, exRepo)
 
 			// Ejecuta el método Report
 			result, err := svc.Report(context.Background(), tc.employeeID)
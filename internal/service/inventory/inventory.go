@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inventory"
+)
+
+// FindOnHand validates the filter and returns the stock on hand, grouped by product unless
+// the filter asks for warehouse or section lines. Stock is expired when its due date has passed.
+func (s *inventoryService) FindOnHand(ctx context.Context, filter models.InventoryFilter) ([]models.InventoryLine, error) {
+	if err := validators.ValidateInventoryFilter(filter); err != nil {
+		return nil, err
+	}
+	if filter.GroupBy == "" {
+		filter.GroupBy = models.GroupByProduct
+	}
+	return s.rp.FindOnHand(ctx, filter, time.Now())
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/inventory"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inventory"
+)
+
+// InventoryService answers how much of a product is on hand and where.
+type InventoryService interface {
+	// FindOnHand returns the stock on hand matching the filter.
+	FindOnHand(ctx context.Context, filter models.InventoryFilter) ([]models.InventoryLine, error)
+}
+
+// inventoryService implements InventoryService using a repository.
+type inventoryService struct {
+	rp repository.InventoryRepository
+}
+
+// NewInventoryService creates a new InventoryService using the provided repository.
+func NewInventoryService(rp repository.InventoryRepository) InventoryService {
+	return &inventoryService{
+		rp: rp,
+	}
+}
@@ -0,0 +1,70 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/inventory"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/inventory"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inventory"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestInventoryService_FindOnHand(t *testing.T) {
+	testCases := []struct {
+		name        string
+		filter      models.InventoryFilter
+		wantGroupBy string
+		wantErrCode string
+	}{
+		{
+			name:        "success - defaults to product grouping",
+			filter:      models.InventoryFilter{SellerId: 4},
+			wantGroupBy: models.GroupByProduct,
+		},
+		{
+			name:        "success - keeps requested grouping",
+			filter:      models.InventoryFilter{GroupBy: models.GroupByWarehouse, ExcludeHeld: true},
+			wantGroupBy: models.GroupByWarehouse,
+		},
+		{
+			name:        "error - unknown grouping",
+			filter:      models.InventoryFilter{GroupBy: "seller"},
+			wantErrCode: apperrors.CodeBadRequest,
+		},
+		{
+			name:        "error - negative id",
+			filter:      models.InventoryFilter{SectionId: -1},
+			wantErrCode: apperrors.CodeBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got models.InventoryFilter
+			rp := &mocks.InventoryRepositoryMock{
+				FuncFindOnHand: func(ctx context.Context, filter models.InventoryFilter, now time.Time) ([]models.InventoryLine, error) {
+					got = filter
+					return []models.InventoryLine{testhelpers.DummyInventoryLine(22)}, nil
+				},
+			}
+			svc := service.NewInventoryService(rp)
+
+			result, err := svc.FindOnHand(context.Background(), tc.filter)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, result, 1)
+			require.Equal(t, tc.wantGroupBy, got.GroupBy)
+			require.Equal(t, tc.filter.SellerId, got.SellerId)
+			require.Equal(t, tc.filter.ExcludeHeld, got.ExcludeHeld)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/occupancy"
+)
+
+// FindAll returns the occupancy of every warehouse, ordered by id.
+func (s *occupancyService) FindAll(ctx context.Context, within time.Duration) ([]models.WarehouseOccupancy, error) {
+	return s.build(ctx, 0, within)
+}
+
+// FindById returns the occupancy of the warehouse.
+// Returns a not found error if the warehouse does not exist.
+func (s *occupancyService) FindById(ctx context.Context, warehouseId int, within time.Duration) (*models.WarehouseOccupancy, error) {
+	warehouses, err := s.build(ctx, warehouseId, within)
+	if err != nil {
+		return nil, err
+	}
+	if len(warehouses) == 0 {
+		return nil, apperrors.NewAppError(apperrors.CodeNotFound, "warehouse not found").
+			WithDetail("warehouse_id", warehouseId)
+	}
+	return &warehouses[0], nil
+}
+
+// build loads the warehouse totals and attaches the product type, section and expiry breakdowns to each one.
+func (s *occupancyService) build(ctx context.Context, warehouseId int, within time.Duration) ([]models.WarehouseOccupancy, error) {
+	warehouses, err := s.rp.FindWarehouses(ctx, warehouseId)
+	if err != nil {
+		return nil, err
+	}
+	if len(warehouses) == 0 {
+		return warehouses, nil
+	}
+	usage, err := s.rp.FindProductTypeUsage(ctx, warehouseId)
+	if err != nil {
+		return nil, err
+	}
+	below, err := s.rp.FindSectionsBelowMinimum(ctx, warehouseId)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	expiry, err := s.rp.FindExpiryCounts(ctx, now, now.Add(within), warehouseId)
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[int]int, len(warehouses))
+	for i := range warehouses {
+		w := &warehouses[i]
+		index[w.WarehouseId] = i
+		w.ProductTypes = []models.ProductTypeUsage{}
+		w.SectionsBelowMinimum = []models.SectionBelowMinimum{}
+		w.FreeCapacity = w.TotalCapacity - w.UsedCapacity
+		w.UsedPercentage = percentage(w.UsedCapacity, w.TotalCapacity)
+		w.BelowMinimumCapacity = w.UsedCapacity < w.MinimumCapacity
+		if w.Temperature.Minimum != nil && w.Temperature.Maximum != nil {
+			spread := math.Round((*w.Temperature.Maximum-*w.Temperature.Minimum)*100) / 100
+			w.Temperature.Spread = &spread
+		}
+		if w.Temperature.Average != nil {
+			avg := math.Round(*w.Temperature.Average*100) / 100
+			w.Temperature.Average = &avg
+		}
+	}
+	for _, u := range usage {
+		if i, ok := index[u.WarehouseId]; ok {
+			u.UsedPercentage = percentage(u.UsedCapacity, u.TotalCapacity)
+			warehouses[i].ProductTypes = append(warehouses[i].ProductTypes, u)
+		}
+	}
+	for _, b := range below {
+		if i, ok := index[b.WarehouseId]; ok {
+			warehouses[i].SectionsBelowMinimum = append(warehouses[i].SectionsBelowMinimum, b)
+		}
+	}
+	for _, e := range expiry {
+		if i, ok := index[e.WarehouseId]; ok {
+			warehouses[i].Expiry = e
+		}
+	}
+	return warehouses, nil
+}
+
+// percentage returns used over total as a percentage rounded to two decimals, or 0 without capacity.
+func percentage(used, total int) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return math.Round(float64(used)/float64(total)*10000) / 100
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/occupancy"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/occupancy"
+)
+
+// OccupancyService builds the warehouse capacity dashboard.
+type OccupancyService interface {
+	// FindAll returns the occupancy of every warehouse, counting batches due within the given window.
+	FindAll(ctx context.Context, within time.Duration) ([]models.WarehouseOccupancy, error)
+
+	// FindById returns the occupancy of one warehouse, counting batches due within the given window.
+	FindById(ctx context.Context, warehouseId int, within time.Duration) (*models.WarehouseOccupancy, error)
+}
+
+// occupancyService implements OccupancyService using a repository.
+type occupancyService struct {
+	rp repository.OccupancyRepository
+}
+
+// NewOccupancyService creates a new OccupancyService using the provided repository.
+func NewOccupancyService(rp repository.OccupancyRepository) OccupancyService {
+	return &occupancyService{
+		rp: rp,
+	}
+}
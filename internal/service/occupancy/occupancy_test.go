@@ -0,0 +1,147 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/occupancy"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/occupancy"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/occupancy"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestOccupancyService_FindAll(t *testing.T) {
+	testCases := []struct {
+		name        string
+		rp          func() *mocks.OccupancyRepositoryMock
+		wantErrCode string
+		check       func(t *testing.T, result []models.WarehouseOccupancy)
+	}{
+		{
+			name: "success - breakdowns attached to their warehouse",
+			rp: func() *mocks.OccupancyRepositoryMock {
+				return &mocks.OccupancyRepositoryMock{
+					FuncFindWarehouses: func(ctx context.Context, warehouseId int) ([]models.WarehouseOccupancy, error) {
+						empty := testhelpers.DummyWarehouseOccupancy(2)
+						empty.SectionCount, empty.TotalCapacity, empty.UsedCapacity = 0, 0, 0
+						empty.Temperature = models.TemperatureSpread{}
+						return []models.WarehouseOccupancy{testhelpers.DummyWarehouseOccupancy(1), empty}, nil
+					},
+					FuncFindProductTypeUsage: func(ctx context.Context, warehouseId int) ([]models.ProductTypeUsage, error) {
+						return []models.ProductTypeUsage{
+							testhelpers.DummyProductTypeUsage(1, 1, 200, 50),
+							testhelpers.DummyProductTypeUsage(1, 2, 100, 70),
+						}, nil
+					},
+					FuncFindSectionsBelowMinimum: func(ctx context.Context, warehouseId int) ([]models.SectionBelowMinimum, error) {
+						return []models.SectionBelowMinimum{testhelpers.DummySectionBelowMinimum(1, 3)}, nil
+					},
+					FuncFindExpiryCounts: func(ctx context.Context, now, until time.Time, warehouseId int) ([]models.ExpiryCounts, error) {
+						require.Equal(t, 48*time.Hour, until.Sub(now))
+						return []models.ExpiryCounts{{WarehouseId: 1, Expired: 1, Expiring: 4}}, nil
+					},
+				}
+			},
+			check: func(t *testing.T, result []models.WarehouseOccupancy) {
+				require.Len(t, result, 2)
+				w := result[0]
+				require.Equal(t, 180, w.FreeCapacity)
+				require.Equal(t, 40.0, w.UsedPercentage)
+				require.False(t, w.BelowMinimumCapacity)
+				require.Len(t, w.ProductTypes, 2)
+				require.Equal(t, 25.0, w.ProductTypes[0].UsedPercentage)
+				require.Equal(t, 70.0, w.ProductTypes[1].UsedPercentage)
+				require.Len(t, w.SectionsBelowMinimum, 1)
+				require.Equal(t, 22.5, *w.Temperature.Spread)
+				require.Equal(t, -6.76, *w.Temperature.Average)
+				require.Equal(t, 4, w.Expiry.Expiring)
+
+				empty := result[1]
+				require.Equal(t, 0.0, empty.UsedPercentage)
+				require.True(t, empty.BelowMinimumCapacity)
+				require.NotNil(t, empty.ProductTypes)
+				require.Empty(t, empty.ProductTypes)
+				require.NotNil(t, empty.SectionsBelowMinimum)
+				require.Nil(t, empty.Temperature.Spread)
+				require.Equal(t, models.ExpiryCounts{}, empty.Expiry)
+			},
+		},
+		{
+			name: "success - no warehouses skips the breakdown queries",
+			rp: func() *mocks.OccupancyRepositoryMock {
+				return &mocks.OccupancyRepositoryMock{
+					FuncFindProductTypeUsage: func(ctx context.Context, warehouseId int) ([]models.ProductTypeUsage, error) {
+						return nil, errors.New("should not be called")
+					},
+				}
+			},
+			check: func(t *testing.T, result []models.WarehouseOccupancy) {
+				require.Empty(t, result)
+			},
+		},
+		{
+			name: "error - expiry counts fail",
+			rp: func() *mocks.OccupancyRepositoryMock {
+				return &mocks.OccupancyRepositoryMock{
+					FuncFindWarehouses: func(ctx context.Context, warehouseId int) ([]models.WarehouseOccupancy, error) {
+						return []models.WarehouseOccupancy{testhelpers.DummyWarehouseOccupancy(1)}, nil
+					},
+					FuncFindExpiryCounts: func(ctx context.Context, now, until time.Time, warehouseId int) ([]models.ExpiryCounts, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying batch expiry counts")
+					},
+				}
+			},
+			wantErrCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := service.NewOccupancyService(tc.rp())
+
+			result, err := svc.FindAll(context.Background(), 48*time.Hour)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			tc.check(t, result)
+		})
+	}
+}
+
+func TestOccupancyService_FindById(t *testing.T) {
+	t.Run("success - filters every query by warehouse", func(t *testing.T) {
+		rp := &mocks.OccupancyRepositoryMock{
+			FuncFindWarehouses: func(ctx context.Context, warehouseId int) ([]models.WarehouseOccupancy, error) {
+				require.Equal(t, 7, warehouseId)
+				return []models.WarehouseOccupancy{testhelpers.DummyWarehouseOccupancy(7)}, nil
+			},
+			FuncFindSectionsBelowMinimum: func(ctx context.Context, warehouseId int) ([]models.SectionBelowMinimum, error) {
+				require.Equal(t, 7, warehouseId)
+				return []models.SectionBelowMinimum{}, nil
+			},
+		}
+		svc := service.NewOccupancyService(rp)
+
+		result, err := svc.FindById(context.Background(), 7, time.Hour)
+
+		require.NoError(t, err)
+		require.Equal(t, 7, result.WarehouseId)
+	})
+
+	t.Run("error - warehouse not found", func(t *testing.T) {
+		svc := service.NewOccupancyService(&mocks.OccupancyRepositoryMock{})
+
+		result, err := svc.FindById(context.Background(), 99, time.Hour)
+
+		testhelpers.RequireAppErr(t, err, apperrors.CodeNotFound)
+		require.Nil(t, result)
+	})
+}
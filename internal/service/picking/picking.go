@@ -0,0 +1,342 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	allocationModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/picking"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	movementModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+)
+
+// CreateWave takes every reserved allocation of a confirmed purchase order stored at the warehouse that is
+// not in a pick list yet and splits them, in section order, into lists of at most MaxLines lines.
+// Allocations of batches that are on hold, quarantined or disposed are left out and reported as blocked.
+// Returns a validation error when there is nothing to pick.
+func (s *pickingService) CreateWave(ctx context.Context, req models.PostWave) (*models.WaveDetail, error) {
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	candidates, err := s.rp.FindWaveCandidatesForUpdate(ctx, tx, req.WarehouseId)
+	if err != nil {
+		return nil, err
+	}
+	pickable, blocked := splitBlocked(candidates)
+	if len(pickable) == 0 {
+		appErr := apperrors.NewAppError(apperrors.CodeValidationError, "no confirmed purchase orders to pick at the warehouse").
+			WithDetail("warehouse_id", req.WarehouseId)
+		if len(blocked) > 0 {
+			appErr = appErr.WithDetail("blocked_allocations", len(blocked))
+		}
+		err = appErr
+		return nil, err
+	}
+
+	wave := models.Wave{
+		WarehouseId: req.WarehouseId,
+		Status:      models.WaveStatusOpen,
+		CreatedAt:   time.Now(),
+	}
+	wave.Id, err = s.rp.CreateWave(ctx, tx, wave)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &models.WaveDetail{Wave: wave, Lists: make([]models.PickList, 0), Blocked: blocked}
+	for _, chunk := range chunkLines(pickable, req.MaxLines) {
+		list := models.PickList{
+			WaveId:      wave.Id,
+			WarehouseId: wave.WarehouseId,
+			Status:      models.ListStatusOpen,
+			Lines:       chunk,
+		}
+		list.Id, err = s.rp.CreateList(ctx, tx, wave.Id)
+		if err != nil {
+			return nil, err
+		}
+		for i := range list.Lines {
+			list.Lines[i].Sequence = i + 1
+			if err = s.rp.CreateLine(ctx, tx, list.Id, list.Lines[i]); err != nil {
+				return nil, err
+			}
+		}
+		detail.Lists = append(detail.Lists, list)
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+	return detail, nil
+}
+
+// FindWaves returns the waves, latest first, optionally of one warehouse and in one status; zero values are ignored.
+func (s *pickingService) FindWaves(ctx context.Context, warehouseId int, status string) ([]models.Wave, error) {
+	return s.rp.FindWaves(ctx, warehouseId, status)
+}
+
+// FindWave returns the wave with its pick lists and their lines in walking order.
+// Returns a not found error if the wave does not exist.
+func (s *pickingService) FindWave(ctx context.Context, id int) (*models.WaveDetail, error) {
+	wave, err := s.rp.FindWaveById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	lists, err := s.rp.FindListsByWave(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for i := range lists {
+		lists[i].Lines, err = s.rp.FindLines(ctx, lists[i].Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &models.WaveDetail{Wave: *wave, Lists: lists}, nil
+}
+
+// FindList returns the pick list with its lines in walking order.
+// Returns a not found error if the pick list does not exist.
+func (s *pickingService) FindList(ctx context.Context, id int) (*models.PickList, error) {
+	list, err := s.rp.FindListById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	list.Lines, err = s.rp.FindLines(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Confirm closes an open pick list with the quantity picked for each of its lines, in one transaction.
+// The employee must work at the wave's warehouse or hold an exception for it.
+//
+// Confirming a purchase order already took the reserved units out of current_quantity, so every line
+// first releases its reservation and then posts a pick movement for what was actually picked. Units that
+// were reserved but not found go back to the batch, where a cycle count can correct them, and are
+// reported as shortages. Lines whose purchase order was cancelled in the meantime already had their
+// stock released and must be reported with nothing picked. The wave completes once its last list is confirmed.
+func (s *pickingService) Confirm(ctx context.Context, listId int, req models.PostPickConfirmation) (*models.PickConfirmation, error) {
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	list, err := s.rp.FindListForUpdate(ctx, tx, listId)
+	if err != nil {
+		return nil, err
+	}
+	if list.Status != models.ListStatusOpen {
+		err = apperrors.NewAppError(apperrors.CodeConflict, "pick list is already confirmed").
+			WithDetail("pick_list_id", listId)
+		return nil, err
+	}
+
+	employeeWarehouseId, err := s.rp.FindEmployeeWarehouse(ctx, tx, req.EmployeeId)
+	if err != nil {
+		return nil, err
+	}
+	hasException := false
+	if employeeWarehouseId != list.WarehouseId {
+		hasException, err = s.exceptions.Exists(ctx, req.EmployeeId, list.WarehouseId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err = validators.ValidateEmployeeWarehouse(req.EmployeeId, employeeWarehouseId, list.WarehouseId, hasException); err != nil {
+		return nil, err
+	}
+
+	list.Lines, err = s.rp.FindLinesForUpdate(ctx, tx, listId)
+	if err != nil {
+		return nil, err
+	}
+	picked, err := pickedByLine(list.Lines, req.Lines)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	actor := fmt.Sprintf("employee %d", req.EmployeeId)
+	shortages := make([]models.PickShortage, 0)
+	for i := range list.Lines {
+		line := &list.Lines[i]
+		qty := picked[line.Id]
+		if line.AllocationStatus == allocationModels.AllocationStatusReleased {
+			if err = s.rp.RecordPicked(ctx, tx, line.Id, qty); err != nil {
+				return nil, err
+			}
+			line.PickedQuantity = &qty
+			continue
+		}
+		if err = s.pickLine(ctx, tx, *line, qty, listId, actor); err != nil {
+			return nil, err
+		}
+		line.PickedQuantity = &qty
+		if qty < line.Quantity {
+			shortages = append(shortages, models.PickShortage{
+				PickListId:      listId,
+				WaveId:          list.WaveId,
+				WarehouseId:     list.WarehouseId,
+				PurchaseOrderId: line.PurchaseOrderId,
+				ProductId:       line.ProductId,
+				ProductBatchId:  line.ProductBatchId,
+				BatchNumber:     line.BatchNumber,
+				SectionNumber:   line.SectionNumber,
+				Quantity:        line.Quantity,
+				PickedQuantity:  qty,
+				ShortQuantity:   line.Quantity - qty,
+				ConfirmedAt:     now,
+			})
+		}
+	}
+
+	list.Status = models.ListStatusConfirmed
+	list.EmployeeId = &req.EmployeeId
+	list.ConfirmedAt = &now
+	if err = s.rp.ConfirmList(ctx, tx, *list); err != nil {
+		return nil, err
+	}
+	open, err := s.rp.CountOpenLists(ctx, tx, list.WaveId)
+	if err != nil {
+		return nil, err
+	}
+	if open == 0 {
+		if err = s.rp.CompleteWave(ctx, tx, list.WaveId, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+	return &models.PickConfirmation{PickList: *list, Shortages: shortages}, nil
+}
+
+// Shortages returns the confirmed pick lines that were picked short; a zero warehouseId returns them all.
+func (s *pickingService) Shortages(ctx context.Context, warehouseId int) ([]models.PickShortage, error) {
+	return s.rp.FindShortages(ctx, warehouseId)
+}
+
+// pickLine releases the reservation of a line, takes the picked units out of the batch and its section
+// and marks the allocation as picked.
+func (s *pickingService) pickLine(ctx context.Context, tx *sql.Tx, line models.PickLine, qty, listId int, actor string) error {
+	reason := fmt.Sprintf("pick list %d", listId)
+	_, err := s.ledger.Append(ctx, tx, movementModels.StockMovement{
+		ProductBatchId: line.ProductBatchId,
+		MovementType:   movementModels.MovementTypeAllocation,
+		Quantity:       line.Quantity,
+		Reason:         reason,
+		Actor:          actor,
+	})
+	if err != nil {
+		return err
+	}
+	if qty > 0 {
+		_, err = s.ledger.Append(ctx, tx, movementModels.StockMovement{
+			ProductBatchId: line.ProductBatchId,
+			MovementType:   movementModels.MovementTypePick,
+			Quantity:       -qty,
+			Reason:         reason,
+			Actor:          actor,
+		})
+		if err != nil {
+			return err
+		}
+		if err = s.rp.AddSectionCapacity(ctx, tx, line.SectionId, -qty); err != nil {
+			return err
+		}
+	}
+	if err = s.rp.RecordPicked(ctx, tx, line.Id, qty); err != nil {
+		return err
+	}
+	return s.rp.MarkAllocationPicked(ctx, tx, line.StockAllocationId)
+}
+
+// pickedByLine maps the reported quantities by line id. Every line of the list must be reported
+// exactly once, with a quantity between zero and the quantity to pick; released lines have nothing to pick.
+func pickedByLine(lines []models.PickLine, reported []models.PostPickedLine) (map[int]int, error) {
+	quantities := make(map[int]int, len(lines))
+	for _, l := range lines {
+		quantities[l.Id] = l.Quantity
+		if l.AllocationStatus == allocationModels.AllocationStatusReleased {
+			quantities[l.Id] = 0
+		}
+	}
+
+	picked := make(map[int]int, len(reported))
+	for _, r := range reported {
+		quantity, ok := quantities[r.LineId]
+		if !ok {
+			return nil, apperrors.NewAppError(apperrors.CodeValidationError, "line does not belong to the pick list").
+				WithDetail("line_id", r.LineId)
+		}
+		if _, dup := picked[r.LineId]; dup {
+			return nil, apperrors.NewAppError(apperrors.CodeValidationError, "line reported more than once").
+				WithDetail("line_id", r.LineId)
+		}
+		if *r.PickedQuantity > quantity {
+			return nil, apperrors.NewAppError(apperrors.CodeValidationError, "picked_quantity exceeds the quantity to pick").
+				WithDetail("line_id", r.LineId).
+				WithDetail("quantity", quantity)
+		}
+		picked[r.LineId] = *r.PickedQuantity
+	}
+
+	for _, l := range lines {
+		if _, ok := picked[l.Id]; !ok {
+			return nil, apperrors.NewAppError(apperrors.CodeValidationError, "every line of the pick list must be reported").
+				WithDetail("line_id", l.Id)
+		}
+	}
+	return picked, nil
+}
+
+// splitBlocked separates the lines whose batch is available from those whose batch is not.
+func splitBlocked(lines []models.PickLine) (pickable, blocked []models.PickLine) {
+	pickable = make([]models.PickLine, 0, len(lines))
+	for _, l := range lines {
+		if l.BatchStatus == batchModels.BatchStatusAvailable {
+			pickable = append(pickable, l)
+		} else {
+			blocked = append(blocked, l)
+		}
+	}
+	return pickable, blocked
+}
+
+// chunkLines splits lines into consecutive chunks of at most size lines; a size of zero keeps them together.
+func chunkLines(lines []models.PickLine, size int) [][]models.PickLine {
+	if size <= 0 || size >= len(lines) {
+		return [][]models.PickLine{lines}
+	}
+	chunks := make([][]models.PickLine, 0, (len(lines)+size-1)/size)
+	for start := 0; start < len(lines); start += size {
+		end := min(start+size, len(lines))
+		chunks = append(chunks, lines[start:end])
+	}
+	return chunks
+}
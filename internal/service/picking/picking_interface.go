@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/picking"
+	stockMovementRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	exceptionRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/warehouse_exception"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/picking"
+)
+
+// PickingService plans pick waves from confirmed purchase orders and records what employees pick.
+type PickingService interface {
+	// CreateWave groups the reserved stock of confirmed purchase orders at a warehouse into pick lists.
+	CreateWave(ctx context.Context, req models.PostWave) (*models.WaveDetail, error)
+
+	// FindWaves returns the waves matching the filters; zero values are ignored.
+	FindWaves(ctx context.Context, warehouseId int, status string) ([]models.Wave, error)
+
+	// FindWave returns a wave with its pick lists and their lines.
+	FindWave(ctx context.Context, id int) (*models.WaveDetail, error)
+
+	// FindList returns a pick list with its lines.
+	FindList(ctx context.Context, id int) (*models.PickList, error)
+
+	// Confirm records the quantities picked for a pick list and consumes them from stock.
+	Confirm(ctx context.Context, listId int, req models.PostPickConfirmation) (*models.PickConfirmation, error)
+
+	// Shortages returns the lines picked short; a zero warehouseId returns them all.
+	Shortages(ctx context.Context, warehouseId int) ([]models.PickShortage, error)
+}
+
+// pickingService implements PickingService using a repository, the stock ledger
+// and the employee cross-warehouse exception list.
+type pickingService struct {
+	rp         repository.PickingRepository
+	ledger     stockMovementRepository.StockMovementRepository
+	exceptions exceptionRepository.WarehouseExceptionRepository
+}
+
+// NewPickingService creates a new PickingService using the provided repositories.
+func NewPickingService(rp repository.PickingRepository, ledger stockMovementRepository.StockMovementRepository,
+	exceptions exceptionRepository.WarehouseExceptionRepository) PickingService {
+	return &pickingService{
+		rp:         rp,
+		ledger:     ledger,
+		exceptions: exceptions,
+	}
+}
@@ -0,0 +1,386 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/picking"
+	stockMovementRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/picking"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/picking"
+	stockMovementMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/stock_movement"
+	exceptionMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/warehouse_exception"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	allocationModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/picking"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	movementModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func picked(lineId, quantity int) models.PostPickedLine {
+	return models.PostPickedLine{LineId: lineId, PickedQuantity: testhelpers.IntPtr(quantity)}
+}
+
+func TestPickingService_CreateWave(t *testing.T) {
+	candidate := func(quantity int, batchStatus string) models.PickLine {
+		l := testhelpers.DummyPickLine(0, quantity)
+		l.BatchStatus = batchStatus
+		return l
+	}
+	candidates := func() []models.PickLine {
+		return []models.PickLine{
+			candidate(5, batchModels.BatchStatusAvailable),
+			candidate(3, batchModels.BatchStatusAvailable),
+			candidate(1, batchModels.BatchStatusAvailable),
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		req           models.PostWave
+		candidates    []models.PickLine
+		wantErrCode   string
+		wantListSizes []int
+		wantBlocked   int
+	}{
+		{
+			name:          "success - a single list without max_lines",
+			req:           models.PostWave{WarehouseId: 1},
+			candidates:    candidates(),
+			wantListSizes: []int{3},
+		},
+		{
+			name:          "success - lists capped by max_lines",
+			req:           models.PostWave{WarehouseId: 1, MaxLines: 2},
+			candidates:    candidates(),
+			wantListSizes: []int{2, 1},
+		},
+		{
+			name: "success - allocations of unavailable batches are reported as blocked",
+			req:  models.PostWave{WarehouseId: 1},
+			candidates: append(candidates(),
+				candidate(2, batchModels.BatchStatusOnHold),
+				candidate(4, batchModels.BatchStatusDisposed)),
+			wantListSizes: []int{3},
+			wantBlocked:   2,
+		},
+		{
+			name:        "error - nothing to pick",
+			req:         models.PostWave{WarehouseId: 1},
+			candidates:  []models.PickLine{},
+			wantErrCode: apperrors.CodeValidationError,
+		},
+		{
+			name:        "error - every allocation blocked",
+			req:         models.PostWave{WarehouseId: 1},
+			candidates:  []models.PickLine{candidate(2, batchModels.BatchStatusQuarantined)},
+			wantErrCode: apperrors.CodeValidationError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rolledBack, committed bool
+			var createdLines []models.PickLine
+			lists := 0
+			rp := &mocks.PickingRepositoryMock{
+				FuncFindWaveCandidatesForUpdate: func(ctx context.Context, exec repository.Executor, warehouseId int) ([]models.PickLine, error) {
+					require.Equal(t, tc.req.WarehouseId, warehouseId)
+					return tc.candidates, nil
+				},
+				FuncCreateWave: func(ctx context.Context, exec repository.Executor, w models.Wave) (int, error) {
+					require.Equal(t, models.WaveStatusOpen, w.Status)
+					return 2, nil
+				},
+				FuncCreateList: func(ctx context.Context, exec repository.Executor, waveId int) (int, error) {
+					require.Equal(t, 2, waveId)
+					lists++
+					return 8 + lists, nil
+				},
+				FuncCreateLine: func(ctx context.Context, exec repository.Executor, listId int, l models.PickLine) error {
+					createdLines = append(createdLines, l)
+					return nil
+				},
+				FuncCommitTx: func(tx *sql.Tx) error {
+					committed = true
+					return nil
+				},
+				FuncRollbackTx: func(tx *sql.Tx) error {
+					rolledBack = true
+					return nil
+				},
+			}
+			svc := service.NewPickingService(rp, &stockMovementMocks.StockMovementRepositoryMock{}, &exceptionMocks.WarehouseExceptionRepositoryMock{})
+
+			result, err := svc.CreateWave(context.Background(), tc.req)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, result)
+				require.True(t, rolledBack)
+				require.False(t, committed)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, committed)
+			require.Equal(t, 2, result.Id)
+			require.Len(t, result.Lists, len(tc.wantListSizes))
+			for i, size := range tc.wantListSizes {
+				require.Equal(t, 9+i, result.Lists[i].Id)
+				require.Len(t, result.Lists[i].Lines, size)
+				for j, l := range result.Lists[i].Lines {
+					require.Equal(t, j+1, l.Sequence)
+				}
+			}
+			require.Len(t, createdLines, len(tc.candidates)-tc.wantBlocked)
+			require.Len(t, result.Blocked, tc.wantBlocked)
+			for _, l := range result.Blocked {
+				require.NotEqual(t, batchModels.BatchStatusAvailable, l.BatchStatus)
+			}
+		})
+	}
+}
+
+func TestPickingService_Confirm(t *testing.T) {
+	released := testhelpers.DummyPickLine(2, 4)
+	released.AllocationStatus = allocationModels.AllocationStatusReleased
+
+	testCases := []struct {
+		name              string
+		list              models.PickList
+		lines             []models.PickLine
+		req               models.PostPickConfirmation
+		employeeWarehouse int
+		hasException      bool
+		openLists         int
+		wantErrCode       string
+		wantMovements     []movementModels.StockMovement
+		wantShortages     []int
+		wantCapacity      map[int]int
+		wantWaveCompleted bool
+	}{
+		{
+			name:              "success - every line fully picked completes the wave",
+			list:              testhelpers.DummyPickList(models.ListStatusOpen),
+			lines:             []models.PickLine{testhelpers.DummyPickLine(1, 5)},
+			req:               testhelpers.DummyPostPickConfirmation(picked(1, 5)),
+			employeeWarehouse: 1,
+			wantMovements: []movementModels.StockMovement{
+				{ProductBatchId: 20, MovementType: movementModels.MovementTypeAllocation, Quantity: 5, Reason: "pick list 9", Actor: "employee 6"},
+				{ProductBatchId: 20, MovementType: movementModels.MovementTypePick, Quantity: -5, Reason: "pick list 9", Actor: "employee 6"},
+			},
+			wantShortages:     []int{},
+			wantCapacity:      map[int]int{4: -5},
+			wantWaveCompleted: true,
+		},
+		{
+			name:              "success - short pick returns the missing units and reports them",
+			list:              testhelpers.DummyPickList(models.ListStatusOpen),
+			lines:             []models.PickLine{testhelpers.DummyPickLine(1, 5)},
+			req:               testhelpers.DummyPostPickConfirmation(picked(1, 3)),
+			employeeWarehouse: 1,
+			openLists:         1,
+			wantMovements: []movementModels.StockMovement{
+				{ProductBatchId: 20, MovementType: movementModels.MovementTypeAllocation, Quantity: 5, Reason: "pick list 9", Actor: "employee 6"},
+				{ProductBatchId: 20, MovementType: movementModels.MovementTypePick, Quantity: -3, Reason: "pick list 9", Actor: "employee 6"},
+			},
+			wantShortages: []int{2},
+			wantCapacity:  map[int]int{4: -3},
+		},
+		{
+			name:              "success - nothing found only releases the reservation",
+			list:              testhelpers.DummyPickList(models.ListStatusOpen),
+			lines:             []models.PickLine{testhelpers.DummyPickLine(1, 5)},
+			req:               testhelpers.DummyPostPickConfirmation(picked(1, 0)),
+			employeeWarehouse: 1,
+			wantMovements: []movementModels.StockMovement{
+				{ProductBatchId: 20, MovementType: movementModels.MovementTypeAllocation, Quantity: 5, Reason: "pick list 9", Actor: "employee 6"},
+			},
+			wantShortages:     []int{5},
+			wantCapacity:      map[int]int{},
+			wantWaveCompleted: true,
+		},
+		{
+			name:              "success - line of a cancelled order is skipped",
+			list:              testhelpers.DummyPickList(models.ListStatusOpen),
+			lines:             []models.PickLine{testhelpers.DummyPickLine(1, 5), released},
+			req:               testhelpers.DummyPostPickConfirmation(picked(1, 5), picked(2, 0)),
+			employeeWarehouse: 1,
+			wantMovements: []movementModels.StockMovement{
+				{ProductBatchId: 20, MovementType: movementModels.MovementTypeAllocation, Quantity: 5, Reason: "pick list 9", Actor: "employee 6"},
+				{ProductBatchId: 20, MovementType: movementModels.MovementTypePick, Quantity: -5, Reason: "pick list 9", Actor: "employee 6"},
+			},
+			wantShortages:     []int{},
+			wantCapacity:      map[int]int{4: -5},
+			wantWaveCompleted: true,
+		},
+		{
+			name:              "success - employee with an exception for the warehouse",
+			list:              testhelpers.DummyPickList(models.ListStatusOpen),
+			lines:             []models.PickLine{testhelpers.DummyPickLine(1, 5)},
+			req:               testhelpers.DummyPostPickConfirmation(picked(1, 5)),
+			employeeWarehouse: 2,
+			hasException:      true,
+			wantMovements: []movementModels.StockMovement{
+				{ProductBatchId: 20, MovementType: movementModels.MovementTypeAllocation, Quantity: 5, Reason: "pick list 9", Actor: "employee 6"},
+				{ProductBatchId: 20, MovementType: movementModels.MovementTypePick, Quantity: -5, Reason: "pick list 9", Actor: "employee 6"},
+			},
+			wantShortages:     []int{},
+			wantCapacity:      map[int]int{4: -5},
+			wantWaveCompleted: true,
+		},
+		{
+			name:              "error - list already confirmed",
+			list:              testhelpers.DummyPickList(models.ListStatusConfirmed),
+			lines:             []models.PickLine{testhelpers.DummyPickLine(1, 5)},
+			req:               testhelpers.DummyPostPickConfirmation(picked(1, 5)),
+			employeeWarehouse: 1,
+			wantErrCode:       apperrors.CodeConflict,
+		},
+		{
+			name:              "error - employee of another warehouse",
+			list:              testhelpers.DummyPickList(models.ListStatusOpen),
+			lines:             []models.PickLine{testhelpers.DummyPickLine(1, 5)},
+			req:               testhelpers.DummyPostPickConfirmation(picked(1, 5)),
+			employeeWarehouse: 2,
+			wantErrCode:       apperrors.CodeValidationError,
+		},
+		{
+			name:              "error - line not reported",
+			list:              testhelpers.DummyPickList(models.ListStatusOpen),
+			lines:             []models.PickLine{testhelpers.DummyPickLine(1, 5), testhelpers.DummyPickLine(2, 1)},
+			req:               testhelpers.DummyPostPickConfirmation(picked(1, 5)),
+			employeeWarehouse: 1,
+			wantErrCode:       apperrors.CodeValidationError,
+		},
+		{
+			name:              "error - line reported twice",
+			list:              testhelpers.DummyPickList(models.ListStatusOpen),
+			lines:             []models.PickLine{testhelpers.DummyPickLine(1, 5)},
+			req:               testhelpers.DummyPostPickConfirmation(picked(1, 2), picked(1, 3)),
+			employeeWarehouse: 1,
+			wantErrCode:       apperrors.CodeValidationError,
+		},
+		{
+			name:              "error - line of another list",
+			list:              testhelpers.DummyPickList(models.ListStatusOpen),
+			lines:             []models.PickLine{testhelpers.DummyPickLine(1, 5)},
+			req:               testhelpers.DummyPostPickConfirmation(picked(1, 5), picked(3, 1)),
+			employeeWarehouse: 1,
+			wantErrCode:       apperrors.CodeValidationError,
+		},
+		{
+			name:              "error - more picked than reserved",
+			list:              testhelpers.DummyPickList(models.ListStatusOpen),
+			lines:             []models.PickLine{testhelpers.DummyPickLine(1, 5)},
+			req:               testhelpers.DummyPostPickConfirmation(picked(1, 6)),
+			employeeWarehouse: 1,
+			wantErrCode:       apperrors.CodeValidationError,
+		},
+		{
+			name:              "error - units picked for a cancelled order",
+			list:              testhelpers.DummyPickList(models.ListStatusOpen),
+			lines:             []models.PickLine{released},
+			req:               testhelpers.DummyPostPickConfirmation(picked(2, 1)),
+			employeeWarehouse: 1,
+			wantErrCode:       apperrors.CodeValidationError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rolledBack, committed, waveCompleted bool
+			var confirmed *models.PickList
+			movements := make([]movementModels.StockMovement, 0)
+			pickedAllocations := make([]int, 0)
+			capacity := map[int]int{}
+			rp := &mocks.PickingRepositoryMock{
+				FuncFindListForUpdate: func(ctx context.Context, exec repository.Executor, id int) (*models.PickList, error) {
+					l := tc.list
+					return &l, nil
+				},
+				FuncFindEmployeeWarehouse: func(ctx context.Context, exec repository.Executor, employeeId int) (int, error) {
+					return tc.employeeWarehouse, nil
+				},
+				FuncFindLinesForUpdate: func(ctx context.Context, exec repository.Executor, listId int) ([]models.PickLine, error) {
+					return append([]models.PickLine{}, tc.lines...), nil
+				},
+				FuncMarkAllocationPicked: func(ctx context.Context, exec repository.Executor, allocationId int) error {
+					pickedAllocations = append(pickedAllocations, allocationId)
+					return nil
+				},
+				FuncAddSectionCapacity: func(ctx context.Context, exec repository.Executor, sectionId int, delta int) error {
+					capacity[sectionId] += delta
+					return nil
+				},
+				FuncConfirmList: func(ctx context.Context, exec repository.Executor, l models.PickList) error {
+					confirmed = &l
+					return nil
+				},
+				FuncCountOpenLists: func(ctx context.Context, exec repository.Executor, waveId int) (int, error) {
+					return tc.openLists, nil
+				},
+				FuncCompleteWave: func(ctx context.Context, exec repository.Executor, waveId int, completedAt time.Time) error {
+					require.Equal(t, 2, waveId)
+					waveCompleted = true
+					return nil
+				},
+				FuncCommitTx: func(tx *sql.Tx) error {
+					committed = true
+					return nil
+				},
+				FuncRollbackTx: func(tx *sql.Tx) error {
+					rolledBack = true
+					return nil
+				},
+			}
+			ledger := &stockMovementMocks.StockMovementRepositoryMock{
+				FuncAppend: func(ctx context.Context, exec stockMovementRepository.Executor, mv movementModels.StockMovement) (*movementModels.StockMovement, error) {
+					movements = append(movements, mv)
+					return &mv, nil
+				},
+			}
+			exceptions := &exceptionMocks.WarehouseExceptionRepositoryMock{
+				FuncExists: func(ctx context.Context, employeeId int, warehouseId int) (bool, error) {
+					return tc.hasException, nil
+				},
+			}
+			svc := service.NewPickingService(rp, ledger, exceptions)
+
+			result, err := svc.Confirm(context.Background(), 9, tc.req)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, result)
+				require.Empty(t, movements)
+				require.Empty(t, capacity)
+				require.Nil(t, confirmed)
+				require.True(t, rolledBack)
+				require.False(t, committed)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, committed)
+			require.False(t, rolledBack)
+			require.Equal(t, tc.wantMovements, movements)
+			require.Equal(t, tc.wantCapacity, capacity)
+			require.Equal(t, []int{101}, pickedAllocations)
+			require.Equal(t, models.ListStatusConfirmed, confirmed.Status)
+			require.Equal(t, 6, *confirmed.EmployeeId)
+			require.Equal(t, tc.wantWaveCompleted, waveCompleted)
+			require.Equal(t, models.ListStatusConfirmed, result.PickList.Status)
+			for _, l := range result.PickList.Lines {
+				require.NotNil(t, l.PickedQuantity)
+			}
+			short := make([]int, 0)
+			for _, s := range result.Shortages {
+				short = append(short, s.ShortQuantity)
+			}
+			require.Equal(t, tc.wantShortages, short)
+		})
+	}
+}
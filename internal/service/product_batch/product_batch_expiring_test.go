@@ -0,0 +1,56 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/product_batch"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/product_batch"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestProductBatchesService_GetExpiring(t *testing.T) {
+	now := time.Now()
+
+	t.Run("flags past due batches as expired", func(t *testing.T) {
+		var gotUntil time.Time
+		var gotWarehouse int
+		repoMock := &mocks.ProductBatchRepositoryMock{
+			FuncGetExpiring: func(ctx context.Context, until time.Time, warehouseId int) ([]models.ExpiringBatch, error) {
+				gotUntil, gotWarehouse = until, warehouseId
+				return []models.ExpiringBatch{
+					testhelpers.DummyExpiringBatch(1, now.Add(-24*time.Hour)),
+					testhelpers.DummyExpiringBatch(2, now.Add(72*time.Hour)),
+				}, nil
+			},
+		}
+		svc := service.NewProductBatchesService(repoMock)
+
+		result, err := svc.GetExpiring(context.Background(), 7*24*time.Hour, 3)
+
+		require.NoError(t, err)
+		require.Equal(t, 3, gotWarehouse)
+		require.WithinDuration(t, now.Add(7*24*time.Hour), gotUntil, time.Minute)
+		require.Len(t, result, 2)
+		require.True(t, result[0].Expired)
+		require.False(t, result[1].Expired)
+	})
+
+	t.Run("returns repository error", func(t *testing.T) {
+		repoMock := &mocks.ProductBatchRepositoryMock{
+			FuncGetExpiring: func(ctx context.Context, until time.Time, warehouseId int) ([]models.ExpiringBatch, error) {
+				return nil, apperrors.NewAppError(apperrors.CodeInternal, "An internal server error occurred while retrieving the expiring product batches.")
+			},
+		}
+		svc := service.NewProductBatchesService(repoMock)
+
+		result, err := svc.GetExpiring(context.Background(), time.Hour, 0)
+
+		testhelpers.RequireAppErr(t, err, apperrors.CodeInternal)
+		require.Nil(t, result)
+	})
+}
@@ -13,7 +13,7 @@ import (
 
 func TestProductBatchesService_GetReportProduct(t *testing.T) {
 	type arrange struct {
-		repoMock func() *mocks.ProductBatchServiceMock
+		repoMock func() *mocks.ProductBatchRepositoryMock
 	}
 	type output struct {
 		expected      []models.ReportProduct
@@ -28,13 +28,16 @@ func TestProductBatchesService_GetReportProduct(t *testing.T) {
 
 	expectedReport := testhelpers.DummyReportProductsList()
 
+	withoutFill := testhelpers.DummyReportProductsList()
+	withoutFill[0].FillPercentage = 0
+
 	testCases := []testCase{
 		{
 			name: "returns product batch report successfully",
 			arrange: arrange{
-				repoMock: func() *mocks.ProductBatchServiceMock {
-					return &mocks.ProductBatchServiceMock{
-						FuncGetReport: func(ctx context.Context) ([]models.ReportProduct, error) {
+				repoMock: func() *mocks.ProductBatchRepositoryMock {
+					return &mocks.ProductBatchRepositoryMock{
+						FuncGetReport: func(ctx context.Context, warehouseId int) ([]models.ReportProduct, error) {
 							return expectedReport, nil
 						},
 					}
@@ -46,13 +49,30 @@ func TestProductBatchesService_GetReportProduct(t *testing.T) {
 				err:           nil,
 			},
 		},
+		{
+			name: "computes fill percentage from available and held stock",
+			arrange: arrange{
+				repoMock: func() *mocks.ProductBatchRepositoryMock {
+					return &mocks.ProductBatchRepositoryMock{
+						FuncGetReport: func(ctx context.Context, warehouseId int) ([]models.ReportProduct, error) {
+							return withoutFill, nil
+						},
+					}
+				},
+			},
+			output: output{
+				expected:      expectedReport,
+				expectedError: false,
+				err:           nil,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			svc := service.NewProductBatchesService(tc.arrange.repoMock())
 
-			result, err := svc.GetReportProduct(context.Background())
+			result, err := svc.GetReportProduct(context.Background(), 0)
 
 			if tc.output.expectedError {
 				require.Error(t, err)
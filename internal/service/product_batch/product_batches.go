@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"math"
+	"time"
+
 	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
 )
 
@@ -16,21 +19,55 @@ func (s *productBatchesService) CreateProductBatches(ctx context.Context, proBa
 }
 
 // GetReportProductById retrieves a report for products in a section by its number.
-// Calls the repository to fetch the report for a specific section.
+// Calls the repository to fetch the report for a specific section and adds its fill percentage.
 func (s *productBatchesService) GetReportProductById(ctx context.Context, sectionNumber int) (*models.ReportProduct, error) {
 	reportProduct, err := s.r.GetReportProductById(ctx, sectionNumber)
 	if err != nil {
 		return nil, err
 	}
+	reportProduct.FillPercentage = fillPercentage(*reportProduct)
 	return reportProduct, nil
 }
 
-// GetReportProduct gets product report data for all sections.
-// Uses the repository to retrieve aggregated product batch information.
-func (s *productBatchesService) GetReportProduct(ctx context.Context) ([]models.ReportProduct, error) {
-	reportsProduct, err := s.r.GetReportProduct(ctx)
+// GetReportProduct gets product report data for all sections, or for the sections of one warehouse
+// when warehouseId is not zero, and adds the fill percentage of each section.
+func (s *productBatchesService) GetReportProduct(ctx context.Context, warehouseId int) ([]models.ReportProduct, error) {
+	reportsProduct, err := s.r.GetReportProduct(ctx, warehouseId)
 	if err != nil {
 		return nil, err
 	}
+	for i := range reportsProduct {
+		reportsProduct[i].FillPercentage = fillPercentage(reportsProduct[i])
+	}
 	return reportsProduct, nil
 }
+
+// fillPercentage returns the share of the section maximum capacity taken by available and held stock,
+// rounded to two decimals. Sections without a maximum capacity report zero.
+func fillPercentage(rp models.ReportProduct) float64 {
+	if rp.MaximumCapacity <= 0 {
+		return 0
+	}
+	used := float64(rp.TotalQuantity + rp.HeldQuantity)
+	return math.Round(used/float64(rp.MaximumCapacity)*10000) / 100
+}
+
+// GetExpiring lists the batches with stock left that are due within the given window from now,
+// including the ones already past their due date, which are flagged as expired.
+func (s *productBatchesService) GetExpiring(ctx context.Context, within time.Duration, warehouseId int) ([]models.ExpiringBatch, error) {
+	now := time.Now()
+	batches, err := s.r.GetExpiring(ctx, now.Add(within), warehouseId)
+	if err != nil {
+		return nil, err
+	}
+	for i := range batches {
+		batches[i].Expired = !batches[i].DueDate.After(now)
+	}
+	return batches, nil
+}
+
+// QuarantineExpired flags every available batch past its due date as quarantined
+// and returns the ids of the batches it changed.
+func (s *productBatchesService) QuarantineExpired(ctx context.Context) ([]int, error) {
+	return s.r.QuarantineExpired(ctx, time.Now())
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"time"
+
 	productBatchRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/product_batch"
 	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
 )
@@ -10,7 +12,9 @@ import (
 type ProductBatchesService interface {
 	CreateProductBatches(ctx context.Context, proBa models.ProductBatches) (*models.ProductBatches, error)
 	GetReportProductById(ctx context.Context, sectionNumber int) (*models.ReportProduct, error)
-	GetReportProduct(ctx context.Context) ([]models.ReportProduct, error)
+	GetReportProduct(ctx context.Context, warehouseId int) ([]models.ReportProduct, error)
+	GetExpiring(ctx context.Context, within time.Duration, warehouseId int) ([]models.ExpiringBatch, error)
+	QuarantineExpired(ctx context.Context) ([]int, error)
 }
 
 // productBatchesService implements ProductBatchesService using a repository.
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_record"
+)
+
+// GetMarginReport normalises the range to whole days and returns the revenue, cost and margin of the orders
+// placed in it, per group and overall. Amounts are rounded to cents and margin rates to four decimals.
+func (s *productRecordService) GetMarginReport(ctx context.Context, filter models.MarginReportFilter) (*models.MarginReport, error) {
+	filter.From = time.Date(filter.From.Year(), filter.From.Month(), filter.From.Day(), 0, 0, 0, 0, filter.From.Location())
+	filter.To = time.Date(filter.To.Year(), filter.To.Month(), filter.To.Day(), 0, 0, 0, 0, filter.To.Location())
+
+	lines, err := s.repo.GetMarginReport(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.MarginReport{
+		GroupBy: filter.GroupBy,
+		From:    filter.From.Format(time.DateOnly),
+		To:      filter.To.Format(time.DateOnly),
+		Lines:   make([]models.MarginReportLine, 0, len(lines)),
+	}
+	for _, l := range lines {
+		report.Revenue += l.Revenue
+		report.Cost += l.Cost
+		l.Revenue = roundCents(l.Revenue)
+		l.Cost = roundCents(l.Cost)
+		l.Margin = roundCents(l.Revenue - l.Cost)
+		l.MarginRate = marginRate(l.Margin, l.Revenue)
+		report.Lines = append(report.Lines, l)
+	}
+	report.Revenue = roundCents(report.Revenue)
+	report.Cost = roundCents(report.Cost)
+	report.Margin = roundCents(report.Revenue - report.Cost)
+	report.MarginRate = marginRate(report.Margin, report.Revenue)
+	return report, nil
+}
+
+// marginRate is the margin over the revenue, nil when there is no revenue to relate it to.
+func marginRate(margin, revenue float64) *float64 {
+	if revenue == 0 {
+		return nil
+	}
+	rate := math.Round(margin/revenue*10000) / 10000
+	return &rate
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,109 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/product_record"
+	productrecordmock "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/product_record"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_record"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func rate(v float64) *float64 { return &v }
+
+func TestProductRecordService_GetMarginReport(t *testing.T) {
+	t.Parallel()
+
+	// the service truncates the range to whole days before querying
+	filter := models.MarginReportFilter{
+		GroupBy: models.GroupByProduct,
+		From:    time.Date(2025, 1, 1, 15, 30, 0, 0, time.UTC),
+		To:      time.Date(2025, 3, 31, 8, 0, 0, 0, time.UTC),
+	}
+	normalised := models.MarginReportFilter{
+		GroupBy: models.GroupByProduct,
+		From:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:      time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name      string
+		mockSetup func(r *productrecordmock.MockProductRecordRepository)
+		want      *models.MarginReport
+		wantErr   string // expected AppError.Code ("" = no error)
+	}{
+		{
+			name: "margins_and_totals",
+			mockSetup: func(r *productrecordmock.MockProductRecordRepository) {
+				r.On("GetMarginReport", mock.Anything, normalised).Return([]models.MarginReportLine{
+					{Key: "2", Name: "Yogurt", Orders: 3, Units: 12, Revenue: 240.004, Cost: 150.001},
+					{Key: "1", Name: "Milk", Orders: 1, Units: 4, Revenue: 60, Cost: 75},
+					{Key: "3", Name: "Samples", Orders: 1, Units: 2, Revenue: 0, Cost: 5},
+				}, nil).Once()
+			},
+			want: &models.MarginReport{
+				GroupBy: models.GroupByProduct,
+				From:    "2025-01-01",
+				To:      "2025-03-31",
+				Lines: []models.MarginReportLine{
+					{Key: "2", Name: "Yogurt", Orders: 3, Units: 12, Revenue: 240, Cost: 150, Margin: 90, MarginRate: rate(0.375)},
+					{Key: "1", Name: "Milk", Orders: 1, Units: 4, Revenue: 60, Cost: 75, Margin: -15, MarginRate: rate(-0.25)},
+					{Key: "3", Name: "Samples", Orders: 1, Units: 2, Revenue: 0, Cost: 5, Margin: -5},
+				},
+				Revenue:    300,
+				Cost:       230,
+				Margin:     70,
+				MarginRate: rate(0.2333),
+			},
+		},
+		{
+			name: "no_orders",
+			mockSetup: func(r *productrecordmock.MockProductRecordRepository) {
+				r.On("GetMarginReport", mock.Anything, normalised).Return([]models.MarginReportLine{}, nil).Once()
+			},
+			want: &models.MarginReport{
+				GroupBy: models.GroupByProduct,
+				From:    "2025-01-01",
+				To:      "2025-03-31",
+				Lines:   []models.MarginReportLine{},
+			},
+		},
+		{
+			name: "repo_internal_error",
+			mockSetup: func(r *productrecordmock.MockProductRecordRepository) {
+				r.On("GetMarginReport", mock.Anything, normalised).
+					Return(nil, apperrors.NewAppError(apperrors.CodeInternal, "db")).Once()
+			},
+			wantErr: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc // capture range var
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoMock := &productrecordmock.MockProductRecordRepository{}
+			tc.mockSetup(repoMock)
+
+			svc := service.NewProductRecordService(repoMock)
+
+			report, err := svc.GetMarginReport(context.Background(), filter)
+
+			if tc.wantErr != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErr)
+				require.Nil(t, report)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.want, report)
+			}
+			repoMock.AssertExpectations(t)
+		})
+	}
+}
@@ -9,4 +9,5 @@ import (
 type ProductRecordService interface {
 	Create(ctx context.Context, record models.ProductRecord) (models.ProductRecordResponse, error)
 	GetRecordsReport(ctx context.Context, productID int) ([]models.ProductRecordReport, error)
+	GetMarginReport(ctx context.Context, filter models.MarginReportFilter) (*models.MarginReport, error)
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/purchase_order"
+	addressMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/buyer_address"
 	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/purchase_order"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
 	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
@@ -25,7 +26,7 @@ func TestPurchaseOrderService_GetReportByBuyer(t *testing.T) {
 				return expectedReport, nil
 			},
 		}
-		service := service.NewPurchaseOrderService(repoMock)
+		service := service.NewPurchaseOrderService(repoMock, &addressMocks.BuyerAddressRepositoryMock{})
 
 		buyerID := 101
 
@@ -51,7 +52,7 @@ func TestPurchaseOrderService_GetReportByBuyer(t *testing.T) {
 				return expectedReport, nil
 			},
 		}
-		service := service.NewPurchaseOrderService(repoMock)
+		service := service.NewPurchaseOrderService(repoMock, &addressMocks.BuyerAddressRepositoryMock{})
 
 		// Execute
 		result, err := service.GetReportByBuyer(context.Background(), nil)
@@ -70,7 +71,7 @@ func TestPurchaseOrderService_GetReportByBuyer(t *testing.T) {
 				return nil, errors.New("repository error")
 			},
 		}
-		service := service.NewPurchaseOrderService(repoMock)
+		service := service.NewPurchaseOrderService(repoMock, &addressMocks.BuyerAddressRepositoryMock{})
 
 		buyerID := 101
 
@@ -88,7 +89,7 @@ func TestPurchaseOrderService_GetReportByBuyer(t *testing.T) {
 				return nil, errors.New("repository error")
 			},
 		}
-		service := service.NewPurchaseOrderService(repoMock)
+		service := service.NewPurchaseOrderService(repoMock, &addressMocks.BuyerAddressRepositoryMock{})
 
 		// Execute
 		_, err := service.GetReportByBuyer(context.Background(), nil)
@@ -104,7 +105,7 @@ func TestPurchaseOrderService_GetReportByBuyer(t *testing.T) {
 				return nil, apperrors.NewAppError(apperrors.CodeNotFound, "buyer has no orders")
 			},
 		}
-		service := service.NewPurchaseOrderService(repoMock)
+		service := service.NewPurchaseOrderService(repoMock, &addressMocks.BuyerAddressRepositoryMock{})
 
 		buyerID := 999
 
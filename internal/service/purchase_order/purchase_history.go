@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+)
+
+// History normalises the range to whole days and returns the requested page of orders with their lines.
+// The totals cover every order in the range, not only the page. Amounts are rounded to cents.
+// Returns a not found error if the buyer does not exist.
+func (s *purchaseOrderService) History(ctx context.Context, filter models.PurchaseHistoryFilter) (*models.PurchaseHistory, error) {
+	if !s.repo.ExistsBuyer(ctx, filter.BuyerID) {
+		return nil, apperrors.NewAppError(apperrors.CodeNotFound, "buyer not found").WithDetail("buyer_id", filter.BuyerID)
+	}
+	filter.From = time.Date(filter.From.Year(), filter.From.Month(), filter.From.Day(), 0, 0, 0, 0, filter.From.Location())
+	filter.To = time.Date(filter.To.Year(), filter.To.Month(), filter.To.Day(), 0, 0, 0, 0, filter.To.Location())
+
+	totals, err := s.repo.FindPeriodTotals(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	orders, err := s.repo.FindBuyerOrders(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(orders))
+	byId := make(map[int]*models.PurchaseHistoryOrder, len(orders))
+	for i := range orders {
+		ids[i] = orders[i].ID
+		orders[i].Lines = []models.PurchaseHistoryLine{}
+		byId[orders[i].ID] = &orders[i]
+	}
+	lines, err := s.repo.FindOrderLines(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range lines {
+		order, ok := byId[l.PurchaseOrderID]
+		if !ok {
+			continue
+		}
+		l.LineTotal = roundCents(float64(l.Quantity) * l.UnitPrice)
+		order.Lines = append(order.Lines, l)
+		order.Total = roundCents(order.Total + l.LineTotal)
+	}
+
+	history := &models.PurchaseHistory{
+		BuyerID:  filter.BuyerID,
+		From:     filter.From.Format(time.DateOnly),
+		To:       filter.To.Format(time.DateOnly),
+		Page:     filter.Page,
+		PageSize: filter.PageSize,
+		Orders:   orders,
+		Totals:   totals,
+	}
+	for i := range totals {
+		totals[i].Amount = roundCents(totals[i].Amount)
+		history.TotalOrders += totals[i].Orders
+	}
+	history.TotalPages = (history.TotalOrders + filter.PageSize - 1) / filter.PageSize
+	return history, nil
+}
+
+func roundCents(v float64) float64 {
+	return math.Round(v*100) / 100
+}
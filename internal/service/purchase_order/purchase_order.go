@@ -2,20 +2,25 @@ package service
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/mappers"
+	buyerAddressRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/buyer_address"
 	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/purchase_order"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
 	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+	addressModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer_address"
 )
 
 type purchaseOrderService struct {
-	repo repository.PurchaseOrderRepository
+	repo      repository.PurchaseOrderRepository
+	addresses buyerAddressRepository.BuyerAddressRepository
 }
 
-func NewPurchaseOrderService(repo repository.PurchaseOrderRepository) PurchaseOrderService {
-	return &purchaseOrderService{repo: repo}
+// NewPurchaseOrderService uses addresses to look up the buyer address each order is delivered to.
+func NewPurchaseOrderService(repo repository.PurchaseOrderRepository, addresses buyerAddressRepository.BuyerAddressRepository) PurchaseOrderService {
+	return &purchaseOrderService{repo: repo, addresses: addresses}
 }
 
 func (s *purchaseOrderService) Create(ctx context.Context, req models.RequestPurchaseOrder) (*models.ResponsePurchaseOrder, error) {
@@ -34,6 +39,16 @@ func (s *purchaseOrderService) Create(ctx context.Context, req models.RequestPur
 		)
 	}
 
+	address, err := s.deliveryAddress(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if address != nil {
+		po.DeliveryAddressID = &address.Id
+		po.DeliveryAddress = &address.Address
+		po.DeliveryLocalityID = &address.LocalityId
+	}
+
 	createdPO, err := s.repo.Create(ctx, po)
 	if err != nil {
 		return nil, err
@@ -43,6 +58,25 @@ func (s *purchaseOrderService) Create(ctx context.Context, req models.RequestPur
 	return &response, nil
 }
 
+// deliveryAddress returns the buyer address named by the request, or the default address of the
+// buyer when none is named. It returns nil when the buyer has no address, leaving the order
+// without a delivery snapshot.
+func (s *purchaseOrderService) deliveryAddress(ctx context.Context, req models.RequestPurchaseOrder) (*addressModels.Address, error) {
+	if req.DeliveryAddressID != nil {
+		return s.addresses.FindById(ctx, req.BuyerID, *req.DeliveryAddressID)
+	}
+
+	address, err := s.addresses.FindDefault(ctx, req.BuyerID)
+	if err != nil {
+		var appErr *apperrors.AppError
+		if errors.As(err, &appErr) && appErr.Code == apperrors.CodeNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return address, nil
+}
+
 func (s *purchaseOrderService) GetAll(ctx context.Context) ([]models.ResponsePurchaseOrder, error) {
 	pos, err := s.repo.GetAll(ctx)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/purchase_order"
+	addressMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/buyer_address"
 	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/purchase_order"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
 	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
@@ -24,7 +25,7 @@ func TestPurchaseOrderService_Create(t *testing.T) {
 				return false
 			},
 		}
-		service := service.NewPurchaseOrderService(repoMock)
+		service := service.NewPurchaseOrderService(repoMock, &addressMocks.BuyerAddressRepositoryMock{})
 
 		req := models.RequestPurchaseOrder{
 			OrderNumber:     "PO-001",
@@ -54,7 +55,7 @@ func TestPurchaseOrderService_Create(t *testing.T) {
 				return nil, apperrors.NewAppError(apperrors.CodeConflict, "order number already exists")
 			},
 		}
-		service := service.NewPurchaseOrderService(repoMock)
+		service := service.NewPurchaseOrderService(repoMock, &addressMocks.BuyerAddressRepositoryMock{})
 
 		req := models.RequestPurchaseOrder{
 			OrderNumber:     "PO-001",
@@ -77,7 +78,7 @@ func TestPurchaseOrderService_Create(t *testing.T) {
 	t.Run("Fail when date is invalid", func(t *testing.T) {
 		// Setup
 		repoMock := &mocks.PurchaseOrderRepositoryMock{}
-		service := service.NewPurchaseOrderService(repoMock)
+		service := service.NewPurchaseOrderService(repoMock, &addressMocks.BuyerAddressRepositoryMock{})
 
 		req := models.RequestPurchaseOrder{
 			OrderNumber:     "PO-001",
@@ -100,7 +101,7 @@ func TestPurchaseOrderService_Create(t *testing.T) {
 	t.Run("Fail when date is in the future", func(t *testing.T) {
 		// Setup
 		repoMock := &mocks.PurchaseOrderRepositoryMock{}
-		service := service.NewPurchaseOrderService(repoMock)
+		service := service.NewPurchaseOrderService(repoMock, &addressMocks.BuyerAddressRepositoryMock{})
 
 		futureDate := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
 		req := models.RequestPurchaseOrder{
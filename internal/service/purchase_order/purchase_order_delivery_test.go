@@ -0,0 +1,109 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/purchase_order"
+	addressMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/buyer_address"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/purchase_order"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+	addressModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer_address"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestPurchaseOrderService_CreateDeliverySnapshot(t *testing.T) {
+	home := addressModels.Address{Id: 7, BuyerId: 101, Address: "Av. Corrientes 1234", LocalityId: "1001", IsDefault: true}
+	office := addressModels.Address{Id: 8, BuyerId: 101, Address: "Bv. Oroño 500", LocalityId: "2000"}
+
+	tests := []struct {
+		name        string
+		addressId   *int
+		findDefault func(ctx context.Context, buyerId int) (*addressModels.Address, error)
+		findById    func(ctx context.Context, buyerId int, id int) (*addressModels.Address, error)
+		want        *addressModels.Address
+		wantCode    string
+	}{
+		{
+			name: "default address when none is named",
+			findDefault: func(ctx context.Context, buyerId int) (*addressModels.Address, error) {
+				return &home, nil
+			},
+			want: &home,
+		},
+		{
+			name:      "named address",
+			addressId: testhelpers.IntPtr(8),
+			findById: func(ctx context.Context, buyerId int, id int) (*addressModels.Address, error) {
+				return &office, nil
+			},
+			want: &office,
+		},
+		{
+			name: "no snapshot when the buyer has no address",
+			findDefault: func(ctx context.Context, buyerId int) (*addressModels.Address, error) {
+				return nil, apperrors.NewAppError(apperrors.CodeNotFound, "buyer has no default address")
+			},
+		},
+		{
+			name:      "named address of another buyer",
+			addressId: testhelpers.IntPtr(9),
+			findById: func(ctx context.Context, buyerId int, id int) (*addressModels.Address, error) {
+				return nil, apperrors.NewAppError(apperrors.CodeNotFound, "buyer address not found")
+			},
+			wantCode: apperrors.CodeNotFound,
+		},
+		{
+			name: "default lookup fails",
+			findDefault: func(ctx context.Context, buyerId int) (*addressModels.Address, error) {
+				return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying buyer address")
+			},
+			wantCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created *models.PurchaseOrder
+			repoMock := &mocks.PurchaseOrderRepositoryMock{
+				FuncCreate: func(ctx context.Context, po models.PurchaseOrder) (*models.PurchaseOrder, error) {
+					created = &po
+					return &po, nil
+				},
+			}
+			addressMock := &addressMocks.BuyerAddressRepositoryMock{
+				FuncFindDefault: tt.findDefault,
+				FuncFindById:    tt.findById,
+			}
+			svc := service.NewPurchaseOrderService(repoMock, addressMock)
+
+			result, err := svc.Create(context.Background(), models.RequestPurchaseOrder{
+				OrderNumber:       "PO-001",
+				OrderDate:         "2023-01-01",
+				TrackingCode:      "TRACK001",
+				BuyerID:           101,
+				ProductRecordID:   201,
+				DeliveryAddressID: tt.addressId,
+			})
+
+			if tt.wantCode != "" {
+				testhelpers.RequireAppErr(t, err, tt.wantCode)
+				assert.Nil(t, created)
+				return
+			}
+			require.NoError(t, err)
+			if tt.want == nil {
+				assert.Nil(t, result.DeliveryAddressID)
+				assert.Nil(t, result.DeliveryAddress)
+				assert.Nil(t, result.DeliveryLocalityID)
+				return
+			}
+			assert.Equal(t, tt.want.Id, *result.DeliveryAddressID)
+			assert.Equal(t, tt.want.Address, *result.DeliveryAddress)
+			assert.Equal(t, tt.want.LocalityId, *created.DeliveryLocalityID)
+		})
+	}
+}
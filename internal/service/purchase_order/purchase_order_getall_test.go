@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/purchase_order"
+	addressMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/buyer_address"
 	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/purchase_order"
 	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
@@ -25,7 +26,7 @@ func TestPurchaseOrderService_GetAll(t *testing.T) {
 				return expectedPOs, nil
 			},
 		}
-		service := service.NewPurchaseOrderService(repoMock)
+		service := service.NewPurchaseOrderService(repoMock, &addressMocks.BuyerAddressRepositoryMock{})
 
 		// Execute
 		result, err := service.GetAll(context.Background())
@@ -44,7 +45,7 @@ func TestPurchaseOrderService_GetAll(t *testing.T) {
 				return []models.PurchaseOrder{}, nil
 			},
 		}
-		service := service.NewPurchaseOrderService(repoMock)
+		service := service.NewPurchaseOrderService(repoMock, &addressMocks.BuyerAddressRepositoryMock{})
 
 		// Execute
 		result, err := service.GetAll(context.Background())
@@ -61,7 +62,7 @@ func TestPurchaseOrderService_GetAll(t *testing.T) {
 				return nil, errors.New("repository error")
 			},
 		}
-		service := service.NewPurchaseOrderService(repoMock)
+		service := service.NewPurchaseOrderService(repoMock, &addressMocks.BuyerAddressRepositoryMock{})
 
 		// Execute
 		_, err := service.GetAll(context.Background())
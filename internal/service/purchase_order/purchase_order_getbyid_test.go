@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/purchase_order"
+	addressMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/buyer_address"
 	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/purchase_order"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
 	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
@@ -23,7 +24,7 @@ func TestPurchaseOrderService_GetByID(t *testing.T) {
 				return &expectedPO, nil
 			},
 		}
-		service := service.NewPurchaseOrderService(repoMock)
+		service := service.NewPurchaseOrderService(repoMock, &addressMocks.BuyerAddressRepositoryMock{})
 
 		// Execute
 		result, err := service.GetByID(context.Background(), 1)
@@ -41,7 +42,7 @@ func TestPurchaseOrderService_GetByID(t *testing.T) {
 				return nil, apperrors.NewAppError(apperrors.CodeNotFound, "purchase order not found")
 			},
 		}
-		service := service.NewPurchaseOrderService(repoMock)
+		service := service.NewPurchaseOrderService(repoMock, &addressMocks.BuyerAddressRepositoryMock{})
 
 		// Execute
 		_, err := service.GetByID(context.Background(), 999)
@@ -60,7 +61,7 @@ func TestPurchaseOrderService_GetByID(t *testing.T) {
 				return nil, errors.New("repository error")
 			},
 		}
-		service := service.NewPurchaseOrderService(repoMock)
+		service := service.NewPurchaseOrderService(repoMock, &addressMocks.BuyerAddressRepositoryMock{})
 
 		// Execute
 		_, err := service.GetByID(context.Background(), 1)
@@ -0,0 +1,87 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/purchase_order"
+	addressMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/buyer_address"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/purchase_order"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestPurchaseOrderService_History(t *testing.T) {
+	filter := models.PurchaseHistoryFilter{
+		BuyerID:  101,
+		From:     time.Date(2025, 5, 1, 15, 30, 0, 0, time.UTC),
+		To:       time.Date(2025, 6, 30, 23, 59, 0, 0, time.UTC),
+		Period:   models.PeriodMonth,
+		Page:     1,
+		PageSize: 2,
+	}
+
+	t.Run("success - lines priced and totals over the whole range", func(t *testing.T) {
+		var asked models.PurchaseHistoryFilter
+		rp := &mocks.PurchaseOrderRepositoryMock{
+			FuncExistsBuyer: func(ctx context.Context, buyerID int) bool {
+				return true
+			},
+			FuncFindPeriodTotals: func(ctx context.Context, f models.PurchaseHistoryFilter) ([]models.PeriodTotal, error) {
+				return []models.PeriodTotal{
+					{Period: "2025-05", Orders: 1, Items: 2, Amount: 3.3333},
+					{Period: "2025-06", Orders: 2, Items: 4, Amount: 8.7},
+				}, nil
+			},
+			FuncFindBuyerOrders: func(ctx context.Context, f models.PurchaseHistoryFilter) ([]models.PurchaseHistoryOrder, error) {
+				asked = f
+				return []models.PurchaseHistoryOrder{{ID: 11, OrderNumber: "PO-011"}, {ID: 10, OrderNumber: "PO-010"}}, nil
+			},
+			FuncFindOrderLines: func(ctx context.Context, orderIDs []int) ([]models.PurchaseHistoryLine, error) {
+				require.Equal(t, []int{11, 10}, orderIDs)
+				return []models.PurchaseHistoryLine{
+					{OrderDetailID: 1, PurchaseOrderID: 11, Quantity: 3, UnitPrice: 2.5},
+					{OrderDetailID: 2, PurchaseOrderID: 11, Quantity: 1, UnitPrice: 1.2},
+				}, nil
+			},
+		}
+		svc := service.NewPurchaseOrderService(rp, &addressMocks.BuyerAddressRepositoryMock{})
+
+		history, err := svc.History(context.Background(), filter)
+
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), asked.From)
+		require.Equal(t, time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC), asked.To)
+		require.Equal(t, "2025-05-01", history.From)
+		require.Equal(t, "2025-06-30", history.To)
+		require.Equal(t, 3, history.TotalOrders)
+		require.Equal(t, 2, history.TotalPages)
+		require.Equal(t, 3.33, history.Totals[0].Amount)
+
+		require.Len(t, history.Orders, 2)
+		require.Len(t, history.Orders[0].Lines, 2)
+		require.Equal(t, 7.5, history.Orders[0].Lines[0].LineTotal)
+		require.Equal(t, 8.7, history.Orders[0].Total)
+		require.Empty(t, history.Orders[1].Lines)
+		require.NotNil(t, history.Orders[1].Lines)
+		require.Zero(t, history.Orders[1].Total)
+	})
+
+	t.Run("error - buyer missing", func(t *testing.T) {
+		rp := &mocks.PurchaseOrderRepositoryMock{
+			FuncFindBuyerOrders: func(ctx context.Context, f models.PurchaseHistoryFilter) ([]models.PurchaseHistoryOrder, error) {
+				t.Fatal("orders listed for a missing buyer")
+				return nil, nil
+			},
+		}
+		svc := service.NewPurchaseOrderService(rp, &addressMocks.BuyerAddressRepositoryMock{})
+
+		history, err := svc.History(context.Background(), filter)
+
+		testhelpers.RequireAppErr(t, err, apperrors.CodeNotFound)
+		require.Nil(t, history)
+	})
+}
@@ -20,4 +20,7 @@ type PurchaseOrderService interface {
 	// GetReportByBuyer genera el reporte de Purchase Orders por Buyer
 	// Si buyerID es nil, devuelve el reporte para todos los buyers
 	GetReportByBuyer(ctx context.Context, buyerID *int) ([]models.BuyerWithPurchaseCount, error)
+
+	// History devuelve una página de las Purchase Orders de un Buyer con sus líneas y los totales por período
+	History(ctx context.Context, filter models.PurchaseHistoryFilter) (*models.PurchaseHistory, error)
 }
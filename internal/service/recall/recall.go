@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	statusModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/batch_status"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/recall"
+)
+
+// Create resolves the recalled batches, places the available ones on hold with the recall reason code
+// and records the recall in one transaction. Batches transferred out of a recalled batch, whole or split,
+// are recalled with it. Batches already on hold, quarantined or disposed keep their status but are still
+// part of the recall. The buyers to notify are traced once the recall is committed.
+func (s *recallService) Create(ctx context.Context, req models.PostRecall) (*models.Recall, error) {
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	ids := uniqueSorted(req.ProductBatchIds)
+	if req.ProductId != nil {
+		ids, err = s.rp.FindProductBatchIds(ctx, tx, *req.ProductId)
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) == 0 {
+			err = apperrors.NewAppError(apperrors.CodeNotFound, "product has no batches to recall").
+				WithDetail("product_id", *req.ProductId)
+			return nil, err
+		}
+	}
+
+	lineage, err := s.rp.FindBatchLineage(ctx, tx, ids)
+	if err != nil {
+		return nil, err
+	}
+	batches, err := s.rp.FindBatchesForUpdate(ctx, tx, lineage)
+	if err != nil {
+		return nil, err
+	}
+	if missing := missingIds(ids, batches); len(missing) > 0 {
+		err = apperrors.NewAppError(apperrors.CodeNotFound, "product batches not found").
+			WithDetail("product_batch_ids", missing)
+		return nil, err
+	}
+
+	recall, err := s.rp.CreateRecall(ctx, tx, models.Recall{
+		Note:      req.Note,
+		Actor:     req.Actor,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range batches {
+		if batches[i].PreviousStatus == batchModels.BatchStatusAvailable {
+			_, err = s.status.ApplyStatus(ctx, tx, batches[i].ProductBatchId, batchModels.BatchStatusOnHold, statusModels.PostStatusChange{
+				ReasonCode: statusModels.ReasonRecall,
+				Note:       req.Note,
+				Actor:      req.Actor,
+			})
+			if err != nil {
+				return nil, err
+			}
+			batches[i].Held = true
+		}
+		if err = s.rp.CreateRecallBatch(ctx, tx, recall.Id, batches[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+
+	recall.Batches = batches
+	recall.Buyers, err = s.trace.AffectedBuyers(ctx, lineage)
+	if err != nil {
+		return nil, err
+	}
+	return recall, nil
+}
+
+// FindById returns the recall with its batches. Buyers are traced again on every call,
+// so orders linked to the batches after the recall started are included.
+func (s *recallService) FindById(ctx context.Context, id int) (*models.Recall, error) {
+	recall, err := s.rp.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	recall.Batches, err = s.rp.FindBatches(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int, len(recall.Batches))
+	for i, b := range recall.Batches {
+		ids[i] = b.ProductBatchId
+	}
+	recall.Buyers, err = s.trace.AffectedBuyers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return recall, nil
+}
+
+// uniqueSorted returns the ids without duplicates in ascending order, the order batches are locked in.
+func uniqueSorted(ids []int) []int {
+	seen := make(map[int]bool, len(ids))
+	out := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	sort.Ints(out)
+	return out
+}
+
+// missingIds returns the requested ids that have no matching batch.
+func missingIds(ids []int, batches []models.RecallBatch) []int {
+	found := make(map[int]bool, len(batches))
+	for _, b := range batches {
+		found[b.ProductBatchId] = true
+	}
+	missing := make([]int, 0)
+	for _, id := range ids {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/recall"
+	batchStatusService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/batch_status"
+	traceabilityService "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/traceability"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/recall"
+)
+
+// RecallService runs product recalls.
+type RecallService interface {
+	// Create holds every affected batch and returns the recall with the buyers to notify.
+	Create(ctx context.Context, req models.PostRecall) (*models.Recall, error)
+
+	// FindById returns a recall with its batches and the buyers to notify.
+	FindById(ctx context.Context, id int) (*models.Recall, error)
+}
+
+// recallService implements RecallService using a repository, the batch status service and traceability.
+type recallService struct {
+	rp     repository.RecallRepository
+	status batchStatusService.BatchStatusService
+	trace  traceabilityService.TraceabilityService
+}
+
+// NewRecallService creates a new RecallService using the provided repository and services.
+func NewRecallService(rp repository.RecallRepository, status batchStatusService.BatchStatusService, trace traceabilityService.TraceabilityService) RecallService {
+	return &recallService{
+		rp:     rp,
+		status: status,
+		trace:  trace,
+	}
+}
@@ -0,0 +1,173 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	batchStatusRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/batch_status"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/recall"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/recall"
+	statusMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/batch_status"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/recall"
+	traceMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/traceability"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	statusModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/batch_status"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/recall"
+	traceModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/traceability"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestRecallService_Create(t *testing.T) {
+	type recorded struct {
+		held       []int
+		saved      []models.RecallBatch
+		locked     []int
+		traced     []int
+		rolledBack bool
+	}
+	statuses := map[int]string{
+		1: batchModels.BatchStatusAvailable,
+		2: batchModels.BatchStatusQuarantined,
+		3: batchModels.BatchStatusAvailable,
+		4: batchModels.BatchStatusAvailable,
+	}
+
+	testCases := []struct {
+		name         string
+		req          models.PostRecall
+		productBatch []int
+		transferred  []int
+		applyErr     error
+		wantErrCode  string
+		check        func(t *testing.T, res *models.Recall, rec recorded)
+	}{
+		{
+			name: "success - available batches held, others kept",
+			req:  models.PostRecall{ProductBatchIds: []int{2, 1, 2}, Note: "listeria", Actor: "qa.lead"},
+			check: func(t *testing.T, res *models.Recall, rec recorded) {
+				require.Equal(t, []int{1, 2}, rec.locked)
+				require.Equal(t, []int{1}, rec.held)
+				require.Len(t, rec.saved, 2)
+				require.True(t, rec.saved[0].Held)
+				require.False(t, rec.saved[1].Held)
+				require.Equal(t, batchModels.BatchStatusQuarantined, rec.saved[1].PreviousStatus)
+				require.Equal(t, []int{1, 2}, rec.traced)
+				require.Len(t, res.Buyers, 1)
+			},
+		},
+		{
+			name:        "success - batches split off a recalled batch are recalled too",
+			req:         models.PostRecall{ProductBatchIds: []int{1}, Note: "listeria", Actor: "qa.lead"},
+			transferred: []int{4},
+			check: func(t *testing.T, res *models.Recall, rec recorded) {
+				require.Equal(t, []int{1, 4}, rec.locked)
+				require.Equal(t, []int{1, 4}, rec.held)
+				require.Equal(t, []int{1, 4}, rec.traced)
+			},
+		},
+		{
+			name:         "success - every batch of a product",
+			req:          models.PostRecall{ProductId: testhelpers.IntPtr(22), Actor: "qa.lead"},
+			productBatch: []int{1, 3},
+			check: func(t *testing.T, res *models.Recall, rec recorded) {
+				require.Equal(t, []int{1, 3}, rec.held)
+				require.Len(t, res.Batches, 2)
+			},
+		},
+		{
+			name:         "error - product without batches",
+			req:          models.PostRecall{ProductId: testhelpers.IntPtr(22), Actor: "qa.lead"},
+			productBatch: []int{},
+			wantErrCode:  apperrors.CodeNotFound,
+		},
+		{
+			name:        "error - unknown batch",
+			req:         models.PostRecall{ProductBatchIds: []int{1, 99}, Actor: "qa.lead"},
+			wantErrCode: apperrors.CodeNotFound,
+			check: func(t *testing.T, res *models.Recall, rec recorded) {
+				require.True(t, rec.rolledBack)
+				require.Empty(t, rec.held)
+			},
+		},
+		{
+			name:        "error - hold fails",
+			req:         models.PostRecall{ProductBatchIds: []int{1}, Actor: "qa.lead"},
+			applyErr:    apperrors.NewAppError(apperrors.CodeInternal, "error updating product batch status"),
+			wantErrCode: apperrors.CodeInternal,
+			check: func(t *testing.T, res *models.Recall, rec recorded) {
+				require.True(t, rec.rolledBack)
+				require.Nil(t, rec.traced)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rec recorded
+			rp := &mocks.RecallRepositoryMock{
+				FuncFindProductBatchIds: func(ctx context.Context, exec repository.Executor, productId int) ([]int, error) {
+					return tc.productBatch, nil
+				},
+				FuncFindBatchLineage: func(ctx context.Context, exec repository.Executor, batchIds []int) ([]int, error) {
+					return append(append([]int{}, batchIds...), tc.transferred...), nil
+				},
+				FuncFindBatchesForUpdate: func(ctx context.Context, exec repository.Executor, batchIds []int) ([]models.RecallBatch, error) {
+					rec.locked = batchIds
+					batches := make([]models.RecallBatch, 0)
+					for _, id := range batchIds {
+						if status, ok := statuses[id]; ok {
+							batches = append(batches, models.RecallBatch{ProductBatchId: id, BatchNumber: 100 + id, PreviousStatus: status})
+						}
+					}
+					return batches, nil
+				},
+				FuncCreateRecall: func(ctx context.Context, exec repository.Executor, r models.Recall) (*models.Recall, error) {
+					r.Id = 1
+					return &r, nil
+				},
+				FuncCreateRecallBatch: func(ctx context.Context, exec repository.Executor, recallId int, b models.RecallBatch) error {
+					rec.saved = append(rec.saved, b)
+					return nil
+				},
+				FuncRollbackTx: func(tx *sql.Tx) error {
+					rec.rolledBack = true
+					return nil
+				},
+			}
+			status := &statusMocks.BatchStatusServiceMock{
+				FuncApplyStatus: func(ctx context.Context, exec batchStatusRepository.Executor, batchId int, to string, req statusModels.PostStatusChange) (*statusModels.StatusChange, error) {
+					if tc.applyErr != nil {
+						return nil, tc.applyErr
+					}
+					require.Equal(t, batchModels.BatchStatusOnHold, to)
+					require.Equal(t, statusModels.ReasonRecall, req.ReasonCode)
+					rec.held = append(rec.held, batchId)
+					return &statusModels.StatusChange{ProductBatchId: batchId, ToStatus: to}, nil
+				},
+			}
+			trace := &traceMocks.TraceabilityServiceMock{
+				FuncAffectedBuyers: func(ctx context.Context, batchIds []int) ([]traceModels.AffectedBuyer, error) {
+					rec.traced = batchIds
+					return []traceModels.AffectedBuyer{{BuyerId: 10, PurchaseOrderIds: []int{1}, ProductBatchIds: []int{1}}}, nil
+				},
+			}
+			sv := service.NewRecallService(rp, status, trace)
+
+			res, err := sv.Create(context.Background(), tc.req)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, 1, res.Id)
+			}
+			if tc.check != nil {
+				tc.check(t, res, rec)
+			}
+		})
+	}
+}
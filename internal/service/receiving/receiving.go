@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/mappers"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	asnModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/asn"
+	inboundOrderModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inbound_order"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/receiving"
+	movementModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+)
+
+// Receive stores a new batch in the requested section and records the inbound order in one transaction.
+// The employee must work at the receiving warehouse or hold an exception for it, and the section must
+// belong to the warehouse and have room for the received quantity. The batch is created empty and its
+// stock posted as a receipt movement, and the section current_capacity grows by the same quantity.
+// When the batch arrived with an expected delivery, its quantity is added to the ASN. Any failure
+// rolls everything back.
+func (s *receivingService) Receive(ctx context.Context, req models.PostReceipt) (*models.Receipt, error) {
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	exists, err := s.rp.ExistsOrderNumber(ctx, tx, req.OrderNumber)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		err = apperrors.NewAppError(apperrors.CodeConflict, "order_number already exists").
+			WithDetail("order_number", req.OrderNumber)
+		return nil, err
+	}
+
+	employeeWarehouseId, err := s.rp.FindEmployeeWarehouse(ctx, tx, req.EmployeeId)
+	if err != nil {
+		return nil, err
+	}
+	hasException := false
+	if employeeWarehouseId != req.WarehouseId {
+		hasException, err = s.exceptions.Exists(ctx, req.EmployeeId, req.WarehouseId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err = validators.ValidateEmployeeWarehouse(req.EmployeeId, employeeWarehouseId, req.WarehouseId, hasException); err != nil {
+		return nil, err
+	}
+
+	batch := mappers.RequestToProductBatch(req.ProductBatch)
+	section, err := s.rp.FindSectionForUpdate(ctx, tx, batch.SectionId)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateSection(section, req.WarehouseId, batch.CurrentQuantity); err != nil {
+		return nil, err
+	}
+
+	batch.Id, err = s.rp.CreateBatch(ctx, tx, batch)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.ledger.Append(ctx, tx, movementModels.StockMovement{
+		ProductBatchId: batch.Id,
+		MovementType:   movementModels.MovementTypeReceipt,
+		Quantity:       batch.CurrentQuantity,
+		Reason:         fmt.Sprintf("inbound order %s", req.OrderNumber),
+		Actor:          fmt.Sprintf("employee %d", req.EmployeeId),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = s.rp.AddSectionCapacity(ctx, tx, section.Id, batch.CurrentQuantity); err != nil {
+		return nil, err
+	}
+	if req.AsnId != nil {
+		if err = s.recordAsnReceipt(ctx, tx, *req.AsnId, req.WarehouseId, batch.ProductId, batch.CurrentQuantity); err != nil {
+			return nil, err
+		}
+	}
+
+	order, err := s.rp.CreateInboundOrder(ctx, tx, inboundOrderModels.InboundOrder{
+		OrderDate:      req.OrderDate,
+		OrderNumber:    req.OrderNumber,
+		EmployeeID:     req.EmployeeId,
+		ProductBatchID: batch.Id,
+		WarehouseID:    req.WarehouseId,
+		AsnID:          req.AsnId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+
+	return &models.Receipt{
+		InboundOrder: *order,
+		ProductBatch: mappers.ProductBatchesToResponse(batch),
+	}, nil
+}
+
+// recordAsnReceipt adds a received batch to the ASN it arrived with. The ASN must be arriving or
+// already receiving and be expected at the receiving warehouse; the first receipt moves it to receiving.
+// Products the ASN did not announce get a line of their own and show up as overages; products of
+// another seller are rejected with a validation error.
+func (s *receivingService) recordAsnReceipt(ctx context.Context, tx *sql.Tx, asnId, warehouseId, productId, quantity int) error {
+	asn, err := s.asns.FindForUpdate(ctx, tx, asnId)
+	if err != nil {
+		return err
+	}
+	if asn.Status != asnModels.StatusArriving && asn.Status != asnModels.StatusReceiving {
+		return apperrors.NewAppError(apperrors.CodeConflict, "asn is not open for receiving").
+			WithDetail("asn_id", asnId).
+			WithDetail("status", asn.Status)
+	}
+	if asn.WarehouseId != warehouseId {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "asn is expected at another warehouse").
+			WithDetail("asn_id", asnId).
+			WithDetail("asn_warehouse_id", asn.WarehouseId).
+			WithDetail("warehouse_id", warehouseId)
+	}
+	if err := s.asns.AddReceived(ctx, tx, asnId, asn.SellerId, productId, quantity); err != nil {
+		return err
+	}
+	if asn.Status == asnModels.StatusArriving {
+		asn.Status = asnModels.StatusReceiving
+		return s.asns.UpdateStatus(ctx, tx, *asn)
+	}
+	return nil
+}
+
+// validateSection checks that the section belongs to the receiving warehouse and has room for quantity more units.
+func validateSection(section *models.ReceivingSection, warehouseId int, quantity int) error {
+	if section.WarehouseId != warehouseId {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "section does not belong to the receiving warehouse").
+			WithDetail("section_id", section.Id).
+			WithDetail("section_warehouse_id", section.WarehouseId).
+			WithDetail("warehouse_id", warehouseId)
+	}
+	if section.CurrentCapacity+quantity > section.MaximumCapacity {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "section does not have enough capacity").
+			WithDetail("section_id", section.Id).
+			WithDetail("available_capacity", section.MaximumCapacity-section.CurrentCapacity).
+			WithDetail("requested", quantity)
+	}
+	return nil
+}
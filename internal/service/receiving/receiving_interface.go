@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+
+	asnRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/asn"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/receiving"
+	stockMovementRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	exceptionRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/warehouse_exception"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/receiving"
+)
+
+// ReceivingService receives product batches into warehouses.
+type ReceivingService interface {
+	// Receive creates a product batch and the inbound order recording its arrival, atomically.
+	Receive(ctx context.Context, req models.PostReceipt) (*models.Receipt, error)
+}
+
+// receivingService implements ReceivingService using a repository, the stock ledger,
+// the employee cross-warehouse exception list and the expected deliveries.
+type receivingService struct {
+	rp         repository.ReceivingRepository
+	ledger     stockMovementRepository.StockMovementRepository
+	exceptions exceptionRepository.WarehouseExceptionRepository
+	asns       asnRepository.AsnRepository
+}
+
+// NewReceivingService creates a new ReceivingService using the provided repositories.
+func NewReceivingService(rp repository.ReceivingRepository, ledger stockMovementRepository.StockMovementRepository,
+	exceptions exceptionRepository.WarehouseExceptionRepository, asns asnRepository.AsnRepository) ReceivingService {
+	return &receivingService{
+		rp:         rp,
+		ledger:     ledger,
+		exceptions: exceptions,
+		asns:       asns,
+	}
+}
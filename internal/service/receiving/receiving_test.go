@@ -0,0 +1,222 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	asnRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/asn"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/receiving"
+	stockMovementRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/receiving"
+	asnMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/asn"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/receiving"
+	movementMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/stock_movement"
+	exceptionMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/warehouse_exception"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	asnModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/asn"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/receiving"
+	movementModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestReceivingService_Receive(t *testing.T) {
+	testCases := []struct {
+		name              string
+		orderExists       bool
+		employeeWarehouse int
+		hasException      bool
+		section           models.ReceivingSection
+		createBatchErr    error
+		asn               *asnModels.Asn
+		addReceivedErr    error
+		wantErrCode       string
+		wantDetails       map[string]interface{}
+	}{
+		{
+			name:              "success - batch received and order recorded",
+			employeeWarehouse: 1,
+			section:           testhelpers.DummyReceivingSection(1, 40, 100),
+		},
+		{
+			name:              "error - order number taken",
+			orderExists:       true,
+			employeeWarehouse: 1,
+			section:           testhelpers.DummyReceivingSection(1, 40, 100),
+			wantErrCode:       apperrors.CodeConflict,
+		},
+		{
+			name:              "error - employee works at another warehouse",
+			employeeWarehouse: 2,
+			section:           testhelpers.DummyReceivingSection(1, 40, 100),
+			wantErrCode:       apperrors.CodeValidationError,
+			wantDetails:       map[string]interface{}{"employee_id": 4, "employee_warehouse_id": 2, "warehouse_id": 1},
+		},
+		{
+			name:              "success - roaming employee with exception",
+			employeeWarehouse: 2,
+			hasException:      true,
+			section:           testhelpers.DummyReceivingSection(1, 40, 100),
+		},
+		{
+			name:              "error - section in another warehouse",
+			employeeWarehouse: 1,
+			section:           testhelpers.DummyReceivingSection(2, 40, 100),
+			wantErrCode:       apperrors.CodeValidationError,
+		},
+		{
+			name:              "error - section without room",
+			employeeWarehouse: 1,
+			section:           testhelpers.DummyReceivingSection(1, 60, 100),
+			wantErrCode:       apperrors.CodeValidationError,
+		},
+		{
+			name:              "error - batch number taken",
+			employeeWarehouse: 1,
+			section:           testhelpers.DummyReceivingSection(1, 40, 100),
+			createBatchErr:    apperrors.NewAppError(apperrors.CodeConflict, "batch number already exists"),
+			wantErrCode:       apperrors.CodeConflict,
+		},
+		{
+			name:              "success - batch received against an arriving asn",
+			employeeWarehouse: 1,
+			section:           testhelpers.DummyReceivingSection(1, 40, 100),
+			asn:               testhelpers.Ptr(testhelpers.DummyAsn(asnModels.StatusArriving)),
+		},
+		{
+			name:              "error - asn not arrived yet",
+			employeeWarehouse: 1,
+			section:           testhelpers.DummyReceivingSection(1, 40, 100),
+			asn:               testhelpers.Ptr(testhelpers.DummyAsn(asnModels.StatusScheduled)),
+			wantErrCode:       apperrors.CodeConflict,
+		},
+		{
+			name:              "error - asn expected at another warehouse",
+			employeeWarehouse: 1,
+			section:           testhelpers.DummyReceivingSection(1, 40, 100),
+			asn: func() *asnModels.Asn {
+				a := testhelpers.DummyAsn(asnModels.StatusReceiving)
+				a.WarehouseId = 2
+				return &a
+			}(),
+			wantErrCode: apperrors.CodeValidationError,
+		},
+		{
+			name:              "error - product of another seller received against the asn",
+			employeeWarehouse: 1,
+			section:           testhelpers.DummyReceivingSection(1, 40, 100),
+			asn:               testhelpers.Ptr(testhelpers.DummyAsn(asnModels.StatusReceiving)),
+			addReceivedErr:    apperrors.NewAppError(apperrors.CodeValidationError, "product not found for the seller"),
+			wantErrCode:       apperrors.CodeValidationError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rolledBack, committed bool
+			var capacityAdded int
+			var movements []movementModels.StockMovement
+			var received []int
+			var asnStatus string
+			rp := &mocks.ReceivingRepositoryMock{
+				FuncExistsOrderNumber: func(ctx context.Context, exec repository.Executor, orderNumber string) (bool, error) {
+					return tc.orderExists, nil
+				},
+				FuncFindEmployeeWarehouse: func(ctx context.Context, exec repository.Executor, employeeId int) (int, error) {
+					return tc.employeeWarehouse, nil
+				},
+				FuncFindSectionForUpdate: func(ctx context.Context, exec repository.Executor, sectionId int) (*models.ReceivingSection, error) {
+					s := tc.section
+					return &s, nil
+				},
+				FuncCreateBatch: func(ctx context.Context, exec repository.Executor, b batchModels.ProductBatches) (int, error) {
+					if tc.createBatchErr != nil {
+						return 0, tc.createBatchErr
+					}
+					return 9, nil
+				},
+				FuncAddSectionCapacity: func(ctx context.Context, exec repository.Executor, sectionId int, delta int) error {
+					capacityAdded += delta
+					return nil
+				},
+				FuncCommitTx: func(tx *sql.Tx) error {
+					committed = true
+					return nil
+				},
+				FuncRollbackTx: func(tx *sql.Tx) error {
+					rolledBack = true
+					return nil
+				},
+			}
+			ledger := &movementMocks.StockMovementRepositoryMock{
+				FuncAppend: func(ctx context.Context, exec stockMovementRepository.Executor, m movementModels.StockMovement) (*movementModels.StockMovement, error) {
+					movements = append(movements, m)
+					return &m, nil
+				},
+			}
+			exceptions := &exceptionMocks.WarehouseExceptionRepositoryMock{
+				FuncExists: func(ctx context.Context, employeeId int, warehouseId int) (bool, error) {
+					return tc.hasException, nil
+				},
+			}
+			asns := &asnMocks.AsnRepositoryMock{
+				FuncFindForUpdate: func(ctx context.Context, exec asnRepository.Executor, id int) (*asnModels.Asn, error) {
+					a := *tc.asn
+					return &a, nil
+				},
+				FuncAddReceived: func(ctx context.Context, exec asnRepository.Executor, asnId int, sellerId int, productId int, quantity int) error {
+					if tc.addReceivedErr != nil {
+						return tc.addReceivedErr
+					}
+					received = []int{asnId, sellerId, productId, quantity}
+					return nil
+				},
+				FuncUpdateStatus: func(ctx context.Context, exec asnRepository.Executor, a asnModels.Asn) error {
+					asnStatus = a.Status
+					return nil
+				},
+			}
+			svc := service.NewReceivingService(rp, ledger, exceptions, asns)
+
+			req := testhelpers.DummyPostReceipt()
+			if tc.asn != nil {
+				req.AsnId = testhelpers.IntPtr(tc.asn.Id)
+			}
+			result, err := svc.Receive(context.Background(), req)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				if tc.wantDetails != nil {
+					var appErr *apperrors.AppError
+					require.True(t, errors.As(err, &appErr))
+					require.Equal(t, tc.wantDetails, appErr.Details)
+				}
+				require.Nil(t, result)
+				require.True(t, rolledBack)
+				require.False(t, committed)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, committed)
+			require.False(t, rolledBack)
+			require.Equal(t, 9, result.ProductBatch.Id)
+			require.Equal(t, 9, result.InboundOrder.ProductBatchID)
+			require.Equal(t, 1, result.InboundOrder.WarehouseID)
+			require.Equal(t, 50, capacityAdded)
+			require.Len(t, movements, 1)
+			require.Equal(t, movementModels.MovementTypeReceipt, movements[0].MovementType)
+			require.Equal(t, 50, movements[0].Quantity)
+			if tc.asn != nil {
+				require.Equal(t, []int{tc.asn.Id, 3, 22, 50}, received)
+				require.Equal(t, asnModels.StatusReceiving, asnStatus)
+				require.Equal(t, tc.asn.Id, *result.InboundOrder.AsnID)
+			} else {
+				require.Nil(t, received)
+				require.Nil(t, result.InboundOrder.AsnID)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/replenishment"
+)
+
+// FindAll returns the reorder points, optionally of one warehouse and one product; zero filters are ignored.
+func (s *replenishmentService) FindAll(ctx context.Context, warehouseId int, productId int) ([]models.ReorderPoint, error) {
+	return s.rp.FindAll(ctx, warehouseId, productId)
+}
+
+// Create stores the reorder point of a product at a warehouse.
+// Returns a conflict error if the product already has one there and a not found error if the product or warehouse does not exist.
+func (s *replenishmentService) Create(ctx context.Context, p models.PostReorderPoint) (*models.ReorderPoint, error) {
+	return s.rp.Create(ctx, models.ReorderPoint{
+		ProductId:      p.ProductId,
+		WarehouseId:    p.WarehouseId,
+		ReorderPoint:   *p.ReorderPoint,
+		TargetQuantity: *p.TargetQuantity,
+	})
+}
+
+// Update merges the patch into the stored levels and validates the result,
+// so a patch of only one level is checked against the other.
+func (s *replenishmentService) Update(ctx context.Context, id int, p models.PatchReorderPoint) (*models.ReorderPoint, error) {
+	point, err := s.rp.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if p.ReorderPoint != nil {
+		point.ReorderPoint = *p.ReorderPoint
+	}
+	if p.TargetQuantity != nil {
+		point.TargetQuantity = *p.TargetQuantity
+	}
+	if err := validators.ValidateReorderLevels(point.ReorderPoint, point.TargetQuantity); err != nil {
+		return nil, err
+	}
+	if err := s.rp.Update(ctx, *point); err != nil {
+		return nil, err
+	}
+	return point, nil
+}
+
+// Delete removes a reorder point.
+// Returns a not found error if it does not exist.
+func (s *replenishmentService) Delete(ctx context.Context, id int) error {
+	return s.rp.Delete(ctx, id)
+}
+
+// Report suggests a reorder for every product at or below its reorder point, or whose recent
+// demand exceeds its stock. The suggestion tops the stock up to the larger of the target and
+// the demand. Levels come ordered by seller, so lines are grouped as they are read.
+func (s *replenishmentService) Report(ctx context.Context, warehouseId int, sellerId int, window time.Duration) ([]models.SellerReplenishment, error) {
+	now := time.Now()
+	levels, err := s.rp.FindStockLevels(ctx, now, now.Add(-window), warehouseId, sellerId)
+	if err != nil {
+		return nil, err
+	}
+
+	report := make([]models.SellerReplenishment, 0)
+	for _, l := range levels {
+		if l.OnHand > l.ReorderPoint.ReorderPoint && l.OnHand >= l.Demand {
+			continue
+		}
+		suggested := max(l.TargetQuantity, l.Demand) - l.OnHand
+		if suggested <= 0 {
+			continue
+		}
+
+		if len(report) == 0 || report[len(report)-1].SellerId != l.SellerId {
+			report = append(report, models.SellerReplenishment{
+				SellerId:    l.SellerId,
+				CompanyName: l.CompanyName,
+				Lines:       make([]models.ReplenishmentLine, 0),
+			})
+		}
+		seller := &report[len(report)-1]
+		seller.TotalSuggested += suggested
+		seller.Lines = append(seller.Lines, models.ReplenishmentLine{
+			ProductId:         l.ProductId,
+			ProductCode:       l.ProductCode,
+			WarehouseId:       l.WarehouseId,
+			WarehouseCode:     l.WarehouseCode,
+			OnHand:            l.OnHand,
+			ReorderPoint:      l.ReorderPoint.ReorderPoint,
+			TargetQuantity:    l.TargetQuantity,
+			Demand:            l.Demand,
+			SuggestedQuantity: suggested,
+		})
+	}
+	return report, nil
+}
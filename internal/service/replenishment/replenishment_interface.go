@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/replenishment"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/replenishment"
+)
+
+// ReplenishmentService manages reorder points and suggests what to reorder from each seller.
+type ReplenishmentService interface {
+	// FindAll returns the reorder points, optionally filtered by warehouse and product.
+	FindAll(ctx context.Context, warehouseId int, productId int) ([]models.ReorderPoint, error)
+
+	// Create configures the reorder point of a product at a warehouse.
+	Create(ctx context.Context, p models.PostReorderPoint) (*models.ReorderPoint, error)
+
+	// Update changes the levels of a reorder point.
+	Update(ctx context.Context, id int, p models.PatchReorderPoint) (*models.ReorderPoint, error)
+
+	// Delete removes a reorder point.
+	Delete(ctx context.Context, id int) error
+
+	// Report returns the quantities to reorder per seller, weighing the demand of the given window.
+	Report(ctx context.Context, warehouseId int, sellerId int, window time.Duration) ([]models.SellerReplenishment, error)
+}
+
+// replenishmentService implements ReplenishmentService using a repository.
+type replenishmentService struct {
+	rp repository.ReplenishmentRepository
+}
+
+// NewReplenishmentService creates a new ReplenishmentService using the provided repository.
+func NewReplenishmentService(rp repository.ReplenishmentRepository) ReplenishmentService {
+	return &replenishmentService{
+		rp: rp,
+	}
+}
@@ -0,0 +1,188 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/replenishment"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/replenishment"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/replenishment"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestReplenishmentService_Report(t *testing.T) {
+	testCases := []struct {
+		name        string
+		rp          func() *mocks.ReplenishmentRepositoryMock
+		wantErrCode string
+		check       func(t *testing.T, result []models.SellerReplenishment)
+	}{
+		{
+			name: "success - lines grouped by seller",
+			rp: func() *mocks.ReplenishmentRepositoryMock {
+				return &mocks.ReplenishmentRepositoryMock{
+					FuncFindStockLevels: func(ctx context.Context, now time.Time, since time.Time, warehouseId int, sellerId int) ([]models.StockLevel, error) {
+						require.Equal(t, 14*24*time.Hour, now.Sub(since))
+						return []models.StockLevel{
+							testhelpers.DummyStockLevel(1, 1, 20, 5),
+							testhelpers.DummyStockLevel(1, 2, 15, 0),
+							testhelpers.DummyStockLevel(2, 3, 10, 0),
+						}, nil
+					},
+				}
+			},
+			check: func(t *testing.T, result []models.SellerReplenishment) {
+				require.Len(t, result, 2)
+				require.Equal(t, 1, result[0].SellerId)
+				require.Len(t, result[0].Lines, 2)
+				require.Equal(t, 80, result[0].Lines[0].SuggestedQuantity)
+				require.Equal(t, 85, result[0].Lines[1].SuggestedQuantity)
+				require.Equal(t, 165, result[0].TotalSuggested)
+				require.Equal(t, 2, result[1].SellerId)
+				require.Equal(t, 90, result[1].TotalSuggested)
+			},
+		},
+		{
+			name: "success - stock above reorder point and demand is skipped",
+			rp: func() *mocks.ReplenishmentRepositoryMock {
+				return &mocks.ReplenishmentRepositoryMock{
+					FuncFindStockLevels: func(ctx context.Context, now time.Time, since time.Time, warehouseId int, sellerId int) ([]models.StockLevel, error) {
+						return []models.StockLevel{testhelpers.DummyStockLevel(1, 1, 21, 21)}, nil
+					},
+				}
+			},
+			check: func(t *testing.T, result []models.SellerReplenishment) {
+				require.NotNil(t, result)
+				require.Empty(t, result)
+			},
+		},
+		{
+			name: "success - demand above target drives the suggestion",
+			rp: func() *mocks.ReplenishmentRepositoryMock {
+				return &mocks.ReplenishmentRepositoryMock{
+					FuncFindStockLevels: func(ctx context.Context, now time.Time, since time.Time, warehouseId int, sellerId int) ([]models.StockLevel, error) {
+						return []models.StockLevel{testhelpers.DummyStockLevel(1, 1, 50, 150)}, nil
+					},
+				}
+			},
+			check: func(t *testing.T, result []models.SellerReplenishment) {
+				require.Len(t, result, 1)
+				require.Equal(t, 100, result[0].Lines[0].SuggestedQuantity)
+			},
+		},
+		{
+			name: "success - stock at the reorder point above the target is skipped",
+			rp: func() *mocks.ReplenishmentRepositoryMock {
+				return &mocks.ReplenishmentRepositoryMock{
+					FuncFindStockLevels: func(ctx context.Context, now time.Time, since time.Time, warehouseId int, sellerId int) ([]models.StockLevel, error) {
+						l := testhelpers.DummyStockLevel(1, 1, 20, 0)
+						l.TargetQuantity = 20
+						return []models.StockLevel{l}, nil
+					},
+				}
+			},
+			check: func(t *testing.T, result []models.SellerReplenishment) {
+				require.Empty(t, result)
+			},
+		},
+		{
+			name: "error - repository fails",
+			rp: func() *mocks.ReplenishmentRepositoryMock {
+				return &mocks.ReplenishmentRepositoryMock{
+					FuncFindStockLevels: func(ctx context.Context, now time.Time, since time.Time, warehouseId int, sellerId int) ([]models.StockLevel, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeInternal, "error querying stock levels")
+					},
+				}
+			},
+			wantErrCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := service.NewReplenishmentService(tc.rp())
+
+			result, err := svc.Report(context.Background(), 0, 0, 14*24*time.Hour)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			tc.check(t, result)
+		})
+	}
+}
+
+func TestReplenishmentService_Update(t *testing.T) {
+	testCases := []struct {
+		name        string
+		patch       models.PatchReorderPoint
+		rp          func() *mocks.ReplenishmentRepositoryMock
+		wantErrCode string
+		expected    *models.ReorderPoint
+	}{
+		{
+			name:  "success - patch merged with stored levels",
+			patch: models.PatchReorderPoint{TargetQuantity: testhelpers.IntPtr(60)},
+			rp: func() *mocks.ReplenishmentRepositoryMock {
+				return &mocks.ReplenishmentRepositoryMock{
+					FuncFindById: func(ctx context.Context, id int) (*models.ReorderPoint, error) {
+						p := testhelpers.DummyReorderPoint(id)
+						return &p, nil
+					},
+				}
+			},
+			expected: &models.ReorderPoint{Id: 1, ProductId: 1, WarehouseId: 1, ReorderPoint: 20, TargetQuantity: 60},
+		},
+		{
+			name:  "error - reorder point raised above stored target",
+			patch: models.PatchReorderPoint{ReorderPoint: testhelpers.IntPtr(100)},
+			rp: func() *mocks.ReplenishmentRepositoryMock {
+				return &mocks.ReplenishmentRepositoryMock{
+					FuncFindById: func(ctx context.Context, id int) (*models.ReorderPoint, error) {
+						p := testhelpers.DummyReorderPoint(id)
+						return &p, nil
+					},
+					FuncUpdate: func(ctx context.Context, p models.ReorderPoint) error {
+						t.Fatal("update should not be called")
+						return nil
+					},
+				}
+			},
+			wantErrCode: apperrors.CodeValidationError,
+		},
+		{
+			name:  "error - not found",
+			patch: models.PatchReorderPoint{ReorderPoint: testhelpers.IntPtr(10)},
+			rp: func() *mocks.ReplenishmentRepositoryMock {
+				return &mocks.ReplenishmentRepositoryMock{
+					FuncFindById: func(ctx context.Context, id int) (*models.ReorderPoint, error) {
+						return nil, apperrors.NewAppError(apperrors.CodeNotFound, "reorder point not found")
+					},
+				}
+			},
+			wantErrCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := service.NewReplenishmentService(tc.rp())
+
+			result, err := svc.Update(context.Background(), 1, tc.patch)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, result)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/geo"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/routing"
+)
+
+// improvementEpsilon ignores 2-opt gains that are only floating point noise, so the search always ends.
+const improvementEpsilon = 1e-9
+
+// Plan groups the shipments of the day by locality into stops and orders them into a closed tour from the warehouse,
+// first greedily by nearest neighbour and then refined with 2-opt until no reversal shortens it.
+// Returns a conflict error if the warehouse has no coordinates.
+func (s *routingService) Plan(ctx context.Context, filter models.PlanFilter) (*models.RoutePlan, error) {
+	depot, err := s.rp.FindDepot(ctx, filter.WarehouseId)
+	if err != nil {
+		return nil, err
+	}
+	if depot.Latitude == nil || depot.Longitude == nil {
+		return nil, apperrors.NewAppError(apperrors.CodeConflict, "warehouse has no coordinates").
+			WithDetail("warehouse_id", filter.WarehouseId)
+	}
+
+	day := time.Date(filter.Date.Year(), filter.Date.Month(), filter.Date.Day(), 0, 0, 0, 0, filter.Date.Location())
+	shipments, err := s.rp.FindShipments(ctx, filter.WarehouseId, filter.CarrierId, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &models.RoutePlan{
+		WarehouseId: filter.WarehouseId,
+		CarrierId:   filter.CarrierId,
+		Date:        day.Format(time.DateOnly),
+		Stops:       []models.Stop{},
+		Unplanned:   []models.RouteShipmentRef{},
+	}
+	stops := make([]models.Stop, 0)
+	for _, sh := range shipments {
+		if sh.Latitude == nil || sh.Longitude == nil {
+			plan.Unplanned = append(plan.Unplanned, models.RouteShipmentRef{TrackingCode: sh.TrackingCode, LocalityId: sh.LocalityId})
+			continue
+		}
+		if n := len(stops); n == 0 || stops[n-1].LocalityId != sh.LocalityId {
+			stops = append(stops, models.Stop{
+				LocalityId:    sh.LocalityId,
+				LocalityName:  sh.LocalityName,
+				Latitude:      *sh.Latitude,
+				Longitude:     *sh.Longitude,
+				TrackingCodes: []string{},
+			})
+		}
+		stop := &stops[len(stops)-1]
+		stop.TrackingCodes = append(stop.TrackingCodes, sh.TrackingCode)
+	}
+	if len(stops) == 0 {
+		return plan, nil
+	}
+
+	points := make([]geo.Point, len(stops)+1)
+	points[0] = geo.Point{Latitude: *depot.Latitude, Longitude: *depot.Longitude}
+	for i, st := range stops {
+		points[i+1] = geo.Point{Latitude: st.Latitude, Longitude: st.Longitude}
+	}
+	dist := distances(points)
+	tour := twoOpt(nearestNeighbour(dist), dist)
+
+	var total float64
+	for i := 1; i < len(tour); i++ {
+		leg := dist[tour[i-1]][tour[i]]
+		total += leg
+		stop := stops[tour[i]-1]
+		stop.Sequence = i
+		stop.DistanceKm = roundKm(leg)
+		plan.Stops = append(plan.Stops, stop)
+	}
+	back := dist[tour[len(tour)-1]][0]
+	plan.ReturnDistanceKm = roundKm(back)
+	plan.TotalDistanceKm = roundKm(total + back)
+	return plan, nil
+}
+
+// Distance measures between the two localities.
+// Returns a conflict error if either has no coordinates.
+func (s *routingService) Distance(ctx context.Context, fromLocalityId, toLocalityId string) (*models.Distance, error) {
+	from, err := s.rp.FindPlace(ctx, fromLocalityId)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.rp.FindPlace(ctx, toLocalityId)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range []*models.Place{from, to} {
+		if p.Latitude == nil || p.Longitude == nil {
+			return nil, apperrors.NewAppError(apperrors.CodeConflict, "locality has no coordinates").
+				WithDetail("locality_id", p.LocalityId)
+		}
+	}
+
+	km := geo.Distance(
+		geo.Point{Latitude: *from.Latitude, Longitude: *from.Longitude},
+		geo.Point{Latitude: *to.Latitude, Longitude: *to.Longitude},
+	)
+	return &models.Distance{FromLocalityId: fromLocalityId, ToLocalityId: toLocalityId, DistanceKm: roundKm(km)}, nil
+}
+
+// distances returns the matrix of distances between every pair of points.
+func distances(points []geo.Point) [][]float64 {
+	dist := make([][]float64, len(points))
+	for i := range points {
+		dist[i] = make([]float64, len(points))
+		for j := range points {
+			if i != j {
+				dist[i][j] = geo.Distance(points[i], points[j])
+			}
+		}
+	}
+	return dist
+}
+
+// nearestNeighbour builds a tour starting at point 0 that always moves to the closest point not yet visited.
+// Ties go to the lower index, which keeps plans stable.
+func nearestNeighbour(dist [][]float64) []int {
+	visited := make([]bool, len(dist))
+	visited[0] = true
+	tour := []int{0}
+	for len(tour) < len(dist) {
+		current, next := tour[len(tour)-1], -1
+		for j := range dist {
+			if !visited[j] && (next == -1 || dist[current][j] < dist[current][next]) {
+				next = j
+			}
+		}
+		visited[next] = true
+		tour = append(tour, next)
+	}
+	return tour
+}
+
+// twoOpt shortens a closed tour by reversing the segments whose reversal removes a crossing,
+// keeping point 0 first, until no reversal helps.
+func twoOpt(tour []int, dist [][]float64) []int {
+	n := len(tour)
+	for improved := true; improved; {
+		improved = false
+		for i := 1; i < n-1; i++ {
+			for k := i + 1; k < n; k++ {
+				a, b := tour[i-1], tour[i]
+				c, d := tour[k], tour[(k+1)%n]
+				if dist[a][c]+dist[b][d]-dist[a][b]-dist[c][d] < -improvementEpsilon {
+					for l, r := i, k; l < r; l, r = l+1, r-1 {
+						tour[l], tour[r] = tour[r], tour[l]
+					}
+					improved = true
+				}
+			}
+		}
+	}
+	return tour
+}
+
+// roundKm rounds a distance to two decimals.
+func roundKm(km float64) float64 {
+	return math.Round(km*100) / 100
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/routing"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/routing"
+)
+
+// RoutingService plans delivery routes and measures distances from coordinates, without any external maps service.
+type RoutingService interface {
+	// Plan orders the stops a carrier makes delivering its shipments from a warehouse on one day.
+	Plan(ctx context.Context, filter models.PlanFilter) (*models.RoutePlan, error)
+
+	// Distance returns how far apart two localities are.
+	Distance(ctx context.Context, fromLocalityId, toLocalityId string) (*models.Distance, error)
+}
+
+// routingService implements RoutingService using a repository.
+type routingService struct {
+	rp repository.RoutingRepository
+}
+
+// NewRoutingService creates a new RoutingService using the provided repository.
+func NewRoutingService(rp repository.RoutingRepository) RoutingService {
+	return &routingService{
+		rp: rp,
+	}
+}
@@ -0,0 +1,146 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/routing"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/routing"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/routing"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestRoutingService_Plan(t *testing.T) {
+	// Every point lies on the equator, one degree of longitude apart being about 111.19 km. From the warehouse
+	// at 0 nearest neighbour visits -1, 2 and -5 and returns, 16 degrees; visiting 2 first saves two of them.
+	shipment := func(id int, localityId string, longitude *float64) models.RouteShipment {
+		return models.RouteShipment{ShipmentId: id, TrackingCode: "TRK-" + localityId + string(rune('0'+id)), Place: models.Place{
+			LocalityId: localityId, LocalityName: "Locality " + localityId, Latitude: testhelpers.Float64Ptr(0), Longitude: longitude,
+		}}
+	}
+	shipments := []models.RouteShipment{
+		shipment(1, "A", testhelpers.Float64Ptr(-1)),
+		shipment(2, "A", testhelpers.Float64Ptr(-1)),
+		shipment(3, "B", testhelpers.Float64Ptr(2)),
+		shipment(4, "C", testhelpers.Float64Ptr(-5)),
+		shipment(5, "D", nil),
+	}
+	depot := &models.Depot{WarehouseId: 1, Latitude: testhelpers.Float64Ptr(0), Longitude: testhelpers.Float64Ptr(0)}
+	date := time.Date(2025, 6, 2, 15, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		depot       *models.Depot
+		depotErr    error
+		shipments   []models.RouteShipment
+		wantPlan    *models.RoutePlan
+		wantErrCode string
+	}{
+		{
+			name:      "success - 2-opt improves the nearest neighbour tour",
+			depot:     depot,
+			shipments: shipments,
+			wantPlan: &models.RoutePlan{
+				WarehouseId: 1, CarrierId: 2, Date: "2025-06-02",
+				Stops: []models.Stop{
+					{Sequence: 1, LocalityId: "B", LocalityName: "Locality B", Latitude: 0, Longitude: 2, TrackingCodes: []string{"TRK-B3"}, DistanceKm: 222.39},
+					{Sequence: 2, LocalityId: "A", LocalityName: "Locality A", Latitude: 0, Longitude: -1, TrackingCodes: []string{"TRK-A1", "TRK-A2"}, DistanceKm: 333.58},
+					{Sequence: 3, LocalityId: "C", LocalityName: "Locality C", Latitude: 0, Longitude: -5, TrackingCodes: []string{"TRK-C4"}, DistanceKm: 444.78},
+				},
+				ReturnDistanceKm: 555.97,
+				TotalDistanceKm:  1556.73,
+				Unplanned:        []models.RouteShipmentRef{{TrackingCode: "TRK-D5", LocalityId: "D"}},
+			},
+		},
+		{
+			name:      "success - nothing to deliver",
+			depot:     depot,
+			shipments: []models.RouteShipment{},
+			wantPlan: &models.RoutePlan{
+				WarehouseId: 1, CarrierId: 2, Date: "2025-06-02",
+				Stops: []models.Stop{}, Unplanned: []models.RouteShipmentRef{},
+			},
+		},
+		{
+			name:        "error - warehouse without coordinates",
+			depot:       &models.Depot{WarehouseId: 1},
+			wantErrCode: apperrors.CodeConflict,
+		},
+		{
+			name:        "error - warehouse not found",
+			depotErr:    apperrors.NewAppError(apperrors.CodeNotFound, "warehouse not found"),
+			wantErrCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rp := &mocks.RoutingRepositoryMock{
+				FuncFindDepot: func(ctx context.Context, warehouseId int) (*models.Depot, error) {
+					return tc.depot, tc.depotErr
+				},
+				FuncFindShipments: func(ctx context.Context, warehouseId, carrierId int, from, to time.Time) ([]models.RouteShipment, error) {
+					require.Equal(t, time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC), from)
+					require.Equal(t, time.Date(2025, 6, 3, 0, 0, 0, 0, time.UTC), to)
+					return tc.shipments, nil
+				},
+			}
+			sv := service.NewRoutingService(rp)
+
+			plan, err := sv.Plan(context.Background(), models.PlanFilter{WarehouseId: 1, CarrierId: 2, Date: date})
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantPlan, plan)
+		})
+	}
+}
+
+func TestRoutingService_Distance(t *testing.T) {
+	places := map[string]*models.Place{
+		"1000": {LocalityId: "1000", Latitude: testhelpers.Float64Ptr(-34.603722), Longitude: testhelpers.Float64Ptr(-58.381592)},
+		"2000": {LocalityId: "2000", Latitude: testhelpers.Float64Ptr(-32.944242), Longitude: testhelpers.Float64Ptr(-60.650539)},
+		"3000": {LocalityId: "3000"},
+	}
+
+	testCases := []struct {
+		name        string
+		from, to    string
+		wantKm      float64
+		wantErrCode string
+	}{
+		{name: "success", from: "1000", to: "2000", wantKm: 279.32},
+		{name: "success - same locality", from: "1000", to: "1000", wantKm: 0},
+		{name: "error - locality without coordinates", from: "1000", to: "3000", wantErrCode: apperrors.CodeConflict},
+		{name: "error - locality not found", from: "9999", to: "1000", wantErrCode: apperrors.CodeNotFound},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rp := &mocks.RoutingRepositoryMock{
+				FuncFindPlace: func(ctx context.Context, localityId string) (*models.Place, error) {
+					if p, ok := places[localityId]; ok {
+						return p, nil
+					}
+					return nil, apperrors.NewAppError(apperrors.CodeNotFound, "locality not found")
+				},
+			}
+			sv := service.NewRoutingService(rp)
+
+			distance, err := sv.Distance(context.Background(), tc.from, tc.to)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, &models.Distance{FromLocalityId: tc.from, ToLocalityId: tc.to, DistanceKm: tc.wantKm}, distance)
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"math"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/validators"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	buyerModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipment"
+)
+
+// trackingAlphabet leaves out characters easily confused when read over the phone, such as 0/O and 1/I.
+const trackingAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// Create ships the purchase orders in one transaction. Every order must exist, be confirmed and not be
+// carried by another shipment. The shipment gets a generated tracking code, which is also written to the
+// orders so their tracking_code points at it, and starts with a created event.
+func (s *shipmentService) Create(ctx context.Context, req models.PostShipment) (*models.ShipmentDetail, error) {
+	code, err := generateTrackingCode()
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to generate tracking code")
+	}
+
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	orders, err := s.rp.FindOrdersForUpdate(ctx, tx, req.PurchaseOrderIds)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateOrders(orders, req.PurchaseOrderIds); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	shipment := models.Shipment{
+		TrackingCode:          code,
+		CarrierId:             req.CarrierId,
+		WarehouseId:           req.WarehouseId,
+		DestinationLocalityId: req.DestinationLocalityId,
+		Status:                models.StatusCreated,
+		PromisedAt:            req.PromisedAt,
+		MaxTemperature:        req.MaxTemperature,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+	}
+	shipment.Id, err = s.rp.Create(ctx, tx, shipment)
+	if err != nil {
+		return nil, err
+	}
+	for _, orderId := range req.PurchaseOrderIds {
+		if err = s.rp.AddOrder(ctx, tx, shipment.Id, orderId); err != nil {
+			return nil, err
+		}
+	}
+	if err = s.rp.StampTrackingCode(ctx, tx, shipment.Id, code); err != nil {
+		return nil, err
+	}
+	event := models.Event{
+		ShipmentId: shipment.Id,
+		Status:     models.StatusCreated,
+		OccurredAt: now,
+		CreatedAt:  now,
+	}
+	event.Id, err = s.rp.CreateEvent(ctx, tx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+	return &models.ShipmentDetail{
+		Shipment:         shipment,
+		PurchaseOrderIds: req.PurchaseOrderIds,
+		Events:           []models.Event{event},
+	}, nil
+}
+
+// FindAll returns the shipments matching the filter, latest first.
+func (s *shipmentService) FindAll(ctx context.Context, filter models.ShipmentFilter) ([]models.Shipment, error) {
+	return s.rp.FindAll(ctx, filter)
+}
+
+// FindByTrackingCode returns the shipment with the ids of the purchase orders it carries and its events.
+// Returns a not found error if no shipment has the tracking code.
+func (s *shipmentService) FindByTrackingCode(ctx context.Context, trackingCode string) (*models.ShipmentDetail, error) {
+	shipment, err := s.rp.FindByTrackingCode(ctx, trackingCode)
+	if err != nil {
+		return nil, err
+	}
+	orderIds, err := s.rp.FindOrderIds(ctx, shipment.Id)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.rp.FindEvents(ctx, shipment.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &models.ShipmentDetail{Shipment: *shipment, PurchaseOrderIds: orderIds, Events: events}, nil
+}
+
+// RecordEvent locks the shipment, checks it can move to the event status and records the event.
+// Returns a conflict error when the shipment cannot move to that status, e.g. once delivered.
+func (s *shipmentService) RecordEvent(ctx context.Context, trackingCode string, req models.PostEvent) (*models.ShipmentDetail, error) {
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	shipment, err := s.rp.FindByTrackingCodeForUpdate(ctx, tx, trackingCode)
+	if err != nil {
+		return nil, err
+	}
+	if err = validators.ValidateShipmentTransition(*shipment, req.Status); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	occurredAt := now
+	if req.OccurredAt != nil {
+		occurredAt = *req.OccurredAt
+	}
+	_, err = s.rp.CreateEvent(ctx, tx, models.Event{
+		ShipmentId:  shipment.Id,
+		Status:      req.Status,
+		Location:    req.Location,
+		Description: req.Description,
+		OccurredAt:  occurredAt,
+		Temperature: req.Temperature,
+		CreatedAt:   now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	shipment.Status = req.Status
+	shipment.UpdatedAt = now
+	if err = s.rp.UpdateStatus(ctx, tx, *shipment); err != nil {
+		return nil, err
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+	return s.FindByTrackingCode(ctx, trackingCode)
+}
+
+// Performance normalises the period to whole days and folds the shipments, which come ordered by carrier,
+// into one line per carrier.
+func (s *shipmentService) Performance(ctx context.Context, filter models.PerformanceFilter) ([]models.CarrierPerformance, error) {
+	filter.From = time.Date(filter.From.Year(), filter.From.Month(), filter.From.Day(), 0, 0, 0, 0, filter.From.Location())
+	filter.To = time.Date(filter.To.Year(), filter.To.Month(), filter.To.Day(), 0, 0, 0, 0, filter.To.Location())
+	shipments, err := s.rp.FindPerformance(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	report := make([]models.CarrierPerformance, 0)
+	var promised int
+	var transit time.Duration
+	for _, sh := range shipments {
+		if n := len(report); n == 0 || report[n-1].CarrierId != sh.CarrierId {
+			if n > 0 {
+				closePerformance(&report[n-1], promised, transit)
+			}
+			report = append(report, models.CarrierPerformance{CarrierId: sh.CarrierId, Cid: sh.Cid, CompanyName: sh.CompanyName})
+			promised, transit = 0, 0
+		}
+		line := &report[len(report)-1]
+		line.ShipmentsHandled++
+		line.FailedDeliveries += sh.FailedAttempts
+		if sh.ColdChain {
+			line.ColdChainShipments++
+			if sh.Excursions > 0 {
+				line.TemperatureExcursions++
+			}
+		}
+		if sh.DeliveredAt == nil {
+			continue
+		}
+		line.Delivered++
+		start := sh.CreatedAt
+		if sh.PickedUpAt != nil {
+			start = *sh.PickedUpAt
+		}
+		transit += sh.DeliveredAt.Sub(start)
+		if sh.PromisedAt != nil {
+			promised++
+			if !sh.DeliveredAt.After(*sh.PromisedAt) {
+				line.OnTime++
+			}
+		}
+	}
+	if n := len(report); n > 0 {
+		closePerformance(&report[n-1], promised, transit)
+	}
+	return report, nil
+}
+
+// closePerformance sets the on-time rate and average transit time of a carrier, rounded to two decimals,
+// from its delivered shipments that had a promised date and their total transit time.
+func closePerformance(line *models.CarrierPerformance, promised int, transit time.Duration) {
+	if promised > 0 {
+		rate := math.Round(float64(line.OnTime)/float64(promised)*10000) / 100
+		line.OnTimeRate = &rate
+	}
+	if line.Delivered > 0 {
+		hours := math.Round(transit.Hours()/float64(line.Delivered)*100) / 100
+		line.AverageTransitHours = &hours
+	}
+}
+
+// validateOrders checks every requested purchase order was found, is confirmed and is not shipped yet.
+func validateOrders(orders []models.ShipmentOrder, orderIds []int) error {
+	found := make(map[int]models.ShipmentOrder, len(orders))
+	for _, o := range orders {
+		found[o.Id] = o
+	}
+	for _, id := range orderIds {
+		o, ok := found[id]
+		if !ok {
+			return apperrors.NewAppError(apperrors.CodeNotFound, "purchase order not found").
+				WithDetail("purchase_order_id", id)
+		}
+		if o.OrderStatusId != buyerModels.OrderStatusConfirmed {
+			return apperrors.NewAppError(apperrors.CodeConflict, "purchase order is not confirmed").
+				WithDetail("purchase_order_id", id)
+		}
+		if o.ShipmentId != nil {
+			return apperrors.NewAppError(apperrors.CodeConflict, "purchase order already shipped").
+				WithDetail("purchase_order_id", id).
+				WithDetail("shipment_id", *o.ShipmentId)
+		}
+	}
+	return nil
+}
+
+// generateTrackingCode returns a random code such as TRK-7HQ2MZ4KPA.
+func generateTrackingCode() (string, error) {
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = trackingAlphabet[int(b[i])%len(trackingAlphabet)]
+	}
+	return "TRK-" + string(b), nil
+}
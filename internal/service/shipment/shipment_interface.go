@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/shipment"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipment"
+)
+
+// ShipmentService hands purchase orders to carriers and tracks their delivery.
+type ShipmentService interface {
+	// Create ships confirmed purchase orders with a carrier under a new tracking code.
+	Create(ctx context.Context, req models.PostShipment) (*models.ShipmentDetail, error)
+
+	// FindAll returns the shipments matching the filter.
+	FindAll(ctx context.Context, filter models.ShipmentFilter) ([]models.Shipment, error)
+
+	// FindByTrackingCode returns a shipment with its purchase orders and events.
+	FindByTrackingCode(ctx context.Context, trackingCode string) (*models.ShipmentDetail, error)
+
+	// RecordEvent records a status change of a shipment.
+	RecordEvent(ctx context.Context, trackingCode string, req models.PostEvent) (*models.ShipmentDetail, error)
+
+	// Performance reports, per carrier, how the shipments handed to it in a period went.
+	Performance(ctx context.Context, filter models.PerformanceFilter) ([]models.CarrierPerformance, error)
+}
+
+// shipmentService implements ShipmentService using a repository.
+type shipmentService struct {
+	rp repository.ShipmentRepository
+}
+
+// NewShipmentService creates a new ShipmentService using the provided repository.
+func NewShipmentService(rp repository.ShipmentRepository) ShipmentService {
+	return &shipmentService{
+		rp: rp,
+	}
+}
@@ -0,0 +1,273 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/shipment"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/shipment"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/shipment"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	buyerModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipment"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestShipmentService_Create(t *testing.T) {
+	confirmed := func(id int) models.ShipmentOrder {
+		return models.ShipmentOrder{Id: id, OrderStatusId: buyerModels.OrderStatusConfirmed}
+	}
+
+	testCases := []struct {
+		name        string
+		req         models.PostShipment
+		orders      []models.ShipmentOrder
+		wantErrCode string
+	}{
+		{
+			name:   "success - two confirmed orders",
+			req:    testhelpers.DummyPostShipment(7, 8),
+			orders: []models.ShipmentOrder{confirmed(7), confirmed(8)},
+		},
+		{
+			name:        "error - order not found",
+			req:         testhelpers.DummyPostShipment(7, 8),
+			orders:      []models.ShipmentOrder{confirmed(7)},
+			wantErrCode: apperrors.CodeNotFound,
+		},
+		{
+			name:        "error - order still pending",
+			req:         testhelpers.DummyPostShipment(7),
+			orders:      []models.ShipmentOrder{{Id: 7, OrderStatusId: buyerModels.OrderStatusPending}},
+			wantErrCode: apperrors.CodeConflict,
+		},
+		{
+			name:        "error - order already shipped",
+			req:         testhelpers.DummyPostShipment(7),
+			orders:      []models.ShipmentOrder{{Id: 7, OrderStatusId: buyerModels.OrderStatusConfirmed, ShipmentId: testhelpers.IntPtr(3)}},
+			wantErrCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rolledBack, committed bool
+			var created *models.Shipment
+			var stamped string
+			added := make([]int, 0)
+			events := make([]models.Event, 0)
+			rp := &mocks.ShipmentRepositoryMock{
+				FuncFindOrdersForUpdate: func(ctx context.Context, exec repository.Executor, orderIds []int) ([]models.ShipmentOrder, error) {
+					require.Equal(t, tc.req.PurchaseOrderIds, orderIds)
+					return tc.orders, nil
+				},
+				FuncCreate: func(ctx context.Context, exec repository.Executor, s models.Shipment) (int, error) {
+					created = &s
+					return 4, nil
+				},
+				FuncAddOrder: func(ctx context.Context, exec repository.Executor, shipmentId int, orderId int) error {
+					require.Equal(t, 4, shipmentId)
+					added = append(added, orderId)
+					return nil
+				},
+				FuncStampTrackingCode: func(ctx context.Context, exec repository.Executor, shipmentId int, trackingCode string) error {
+					stamped = trackingCode
+					return nil
+				},
+				FuncCreateEvent: func(ctx context.Context, exec repository.Executor, e models.Event) (int, error) {
+					events = append(events, e)
+					return 1, nil
+				},
+				FuncCommitTx: func(tx *sql.Tx) error {
+					committed = true
+					return nil
+				},
+				FuncRollbackTx: func(tx *sql.Tx) error {
+					rolledBack = true
+					return nil
+				},
+			}
+			svc := service.NewShipmentService(rp)
+
+			result, err := svc.Create(context.Background(), tc.req)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, result)
+				require.Nil(t, created)
+				require.True(t, rolledBack)
+				require.False(t, committed)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, committed)
+			require.Regexp(t, regexp.MustCompile(`^TRK-[A-HJ-NP-Z2-9]{10}$`), result.TrackingCode)
+			require.Equal(t, result.TrackingCode, stamped)
+			require.Equal(t, 4, result.Id)
+			require.Equal(t, models.StatusCreated, result.Status)
+			require.Equal(t, tc.req.PurchaseOrderIds, added)
+			require.Len(t, events, 1)
+			require.Equal(t, models.StatusCreated, events[0].Status)
+			require.Equal(t, 4, events[0].ShipmentId)
+		})
+	}
+}
+
+func TestShipmentService_RecordEvent(t *testing.T) {
+	testCases := []struct {
+		name        string
+		status      string
+		req         models.PostEvent
+		wantErrCode string
+	}{
+		{
+			name:   "success - created shipment picked up",
+			status: models.StatusCreated,
+			req:    models.PostEvent{Status: models.StatusPickedUp},
+		},
+		{
+			name:   "success - scan while in transit",
+			status: models.StatusInTransit,
+			req:    models.PostEvent{Status: models.StatusInTransit, Location: "Rosario hub"},
+		},
+		{
+			name:   "success - failed delivery attempted again",
+			status: models.StatusFailed,
+			req:    models.PostEvent{Status: models.StatusInTransit},
+		},
+		{
+			name:        "error - delivered shipment cannot fail",
+			status:      models.StatusDelivered,
+			req:         models.PostEvent{Status: models.StatusFailed},
+			wantErrCode: apperrors.CodeConflict,
+		},
+		{
+			name:        "error - delivered before pick up",
+			status:      models.StatusCreated,
+			req:         models.PostEvent{Status: models.StatusDelivered},
+			wantErrCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rolledBack, committed bool
+			var recorded *models.Event
+			var updated *models.Shipment
+			rp := &mocks.ShipmentRepositoryMock{
+				FuncFindByTrackingCodeForUpdate: func(ctx context.Context, exec repository.Executor, trackingCode string) (*models.Shipment, error) {
+					s := testhelpers.DummyShipment(tc.status)
+					return &s, nil
+				},
+				FuncCreateEvent: func(ctx context.Context, exec repository.Executor, e models.Event) (int, error) {
+					recorded = &e
+					return 2, nil
+				},
+				FuncUpdateStatus: func(ctx context.Context, exec repository.Executor, s models.Shipment) error {
+					updated = &s
+					return nil
+				},
+				FuncFindByTrackingCode: func(ctx context.Context, trackingCode string) (*models.Shipment, error) {
+					return updated, nil
+				},
+				FuncCommitTx: func(tx *sql.Tx) error {
+					committed = true
+					return nil
+				},
+				FuncRollbackTx: func(tx *sql.Tx) error {
+					rolledBack = true
+					return nil
+				},
+			}
+			svc := service.NewShipmentService(rp)
+
+			result, err := svc.RecordEvent(context.Background(), "TRK-ABCDEFGH23", tc.req)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, result)
+				require.Nil(t, recorded)
+				require.True(t, rolledBack)
+				require.False(t, committed)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, committed)
+			require.Equal(t, tc.req.Status, recorded.Status)
+			require.Equal(t, tc.req.Location, recorded.Location)
+			require.False(t, recorded.OccurredAt.IsZero())
+			require.Equal(t, tc.req.Status, result.Status)
+		})
+	}
+}
+
+func TestShipmentService_Performance(t *testing.T) {
+	day := func(d, h int) *time.Time {
+		at := time.Date(2025, 6, d, h, 0, 0, 0, time.Local)
+		return &at
+	}
+
+	testCases := []struct {
+		name      string
+		shipments []models.ShipmentPerformance
+		want      []models.CarrierPerformance
+	}{
+		{
+			name:      "success - no shipments",
+			shipments: []models.ShipmentPerformance{},
+			want:      []models.CarrierPerformance{},
+		},
+		{
+			name: "success - one line per carrier",
+			shipments: []models.ShipmentPerformance{
+				// On time, 30 hours from pickup to delivery, after a failed attempt.
+				{ShipmentId: 1, CarrierId: 2, Cid: "CID-2", CreatedAt: *day(2, 8), PickedUpAt: day(2, 9), DeliveredAt: day(3, 15),
+					PromisedAt: day(4, 0), FailedAttempts: 1},
+				// Late, 18 hours from creation as the pickup was not recorded; cold chain with an excursion.
+				{ShipmentId: 2, CarrierId: 2, Cid: "CID-2", CreatedAt: *day(2, 9), DeliveredAt: day(3, 3), PromisedAt: day(3, 0),
+					ColdChain: true, Excursions: 2},
+				// Still on its way, cold chain kept.
+				{ShipmentId: 3, CarrierId: 2, Cid: "CID-2", CreatedAt: *day(5, 9), ColdChain: true},
+				// Delivered with no promised date.
+				{ShipmentId: 4, CarrierId: 3, Cid: "CID-3", CreatedAt: *day(2, 9), PickedUpAt: day(2, 10), DeliveredAt: day(2, 20)},
+				// Failed twice and never delivered.
+				{ShipmentId: 5, CarrierId: 4, Cid: "CID-4", CreatedAt: *day(2, 9), PromisedAt: day(3, 0), FailedAttempts: 2},
+			},
+			want: []models.CarrierPerformance{
+				{CarrierId: 2, Cid: "CID-2", ShipmentsHandled: 3, Delivered: 2, OnTime: 1, OnTimeRate: floatPtr(50),
+					AverageTransitHours: floatPtr(24), FailedDeliveries: 1, ColdChainShipments: 2, TemperatureExcursions: 1},
+				{CarrierId: 3, Cid: "CID-3", ShipmentsHandled: 1, Delivered: 1, AverageTransitHours: floatPtr(10)},
+				{CarrierId: 4, Cid: "CID-4", ShipmentsHandled: 1, FailedDeliveries: 2},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rp := &mocks.ShipmentRepositoryMock{
+				FuncFindPerformance: func(ctx context.Context, filter models.PerformanceFilter) ([]models.ShipmentPerformance, error) {
+					require.Equal(t, time.Date(2025, 6, 1, 0, 0, 0, 0, time.Local), filter.From)
+					require.Equal(t, time.Date(2025, 6, 30, 0, 0, 0, 0, time.Local), filter.To)
+					return tc.shipments, nil
+				},
+			}
+			sv := service.NewShipmentService(rp)
+
+			report, err := sv.Performance(context.Background(), models.PerformanceFilter{
+				From: time.Date(2025, 6, 1, 0, 0, 0, 0, time.Local),
+				To:   time.Date(2025, 6, 30, 17, 45, 0, 0, time.Local),
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, tc.want, report)
+		})
+	}
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipping"
+)
+
+// scopeRank orders the scopes of coverage areas, the more specific one winning when a carrier
+// covers a destination more than once.
+var scopeRank = map[string]int{
+	models.ScopeLocality: 3,
+	models.ScopeProvince: 2,
+	models.ScopeCountry:  1,
+}
+
+// FindAreas returns the coverage areas with their rate bands, ordered by carrier and optionally of a single carrier.
+func (s *shippingService) FindAreas(ctx context.Context, filter models.CoverageFilter) ([]models.CoverageArea, error) {
+	return s.rp.FindAreas(ctx, filter)
+}
+
+// FindAreaById returns the coverage area with its rate bands.
+// Returns a not found error if it does not exist.
+func (s *shippingService) FindAreaById(ctx context.Context, id int) (*models.CoverageArea, error) {
+	return s.rp.FindAreaById(ctx, id)
+}
+
+// CreateArea inserts the area and its rate bands in one transaction.
+// A carrier can cover a locality, province or country only once; its rate card is edited by replacing the area.
+func (s *shippingService) CreateArea(ctx context.Context, req models.PostCoverageArea) (*models.CoverageArea, error) {
+	area := models.CoverageArea{
+		CarrierId:   req.CarrierId,
+		LocalityId:  req.LocalityId,
+		ProvinceId:  req.ProvinceId,
+		CountryId:   req.CountryId,
+		TransitDays: req.TransitDays,
+		CreatedAt:   time.Now(),
+		RateBands:   make([]models.RateBand, 0, len(req.RateBands)),
+	}
+
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	exists, err := s.rp.ExistsArea(ctx, tx, area)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		err = apperrors.NewAppError(apperrors.CodeConflict, "carrier already covers this area").
+			WithDetail("carrier_id", req.CarrierId)
+		return nil, err
+	}
+	area.Id, err = s.rp.CreateArea(ctx, tx, area)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range req.RateBands {
+		band := models.RateBand{CoverageAreaId: area.Id, MaxWeight: b.MaxWeight, MaxVolume: b.MaxVolume, Price: b.Price}
+		band.Id, err = s.rp.CreateBand(ctx, tx, band)
+		if err != nil {
+			return nil, err
+		}
+		area.RateBands = append(area.RateBands, band)
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+	return &area, nil
+}
+
+// DeleteArea removes the rate bands and then the area in one transaction.
+func (s *shippingService) DeleteArea(ctx context.Context, id int) error {
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	if err = s.rp.DeleteBands(ctx, tx, id); err != nil {
+		return err
+	}
+	if err = s.rp.DeleteArea(ctx, tx, id); err != nil {
+		return err
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+	return nil
+}
+
+// Quote adds up the weight and volume of the items from their products, then prices the shipment with every
+// carrier that covers both the warehouse, where it collects, and the destination. A carrier is priced with its
+// most specific area of the destination and the cheapest band the shipment fits in, and left out when it fits
+// in none. Quotes are ranked by price, then transit days.
+func (s *shippingService) Quote(ctx context.Context, req models.QuoteRequest) (*models.Quote, error) {
+	origin, err := s.rp.FindWarehousePlace(ctx, req.WarehouseId)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := s.rp.FindPlace(ctx, req.DestinationLocalityId)
+	if err != nil {
+		return nil, err
+	}
+	weight, volume, err := s.measure(ctx, req.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	collecting, err := s.rp.FindCoverage(ctx, *origin)
+	if err != nil {
+		return nil, err
+	}
+	collects := make(map[int]bool, len(collecting))
+	for _, c := range collecting {
+		collects[c.CarrierId] = true
+	}
+
+	delivering, err := s.rp.FindCoverage(ctx, *destination)
+	if err != nil {
+		return nil, err
+	}
+	best := make(map[int]models.CarrierCoverage)
+	for _, c := range delivering {
+		if !collects[c.CarrierId] {
+			continue
+		}
+		if current, ok := best[c.CarrierId]; !ok || scopeRank[scopeOf(c.CoverageArea)] > scopeRank[scopeOf(current.CoverageArea)] {
+			best[c.CarrierId] = c
+		}
+	}
+
+	quotes := make([]models.CarrierQuote, 0, len(best))
+	for _, c := range best {
+		band, ok := cheapestBand(c.RateBands, weight, volume)
+		if !ok {
+			continue
+		}
+		quotes = append(quotes, models.CarrierQuote{
+			CarrierId:      c.CarrierId,
+			Cid:            c.Cid,
+			CompanyName:    c.CompanyName,
+			CoverageAreaId: c.Id,
+			Scope:          scopeOf(c.CoverageArea),
+			RateBandId:     band.Id,
+			TransitDays:    c.TransitDays,
+			Price:          band.Price,
+		})
+	}
+	sort.Slice(quotes, func(i, j int) bool {
+		if quotes[i].Price != quotes[j].Price {
+			return quotes[i].Price < quotes[j].Price
+		}
+		if quotes[i].TransitDays != quotes[j].TransitDays {
+			return quotes[i].TransitDays < quotes[j].TransitDays
+		}
+		return quotes[i].CarrierId < quotes[j].CarrierId
+	})
+	for i := range quotes {
+		quotes[i].Rank = i + 1
+	}
+
+	return &models.Quote{
+		WarehouseId:           req.WarehouseId,
+		DestinationLocalityId: req.DestinationLocalityId,
+		TotalWeight:           weight,
+		TotalVolume:           volume,
+		Quotes:                quotes,
+	}, nil
+}
+
+// measure returns the total weight and volume of the items.
+// Returns a not found error naming the first product that does not exist.
+func (s *shippingService) measure(ctx context.Context, items []models.QuoteItem) (float64, float64, error) {
+	ids := make([]int, len(items))
+	for i, it := range items {
+		ids[i] = it.ProductId
+	}
+	measures, err := s.rp.FindProductMeasures(ctx, ids)
+	if err != nil {
+		return 0, 0, err
+	}
+	byId := make(map[int]models.ProductMeasures, len(measures))
+	for _, m := range measures {
+		byId[m.ProductId] = m
+	}
+
+	var weight, volume float64
+	for _, it := range items {
+		m, ok := byId[it.ProductId]
+		if !ok {
+			return 0, 0, apperrors.NewAppError(apperrors.CodeNotFound, "product not found").WithDetail("product_id", it.ProductId)
+		}
+		weight += m.NetWeight * float64(it.Quantity)
+		volume += m.Width * m.Height * m.Length * float64(it.Quantity)
+	}
+	return weight, volume, nil
+}
+
+// cheapestBand returns the cheapest band whose limits the shipment fits within.
+func cheapestBand(bands []models.RateBand, weight, volume float64) (models.RateBand, bool) {
+	var best models.RateBand
+	found := false
+	for _, b := range bands {
+		if weight > b.MaxWeight || volume > b.MaxVolume {
+			continue
+		}
+		if !found || b.Price < best.Price {
+			best = b
+			found = true
+		}
+	}
+	return best, found
+}
+
+func scopeOf(a models.CoverageArea) string {
+	switch {
+	case a.LocalityId != nil:
+		return models.ScopeLocality
+	case a.ProvinceId != nil:
+		return models.ScopeProvince
+	default:
+		return models.ScopeCountry
+	}
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/shipping"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipping"
+)
+
+// ShippingService manages where carriers deliver and what they charge, and quotes shipments against it.
+type ShippingService interface {
+	// FindAreas returns the coverage areas matching the filter.
+	FindAreas(ctx context.Context, filter models.CoverageFilter) ([]models.CoverageArea, error)
+
+	// FindAreaById returns a coverage area with its rate card.
+	FindAreaById(ctx context.Context, id int) (*models.CoverageArea, error)
+
+	// CreateArea adds a coverage area with its rate card to a carrier.
+	CreateArea(ctx context.Context, req models.PostCoverageArea) (*models.CoverageArea, error)
+
+	// DeleteArea removes a coverage area and its rate card.
+	DeleteArea(ctx context.Context, id int) error
+
+	// Quote ranks the carriers able to ship the items from a warehouse to a locality.
+	Quote(ctx context.Context, req models.QuoteRequest) (*models.Quote, error)
+}
+
+// shippingService implements ShippingService using a repository.
+type shippingService struct {
+	rp repository.ShippingRepository
+}
+
+// NewShippingService creates a new ShippingService using the provided repository.
+func NewShippingService(rp repository.ShippingRepository) ShippingService {
+	return &shippingService{
+		rp: rp,
+	}
+}
@@ -0,0 +1,206 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/shipping"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/shipping"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/shipping"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipping"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestShippingService_Quote(t *testing.T) {
+	origin := models.Place{LocalityId: "1000", ProvinceId: 1, CountryId: 1}
+	destination := models.Place{LocalityId: "1001", ProvinceId: 2, CountryId: 1}
+	band := func(id int, maxWeight, price float64) models.RateBand {
+		return models.RateBand{Id: id, MaxWeight: maxWeight, MaxVolume: 5000, Price: price}
+	}
+
+	// Carrier 2 covers the whole country and, more specifically, the destination locality, where only its
+	// heavier band fits. Carrier 3 is cheaper country wide. Carrier 5 does not collect at the warehouse and
+	// carrier 6 has no band large enough.
+	country2 := testhelpers.DummyCarrierCoverage(10, 2, 1, 2, band(100, 10, 900))
+	locality2 := testhelpers.DummyCarrierCoverage(11, 2, 1, 1, band(110, 5, 500), band(111, 20, 700))
+	locality2.CountryId = nil
+	locality2.LocalityId = testhelpers.StringPtr("1001")
+	country3 := testhelpers.DummyCarrierCoverage(12, 3, 1, 4, band(120, 10, 650))
+	province5 := testhelpers.DummyCarrierCoverage(13, 5, 1, 1, band(130, 10, 100))
+	province5.CountryId = nil
+	province5.ProvinceId = testhelpers.IntPtr(2)
+	country6 := testhelpers.DummyCarrierCoverage(14, 6, 1, 1, band(140, 5, 100))
+
+	measures := []models.ProductMeasures{
+		{ProductId: 1, NetWeight: 2, Width: 10, Height: 10, Length: 10},
+		{ProductId: 2, NetWeight: 1.5},
+	}
+
+	testCases := []struct {
+		name        string
+		req         models.QuoteRequest
+		warehouse   error
+		wantQuotes  []models.CarrierQuote
+		wantErrCode string
+	}{
+		{
+			name: "success - ranked by price",
+			req: models.QuoteRequest{WarehouseId: 1, DestinationLocalityId: "1001", Items: []models.QuoteItem{
+				{ProductId: 1, Quantity: 3}, {ProductId: 2, Quantity: 2},
+			}},
+			wantQuotes: []models.CarrierQuote{
+				{Rank: 1, CarrierId: 3, Cid: "CID-3", CompanyName: "Carrier", CoverageAreaId: 12, Scope: models.ScopeCountry, RateBandId: 120, TransitDays: 4, Price: 650},
+				{Rank: 2, CarrierId: 2, Cid: "CID-2", CompanyName: "Carrier", CoverageAreaId: 11, Scope: models.ScopeLocality, RateBandId: 111, TransitDays: 1, Price: 700},
+			},
+		},
+		{
+			name: "success - too heavy for every carrier",
+			req: models.QuoteRequest{WarehouseId: 1, DestinationLocalityId: "1001", Items: []models.QuoteItem{
+				{ProductId: 1, Quantity: 20},
+			}},
+			wantQuotes: []models.CarrierQuote{},
+		},
+		{
+			name: "error - product not found",
+			req: models.QuoteRequest{WarehouseId: 1, DestinationLocalityId: "1001", Items: []models.QuoteItem{
+				{ProductId: 9, Quantity: 1},
+			}},
+			wantErrCode: apperrors.CodeNotFound,
+		},
+		{
+			name:        "error - warehouse not found",
+			req:         models.QuoteRequest{WarehouseId: 99, DestinationLocalityId: "1001", Items: []models.QuoteItem{{ProductId: 1, Quantity: 1}}},
+			warehouse:   apperrors.NewAppError(apperrors.CodeNotFound, "warehouse not found"),
+			wantErrCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rp := &mocks.ShippingRepositoryMock{
+				FuncFindWarehousePlace: func(ctx context.Context, warehouseId int) (*models.Place, error) {
+					if tc.warehouse != nil {
+						return nil, tc.warehouse
+					}
+					return &origin, nil
+				},
+				FuncFindPlace: func(ctx context.Context, localityId string) (*models.Place, error) {
+					require.Equal(t, "1001", localityId)
+					return &destination, nil
+				},
+				FuncFindProductMeasures: func(ctx context.Context, productIds []int) ([]models.ProductMeasures, error) {
+					found := make([]models.ProductMeasures, 0)
+					for _, m := range measures {
+						for _, id := range productIds {
+							if m.ProductId == id {
+								found = append(found, m)
+							}
+						}
+					}
+					return found, nil
+				},
+				FuncFindCoverage: func(ctx context.Context, place models.Place) ([]models.CarrierCoverage, error) {
+					if place == origin {
+						return []models.CarrierCoverage{country2, country3, country6}, nil
+					}
+					return []models.CarrierCoverage{country2, locality2, country3, province5, country6}, nil
+				},
+			}
+			sv := service.NewShippingService(rp)
+
+			quote, err := sv.Quote(context.Background(), tc.req)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, quote)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantQuotes, quote.Quotes)
+		})
+	}
+
+	t.Run("success - totals from product measures", func(t *testing.T) {
+		rp := &mocks.ShippingRepositoryMock{
+			FuncFindWarehousePlace: func(ctx context.Context, warehouseId int) (*models.Place, error) { return &origin, nil },
+			FuncFindPlace:          func(ctx context.Context, localityId string) (*models.Place, error) { return &destination, nil },
+			FuncFindProductMeasures: func(ctx context.Context, productIds []int) ([]models.ProductMeasures, error) {
+				return measures, nil
+			},
+		}
+		sv := service.NewShippingService(rp)
+
+		quote, err := sv.Quote(context.Background(), models.QuoteRequest{WarehouseId: 1, DestinationLocalityId: "1001", Items: []models.QuoteItem{
+			{ProductId: 1, Quantity: 3}, {ProductId: 2, Quantity: 2},
+		}})
+
+		require.NoError(t, err)
+		require.Equal(t, 9.0, quote.TotalWeight)
+		require.Equal(t, 3000.0, quote.TotalVolume)
+		require.Empty(t, quote.Quotes)
+	})
+}
+
+func TestShippingService_CreateArea(t *testing.T) {
+	testCases := []struct {
+		name        string
+		exists      bool
+		bandErr     error
+		wantErrCode string
+	}{
+		{name: "success"},
+		{name: "error - area already covered", exists: true, wantErrCode: apperrors.CodeConflict},
+		{
+			name:        "error - band rejected",
+			bandErr:     apperrors.NewAppError(apperrors.CodeConflict, "rate band already exists"),
+			wantErrCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rolledBack, committed bool
+			bands := make([]models.RateBand, 0)
+			rp := &mocks.ShippingRepositoryMock{
+				FuncExistsArea: func(ctx context.Context, exec repository.Executor, a models.CoverageArea) (bool, error) {
+					require.Equal(t, 2, *a.ProvinceId)
+					return tc.exists, nil
+				},
+				FuncCreateArea: func(ctx context.Context, exec repository.Executor, a models.CoverageArea) (int, error) {
+					require.Equal(t, 3, a.TransitDays)
+					return 7, nil
+				},
+				FuncCreateBand: func(ctx context.Context, exec repository.Executor, b models.RateBand) (int, error) {
+					if tc.bandErr != nil {
+						return 0, tc.bandErr
+					}
+					require.Equal(t, 7, b.CoverageAreaId)
+					bands = append(bands, b)
+					return len(bands), nil
+				},
+				FuncCommitTx:   func(tx *sql.Tx) error { committed = true; return nil },
+				FuncRollbackTx: func(tx *sql.Tx) error { rolledBack = true; return nil },
+			}
+			sv := service.NewShippingService(rp)
+
+			area, err := sv.CreateArea(context.Background(), testhelpers.DummyPostCoverageArea())
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, area)
+				require.True(t, rolledBack)
+				require.False(t, committed)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, committed)
+			require.Equal(t, 7, area.Id)
+			require.Len(t, area.RateBands, 2)
+			require.Equal(t, 2, area.RateBands[1].Id)
+			require.Equal(t, 700.0, area.RateBands[1].Price)
+		})
+	}
+}
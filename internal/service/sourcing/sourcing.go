@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/geo"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/sourcing"
+)
+
+// candidate is a warehouse holding some of the requested products, with its distance to the buyer.
+type candidate struct {
+	id       int
+	code     string
+	distance *float64
+	stock    map[int]int
+}
+
+// Plan merges the lines per product and ranks the warehouses holding stock by distance to the delivery locality,
+// warehouses without coordinates last. The nearest warehouse able to fill the whole order ships it alone;
+// when there is none, each warehouse in turn ships all it can of what is still missing.
+// Returns a conflict error if the delivery locality has no coordinates.
+func (s *sourcingService) Plan(ctx context.Context, req models.SourcingRequest) (*models.SourcingPlan, error) {
+	place, err := s.places.FindPlace(ctx, req.DeliveryLocalityId)
+	if err != nil {
+		return nil, err
+	}
+	if place.Latitude == nil || place.Longitude == nil {
+		return nil, apperrors.NewAppError(apperrors.CodeConflict, "delivery locality has no coordinates").
+			WithDetail("locality_id", req.DeliveryLocalityId)
+	}
+	destination := geo.Point{Latitude: *place.Latitude, Longitude: *place.Longitude}
+
+	requested := make([]models.SourcingLine, 0, len(req.Lines))
+	index := make(map[int]int, len(req.Lines))
+	for _, l := range req.Lines {
+		if i, ok := index[l.ProductId]; ok {
+			requested[i].Quantity += l.Quantity
+			continue
+		}
+		index[l.ProductId] = len(requested)
+		requested = append(requested, l)
+	}
+	productIds := make([]int, len(requested))
+	for i, l := range requested {
+		productIds[i] = l.ProductId
+	}
+
+	stock, err := s.rp.FindStock(ctx, productIds)
+	if err != nil {
+		return nil, err
+	}
+	candidates := rankCandidates(destination, stock)
+
+	plan := &models.SourcingPlan{
+		DeliveryLocalityId: req.DeliveryLocalityId,
+		Shipments:          []models.SourcedShipment{},
+		Shortages:          []models.Shortage{},
+	}
+	for _, c := range candidates {
+		if fillsAll(c, requested) {
+			plan.Shipments = append(plan.Shipments, ship(c, requested))
+			plan.Fulfillable = true
+			return plan, nil
+		}
+	}
+
+	missing := make([]models.SourcingLine, len(requested))
+	copy(missing, requested)
+	for _, c := range candidates {
+		shipment := ship(c, missing)
+		if len(shipment.Lines) == 0 {
+			continue
+		}
+		plan.Shipments = append(plan.Shipments, shipment)
+		for _, l := range shipment.Lines {
+			missing[index[l.ProductId]].Quantity -= l.Quantity
+		}
+	}
+	for i, l := range missing {
+		if l.Quantity > 0 {
+			plan.Shortages = append(plan.Shortages, models.Shortage{
+				ProductId: l.ProductId,
+				Requested: requested[i].Quantity,
+				Available: requested[i].Quantity - l.Quantity,
+			})
+		}
+	}
+	plan.Fulfillable = len(plan.Shortages) == 0
+	plan.Split = len(plan.Shipments) > 1
+	return plan, nil
+}
+
+// rankCandidates folds the stock rows into warehouses ordered by distance to the destination,
+// then by id; warehouses without coordinates go last.
+func rankCandidates(destination geo.Point, stock []models.WarehouseStock) []candidate {
+	candidates := make([]candidate, 0)
+	for _, s := range stock {
+		if n := len(candidates); n == 0 || candidates[n-1].id != s.WarehouseId {
+			c := candidate{id: s.WarehouseId, code: s.WarehouseCode, stock: map[int]int{}}
+			if s.Latitude != nil && s.Longitude != nil {
+				km := math.Round(geo.Distance(destination, geo.Point{Latitude: *s.Latitude, Longitude: *s.Longitude})*100) / 100
+				c.distance = &km
+			}
+			candidates = append(candidates, c)
+		}
+		candidates[len(candidates)-1].stock[s.ProductId] += s.Available
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i].distance, candidates[j].distance
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		if *a != *b {
+			return *a < *b
+		}
+		return candidates[i].id < candidates[j].id
+	})
+	return candidates
+}
+
+// fillsAll reports whether the warehouse has enough of every line.
+func fillsAll(c candidate, lines []models.SourcingLine) bool {
+	for _, l := range lines {
+		if c.stock[l.ProductId] < l.Quantity {
+			return false
+		}
+	}
+	return true
+}
+
+// ship takes from the warehouse as much of each line as it has.
+func ship(c candidate, lines []models.SourcingLine) models.SourcedShipment {
+	shipment := models.SourcedShipment{WarehouseId: c.id, WarehouseCode: c.code, DistanceKm: c.distance, Lines: []models.SourcingLine{}}
+	for _, l := range lines {
+		if take := min(l.Quantity, c.stock[l.ProductId]); take > 0 {
+			shipment.Lines = append(shipment.Lines, models.SourcingLine{ProductId: l.ProductId, Quantity: take})
+		}
+	}
+	return shipment
+}
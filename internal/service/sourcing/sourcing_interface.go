@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	routingRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/routing"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/sourcing"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/sourcing"
+)
+
+// SourcingService chooses the warehouses that fill an order, closest to the buyer first.
+type SourcingService interface {
+	// Plan picks the warehouses to ship the order lines from to the delivery locality, without reserving any stock.
+	Plan(ctx context.Context, req models.SourcingRequest) (*models.SourcingPlan, error)
+}
+
+// sourcingService implements SourcingService using a repository.
+// Where the delivery locality is comes from the routing repository.
+type sourcingService struct {
+	rp     repository.SourcingRepository
+	places routingRepository.RoutingRepository
+}
+
+// NewSourcingService creates a new SourcingService using the provided repositories.
+func NewSourcingService(rp repository.SourcingRepository, places routingRepository.RoutingRepository) SourcingService {
+	return &sourcingService{
+		rp:     rp,
+		places: places,
+	}
+}
@@ -0,0 +1,145 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/sourcing"
+	routingMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/routing"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/sourcing"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	routingModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/routing"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/sourcing"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestSourcingService_Plan(t *testing.T) {
+	// The buyer is at 0,0 on the equator. Warehouse 5 is one degree of longitude away, warehouse 2 two degrees
+	// and warehouse 3 has no coordinates.
+	stockRow := func(warehouseId int, longitude *float64, productId, available int) models.WarehouseStock {
+		s := models.WarehouseStock{WarehouseId: warehouseId, WarehouseCode: "W" + string(rune('0'+warehouseId)), ProductId: productId, Available: available}
+		if longitude != nil {
+			s.Latitude, s.Longitude = testhelpers.Float64Ptr(0), longitude
+		}
+		return s
+	}
+	stock := []models.WarehouseStock{
+		stockRow(2, testhelpers.Float64Ptr(2), 7, 5),
+		stockRow(2, testhelpers.Float64Ptr(2), 8, 2),
+		stockRow(3, nil, 7, 3),
+		stockRow(5, testhelpers.Float64Ptr(1), 7, 10),
+	}
+	near, far := testhelpers.Float64Ptr(111.19), testhelpers.Float64Ptr(222.39)
+	line := func(productId, quantity int) models.SourcingLine {
+		return models.SourcingLine{ProductId: productId, Quantity: quantity}
+	}
+
+	testCases := []struct {
+		name        string
+		lines       []models.SourcingLine
+		place       *routingModels.Place
+		wantPlan    *models.SourcingPlan
+		wantErrCode string
+	}{
+		{
+			name:  "success - farther warehouse holding everything ships alone",
+			lines: []models.SourcingLine{line(7, 5), line(8, 2)},
+			wantPlan: &models.SourcingPlan{
+				DeliveryLocalityId: "1000", Fulfillable: true,
+				Shipments: []models.SourcedShipment{{WarehouseId: 2, WarehouseCode: "W2", DistanceKm: far, Lines: []models.SourcingLine{line(7, 5), line(8, 2)}}},
+				Shortages: []models.Shortage{},
+			},
+		},
+		{
+			name:  "success - lines of the same product are merged",
+			lines: []models.SourcingLine{line(7, 3), line(7, 3)},
+			wantPlan: &models.SourcingPlan{
+				DeliveryLocalityId: "1000", Fulfillable: true,
+				Shipments: []models.SourcedShipment{{WarehouseId: 5, WarehouseCode: "W5", DistanceKm: near, Lines: []models.SourcingLine{line(7, 6)}}},
+				Shortages: []models.Shortage{},
+			},
+		},
+		{
+			name:  "success - split nearest first",
+			lines: []models.SourcingLine{line(7, 12), line(8, 2)},
+			wantPlan: &models.SourcingPlan{
+				DeliveryLocalityId: "1000", Fulfillable: true, Split: true,
+				Shipments: []models.SourcedShipment{
+					{WarehouseId: 5, WarehouseCode: "W5", DistanceKm: near, Lines: []models.SourcingLine{line(7, 10)}},
+					{WarehouseId: 2, WarehouseCode: "W2", DistanceKm: far, Lines: []models.SourcingLine{line(7, 2), line(8, 2)}},
+				},
+				Shortages: []models.Shortage{},
+			},
+		},
+		{
+			name:  "success - shortage after every warehouse",
+			lines: []models.SourcingLine{line(7, 20), line(8, 2)},
+			wantPlan: &models.SourcingPlan{
+				DeliveryLocalityId: "1000", Split: true,
+				Shipments: []models.SourcedShipment{
+					{WarehouseId: 5, WarehouseCode: "W5", DistanceKm: near, Lines: []models.SourcingLine{line(7, 10)}},
+					{WarehouseId: 2, WarehouseCode: "W2", DistanceKm: far, Lines: []models.SourcingLine{line(7, 5), line(8, 2)}},
+					{WarehouseId: 3, WarehouseCode: "W3", Lines: []models.SourcingLine{line(7, 3)}},
+				},
+				Shortages: []models.Shortage{{ProductId: 7, Requested: 20, Available: 18}},
+			},
+		},
+		{
+			name:        "error - delivery locality without coordinates",
+			lines:       []models.SourcingLine{line(7, 1)},
+			place:       &routingModels.Place{LocalityId: "1000"},
+			wantErrCode: apperrors.CodeConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			place := tc.place
+			if place == nil {
+				place = &routingModels.Place{LocalityId: "1000", Latitude: testhelpers.Float64Ptr(0), Longitude: testhelpers.Float64Ptr(0)}
+			}
+			places := &routingMocks.RoutingRepositoryMock{
+				FuncFindPlace: func(ctx context.Context, localityId string) (*routingModels.Place, error) {
+					return place, nil
+				},
+			}
+			rp := &mocks.SourcingRepositoryMock{
+				FuncFindStock: func(ctx context.Context, productIds []int) ([]models.WarehouseStock, error) {
+					var rows []models.WarehouseStock
+					for _, s := range stock {
+						for _, id := range productIds {
+							if s.ProductId == id {
+								rows = append(rows, s)
+							}
+						}
+					}
+					return rows, nil
+				},
+			}
+			sv := service.NewSourcingService(rp, places)
+
+			plan, err := sv.Plan(context.Background(), models.SourcingRequest{DeliveryLocalityId: "1000", Lines: tc.lines})
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantPlan, plan)
+		})
+	}
+}
+
+func TestSourcingService_Plan_LocalityNotFound(t *testing.T) {
+	places := &routingMocks.RoutingRepositoryMock{
+		FuncFindPlace: func(ctx context.Context, localityId string) (*routingModels.Place, error) {
+			return nil, apperrors.NewAppError(apperrors.CodeNotFound, "locality not found")
+		},
+	}
+	sv := service.NewSourcingService(&mocks.SourcingRepositoryMock{}, places)
+
+	_, err := sv.Plan(context.Background(), models.SourcingRequest{DeliveryLocalityId: "9999", Lines: []models.SourcingLine{{ProductId: 7, Quantity: 1}}})
+
+	testhelpers.RequireAppErr(t, err, apperrors.CodeNotFound)
+}
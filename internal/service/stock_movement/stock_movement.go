@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+)
+
+// FindByBatch returns the ledger of a product batch.
+// Returns a not found error if the batch does not exist, so an unknown id is not mistaken for an empty ledger.
+func (s *stockMovementService) FindByBatch(ctx context.Context, batchId int) ([]models.StockMovement, error) {
+	exists, err := s.rp.BatchExists(ctx, batchId)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, apperrors.NewAppError(apperrors.CodeNotFound, "product batch not found")
+	}
+	return s.rp.FindByBatch(ctx, batchId)
+}
+
+// Reconcile returns the batches whose current_quantity no longer matches the sum of their movements.
+func (s *stockMovementService) Reconcile(ctx context.Context) ([]models.BatchDrift, error) {
+	return s.rp.FindDrift(ctx)
+}
@@ -0,0 +1,77 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/stock_movement"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestStockMovementService_FindByBatch(t *testing.T) {
+	movements := []models.StockMovement{
+		testhelpers.DummyStockMovement(1, 1, 50, models.MovementTypeReceipt),
+		testhelpers.DummyStockMovement(2, 1, -5, models.MovementTypeAllocation),
+	}
+
+	testCases := []struct {
+		name     string
+		mockRepo func() *mocks.StockMovementRepositoryMock
+		expected []models.StockMovement
+		errCode  string
+	}{
+		{
+			name: "success - returns the ledger",
+			mockRepo: func() *mocks.StockMovementRepositoryMock {
+				return &mocks.StockMovementRepositoryMock{
+					FuncFindByBatch: func(ctx context.Context, batchId int) ([]models.StockMovement, error) {
+						return movements, nil
+					},
+				}
+			},
+			expected: movements,
+		},
+		{
+			name: "error - batch not found",
+			mockRepo: func() *mocks.StockMovementRepositoryMock {
+				return &mocks.StockMovementRepositoryMock{
+					FuncBatchExists: func(ctx context.Context, batchId int) (bool, error) {
+						return false, nil
+					},
+				}
+			},
+			errCode: apperrors.CodeNotFound,
+		},
+		{
+			name: "error - repository error",
+			mockRepo: func() *mocks.StockMovementRepositoryMock {
+				return &mocks.StockMovementRepositoryMock{
+					FuncBatchExists: func(ctx context.Context, batchId int) (bool, error) {
+						return false, apperrors.NewAppError(apperrors.CodeInternal, "error checking product batch")
+					},
+				}
+			},
+			errCode: apperrors.CodeInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := service.NewStockMovementService(tc.mockRepo())
+
+			result, err := svc.FindByBatch(context.Background(), 1)
+
+			if tc.errCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.errCode)
+				require.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, result)
+		})
+	}
+}
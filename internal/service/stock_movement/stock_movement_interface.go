@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+)
+
+// StockMovementService exposes the stock ledger of product batches.
+type StockMovementService interface {
+	// FindByBatch returns the movements of a product batch, oldest first.
+	FindByBatch(ctx context.Context, batchId int) ([]models.StockMovement, error)
+
+	// Reconcile returns the batches whose current_quantity has drifted from their ledger balance.
+	Reconcile(ctx context.Context) ([]models.BatchDrift, error)
+}
+
+// stockMovementService implements StockMovementService using a repository.
+type stockMovementService struct {
+	rp repository.StockMovementRepository
+}
+
+// NewStockMovementService creates a new StockMovementService using the provided repository.
+func NewStockMovementService(rp repository.StockMovementRepository) StockMovementService {
+	return &stockMovementService{
+		rp: rp,
+	}
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/traceability"
+)
+
+// TraceBatch returns the batch with every order linked to it, oldest first.
+// Returns a not found error if the batch does not exist.
+func (s *traceabilityService) TraceBatch(ctx context.Context, batchId int) (*models.BatchTrace, error) {
+	batch, err := s.rp.FindBatch(ctx, batchId)
+	if err != nil {
+		return nil, err
+	}
+	orders, err := s.rp.FindBatchOrders(ctx, batchId)
+	if err != nil {
+		return nil, err
+	}
+	return &models.BatchTrace{
+		Batch:  *batch,
+		Orders: orders,
+	}, nil
+}
+
+// TraceOrder returns the order with the batches that served it. Each batch lists
+// the inbound orders that received it, with the employee and warehouse involved.
+// Returns a not found error if the order does not exist.
+func (s *traceabilityService) TraceOrder(ctx context.Context, orderId int) (*models.OrderTrace, error) {
+	order, err := s.rp.FindOrder(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	origins, err := s.rp.FindOrderOrigins(ctx, orderId)
+	if err != nil {
+		return nil, err
+	}
+	return &models.OrderTrace{
+		Order:   *order,
+		Batches: groupOrigins(origins),
+	}, nil
+}
+
+// AffectedBuyers traces every batch forward and merges the orders by buyer,
+// keeping buyers in the order they were first reached.
+func (s *traceabilityService) AffectedBuyers(ctx context.Context, batchIds []int) ([]models.AffectedBuyer, error) {
+	buyers := make([]models.AffectedBuyer, 0)
+	index := make(map[int]int)
+	for _, batchId := range batchIds {
+		orders, err := s.rp.FindBatchOrders(ctx, batchId)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range orders {
+			i, ok := index[o.BuyerId]
+			if !ok {
+				i = len(buyers)
+				index[o.BuyerId] = i
+				buyers = append(buyers, models.AffectedBuyer{
+					BuyerId:          o.BuyerId,
+					CardNumberId:     o.CardNumberId,
+					FirstName:        o.FirstName,
+					LastName:         o.LastName,
+					PurchaseOrderIds: []int{},
+					ProductBatchIds:  []int{},
+				})
+			}
+			buyers[i].PurchaseOrderIds = appendUnique(buyers[i].PurchaseOrderIds, o.PurchaseOrderId)
+			buyers[i].ProductBatchIds = appendUnique(buyers[i].ProductBatchIds, batchId)
+		}
+	}
+	return buyers, nil
+}
+
+// groupOrigins folds the rows returned for an order, one per batch and inbound order, into one entry per batch.
+// Rows arrive ordered by batch.
+func groupOrigins(origins []models.BatchOrigin) []models.OrderBatch {
+	batches := make([]models.OrderBatch, 0)
+	for _, o := range origins {
+		last := len(batches) - 1
+		if last < 0 || batches[last].ProductBatchId != o.ProductBatchId || batches[last].Link != o.Link {
+			batches = append(batches, models.OrderBatch{
+				ProductBatchId: o.ProductBatchId,
+				BatchNumber:    o.BatchNumber,
+				ProductId:      o.ProductId,
+				Quantity:       o.Quantity,
+				Link:           o.Link,
+				Inbound:        []models.InboundOrigin{},
+			})
+			last++
+		}
+		if o.InboundOrderId == nil {
+			continue
+		}
+		inbound := models.InboundOrigin{InboundOrderId: *o.InboundOrderId}
+		if o.InboundOrderNumber != nil {
+			inbound.OrderNumber = *o.InboundOrderNumber
+		}
+		if o.InboundOrderDate != nil {
+			inbound.OrderDate = *o.InboundOrderDate
+		}
+		if o.EmployeeId != nil {
+			inbound.EmployeeId = *o.EmployeeId
+			inbound.EmployeeFirstName = derefString(o.EmployeeFirstName)
+			inbound.EmployeeLastName = derefString(o.EmployeeLastName)
+		}
+		if o.WarehouseId != nil {
+			inbound.WarehouseId = *o.WarehouseId
+			inbound.WarehouseCode = derefString(o.WarehouseCode)
+		}
+		batches[last].Inbound = append(batches[last].Inbound, inbound)
+	}
+	return batches
+}
+
+func appendUnique(ids []int, id int) []int {
+	for _, v := range ids {
+		if v == id {
+			return ids
+		}
+	}
+	return append(ids, id)
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/traceability"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/traceability"
+)
+
+// TraceabilityService answers where a batch went and where an order came from.
+type TraceabilityService interface {
+	// TraceBatch returns the orders and buyers that received product from a batch.
+	TraceBatch(ctx context.Context, batchId int) (*models.BatchTrace, error)
+
+	// TraceOrder returns the batches that served an order and the inbound orders that received them.
+	TraceOrder(ctx context.Context, orderId int) (*models.OrderTrace, error)
+
+	// AffectedBuyers returns the distinct buyers reached by any of the batches.
+	AffectedBuyers(ctx context.Context, batchIds []int) ([]models.AffectedBuyer, error)
+}
+
+// traceabilityService implements TraceabilityService using a repository.
+type traceabilityService struct {
+	rp repository.TraceabilityRepository
+}
+
+// NewTraceabilityService creates a new TraceabilityService using the provided repository.
+func NewTraceabilityService(rp repository.TraceabilityRepository) TraceabilityService {
+	return &traceabilityService{
+		rp: rp,
+	}
+}
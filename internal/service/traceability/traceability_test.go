@@ -0,0 +1,108 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/traceability"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/traceability"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/traceability"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestTraceabilityService_TraceOrder(t *testing.T) {
+	testCases := []struct {
+		name        string
+		findErr     error
+		origins     []models.BatchOrigin
+		wantErrCode string
+		check       func(t *testing.T, batches []models.OrderBatch)
+	}{
+		{
+			name: "success - rows grouped by batch with their inbound orders",
+			origins: []models.BatchOrigin{
+				testhelpers.DummyBatchOrigin(1, testhelpers.IntPtr(5)),
+				testhelpers.DummyBatchOrigin(1, testhelpers.IntPtr(6)),
+				testhelpers.DummyBatchOrigin(2, nil),
+			},
+			check: func(t *testing.T, batches []models.OrderBatch) {
+				require.Len(t, batches, 2)
+				require.Equal(t, 1, batches[0].ProductBatchId)
+				require.Len(t, batches[0].Inbound, 2)
+				require.Equal(t, 5, batches[0].Inbound[0].InboundOrderId)
+				require.Equal(t, "Juan", batches[0].Inbound[0].EmployeeFirstName)
+				require.Equal(t, "WH001", batches[0].Inbound[0].WarehouseCode)
+				require.Equal(t, 2, batches[1].ProductBatchId)
+				require.Empty(t, batches[1].Inbound)
+			},
+		},
+		{
+			name:    "success - order without batches",
+			origins: []models.BatchOrigin{},
+			check: func(t *testing.T, batches []models.OrderBatch) {
+				require.NotNil(t, batches)
+				require.Empty(t, batches)
+			},
+		},
+		{
+			name:        "error - order not found",
+			findErr:     apperrors.NewAppError(apperrors.CodeNotFound, "purchase order not found"),
+			wantErrCode: apperrors.CodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rp := &mocks.TraceabilityRepositoryMock{
+				FuncFindOrder: func(ctx context.Context, orderId int) (*models.TracedPurchaseOrder, error) {
+					if tc.findErr != nil {
+						return nil, tc.findErr
+					}
+					return &models.TracedPurchaseOrder{Id: orderId, ProductId: 22}, nil
+				},
+				FuncFindOrderOrigins: func(ctx context.Context, orderId int) ([]models.BatchOrigin, error) {
+					return tc.origins, nil
+				},
+			}
+			sv := service.NewTraceabilityService(rp)
+
+			res, err := sv.TraceOrder(context.Background(), 7)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, res)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 7, res.Order.Id)
+			tc.check(t, res.Batches)
+		})
+	}
+}
+
+func TestTraceabilityService_AffectedBuyers(t *testing.T) {
+	ordersByBatch := map[int][]models.TracedOrder{
+		1: {testhelpers.DummyTracedOrder(1, 10, models.LinkAllocation), testhelpers.DummyTracedOrder(2, 20, models.LinkAllocation)},
+		2: {testhelpers.DummyTracedOrder(1, 10, models.LinkAllocation), testhelpers.DummyTracedOrder(3, 10, models.LinkProduct)},
+		3: {},
+	}
+	rp := &mocks.TraceabilityRepositoryMock{
+		FuncFindBatchOrders: func(ctx context.Context, batchId int) ([]models.TracedOrder, error) {
+			return ordersByBatch[batchId], nil
+		},
+	}
+	sv := service.NewTraceabilityService(rp)
+
+	buyers, err := sv.AffectedBuyers(context.Background(), []int{1, 2, 3})
+
+	require.NoError(t, err)
+	require.Len(t, buyers, 2)
+	require.Equal(t, 10, buyers[0].BuyerId)
+	require.Equal(t, []int{1, 3}, buyers[0].PurchaseOrderIds)
+	require.Equal(t, []int{1, 2}, buyers[0].ProductBatchIds)
+	require.Equal(t, 20, buyers[1].BuyerId)
+	require.Equal(t, []int{2}, buyers[1].PurchaseOrderIds)
+	require.Equal(t, []int{1}, buyers[1].ProductBatchIds)
+}
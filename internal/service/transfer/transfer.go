@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	movementModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/transfer"
+)
+
+// Transfer moves quantity units of a batch to req.ToSectionId in one transaction.
+// Moving the whole batch re-points it to the new section; moving part of it splits
+// the quantity off into a new batch with the next batch number.
+// Both sections' current_capacity are updated, the ledger gets a transfer movement out
+// and one in, and a batch_transfers row is written for audit. The reserved units of a batch
+// moved whole go with it, so they count against the destination capacity as well; a partial
+// transfer leaves them in the source batch.
+func (s *transferService) Transfer(ctx context.Context, batchId int, req models.PostBatchTransfer) (*models.BatchTransfer, error) {
+	tx, err := s.rp.BeginTx(ctx)
+	if err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to start transaction").
+			WithDetail("error", err.Error())
+	}
+
+	defer func() {
+		if err != nil {
+			s.rp.RollbackTx(tx)
+		}
+	}()
+
+	batch, err := s.rp.FindBatchForUpdate(ctx, tx, batchId)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity := batch.CurrentQuantity
+	if req.Quantity != nil {
+		quantity = *req.Quantity
+	}
+	if quantity <= 0 || quantity > batch.CurrentQuantity {
+		err = apperrors.NewAppError(apperrors.CodeValidationError, "quantity must be between 1 and the batch current quantity").
+			WithDetail("requested", quantity).
+			WithDetail("current_quantity", batch.CurrentQuantity)
+		return nil, err
+	}
+	if req.ToSectionId == batch.SectionId {
+		err = apperrors.NewAppError(apperrors.CodeValidationError, "product batch is already in the destination section")
+		return nil, err
+	}
+
+	full := quantity == batch.CurrentQuantity
+	units := quantity
+	if full {
+		units += batch.ReservedQuantity
+	}
+
+	from, to, err := s.lockSections(ctx, tx, batch.SectionId, req.ToSectionId)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateDestination(to, batch, units); err != nil {
+		return nil, err
+	}
+
+	destinationBatchId := batch.Id
+	if full {
+		if err = s.rp.MoveBatch(ctx, tx, batch.Id, to.Id); err != nil {
+			return nil, err
+		}
+	} else {
+		destinationBatchId, err = s.splitBatch(ctx, tx, *batch, to.Id, quantity)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.postMovements(ctx, tx, batch.Id, destinationBatchId, from.Id, to.Id, quantity, req); err != nil {
+		return nil, err
+	}
+
+	if err = s.rp.AddSectionCapacity(ctx, tx, from.Id, -units); err != nil {
+		return nil, err
+	}
+	if err = s.rp.AddSectionCapacity(ctx, tx, to.Id, units); err != nil {
+		return nil, err
+	}
+
+	transfer, err := s.rp.CreateTransfer(ctx, tx, models.BatchTransfer{
+		SourceBatchId:      batch.Id,
+		DestinationBatchId: destinationBatchId,
+		FromSectionId:      from.Id,
+		ToSectionId:        to.Id,
+		FromWarehouseId:    from.WarehouseId,
+		ToWarehouseId:      to.WarehouseId,
+		Quantity:           quantity,
+		Reason:             req.Reason,
+		Actor:              req.Actor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.rp.CommitTx(tx); err != nil {
+		return nil, apperrors.NewAppError(apperrors.CodeInternal, "failed to commit transaction").
+			WithDetail("error", err.Error())
+	}
+
+	return transfer, nil
+}
+
+// FindByBatch returns the transfers a batch took part in, oldest first.
+func (s *transferService) FindByBatch(ctx context.Context, batchId int) ([]models.BatchTransfer, error) {
+	return s.rp.FindByBatch(ctx, batchId)
+}
+
+// validateDestination checks that the destination section has room for units more units
+// and is not colder than the batch minimum temperature.
+func validateDestination(to *models.TransferSection, batch *models.TransferBatch, units int) error {
+	if to.CurrentCapacity+units > to.MaximumCapacity {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "destination section does not have enough capacity").
+			WithDetail("section_id", to.Id).
+			WithDetail("available_capacity", to.MaximumCapacity-to.CurrentCapacity).
+			WithDetail("requested", units)
+	}
+	if to.CurrentTemperature < batch.MinimumTemperature {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "destination section is colder than the batch minimum temperature").
+			WithDetail("section_id", to.Id).
+			WithDetail("section_temperature", to.CurrentTemperature).
+			WithDetail("batch_minimum_temperature", batch.MinimumTemperature)
+	}
+	return nil
+}
+
+// lockSections locks the source and destination sections in id order,
+// so two opposite transfers cannot deadlock each other.
+func (s *transferService) lockSections(ctx context.Context, tx *sql.Tx, fromId, toId int) (*models.TransferSection, *models.TransferSection, error) {
+	firstId, secondId := fromId, toId
+	if toId < fromId {
+		firstId, secondId = toId, fromId
+	}
+	first, err := s.rp.FindSectionForUpdate(ctx, tx, firstId)
+	if err != nil {
+		return nil, nil, err
+	}
+	second, err := s.rp.FindSectionForUpdate(ctx, tx, secondId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if first.Id == fromId {
+		return first, second, nil
+	}
+	return second, first, nil
+}
+
+// splitBatch creates the batch that receives a partial transfer and returns its id.
+func (s *transferService) splitBatch(ctx context.Context, tx *sql.Tx, batch models.TransferBatch, toSectionId int, quantity int) (int, error) {
+	number, err := s.rp.NextBatchNumber(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+	batch.BatchNumber = number
+	batch.SectionId = toSectionId
+	return s.rp.CreateSplitBatch(ctx, tx, batch, quantity)
+}
+
+// postMovements records the stock leaving the source batch and entering the destination batch.
+// On a full transfer both movements belong to the same batch and its balance does not change.
+func (s *transferService) postMovements(ctx context.Context, tx *sql.Tx, sourceId, destinationId, fromId, toId, quantity int, req models.PostBatchTransfer) error {
+	_, err := s.ledger.Append(ctx, tx, movementModels.StockMovement{
+		ProductBatchId: sourceId,
+		MovementType:   movementModels.MovementTypeTransfer,
+		Quantity:       -quantity,
+		Reason:         fmt.Sprintf("transfer to section %d: %s", toId, req.Reason),
+		Actor:          req.Actor,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = s.ledger.Append(ctx, tx, movementModels.StockMovement{
+		ProductBatchId: destinationId,
+		MovementType:   movementModels.MovementTypeTransfer,
+		Quantity:       quantity,
+		Reason:         fmt.Sprintf("transfer from section %d: %s", fromId, req.Reason),
+		Actor:          req.Actor,
+	})
+	return err
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	stockMovementRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/transfer"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/transfer"
+)
+
+// TransferService moves product batches between sections, within or across warehouses.
+type TransferService interface {
+	// Transfer moves all or part of a batch to another section.
+	Transfer(ctx context.Context, batchId int, req models.PostBatchTransfer) (*models.BatchTransfer, error)
+
+	// FindByBatch returns the transfers a batch took part in.
+	FindByBatch(ctx context.Context, batchId int) ([]models.BatchTransfer, error)
+}
+
+// transferService implements TransferService using a repository and the stock ledger.
+type transferService struct {
+	rp     repository.TransferRepository
+	ledger stockMovementRepository.StockMovementRepository
+}
+
+// NewTransferService creates a new TransferService using the provided repositories.
+func NewTransferService(rp repository.TransferRepository, ledger stockMovementRepository.StockMovementRepository) TransferService {
+	return &transferService{
+		rp:     rp,
+		ledger: ledger,
+	}
+}
@@ -0,0 +1,216 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	stockMovementRepository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/transfer"
+	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/transfer"
+	movementMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/stock_movement"
+	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/transfer"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	movementModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/transfer"
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/testhelpers"
+)
+
+func TestTransferService_Transfer(t *testing.T) {
+	type recorded struct {
+		moved      bool
+		split      *models.TransferBatch
+		capacities map[int]int
+		ledger     map[int]int
+	}
+	type testCase struct {
+		name        string
+		batch       func() models.TransferBatch
+		req         models.PostBatchTransfer
+		destination func() models.TransferSection
+		wantErrCode string
+		check       func(t *testing.T, res *models.BatchTransfer, rec recorded)
+	}
+
+	testCases := []testCase{
+		{
+			name:        "success - full transfer across warehouses moves the batch",
+			req:         models.PostBatchTransfer{ToSectionId: 2, Reason: "rebalance", Actor: "jdoe"},
+			destination: func() models.TransferSection { return testhelpers.DummyTransferSection(2, 2) },
+			check: func(t *testing.T, res *models.BatchTransfer, rec recorded) {
+				require.True(t, rec.moved)
+				require.Nil(t, rec.split)
+				require.Equal(t, 1, res.DestinationBatchId)
+				require.Equal(t, 50, res.Quantity)
+				require.Equal(t, 1, res.FromWarehouseId)
+				require.Equal(t, 2, res.ToWarehouseId)
+				require.Equal(t, map[int]int{1: -50, 2: 50}, rec.capacities)
+				require.Equal(t, map[int]int{1: 0}, rec.ledger)
+			},
+		},
+		{
+			name:        "success - partial transfer splits a new batch",
+			req:         models.PostBatchTransfer{ToSectionId: 2, Quantity: testhelpers.IntPtr(20), Reason: "rebalance", Actor: "jdoe"},
+			destination: func() models.TransferSection { return testhelpers.DummyTransferSection(2, 1) },
+			check: func(t *testing.T, res *models.BatchTransfer, rec recorded) {
+				require.False(t, rec.moved)
+				require.NotNil(t, rec.split)
+				require.Equal(t, 500, rec.split.BatchNumber)
+				require.Equal(t, 2, rec.split.SectionId)
+				require.Equal(t, 7, res.DestinationBatchId)
+				require.Equal(t, map[int]int{1: -20, 2: 20}, rec.capacities)
+				require.Equal(t, map[int]int{1: -20, 7: 20}, rec.ledger)
+			},
+		},
+		{
+			name: "success - full transfer moves the reserved units with the batch",
+			batch: func() models.TransferBatch {
+				b := testhelpers.DummyTransferBatch()
+				b.ReservedQuantity = 10
+				return b
+			},
+			req:         models.PostBatchTransfer{ToSectionId: 2, Reason: "rebalance", Actor: "jdoe"},
+			destination: func() models.TransferSection { return testhelpers.DummyTransferSection(2, 1) },
+			check: func(t *testing.T, res *models.BatchTransfer, rec recorded) {
+				require.True(t, rec.moved)
+				require.Equal(t, 50, res.Quantity)
+				require.Equal(t, map[int]int{1: -60, 2: 60}, rec.capacities)
+				require.Equal(t, map[int]int{1: 0}, rec.ledger)
+			},
+		},
+		{
+			name: "success - partial transfer leaves the reserved units behind",
+			batch: func() models.TransferBatch {
+				b := testhelpers.DummyTransferBatch()
+				b.ReservedQuantity = 10
+				return b
+			},
+			req:         models.PostBatchTransfer{ToSectionId: 2, Quantity: testhelpers.IntPtr(20), Reason: "rebalance", Actor: "jdoe"},
+			destination: func() models.TransferSection { return testhelpers.DummyTransferSection(2, 1) },
+			check: func(t *testing.T, res *models.BatchTransfer, rec recorded) {
+				require.NotNil(t, rec.split)
+				require.Equal(t, map[int]int{1: -20, 2: 20}, rec.capacities)
+			},
+		},
+		{
+			name: "error - destination without room for the reserved units",
+			batch: func() models.TransferBatch {
+				b := testhelpers.DummyTransferBatch()
+				b.ReservedQuantity = 60
+				return b
+			},
+			req:         models.PostBatchTransfer{ToSectionId: 2, Reason: "rebalance", Actor: "jdoe"},
+			destination: func() models.TransferSection { return testhelpers.DummyTransferSection(2, 1) },
+			wantErrCode: apperrors.CodeValidationError,
+		},
+		{
+			name: "success - split of a quarantined batch stays quarantined",
+			batch: func() models.TransferBatch {
+				b := testhelpers.DummyTransferBatch()
+				b.Status = batchModels.BatchStatusQuarantined
+				b.StatusReason = testhelpers.Ptr("temperature_excursion")
+				b.StatusSetBy = testhelpers.Ptr("qa")
+				return b
+			},
+			req:         models.PostBatchTransfer{ToSectionId: 2, Quantity: testhelpers.IntPtr(20), Reason: "quarantine area", Actor: "jdoe"},
+			destination: func() models.TransferSection { return testhelpers.DummyTransferSection(2, 1) },
+			check: func(t *testing.T, res *models.BatchTransfer, rec recorded) {
+				require.NotNil(t, rec.split)
+				require.Equal(t, batchModels.BatchStatusQuarantined, rec.split.Status)
+				require.Equal(t, testhelpers.Ptr("temperature_excursion"), rec.split.StatusReason)
+				require.Equal(t, testhelpers.Ptr("qa"), rec.split.StatusSetBy)
+			},
+		},
+		{
+			name: "error - destination without capacity",
+			req:  models.PostBatchTransfer{ToSectionId: 2, Reason: "rebalance", Actor: "jdoe"},
+			destination: func() models.TransferSection {
+				s := testhelpers.DummyTransferSection(2, 1)
+				s.CurrentCapacity = 180
+				return s
+			},
+			wantErrCode: apperrors.CodeValidationError,
+		},
+		{
+			name: "error - destination too cold",
+			req:  models.PostBatchTransfer{ToSectionId: 2, Reason: "rebalance", Actor: "jdoe"},
+			destination: func() models.TransferSection {
+				s := testhelpers.DummyTransferSection(2, 1)
+				s.CurrentTemperature = -18
+				return s
+			},
+			wantErrCode: apperrors.CodeValidationError,
+		},
+		{
+			name:        "error - quantity above batch stock",
+			req:         models.PostBatchTransfer{ToSectionId: 2, Quantity: testhelpers.IntPtr(51), Reason: "rebalance", Actor: "jdoe"},
+			destination: func() models.TransferSection { return testhelpers.DummyTransferSection(2, 1) },
+			wantErrCode: apperrors.CodeValidationError,
+		},
+		{
+			name:        "error - same section",
+			req:         models.PostBatchTransfer{ToSectionId: 1, Reason: "rebalance", Actor: "jdoe"},
+			destination: func() models.TransferSection { return testhelpers.DummyTransferSection(1, 1) },
+			wantErrCode: apperrors.CodeValidationError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := recorded{capacities: map[int]int{}, ledger: map[int]int{}}
+			repoMock := &mocks.TransferRepositoryMock{
+				FuncFindBatchForUpdate: func(ctx context.Context, exec repository.Executor, batchId int) (*models.TransferBatch, error) {
+					b := testhelpers.DummyTransferBatch()
+					if tc.batch != nil {
+						b = tc.batch()
+					}
+					return &b, nil
+				},
+				FuncFindSectionForUpdate: func(ctx context.Context, exec repository.Executor, sectionId int) (*models.TransferSection, error) {
+					if sectionId == 1 {
+						s := testhelpers.DummyTransferSection(1, 1)
+						return &s, nil
+					}
+					s := tc.destination()
+					return &s, nil
+				},
+				FuncMoveBatch: func(ctx context.Context, exec repository.Executor, batchId int, sectionId int) error {
+					rec.moved = true
+					return nil
+				},
+				FuncNextBatchNumber: func(ctx context.Context, exec repository.Executor) (int, error) {
+					return 500, nil
+				},
+				FuncCreateSplitBatch: func(ctx context.Context, exec repository.Executor, b models.TransferBatch, initialQuantity int) (int, error) {
+					rec.split = &b
+					return 7, nil
+				},
+				FuncAddSectionCapacity: func(ctx context.Context, exec repository.Executor, sectionId int, delta int) error {
+					rec.capacities[sectionId] += delta
+					return nil
+				},
+			}
+			ledgerMock := &movementMocks.StockMovementRepositoryMock{
+				FuncAppend: func(ctx context.Context, exec stockMovementRepository.Executor, m movementModels.StockMovement) (*movementModels.StockMovement, error) {
+					require.Equal(t, movementModels.MovementTypeTransfer, m.MovementType)
+					require.Equal(t, "jdoe", m.Actor)
+					rec.ledger[m.ProductBatchId] += m.Quantity
+					return &m, nil
+				},
+			}
+			svc := service.NewTransferService(repoMock, ledgerMock)
+
+			res, err := svc.Transfer(context.Background(), 1, tc.req)
+
+			if tc.wantErrCode != "" {
+				testhelpers.RequireAppErr(t, err, tc.wantErrCode)
+				require.Nil(t, res)
+				require.Empty(t, rec.capacities)
+				return
+			}
+			require.NoError(t, err)
+			tc.check(t, res, rec)
+		})
+	}
+}
@@ -41,6 +41,11 @@ func (s *WarehouseDefault) Update(ctx context.Context, id int, patch warehouse.W
 			return nil, err
 		}
 	}
+	if patch.Latitude != nil || patch.Longitude != nil {
+		if err := validators.ValidateCoordinates(patch.Latitude, patch.Longitude); err != nil {
+			return nil, err
+		}
+	}
 
 	mappers.ApplyWarehousePatch(existing, patch)
 
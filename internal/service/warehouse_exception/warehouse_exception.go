@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/warehouse_exception"
+)
+
+func (s *warehouseExceptionService) FindByEmployee(ctx context.Context, employeeId int) ([]models.WarehouseException, error) {
+	return s.rp.FindByEmployee(ctx, employeeId)
+}
+
+func (s *warehouseExceptionService) Create(ctx context.Context, employeeId int, p models.PostWarehouseException) (*models.WarehouseException, error) {
+	return s.rp.Create(ctx, models.WarehouseException{
+		EmployeeId:  employeeId,
+		WarehouseId: p.WarehouseId,
+		Reason:      p.Reason,
+		CreatedAt:   time.Now(),
+	})
+}
+
+func (s *warehouseExceptionService) Delete(ctx context.Context, employeeId int, warehouseId int) error {
+	return s.rp.Delete(ctx, employeeId, warehouseId)
+}
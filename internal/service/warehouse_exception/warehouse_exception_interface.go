@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/warehouse_exception"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/warehouse_exception"
+)
+
+// WarehouseExceptionService manages the warehouses employees may work at besides their own.
+type WarehouseExceptionService interface {
+	// FindByEmployee returns the exceptions of an employee.
+	FindByEmployee(ctx context.Context, employeeId int) ([]models.WarehouseException, error)
+
+	// Create grants an employee access to another warehouse.
+	Create(ctx context.Context, employeeId int, p models.PostWarehouseException) (*models.WarehouseException, error)
+
+	// Delete revokes the access of an employee to a warehouse.
+	Delete(ctx context.Context, employeeId int, warehouseId int) error
+}
+
+// warehouseExceptionService implements WarehouseExceptionService using a repository.
+type warehouseExceptionService struct {
+	rp repository.WarehouseExceptionRepository
+}
+
+// NewWarehouseExceptionService creates a new WarehouseExceptionService using the provided repository.
+func NewWarehouseExceptionService(rp repository.WarehouseExceptionRepository) WarehouseExceptionService {
+	return &warehouseExceptionService{
+		rp: rp,
+	}
+}
@@ -0,0 +1,52 @@
+package validators
+
+import (
+	"strings"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/asn"
+)
+
+var asnStatuses = map[string]bool{
+	models.StatusScheduled: true,
+	models.StatusArriving:  true,
+	models.StatusReceiving: true,
+	models.StatusReceived:  true,
+	models.StatusClosed:    true,
+}
+
+func ValidateAsnPost(a models.PostAsn) error {
+	if strings.TrimSpace(a.AsnNumber) == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "asn_number is required")
+	}
+	if a.SellerId <= 0 || a.WarehouseId <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "seller_id and warehouse_id are required and must be positive")
+	}
+	if a.ExpectedAt.IsZero() {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "expected_at is required")
+	}
+	if len(a.Lines) == 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "at least one line is required")
+	}
+	seen := make(map[int]bool, len(a.Lines))
+	for i, l := range a.Lines {
+		if l.ProductId <= 0 || l.ExpectedQuantity == nil || *l.ExpectedQuantity <= 0 {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "every line needs a product_id and a positive expected_quantity").
+				WithDetail("line", i)
+		}
+		if seen[l.ProductId] {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "a product can only appear once per asn").
+				WithDetail("product_id", l.ProductId)
+		}
+		seen[l.ProductId] = true
+	}
+	return nil
+}
+
+// ValidateAsnStatus checks an optional status filter.
+func ValidateAsnStatus(status string) error {
+	if status != "" && !asnStatuses[status] {
+		return apperrors.NewAppError(apperrors.CodeBadRequest, "invalid status").WithDetail("status", status)
+	}
+	return nil
+}
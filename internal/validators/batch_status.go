@@ -0,0 +1,17 @@
+package validators
+
+import (
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/batch_status"
+)
+
+func ValidateStatusChangePost(c models.PostStatusChange) error {
+	if c.ReasonCode == "" || c.Actor == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "reason_code and actor are required")
+	}
+	if !models.ReasonCodes[c.ReasonCode] {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "reason_code is not valid").
+			WithDetail("reason_code", c.ReasonCode)
+	}
+	return nil
+}
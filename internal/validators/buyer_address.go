@@ -0,0 +1,33 @@
+package validators
+
+import (
+	"strings"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer_address"
+)
+
+// ValidateAddressPost checks that the address and its locality are given.
+func ValidateAddressPost(a models.PostAddress) error {
+	if strings.TrimSpace(a.Address) == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "address is required")
+	}
+	if strings.TrimSpace(a.LocalityId) == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "locality_id is required")
+	}
+	return nil
+}
+
+// ValidateAddressPatch checks that the fields being updated are not blank.
+func ValidateAddressPatch(a models.PatchAddress) error {
+	if a.Label == nil && a.Address == nil && a.LocalityId == nil && a.IsDefault == nil {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "at least one field is required")
+	}
+	if a.Address != nil && strings.TrimSpace(*a.Address) == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "address cannot be empty")
+	}
+	if a.LocalityId != nil && strings.TrimSpace(*a.LocalityId) == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "locality_id cannot be empty")
+	}
+	return nil
+}
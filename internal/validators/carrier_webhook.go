@@ -0,0 +1,38 @@
+package validators
+
+import (
+	"strings"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/carrier_webhook"
+)
+
+// ValidateWebhookSignature checks the signature headers of a webhook request are present.
+func ValidateWebhookSignature(s models.Signature) error {
+	if s.Timestamp == "" || s.Nonce == "" || s.Signature == "" {
+		return apperrors.NewAppError(apperrors.CodeUnauthorized, "missing signature headers")
+	}
+	if len(s.Nonce) > 128 {
+		return apperrors.NewAppError(apperrors.CodeUnauthorized, "nonce cannot exceed 128 characters")
+	}
+	return nil
+}
+
+func ValidateWebhookPayload(p models.Payload) error {
+	if strings.TrimSpace(p.EventId) == "" || len(p.EventId) > 255 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "event_id is required and cannot exceed 255 characters")
+	}
+	if strings.TrimSpace(p.TrackingCode) == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "tracking_code is required")
+	}
+	if p.Status == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "status is required")
+	}
+	if p.OccurredAt.IsZero() {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "occurred_at is required")
+	}
+	if len(p.Location) > 255 || len(p.Description) > 255 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "location and description cannot exceed 255 characters")
+	}
+	return nil
+}
@@ -1,6 +1,10 @@
 package validators
 
-import "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+import (
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+)
 
 func ValidateID(value int, fieldName string) error {
 	if value <= 0 {
@@ -8,3 +12,21 @@ func ValidateID(value int, fieldName string) error {
 	}
 	return nil
 }
+
+// ValidateReportPeriod checks a report period does not end before it starts.
+func ValidateReportPeriod(from, to time.Time) error {
+	if to.Before(from) {
+		return apperrors.NewAppError(apperrors.CodeBadRequest, "from must not be after to").
+			WithDetail("from", from.Format(time.DateOnly)).
+			WithDetail("to", to.Format(time.DateOnly))
+	}
+	return nil
+}
+
+// ValidateReportFormat checks the format a report is asked in: json, the default, or csv.
+func ValidateReportFormat(format string) error {
+	if format != "" && format != "json" && format != "csv" {
+		return apperrors.NewAppError(apperrors.CodeBadRequest, "format must be json or csv").WithDetail("format", format)
+	}
+	return nil
+}
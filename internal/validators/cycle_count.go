@@ -0,0 +1,53 @@
+package validators
+
+import (
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/cycle_count"
+)
+
+func ValidateCycleCountPost(c models.PostCycleCount) error {
+	if c.SectionId <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "section_id is required")
+	}
+	if c.Actor == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "actor is required")
+	}
+	return nil
+}
+
+func ValidateCountedLinesPost(c models.PostCountedLines) error {
+	if c.Actor == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "actor is required")
+	}
+	if len(c.Lines) == 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "lines are required")
+	}
+	seen := make(map[int]bool, len(c.Lines))
+	for _, l := range c.Lines {
+		if l.ProductBatchId <= 0 {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "product_batch_id is required")
+		}
+		if seen[l.ProductBatchId] {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "product_batch_id is repeated").
+				WithDetail("product_batch_id", l.ProductBatchId)
+		}
+		seen[l.ProductBatchId] = true
+		if l.CountedQuantity == nil || *l.CountedQuantity < 0 {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "counted_quantity must be zero or greater").
+				WithDetail("product_batch_id", l.ProductBatchId)
+		}
+		if l.ReasonCode != "" && !models.ReasonCodes[l.ReasonCode] {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "reason_code is not valid").
+				WithDetail("product_batch_id", l.ProductBatchId).
+				WithDetail("reason_code", l.ReasonCode)
+		}
+	}
+	return nil
+}
+
+func ValidateCountDecisionPost(c models.PostCountDecision) error {
+	if c.Actor == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "actor is required")
+	}
+	return nil
+}
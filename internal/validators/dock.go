@@ -0,0 +1,57 @@
+package validators
+
+import (
+	"strings"
+	"time"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/dock"
+)
+
+// ValidateDockPost checks the dock code and that its opening hours are HH:MM clock times, opening before closing.
+func ValidateDockPost(d models.PostDock) error {
+	if d.WarehouseId <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "warehouse_id is required and must be positive")
+	}
+	if strings.TrimSpace(d.Code) == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "code is required")
+	}
+	opens, err := time.Parse("15:04", d.OpensAt)
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "opens_at must be a clock time such as 08:00")
+	}
+	closes, err := time.Parse("15:04", d.ClosesAt)
+	if err != nil {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "closes_at must be a clock time such as 18:00")
+	}
+	if !opens.Before(closes) {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "opens_at must be before closes_at")
+	}
+	return nil
+}
+
+func ValidateAppointmentPost(a models.PostAppointment) error {
+	if a.DockId <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "dock_id is required and must be positive")
+	}
+	if (a.SellerId == nil) == (a.CarrierId == nil) {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "exactly one of seller_id and carrier_id is required")
+	}
+	if (a.SellerId != nil && *a.SellerId <= 0) || (a.CarrierId != nil && *a.CarrierId <= 0) || (a.AsnId != nil && *a.AsnId <= 0) {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "seller_id, carrier_id and asn_id must be positive")
+	}
+	if a.StartsAt.IsZero() || a.EndsAt.IsZero() {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "starts_at and ends_at are required")
+	}
+	if !a.StartsAt.Before(a.EndsAt) {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "starts_at must be before ends_at")
+	}
+	return nil
+}
+
+func ValidateArrivalPost(a models.PostArrival) error {
+	if a.InboundOrderId <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "inbound_order_id is required and must be positive")
+	}
+	return nil
+}
@@ -24,6 +24,25 @@ func ValidateGeographyPost(rg models.RequestGeography) error {
 		err.Message = "Locality Name is required and cannot be empty."
 		return err
 	}
+	if rg.Latitude != nil || rg.Longitude != nil {
+		return ValidateCoordinates(rg.Latitude, rg.Longitude)
+	}
+
+	return nil
+}
 
+// ValidateCoordinates checks both coordinates are given, in decimal degrees, within the range of the globe.
+func ValidateCoordinates(latitude, longitude *float64) error {
+	if latitude == nil || longitude == nil {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "latitude and longitude are required together")
+	}
+	if *latitude < -90 || *latitude > 90 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "latitude must be between -90 and 90").
+			WithDetail("latitude", *latitude)
+	}
+	if *longitude < -180 || *longitude > 180 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "longitude must be between -180 and 180").
+			WithDetail("longitude", *longitude)
+	}
 	return nil
 }
@@ -0,0 +1,29 @@
+package validators
+
+import (
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inventory"
+)
+
+func ValidateInventoryFilter(f models.InventoryFilter) error {
+	if f.GroupBy != "" && !models.GroupByOptions[f.GroupBy] {
+		return apperrors.NewAppError(apperrors.CodeBadRequest, "group_by must be one of product, warehouse or section").
+			WithDetail("group_by", f.GroupBy)
+	}
+	ids := []struct {
+		name string
+		id   int
+	}{
+		{"product_id", f.ProductId},
+		{"seller_id", f.SellerId},
+		{"warehouse_id", f.WarehouseId},
+		{"section_id", f.SectionId},
+		{"product_type_id", f.ProductTypeId},
+	}
+	for _, p := range ids {
+		if p.id < 0 {
+			return apperrors.NewAppError(apperrors.CodeBadRequest, p.name+" must be positive")
+		}
+	}
+	return nil
+}
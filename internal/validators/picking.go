@@ -0,0 +1,46 @@
+package validators
+
+import (
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/picking"
+)
+
+func ValidateWavePost(w models.PostWave) error {
+	if w.WarehouseId <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "warehouse_id is required and must be positive")
+	}
+	if w.MaxLines < 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "max_lines cannot be negative")
+	}
+	return nil
+}
+
+// ValidatePickConfirmation checks the picker and that every reported line has a non-negative picked quantity.
+// Whether the lines match the pick list is checked by the service.
+func ValidatePickConfirmation(c models.PostPickConfirmation) error {
+	if c.EmployeeId <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "employee_id is required and must be positive")
+	}
+	if len(c.Lines) == 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "lines are required")
+	}
+	for i, l := range c.Lines {
+		if l.LineId <= 0 {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "line_id is required and must be positive").
+				WithDetail("index", i)
+		}
+		if l.PickedQuantity == nil || *l.PickedQuantity < 0 {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "picked_quantity is required and cannot be negative").
+				WithDetail("line_id", l.LineId)
+		}
+	}
+	return nil
+}
+
+// ValidateWaveStatus checks an optional status filter.
+func ValidateWaveStatus(status string) error {
+	if status != "" && status != models.WaveStatusOpen && status != models.WaveStatusCompleted {
+		return apperrors.NewAppError(apperrors.CodeBadRequest, "invalid status").WithDetail("status", status)
+	}
+	return nil
+}
@@ -34,3 +34,17 @@ func ValidateProductRecordBusinessRules(record models.ProductRecord) error {
 
 	return nil
 }
+
+// ValidateMarginReportFilter checks the date range and the dimension the margin report is grouped by.
+func ValidateMarginReportFilter(f models.MarginReportFilter) error {
+	if err := ValidateReportPeriod(f.From, f.To); err != nil {
+		return err
+	}
+	switch f.GroupBy {
+	case models.GroupByProduct, models.GroupBySeller, models.GroupByProductType, models.GroupByBuyer, models.GroupByMonth:
+	default:
+		return apperrors.NewAppError(apperrors.CodeBadRequest, "group_by must be product, seller, product_type, buyer or month").
+			WithDetail("group_by", f.GroupBy)
+	}
+	return nil
+}
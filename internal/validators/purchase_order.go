@@ -32,5 +32,28 @@ func ValidatePurchaseOrderPost(po models.RequestPurchaseOrder) error {
 		return apperrors.NewAppError(apperrors.CodeValidationError, "product_record_id must be greater than 0")
 	}
 
+	if po.DeliveryAddressID != nil && *po.DeliveryAddressID <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "delivery_address_id must be greater than 0")
+	}
+
+	return nil
+}
+
+// ValidatePurchaseHistoryFilter checks the date range, the grouping period and that the page size stays within 100 orders.
+func ValidatePurchaseHistoryFilter(f models.PurchaseHistoryFilter) error {
+	if err := ValidateReportPeriod(f.From, f.To); err != nil {
+		return err
+	}
+	switch f.Period {
+	case models.PeriodDay, models.PeriodMonth, models.PeriodYear:
+	default:
+		return apperrors.NewAppError(apperrors.CodeBadRequest, "period must be day, month or year").WithDetail("period", f.Period)
+	}
+	if f.Page < 1 {
+		return apperrors.NewAppError(apperrors.CodeBadRequest, "page must be a positive integer")
+	}
+	if f.PageSize < 1 || f.PageSize > 100 {
+		return apperrors.NewAppError(apperrors.CodeBadRequest, "page_size must be between 1 and 100")
+	}
 	return nil
 }
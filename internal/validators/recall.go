@@ -0,0 +1,25 @@
+package validators
+
+import (
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/recall"
+)
+
+func ValidateRecallPost(r models.PostRecall) error {
+	if r.Actor == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "actor is required")
+	}
+	if (len(r.ProductBatchIds) == 0) == (r.ProductId == nil) {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "exactly one of product_batch_ids or product_id is required")
+	}
+	for _, id := range r.ProductBatchIds {
+		if id <= 0 {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "product_batch_ids must be positive").
+				WithDetail("product_batch_id", id)
+		}
+	}
+	if r.ProductId != nil && *r.ProductId <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "product_id must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package validators
+
+import (
+	"strings"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/receiving"
+)
+
+// ValidateReceiptPost checks the inbound order fields and the batch being received,
+// which must bring some stock.
+func ValidateReceiptPost(r models.PostReceipt) error {
+	if strings.TrimSpace(r.OrderNumber) == "" || strings.TrimSpace(r.OrderDate) == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "order_number and order_date are required")
+	}
+	if r.EmployeeId <= 0 || r.WarehouseId <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "employee_id and warehouse_id are required and must be positive")
+	}
+	if r.AsnId != nil && *r.AsnId <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "asn_id must be positive")
+	}
+	if err := ValidateProductBatchPost(r.ProductBatch); err != nil {
+		return err
+	}
+	if *r.ProductBatch.CurrentQuantity == 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "product_batch.current_quantity must be greater than zero")
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package validators
+
+import (
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/replenishment"
+)
+
+func ValidateReorderPointPost(p models.PostReorderPoint) error {
+	if p.ProductId <= 0 || p.WarehouseId <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "product_id and warehouse_id are required")
+	}
+	if p.ReorderPoint == nil || p.TargetQuantity == nil {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "reorder_point and target_quantity are required")
+	}
+	return ValidateReorderLevels(*p.ReorderPoint, *p.TargetQuantity)
+}
+
+// ValidateReorderLevels checks the reorder point is not negative and the target is above it.
+func ValidateReorderLevels(reorderPoint, targetQuantity int) error {
+	if reorderPoint < 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "reorder_point must be zero or greater")
+	}
+	if targetQuantity <= reorderPoint {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "target_quantity must be greater than reorder_point").
+			WithDetail("reorder_point", reorderPoint).
+			WithDetail("target_quantity", targetQuantity)
+	}
+	return nil
+}
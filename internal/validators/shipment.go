@@ -0,0 +1,87 @@
+package validators
+
+import (
+	"strings"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipment"
+)
+
+// shipmentStatuses are the statuses a shipment can be in.
+var shipmentStatuses = map[string]bool{
+	models.StatusCreated:   true,
+	models.StatusPickedUp:  true,
+	models.StatusInTransit: true,
+	models.StatusDelivered: true,
+	models.StatusFailed:    true,
+}
+
+// shipmentTransitions lists, for each status a shipment can be moved to, the statuses it can come from.
+// In transit can follow itself, so every scan along the way is recorded.
+var shipmentTransitions = map[string][]string{
+	models.StatusPickedUp:  {models.StatusCreated},
+	models.StatusInTransit: {models.StatusPickedUp, models.StatusInTransit, models.StatusFailed},
+	models.StatusDelivered: {models.StatusPickedUp, models.StatusInTransit},
+	models.StatusFailed:    {models.StatusCreated, models.StatusPickedUp, models.StatusInTransit},
+}
+
+func ValidateShipmentPost(s models.PostShipment) error {
+	if s.CarrierId <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "carrier_id is required and must be positive")
+	}
+	if s.WarehouseId <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "warehouse_id is required and must be positive")
+	}
+	if strings.TrimSpace(s.DestinationLocalityId) == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "destination_locality_id is required")
+	}
+	if len(s.PurchaseOrderIds) == 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "purchase_order_ids are required")
+	}
+	seen := make(map[int]bool, len(s.PurchaseOrderIds))
+	for _, id := range s.PurchaseOrderIds {
+		if id <= 0 {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "purchase_order_ids must be positive")
+		}
+		if seen[id] {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "purchase order listed more than once").
+				WithDetail("purchase_order_id", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// ValidateShipmentEventPost checks the event status. Created is set when the shipment is made and cannot be recorded.
+func ValidateShipmentEventPost(e models.PostEvent) error {
+	if !shipmentStatuses[e.Status] || e.Status == models.StatusCreated {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "status must be one of picked_up, in_transit, delivered or failed").
+			WithDetail("status", e.Status)
+	}
+	if len(e.Location) > 255 || len(e.Description) > 255 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "location and description cannot exceed 255 characters")
+	}
+	return nil
+}
+
+// ValidateShipmentStatus checks an optional status filter.
+func ValidateShipmentStatus(status string) error {
+	if status != "" && !shipmentStatuses[status] {
+		return apperrors.NewAppError(apperrors.CodeBadRequest, "invalid status").WithDetail("status", status)
+	}
+	return nil
+}
+
+// ValidateShipmentTransition checks the shipment can move from its current status to the given one.
+// Returns a conflict error otherwise, e.g. once it is delivered.
+func ValidateShipmentTransition(s models.Shipment, to string) error {
+	for _, from := range shipmentTransitions[to] {
+		if from == s.Status {
+			return nil
+		}
+	}
+	return apperrors.NewAppError(apperrors.CodeConflict, "shipment cannot move to the requested status").
+		WithDetail("tracking_code", s.TrackingCode).
+		WithDetail("status", s.Status).
+		WithDetail("requested_status", to)
+}
@@ -0,0 +1,85 @@
+package validators
+
+import (
+	"strings"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipping"
+)
+
+// ValidateCoverageAreaPost checks the area names exactly one locality, province or country and that
+// its rate card has at least one band, no two bands sharing the same limits.
+func ValidateCoverageAreaPost(a models.PostCoverageArea) error {
+	if a.CarrierId <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "carrier_id is required and must be positive")
+	}
+	set := 0
+	if a.LocalityId != nil {
+		if strings.TrimSpace(*a.LocalityId) == "" {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "locality_id cannot be empty")
+		}
+		set++
+	}
+	if a.ProvinceId != nil {
+		if *a.ProvinceId <= 0 {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "province_id must be positive")
+		}
+		set++
+	}
+	if a.CountryId != nil {
+		if *a.CountryId <= 0 {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "country_id must be positive")
+		}
+		set++
+	}
+	if set != 1 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "exactly one of locality_id, province_id and country_id is required")
+	}
+	if a.TransitDays < 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "transit_days cannot be negative")
+	}
+	if len(a.RateBands) == 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "rate_bands are required")
+	}
+	seen := make(map[models.PostRateBand]bool, len(a.RateBands))
+	for _, b := range a.RateBands {
+		if b.MaxWeight <= 0 || b.MaxVolume <= 0 {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "max_weight and max_volume must be positive")
+		}
+		if b.Price < 0 {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "price cannot be negative")
+		}
+		limits := models.PostRateBand{MaxWeight: b.MaxWeight, MaxVolume: b.MaxVolume}
+		if seen[limits] {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "rate band listed more than once").
+				WithDetail("max_weight", b.MaxWeight).
+				WithDetail("max_volume", b.MaxVolume)
+		}
+		seen[limits] = true
+	}
+	return nil
+}
+
+func ValidateQuoteRequest(q models.QuoteRequest) error {
+	if q.WarehouseId <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "warehouse_id is required and must be positive")
+	}
+	if strings.TrimSpace(q.DestinationLocalityId) == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "destination_locality_id is required")
+	}
+	if len(q.Items) == 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "items are required")
+	}
+	seen := make(map[int]bool, len(q.Items))
+	for _, it := range q.Items {
+		if it.ProductId <= 0 || it.Quantity <= 0 {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "product_id and quantity must be positive")
+		}
+		if seen[it.ProductId] {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "product listed more than once").
+				WithDetail("product_id", it.ProductId)
+		}
+		seen[it.ProductId] = true
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package validators
+
+import (
+	"strings"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	allocationModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/sourcing"
+)
+
+// ValidateSourcingRequest checks the delivery locality is given and every line asks for a positive quantity
+// of a product. A product may appear in several lines, as it can in a purchase order.
+func ValidateSourcingRequest(req models.SourcingRequest) error {
+	if strings.TrimSpace(req.DeliveryLocalityId) == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "delivery_locality_id is required")
+	}
+	if len(req.Lines) == 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "lines are required")
+	}
+	for _, l := range req.Lines {
+		if l.ProductId <= 0 || l.Quantity <= 0 {
+			return apperrors.NewAppError(apperrors.CodeValidationError, "product_id and quantity must be positive")
+		}
+	}
+	return nil
+}
+
+// ValidateConfirmRequest checks the delivery locality, when given, is not empty.
+func ValidateConfirmRequest(req allocationModels.ConfirmRequest) error {
+	if req.DeliveryLocalityId != nil && strings.TrimSpace(*req.DeliveryLocalityId) == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "delivery_locality_id cannot be empty")
+	}
+	return nil
+}
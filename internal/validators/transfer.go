@@ -0,0 +1,19 @@
+package validators
+
+import (
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/transfer"
+)
+
+func ValidateBatchTransferPost(t models.PostBatchTransfer) error {
+	if t.ToSectionId <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "to_section_id is required")
+	}
+	if t.Quantity != nil && *t.Quantity <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "quantity must be greater than zero")
+	}
+	if t.Reason == "" || t.Actor == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "reason and actor are required")
+	}
+	return nil
+}
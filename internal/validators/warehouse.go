@@ -15,6 +15,9 @@ func ValidateWarehouseCreateRequest(req warehouse.WarehouseRequest) error {
 	if !isValidPhone(req.Telephone) {
 		return apperrors.NewAppError(apperrors.CodeValidationError, "invalid phone number")
 	}
+	if req.Latitude != nil || req.Longitude != nil {
+		return ValidateCoordinates(req.Latitude, req.Longitude)
+	}
 	return nil
 }
 
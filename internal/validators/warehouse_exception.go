@@ -0,0 +1,30 @@
+package validators
+
+import (
+	"strings"
+
+	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/warehouse_exception"
+)
+
+func ValidateWarehouseExceptionPost(p models.PostWarehouseException) error {
+	if p.WarehouseId <= 0 {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "warehouse_id is required and must be positive")
+	}
+	if strings.TrimSpace(p.Reason) == "" {
+		return apperrors.NewAppError(apperrors.CodeValidationError, "reason is required")
+	}
+	return nil
+}
+
+// ValidateEmployeeWarehouse checks an employee may work at warehouseId:
+// either it is the warehouse they are assigned to, or they hold an exception for it.
+func ValidateEmployeeWarehouse(employeeId, employeeWarehouseId, warehouseId int, hasException bool) error {
+	if employeeWarehouseId == warehouseId || hasException {
+		return nil
+	}
+	return apperrors.NewAppError(apperrors.CodeValidationError, "employee does not belong to the warehouse").
+		WithDetail("employee_id", employeeId).
+		WithDetail("employee_warehouse_id", employeeWarehouseId).
+		WithDetail("warehouse_id", warehouseId)
+}
@@ -0,0 +1,108 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/allocation"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+)
+
+type AllocationRepositoryMock struct {
+	FuncGetOrderStatusForUpdate       func(ctx context.Context, exec repository.Executor, orderId int) (int, error)
+	FuncUpdateOrderStatus             func(ctx context.Context, exec repository.Executor, orderId int, statusId int) error
+	FuncFindOrderLines                func(ctx context.Context, exec repository.Executor, orderId int) ([]models.OrderLine, error)
+	FuncFindAvailableBatchesForUpdate func(ctx context.Context, exec repository.Executor, productId int) ([]models.BatchStock, error)
+	FuncFindWarehouseBatchesForUpdate func(ctx context.Context, exec repository.Executor, productId int, warehouseId int) ([]models.BatchStock, error)
+	FuncCreateAllocation              func(ctx context.Context, exec repository.Executor, a models.StockAllocation) (*models.StockAllocation, error)
+	FuncFindReservedByOrder           func(ctx context.Context, exec repository.Executor, orderId int) ([]models.StockAllocation, error)
+	FuncReleaseAllocations            func(ctx context.Context, exec repository.Executor, orderId int) error
+	FuncFindByOrder                   func(ctx context.Context, orderId int) ([]models.StockAllocation, error)
+	FuncBeginTx                       func(ctx context.Context) (*sql.Tx, error)
+	FuncCommitTx                      func(tx *sql.Tx) error
+	FuncRollbackTx                    func(tx *sql.Tx) error
+}
+
+func (m *AllocationRepositoryMock) GetOrderStatusForUpdate(ctx context.Context, exec repository.Executor, orderId int) (int, error) {
+	if m.FuncGetOrderStatusForUpdate != nil {
+		return m.FuncGetOrderStatusForUpdate(ctx, exec, orderId)
+	}
+	return 0, nil
+}
+
+func (m *AllocationRepositoryMock) UpdateOrderStatus(ctx context.Context, exec repository.Executor, orderId int, statusId int) error {
+	if m.FuncUpdateOrderStatus != nil {
+		return m.FuncUpdateOrderStatus(ctx, exec, orderId, statusId)
+	}
+	return nil
+}
+
+func (m *AllocationRepositoryMock) FindOrderLines(ctx context.Context, exec repository.Executor, orderId int) ([]models.OrderLine, error) {
+	if m.FuncFindOrderLines != nil {
+		return m.FuncFindOrderLines(ctx, exec, orderId)
+	}
+	return nil, nil
+}
+
+func (m *AllocationRepositoryMock) FindAvailableBatchesForUpdate(ctx context.Context, exec repository.Executor, productId int) ([]models.BatchStock, error) {
+	if m.FuncFindAvailableBatchesForUpdate != nil {
+		return m.FuncFindAvailableBatchesForUpdate(ctx, exec, productId)
+	}
+	return nil, nil
+}
+
+func (m *AllocationRepositoryMock) FindWarehouseBatchesForUpdate(ctx context.Context, exec repository.Executor, productId int, warehouseId int) ([]models.BatchStock, error) {
+	if m.FuncFindWarehouseBatchesForUpdate != nil {
+		return m.FuncFindWarehouseBatchesForUpdate(ctx, exec, productId, warehouseId)
+	}
+	return nil, nil
+}
+
+func (m *AllocationRepositoryMock) CreateAllocation(ctx context.Context, exec repository.Executor, a models.StockAllocation) (*models.StockAllocation, error) {
+	if m.FuncCreateAllocation != nil {
+		return m.FuncCreateAllocation(ctx, exec, a)
+	}
+	return nil, nil
+}
+
+func (m *AllocationRepositoryMock) FindReservedByOrder(ctx context.Context, exec repository.Executor, orderId int) ([]models.StockAllocation, error) {
+	if m.FuncFindReservedByOrder != nil {
+		return m.FuncFindReservedByOrder(ctx, exec, orderId)
+	}
+	return nil, nil
+}
+
+func (m *AllocationRepositoryMock) ReleaseAllocations(ctx context.Context, exec repository.Executor, orderId int) error {
+	if m.FuncReleaseAllocations != nil {
+		return m.FuncReleaseAllocations(ctx, exec, orderId)
+	}
+	return nil
+}
+
+func (m *AllocationRepositoryMock) FindByOrder(ctx context.Context, orderId int) ([]models.StockAllocation, error) {
+	if m.FuncFindByOrder != nil {
+		return m.FuncFindByOrder(ctx, orderId)
+	}
+	return nil, nil
+}
+
+func (m *AllocationRepositoryMock) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	if m.FuncBeginTx != nil {
+		return m.FuncBeginTx(ctx)
+	}
+	return &sql.Tx{}, nil
+}
+
+func (m *AllocationRepositoryMock) CommitTx(tx *sql.Tx) error {
+	if m.FuncCommitTx != nil {
+		return m.FuncCommitTx(tx)
+	}
+	return nil
+}
+
+func (m *AllocationRepositoryMock) RollbackTx(tx *sql.Tx) error {
+	if m.FuncRollbackTx != nil {
+		return m.FuncRollbackTx(tx)
+	}
+	return nil
+}
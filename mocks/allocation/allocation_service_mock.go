@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+)
+
+type AllocationServiceMock struct {
+	FuncConfirm     func(ctx context.Context, orderId int, req models.ConfirmRequest) (*models.AllocationResponse, error)
+	FuncCancel      func(ctx context.Context, orderId int) (*models.AllocationResponse, error)
+	FuncFindByOrder func(ctx context.Context, orderId int) ([]models.StockAllocation, error)
+}
+
+func (m *AllocationServiceMock) Confirm(ctx context.Context, orderId int, req models.ConfirmRequest) (*models.AllocationResponse, error) {
+	return m.FuncConfirm(ctx, orderId, req)
+}
+
+func (m *AllocationServiceMock) Cancel(ctx context.Context, orderId int) (*models.AllocationResponse, error) {
+	return m.FuncCancel(ctx, orderId)
+}
+
+func (m *AllocationServiceMock) FindByOrder(ctx context.Context, orderId int) ([]models.StockAllocation, error) {
+	return m.FuncFindByOrder(ctx, orderId)
+}
@@ -0,0 +1,108 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/asn"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/asn"
+)
+
+type AsnRepositoryMock struct {
+	FuncCreate               func(ctx context.Context, exec repository.Executor, a models.Asn) (*models.Asn, error)
+	FuncCreateLine           func(ctx context.Context, exec repository.Executor, asnId int, sellerId int, l models.PostAsnLine) error
+	FuncFindAll              func(ctx context.Context, filter models.AsnFilter) ([]models.Asn, error)
+	FuncFindById             func(ctx context.Context, id int) (*models.Asn, error)
+	FuncFindForUpdate        func(ctx context.Context, exec repository.Executor, id int) (*models.Asn, error)
+	FuncFindLines            func(ctx context.Context, asnId int) ([]models.AsnLine, error)
+	FuncAddReceived          func(ctx context.Context, exec repository.Executor, asnId int, sellerId int, productId int, quantity int) error
+	FuncUpdateStatus         func(ctx context.Context, exec repository.Executor, a models.Asn) error
+	FuncFindDiscrepancyLines func(ctx context.Context, sellerId int, warehouseId int) ([]models.DiscrepancyLine, error)
+	FuncBeginTx              func(ctx context.Context) (*sql.Tx, error)
+	FuncCommitTx             func(tx *sql.Tx) error
+	FuncRollbackTx           func(tx *sql.Tx) error
+}
+
+func (m *AsnRepositoryMock) Create(ctx context.Context, exec repository.Executor, a models.Asn) (*models.Asn, error) {
+	if m.FuncCreate != nil {
+		return m.FuncCreate(ctx, exec, a)
+	}
+	return &a, nil
+}
+
+func (m *AsnRepositoryMock) CreateLine(ctx context.Context, exec repository.Executor, asnId int, sellerId int, l models.PostAsnLine) error {
+	if m.FuncCreateLine != nil {
+		return m.FuncCreateLine(ctx, exec, asnId, sellerId, l)
+	}
+	return nil
+}
+
+func (m *AsnRepositoryMock) FindAll(ctx context.Context, filter models.AsnFilter) ([]models.Asn, error) {
+	if m.FuncFindAll != nil {
+		return m.FuncFindAll(ctx, filter)
+	}
+	return []models.Asn{}, nil
+}
+
+func (m *AsnRepositoryMock) FindById(ctx context.Context, id int) (*models.Asn, error) {
+	if m.FuncFindById != nil {
+		return m.FuncFindById(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *AsnRepositoryMock) FindForUpdate(ctx context.Context, exec repository.Executor, id int) (*models.Asn, error) {
+	if m.FuncFindForUpdate != nil {
+		return m.FuncFindForUpdate(ctx, exec, id)
+	}
+	return nil, nil
+}
+
+func (m *AsnRepositoryMock) FindLines(ctx context.Context, asnId int) ([]models.AsnLine, error) {
+	if m.FuncFindLines != nil {
+		return m.FuncFindLines(ctx, asnId)
+	}
+	return []models.AsnLine{}, nil
+}
+
+func (m *AsnRepositoryMock) AddReceived(ctx context.Context, exec repository.Executor, asnId int, sellerId int, productId int, quantity int) error {
+	if m.FuncAddReceived != nil {
+		return m.FuncAddReceived(ctx, exec, asnId, sellerId, productId, quantity)
+	}
+	return nil
+}
+
+func (m *AsnRepositoryMock) UpdateStatus(ctx context.Context, exec repository.Executor, a models.Asn) error {
+	if m.FuncUpdateStatus != nil {
+		return m.FuncUpdateStatus(ctx, exec, a)
+	}
+	return nil
+}
+
+func (m *AsnRepositoryMock) FindDiscrepancyLines(ctx context.Context, sellerId int, warehouseId int) ([]models.DiscrepancyLine, error) {
+	if m.FuncFindDiscrepancyLines != nil {
+		return m.FuncFindDiscrepancyLines(ctx, sellerId, warehouseId)
+	}
+	return []models.DiscrepancyLine{}, nil
+}
+
+func (m *AsnRepositoryMock) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	if m.FuncBeginTx != nil {
+		return m.FuncBeginTx(ctx)
+	}
+	return &sql.Tx{}, nil
+}
+
+func (m *AsnRepositoryMock) CommitTx(tx *sql.Tx) error {
+	if m.FuncCommitTx != nil {
+		return m.FuncCommitTx(tx)
+	}
+	return nil
+}
+
+func (m *AsnRepositoryMock) RollbackTx(tx *sql.Tx) error {
+	if m.FuncRollbackTx != nil {
+		return m.FuncRollbackTx(tx)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/asn"
+)
+
+type AsnServiceMock struct {
+	FuncCreate            func(ctx context.Context, req models.PostAsn) (*models.AsnDetail, error)
+	FuncFindAll           func(ctx context.Context, filter models.AsnFilter) ([]models.Asn, error)
+	FuncFindById          func(ctx context.Context, id int) (*models.AsnDetail, error)
+	FuncArrive            func(ctx context.Context, id int) (*models.AsnDetail, error)
+	FuncComplete          func(ctx context.Context, id int) (*models.AsnDetail, error)
+	FuncClose             func(ctx context.Context, id int) (*models.AsnDetail, error)
+	FuncDiscrepancyReport func(ctx context.Context, sellerId int, warehouseId int) ([]models.SupplierDiscrepancy, error)
+}
+
+func (m *AsnServiceMock) Create(ctx context.Context, req models.PostAsn) (*models.AsnDetail, error) {
+	return m.FuncCreate(ctx, req)
+}
+
+func (m *AsnServiceMock) FindAll(ctx context.Context, filter models.AsnFilter) ([]models.Asn, error) {
+	return m.FuncFindAll(ctx, filter)
+}
+
+func (m *AsnServiceMock) FindById(ctx context.Context, id int) (*models.AsnDetail, error) {
+	return m.FuncFindById(ctx, id)
+}
+
+func (m *AsnServiceMock) Arrive(ctx context.Context, id int) (*models.AsnDetail, error) {
+	return m.FuncArrive(ctx, id)
+}
+
+func (m *AsnServiceMock) Complete(ctx context.Context, id int) (*models.AsnDetail, error) {
+	return m.FuncComplete(ctx, id)
+}
+
+func (m *AsnServiceMock) Close(ctx context.Context, id int) (*models.AsnDetail, error) {
+	return m.FuncClose(ctx, id)
+}
+
+func (m *AsnServiceMock) DiscrepancyReport(ctx context.Context, sellerId int, warehouseId int) ([]models.SupplierDiscrepancy, error) {
+	return m.FuncDiscrepancyReport(ctx, sellerId, warehouseId)
+}
@@ -0,0 +1,101 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/batch_status"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/batch_status"
+)
+
+type BatchStatusRepositoryMock struct {
+	FuncFindStatusForUpdate   func(ctx context.Context, exec repository.Executor, batchId int) (*models.BatchStatus, error)
+	FuncFindStatus            func(ctx context.Context, batchId int) (*models.BatchStatus, error)
+	FuncUpdateStatus          func(ctx context.Context, exec repository.Executor, batchId int, status string, reasonCode string, actor string, at time.Time) error
+	FuncCreateChange          func(ctx context.Context, exec repository.Executor, c models.StatusChange) (*models.StatusChange, error)
+	FuncFindChanges           func(ctx context.Context, batchId int) ([]models.StatusChange, error)
+	FuncFindReservedForUpdate func(ctx context.Context, exec repository.Executor, batchId int) (int, error)
+	FuncReleaseReservations   func(ctx context.Context, exec repository.Executor, batchId int) error
+	FuncAddSectionCapacity    func(ctx context.Context, exec repository.Executor, sectionId int, delta int) error
+	FuncBeginTx               func(ctx context.Context) (*sql.Tx, error)
+	FuncCommitTx              func(tx *sql.Tx) error
+	FuncRollbackTx            func(tx *sql.Tx) error
+}
+
+func (m *BatchStatusRepositoryMock) FindStatusForUpdate(ctx context.Context, exec repository.Executor, batchId int) (*models.BatchStatus, error) {
+	if m.FuncFindStatusForUpdate != nil {
+		return m.FuncFindStatusForUpdate(ctx, exec, batchId)
+	}
+	return nil, nil
+}
+
+func (m *BatchStatusRepositoryMock) FindStatus(ctx context.Context, batchId int) (*models.BatchStatus, error) {
+	if m.FuncFindStatus != nil {
+		return m.FuncFindStatus(ctx, batchId)
+	}
+	return nil, nil
+}
+
+func (m *BatchStatusRepositoryMock) UpdateStatus(ctx context.Context, exec repository.Executor, batchId int, status string, reasonCode string, actor string, at time.Time) error {
+	if m.FuncUpdateStatus != nil {
+		return m.FuncUpdateStatus(ctx, exec, batchId, status, reasonCode, actor, at)
+	}
+	return nil
+}
+
+func (m *BatchStatusRepositoryMock) CreateChange(ctx context.Context, exec repository.Executor, c models.StatusChange) (*models.StatusChange, error) {
+	if m.FuncCreateChange != nil {
+		return m.FuncCreateChange(ctx, exec, c)
+	}
+	return &c, nil
+}
+
+func (m *BatchStatusRepositoryMock) FindChanges(ctx context.Context, batchId int) ([]models.StatusChange, error) {
+	if m.FuncFindChanges != nil {
+		return m.FuncFindChanges(ctx, batchId)
+	}
+	return []models.StatusChange{}, nil
+}
+
+func (m *BatchStatusRepositoryMock) FindReservedForUpdate(ctx context.Context, exec repository.Executor, batchId int) (int, error) {
+	if m.FuncFindReservedForUpdate != nil {
+		return m.FuncFindReservedForUpdate(ctx, exec, batchId)
+	}
+	return 0, nil
+}
+
+func (m *BatchStatusRepositoryMock) ReleaseReservations(ctx context.Context, exec repository.Executor, batchId int) error {
+	if m.FuncReleaseReservations != nil {
+		return m.FuncReleaseReservations(ctx, exec, batchId)
+	}
+	return nil
+}
+
+func (m *BatchStatusRepositoryMock) AddSectionCapacity(ctx context.Context, exec repository.Executor, sectionId int, delta int) error {
+	if m.FuncAddSectionCapacity != nil {
+		return m.FuncAddSectionCapacity(ctx, exec, sectionId, delta)
+	}
+	return nil
+}
+
+func (m *BatchStatusRepositoryMock) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	if m.FuncBeginTx != nil {
+		return m.FuncBeginTx(ctx)
+	}
+	return &sql.Tx{}, nil
+}
+
+func (m *BatchStatusRepositoryMock) CommitTx(tx *sql.Tx) error {
+	if m.FuncCommitTx != nil {
+		return m.FuncCommitTx(tx)
+	}
+	return nil
+}
+
+func (m *BatchStatusRepositoryMock) RollbackTx(tx *sql.Tx) error {
+	if m.FuncRollbackTx != nil {
+		return m.FuncRollbackTx(tx)
+	}
+	return nil
+}
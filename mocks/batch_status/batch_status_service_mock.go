@@ -0,0 +1,36 @@
+package mocks
+
+import (
+	"context"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/batch_status"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/batch_status"
+)
+
+type BatchStatusServiceMock struct {
+	FuncHold        func(ctx context.Context, batchId int, req models.PostStatusChange) (*models.StatusChange, error)
+	FuncRelease     func(ctx context.Context, batchId int, req models.PostStatusChange) (*models.StatusChange, error)
+	FuncSetStatus   func(ctx context.Context, batchId int, status string, req models.PostStatusChange) (*models.StatusChange, error)
+	FuncApplyStatus func(ctx context.Context, exec repository.Executor, batchId int, status string, req models.PostStatusChange) (*models.StatusChange, error)
+	FuncFindStatus  func(ctx context.Context, batchId int) (*models.BatchStatusDetail, error)
+}
+
+func (m *BatchStatusServiceMock) Hold(ctx context.Context, batchId int, req models.PostStatusChange) (*models.StatusChange, error) {
+	return m.FuncHold(ctx, batchId, req)
+}
+
+func (m *BatchStatusServiceMock) Release(ctx context.Context, batchId int, req models.PostStatusChange) (*models.StatusChange, error) {
+	return m.FuncRelease(ctx, batchId, req)
+}
+
+func (m *BatchStatusServiceMock) SetStatus(ctx context.Context, batchId int, status string, req models.PostStatusChange) (*models.StatusChange, error) {
+	return m.FuncSetStatus(ctx, batchId, status, req)
+}
+
+func (m *BatchStatusServiceMock) ApplyStatus(ctx context.Context, exec repository.Executor, batchId int, status string, req models.PostStatusChange) (*models.StatusChange, error) {
+	return m.FuncApplyStatus(ctx, exec, batchId, status, req)
+}
+
+func (m *BatchStatusServiceMock) FindStatus(ctx context.Context, batchId int) (*models.BatchStatusDetail, error) {
+	return m.FuncFindStatus(ctx, batchId)
+}
@@ -0,0 +1,132 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/buyer_address"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer_address"
+)
+
+type BuyerAddressRepositoryMock struct {
+	FuncBuyerExists   func(ctx context.Context, buyerId int) (bool, error)
+	FuncLockBuyer     func(ctx context.Context, exec repository.Executor, buyerId int) error
+	FuncFindByBuyer   func(ctx context.Context, buyerId int) ([]models.Address, error)
+	FuncFindById      func(ctx context.Context, buyerId int, id int) (*models.Address, error)
+	FuncFindDefault   func(ctx context.Context, buyerId int) (*models.Address, error)
+	FuncFindForUpdate func(ctx context.Context, exec repository.Executor, buyerId int, id int) (*models.Address, error)
+	FuncFindOldest    func(ctx context.Context, exec repository.Executor, buyerId int) (*models.Address, error)
+	FuncCountByBuyer  func(ctx context.Context, exec repository.Executor, buyerId int) (int, error)
+	FuncCreate        func(ctx context.Context, exec repository.Executor, a models.Address) (int, error)
+	FuncUpdate        func(ctx context.Context, exec repository.Executor, a models.Address) error
+	FuncDelete        func(ctx context.Context, exec repository.Executor, buyerId int, id int) error
+	FuncClearDefault  func(ctx context.Context, exec repository.Executor, buyerId int) error
+	FuncBeginTx       func(ctx context.Context) (*sql.Tx, error)
+	FuncCommitTx      func(tx *sql.Tx) error
+	FuncRollbackTx    func(tx *sql.Tx) error
+}
+
+func (m *BuyerAddressRepositoryMock) BuyerExists(ctx context.Context, buyerId int) (bool, error) {
+	if m.FuncBuyerExists != nil {
+		return m.FuncBuyerExists(ctx, buyerId)
+	}
+	return false, nil
+}
+
+func (m *BuyerAddressRepositoryMock) LockBuyer(ctx context.Context, exec repository.Executor, buyerId int) error {
+	if m.FuncLockBuyer != nil {
+		return m.FuncLockBuyer(ctx, exec, buyerId)
+	}
+	return nil
+}
+
+func (m *BuyerAddressRepositoryMock) FindByBuyer(ctx context.Context, buyerId int) ([]models.Address, error) {
+	if m.FuncFindByBuyer != nil {
+		return m.FuncFindByBuyer(ctx, buyerId)
+	}
+	return []models.Address{}, nil
+}
+
+func (m *BuyerAddressRepositoryMock) FindById(ctx context.Context, buyerId int, id int) (*models.Address, error) {
+	if m.FuncFindById != nil {
+		return m.FuncFindById(ctx, buyerId, id)
+	}
+	return nil, nil
+}
+
+func (m *BuyerAddressRepositoryMock) FindDefault(ctx context.Context, buyerId int) (*models.Address, error) {
+	if m.FuncFindDefault != nil {
+		return m.FuncFindDefault(ctx, buyerId)
+	}
+	return nil, nil
+}
+
+func (m *BuyerAddressRepositoryMock) FindForUpdate(ctx context.Context, exec repository.Executor, buyerId int, id int) (*models.Address, error) {
+	if m.FuncFindForUpdate != nil {
+		return m.FuncFindForUpdate(ctx, exec, buyerId, id)
+	}
+	return nil, nil
+}
+
+func (m *BuyerAddressRepositoryMock) FindOldest(ctx context.Context, exec repository.Executor, buyerId int) (*models.Address, error) {
+	if m.FuncFindOldest != nil {
+		return m.FuncFindOldest(ctx, exec, buyerId)
+	}
+	return nil, nil
+}
+
+func (m *BuyerAddressRepositoryMock) CountByBuyer(ctx context.Context, exec repository.Executor, buyerId int) (int, error) {
+	if m.FuncCountByBuyer != nil {
+		return m.FuncCountByBuyer(ctx, exec, buyerId)
+	}
+	return 0, nil
+}
+
+func (m *BuyerAddressRepositoryMock) Create(ctx context.Context, exec repository.Executor, a models.Address) (int, error) {
+	if m.FuncCreate != nil {
+		return m.FuncCreate(ctx, exec, a)
+	}
+	return 0, nil
+}
+
+func (m *BuyerAddressRepositoryMock) Update(ctx context.Context, exec repository.Executor, a models.Address) error {
+	if m.FuncUpdate != nil {
+		return m.FuncUpdate(ctx, exec, a)
+	}
+	return nil
+}
+
+func (m *BuyerAddressRepositoryMock) Delete(ctx context.Context, exec repository.Executor, buyerId int, id int) error {
+	if m.FuncDelete != nil {
+		return m.FuncDelete(ctx, exec, buyerId, id)
+	}
+	return nil
+}
+
+func (m *BuyerAddressRepositoryMock) ClearDefault(ctx context.Context, exec repository.Executor, buyerId int) error {
+	if m.FuncClearDefault != nil {
+		return m.FuncClearDefault(ctx, exec, buyerId)
+	}
+	return nil
+}
+
+func (m *BuyerAddressRepositoryMock) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	if m.FuncBeginTx != nil {
+		return m.FuncBeginTx(ctx)
+	}
+	return &sql.Tx{}, nil
+}
+
+func (m *BuyerAddressRepositoryMock) CommitTx(tx *sql.Tx) error {
+	if m.FuncCommitTx != nil {
+		return m.FuncCommitTx(tx)
+	}
+	return nil
+}
+
+func (m *BuyerAddressRepositoryMock) RollbackTx(tx *sql.Tx) error {
+	if m.FuncRollbackTx != nil {
+		return m.FuncRollbackTx(tx)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer_address"
+)
+
+type BuyerAddressServiceMock struct {
+	FuncFindAll  func(ctx context.Context, buyerId int) ([]models.Address, error)
+	FuncFindById func(ctx context.Context, buyerId int, id int) (*models.Address, error)
+	FuncCreate   func(ctx context.Context, buyerId int, req models.PostAddress) (*models.Address, error)
+	FuncUpdate   func(ctx context.Context, buyerId int, id int, req models.PatchAddress) (*models.Address, error)
+	FuncDelete   func(ctx context.Context, buyerId int, id int) error
+}
+
+func (m *BuyerAddressServiceMock) FindAll(ctx context.Context, buyerId int) ([]models.Address, error) {
+	return m.FuncFindAll(ctx, buyerId)
+}
+
+func (m *BuyerAddressServiceMock) FindById(ctx context.Context, buyerId int, id int) (*models.Address, error) {
+	return m.FuncFindById(ctx, buyerId, id)
+}
+
+func (m *BuyerAddressServiceMock) Create(ctx context.Context, buyerId int, req models.PostAddress) (*models.Address, error) {
+	return m.FuncCreate(ctx, buyerId, req)
+}
+
+func (m *BuyerAddressServiceMock) Update(ctx context.Context, buyerId int, id int, req models.PatchAddress) (*models.Address, error) {
+	return m.FuncUpdate(ctx, buyerId, id, req)
+}
+
+func (m *BuyerAddressServiceMock) Delete(ctx context.Context, buyerId int, id int) error {
+	return m.FuncDelete(ctx, buyerId, id)
+}
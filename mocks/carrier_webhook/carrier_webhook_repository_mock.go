@@ -0,0 +1,69 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/carrier_webhook"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/carrier_webhook"
+)
+
+type CarrierWebhookRepositoryMock struct {
+	FuncFindCredentials    func(ctx context.Context, cid string) (*models.Credentials, error)
+	FuncSaveSecret         func(ctx context.Context, carrierId int, secret string, createdAt time.Time) error
+	FuncCreateNonce        func(ctx context.Context, exec repository.Executor, carrierId int, nonce string, receivedAt time.Time) error
+	FuncDeleteNoncesBefore func(ctx context.Context, before time.Time) (int64, error)
+	FuncBeginTx            func(ctx context.Context) (*sql.Tx, error)
+	FuncCommitTx           func(tx *sql.Tx) error
+	FuncRollbackTx         func(tx *sql.Tx) error
+}
+
+func (m *CarrierWebhookRepositoryMock) FindCredentials(ctx context.Context, cid string) (*models.Credentials, error) {
+	if m.FuncFindCredentials != nil {
+		return m.FuncFindCredentials(ctx, cid)
+	}
+	return &models.Credentials{CarrierId: 2, Secret: "secret"}, nil
+}
+
+func (m *CarrierWebhookRepositoryMock) SaveSecret(ctx context.Context, carrierId int, secret string, createdAt time.Time) error {
+	if m.FuncSaveSecret != nil {
+		return m.FuncSaveSecret(ctx, carrierId, secret, createdAt)
+	}
+	return nil
+}
+
+func (m *CarrierWebhookRepositoryMock) CreateNonce(ctx context.Context, exec repository.Executor, carrierId int, nonce string, receivedAt time.Time) error {
+	if m.FuncCreateNonce != nil {
+		return m.FuncCreateNonce(ctx, exec, carrierId, nonce, receivedAt)
+	}
+	return nil
+}
+
+func (m *CarrierWebhookRepositoryMock) DeleteNoncesBefore(ctx context.Context, before time.Time) (int64, error) {
+	if m.FuncDeleteNoncesBefore != nil {
+		return m.FuncDeleteNoncesBefore(ctx, before)
+	}
+	return 0, nil
+}
+
+func (m *CarrierWebhookRepositoryMock) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	if m.FuncBeginTx != nil {
+		return m.FuncBeginTx(ctx)
+	}
+	return &sql.Tx{}, nil
+}
+
+func (m *CarrierWebhookRepositoryMock) CommitTx(tx *sql.Tx) error {
+	if m.FuncCommitTx != nil {
+		return m.FuncCommitTx(tx)
+	}
+	return nil
+}
+
+func (m *CarrierWebhookRepositoryMock) RollbackTx(tx *sql.Tx) error {
+	if m.FuncRollbackTx != nil {
+		return m.FuncRollbackTx(tx)
+	}
+	return nil
+}
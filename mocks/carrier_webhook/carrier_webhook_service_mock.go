@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/carrier_webhook"
+)
+
+type CarrierWebhookServiceMock struct {
+	FuncRotateSecret func(ctx context.Context, cid string) (*models.WebhookSecret, error)
+	FuncReceive      func(ctx context.Context, cid string, sig models.Signature, body []byte) (*models.Receipt, error)
+	FuncPurgeNonces  func(ctx context.Context) (int64, error)
+}
+
+func (m *CarrierWebhookServiceMock) RotateSecret(ctx context.Context, cid string) (*models.WebhookSecret, error) {
+	return m.FuncRotateSecret(ctx, cid)
+}
+
+func (m *CarrierWebhookServiceMock) Receive(ctx context.Context, cid string, sig models.Signature, body []byte) (*models.Receipt, error) {
+	return m.FuncReceive(ctx, cid, sig, body)
+}
+
+func (m *CarrierWebhookServiceMock) PurgeNonces(ctx context.Context) (int64, error) {
+	return m.FuncPurgeNonces(ctx)
+}
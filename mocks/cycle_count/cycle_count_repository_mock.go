@@ -0,0 +1,148 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/cycle_count"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/cycle_count"
+)
+
+type CycleCountRepositoryMock struct {
+	FuncLockSection        func(ctx context.Context, exec repository.Executor, sectionId int) error
+	FuncHasActiveCount     func(ctx context.Context, exec repository.Executor, sectionId int) (bool, error)
+	FuncCreate             func(ctx context.Context, exec repository.Executor, c models.CycleCount) (*models.CycleCount, error)
+	FuncCreateLines        func(ctx context.Context, exec repository.Executor, countId int, sectionId int) (int, error)
+	FuncFindForUpdate      func(ctx context.Context, exec repository.Executor, id int) (*models.CycleCount, error)
+	FuncFindById           func(ctx context.Context, id int) (*models.CycleCount, error)
+	FuncFindAll            func(ctx context.Context, sectionId int, status string) ([]models.CycleCount, error)
+	FuncFindLinesForUpdate func(ctx context.Context, exec repository.Executor, countId int) ([]models.CountLine, error)
+	FuncFindLines          func(ctx context.Context, countId int) ([]models.CountLine, error)
+	FuncUpdateLine         func(ctx context.Context, exec repository.Executor, l models.CountLine) error
+	FuncUpdateStatus       func(ctx context.Context, exec repository.Executor, c models.CycleCount) error
+	FuncCreateEvent        func(ctx context.Context, exec repository.Executor, e models.CountEvent) error
+	FuncAddSectionCapacity func(ctx context.Context, exec repository.Executor, sectionId int, delta int) error
+	FuncFindEvents         func(ctx context.Context, countId int) ([]models.CountEvent, error)
+	FuncBeginTx            func(ctx context.Context) (*sql.Tx, error)
+	FuncCommitTx           func(tx *sql.Tx) error
+	FuncRollbackTx         func(tx *sql.Tx) error
+}
+
+func (m *CycleCountRepositoryMock) LockSection(ctx context.Context, exec repository.Executor, sectionId int) error {
+	if m.FuncLockSection != nil {
+		return m.FuncLockSection(ctx, exec, sectionId)
+	}
+	return nil
+}
+
+func (m *CycleCountRepositoryMock) HasActiveCount(ctx context.Context, exec repository.Executor, sectionId int) (bool, error) {
+	if m.FuncHasActiveCount != nil {
+		return m.FuncHasActiveCount(ctx, exec, sectionId)
+	}
+	return false, nil
+}
+
+func (m *CycleCountRepositoryMock) Create(ctx context.Context, exec repository.Executor, c models.CycleCount) (*models.CycleCount, error) {
+	if m.FuncCreate != nil {
+		return m.FuncCreate(ctx, exec, c)
+	}
+	return &c, nil
+}
+
+func (m *CycleCountRepositoryMock) CreateLines(ctx context.Context, exec repository.Executor, countId int, sectionId int) (int, error) {
+	if m.FuncCreateLines != nil {
+		return m.FuncCreateLines(ctx, exec, countId, sectionId)
+	}
+	return 0, nil
+}
+
+func (m *CycleCountRepositoryMock) FindForUpdate(ctx context.Context, exec repository.Executor, id int) (*models.CycleCount, error) {
+	if m.FuncFindForUpdate != nil {
+		return m.FuncFindForUpdate(ctx, exec, id)
+	}
+	return nil, nil
+}
+
+func (m *CycleCountRepositoryMock) FindById(ctx context.Context, id int) (*models.CycleCount, error) {
+	if m.FuncFindById != nil {
+		return m.FuncFindById(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *CycleCountRepositoryMock) FindAll(ctx context.Context, sectionId int, status string) ([]models.CycleCount, error) {
+	if m.FuncFindAll != nil {
+		return m.FuncFindAll(ctx, sectionId, status)
+	}
+	return []models.CycleCount{}, nil
+}
+
+func (m *CycleCountRepositoryMock) FindLinesForUpdate(ctx context.Context, exec repository.Executor, countId int) ([]models.CountLine, error) {
+	if m.FuncFindLinesForUpdate != nil {
+		return m.FuncFindLinesForUpdate(ctx, exec, countId)
+	}
+	return []models.CountLine{}, nil
+}
+
+func (m *CycleCountRepositoryMock) FindLines(ctx context.Context, countId int) ([]models.CountLine, error) {
+	if m.FuncFindLines != nil {
+		return m.FuncFindLines(ctx, countId)
+	}
+	return []models.CountLine{}, nil
+}
+
+func (m *CycleCountRepositoryMock) UpdateLine(ctx context.Context, exec repository.Executor, l models.CountLine) error {
+	if m.FuncUpdateLine != nil {
+		return m.FuncUpdateLine(ctx, exec, l)
+	}
+	return nil
+}
+
+func (m *CycleCountRepositoryMock) UpdateStatus(ctx context.Context, exec repository.Executor, c models.CycleCount) error {
+	if m.FuncUpdateStatus != nil {
+		return m.FuncUpdateStatus(ctx, exec, c)
+	}
+	return nil
+}
+
+func (m *CycleCountRepositoryMock) CreateEvent(ctx context.Context, exec repository.Executor, e models.CountEvent) error {
+	if m.FuncCreateEvent != nil {
+		return m.FuncCreateEvent(ctx, exec, e)
+	}
+	return nil
+}
+
+func (m *CycleCountRepositoryMock) AddSectionCapacity(ctx context.Context, exec repository.Executor, sectionId int, delta int) error {
+	if m.FuncAddSectionCapacity != nil {
+		return m.FuncAddSectionCapacity(ctx, exec, sectionId, delta)
+	}
+	return nil
+}
+
+func (m *CycleCountRepositoryMock) FindEvents(ctx context.Context, countId int) ([]models.CountEvent, error) {
+	if m.FuncFindEvents != nil {
+		return m.FuncFindEvents(ctx, countId)
+	}
+	return []models.CountEvent{}, nil
+}
+
+func (m *CycleCountRepositoryMock) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	if m.FuncBeginTx != nil {
+		return m.FuncBeginTx(ctx)
+	}
+	return &sql.Tx{}, nil
+}
+
+func (m *CycleCountRepositoryMock) CommitTx(tx *sql.Tx) error {
+	if m.FuncCommitTx != nil {
+		return m.FuncCommitTx(tx)
+	}
+	return nil
+}
+
+func (m *CycleCountRepositoryMock) RollbackTx(tx *sql.Tx) error {
+	if m.FuncRollbackTx != nil {
+		return m.FuncRollbackTx(tx)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/cycle_count"
+)
+
+type CycleCountServiceMock struct {
+	FuncCreate       func(ctx context.Context, req models.PostCycleCount) (*models.CycleCountDetail, error)
+	FuncFindAll      func(ctx context.Context, sectionId int, status string) ([]models.CycleCount, error)
+	FuncFindById     func(ctx context.Context, id int) (*models.CycleCountDetail, error)
+	FuncRecordCounts func(ctx context.Context, id int, req models.PostCountedLines) (*models.CycleCountDetail, error)
+	FuncApprove      func(ctx context.Context, id int, req models.PostCountDecision) (*models.CycleCountDetail, error)
+	FuncCancel       func(ctx context.Context, id int, req models.PostCountDecision) (*models.CycleCountDetail, error)
+}
+
+func (m *CycleCountServiceMock) Create(ctx context.Context, req models.PostCycleCount) (*models.CycleCountDetail, error) {
+	return m.FuncCreate(ctx, req)
+}
+
+func (m *CycleCountServiceMock) FindAll(ctx context.Context, sectionId int, status string) ([]models.CycleCount, error) {
+	return m.FuncFindAll(ctx, sectionId, status)
+}
+
+func (m *CycleCountServiceMock) FindById(ctx context.Context, id int) (*models.CycleCountDetail, error) {
+	return m.FuncFindById(ctx, id)
+}
+
+func (m *CycleCountServiceMock) RecordCounts(ctx context.Context, id int, req models.PostCountedLines) (*models.CycleCountDetail, error) {
+	return m.FuncRecordCounts(ctx, id, req)
+}
+
+func (m *CycleCountServiceMock) Approve(ctx context.Context, id int, req models.PostCountDecision) (*models.CycleCountDetail, error) {
+	return m.FuncApprove(ctx, id, req)
+}
+
+func (m *CycleCountServiceMock) Cancel(ctx context.Context, id int, req models.PostCountDecision) (*models.CycleCountDetail, error) {
+	return m.FuncCancel(ctx, id, req)
+}
@@ -0,0 +1,125 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/dock"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/dock"
+)
+
+type DockRepositoryMock struct {
+	FuncFindDocks                 func(ctx context.Context, warehouseId int) ([]models.Dock, error)
+	FuncCreateDock                func(ctx context.Context, d models.PostDock) (*models.Dock, error)
+	FuncFindDockForUpdate         func(ctx context.Context, exec repository.Executor, id int) (*models.Dock, error)
+	FuncCountOverlapping          func(ctx context.Context, exec repository.Executor, dockId int, startsAt time.Time, endsAt time.Time) (int, error)
+	FuncFindAsnWarehouse          func(ctx context.Context, exec repository.Executor, asnId int) (int, error)
+	FuncFindInboundOrderWarehouse func(ctx context.Context, exec repository.Executor, inboundOrderId int) (int, error)
+	FuncCreateAppointment         func(ctx context.Context, exec repository.Executor, a models.Appointment) (int, error)
+	FuncFindAppointmentById       func(ctx context.Context, id int) (*models.Appointment, error)
+	FuncFindAppointmentForUpdate  func(ctx context.Context, exec repository.Executor, id int) (*models.Appointment, error)
+	FuncUpdateAppointment         func(ctx context.Context, exec repository.Executor, a models.Appointment) error
+	FuncFindAppointmentsBetween   func(ctx context.Context, warehouseId int, from time.Time, to time.Time) ([]models.Appointment, error)
+	FuncBeginTx                   func(ctx context.Context) (*sql.Tx, error)
+	FuncCommitTx                  func(tx *sql.Tx) error
+	FuncRollbackTx                func(tx *sql.Tx) error
+}
+
+func (m *DockRepositoryMock) FindDocks(ctx context.Context, warehouseId int) ([]models.Dock, error) {
+	if m.FuncFindDocks != nil {
+		return m.FuncFindDocks(ctx, warehouseId)
+	}
+	return []models.Dock{}, nil
+}
+
+func (m *DockRepositoryMock) CreateDock(ctx context.Context, d models.PostDock) (*models.Dock, error) {
+	if m.FuncCreateDock != nil {
+		return m.FuncCreateDock(ctx, d)
+	}
+	return &models.Dock{WarehouseId: d.WarehouseId, Code: d.Code, OpensAt: d.OpensAt, ClosesAt: d.ClosesAt}, nil
+}
+
+func (m *DockRepositoryMock) FindDockForUpdate(ctx context.Context, exec repository.Executor, id int) (*models.Dock, error) {
+	if m.FuncFindDockForUpdate != nil {
+		return m.FuncFindDockForUpdate(ctx, exec, id)
+	}
+	return nil, nil
+}
+
+func (m *DockRepositoryMock) CountOverlapping(ctx context.Context, exec repository.Executor, dockId int, startsAt time.Time, endsAt time.Time) (int, error) {
+	if m.FuncCountOverlapping != nil {
+		return m.FuncCountOverlapping(ctx, exec, dockId, startsAt, endsAt)
+	}
+	return 0, nil
+}
+
+func (m *DockRepositoryMock) FindAsnWarehouse(ctx context.Context, exec repository.Executor, asnId int) (int, error) {
+	if m.FuncFindAsnWarehouse != nil {
+		return m.FuncFindAsnWarehouse(ctx, exec, asnId)
+	}
+	return 0, nil
+}
+
+func (m *DockRepositoryMock) FindInboundOrderWarehouse(ctx context.Context, exec repository.Executor, inboundOrderId int) (int, error) {
+	if m.FuncFindInboundOrderWarehouse != nil {
+		return m.FuncFindInboundOrderWarehouse(ctx, exec, inboundOrderId)
+	}
+	return 0, nil
+}
+
+func (m *DockRepositoryMock) CreateAppointment(ctx context.Context, exec repository.Executor, a models.Appointment) (int, error) {
+	if m.FuncCreateAppointment != nil {
+		return m.FuncCreateAppointment(ctx, exec, a)
+	}
+	return 0, nil
+}
+
+func (m *DockRepositoryMock) FindAppointmentById(ctx context.Context, id int) (*models.Appointment, error) {
+	if m.FuncFindAppointmentById != nil {
+		return m.FuncFindAppointmentById(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *DockRepositoryMock) FindAppointmentForUpdate(ctx context.Context, exec repository.Executor, id int) (*models.Appointment, error) {
+	if m.FuncFindAppointmentForUpdate != nil {
+		return m.FuncFindAppointmentForUpdate(ctx, exec, id)
+	}
+	return nil, nil
+}
+
+func (m *DockRepositoryMock) UpdateAppointment(ctx context.Context, exec repository.Executor, a models.Appointment) error {
+	if m.FuncUpdateAppointment != nil {
+		return m.FuncUpdateAppointment(ctx, exec, a)
+	}
+	return nil
+}
+
+func (m *DockRepositoryMock) FindAppointmentsBetween(ctx context.Context, warehouseId int, from time.Time, to time.Time) ([]models.Appointment, error) {
+	if m.FuncFindAppointmentsBetween != nil {
+		return m.FuncFindAppointmentsBetween(ctx, warehouseId, from, to)
+	}
+	return []models.Appointment{}, nil
+}
+
+func (m *DockRepositoryMock) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	if m.FuncBeginTx != nil {
+		return m.FuncBeginTx(ctx)
+	}
+	return &sql.Tx{}, nil
+}
+
+func (m *DockRepositoryMock) CommitTx(tx *sql.Tx) error {
+	if m.FuncCommitTx != nil {
+		return m.FuncCommitTx(tx)
+	}
+	return nil
+}
+
+func (m *DockRepositoryMock) RollbackTx(tx *sql.Tx) error {
+	if m.FuncRollbackTx != nil {
+		return m.FuncRollbackTx(tx)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/dock"
+)
+
+type DockServiceMock struct {
+	FuncFindDocks           func(ctx context.Context, warehouseId int) ([]models.Dock, error)
+	FuncCreateDock          func(ctx context.Context, req models.PostDock) (*models.Dock, error)
+	FuncBook                func(ctx context.Context, req models.PostAppointment) (*models.Appointment, error)
+	FuncFindAppointmentById func(ctx context.Context, id int) (*models.Appointment, error)
+	FuncCancel              func(ctx context.Context, id int) (*models.Appointment, error)
+	FuncMarkMissed          func(ctx context.Context, id int) (*models.Appointment, error)
+	FuncRecordArrival       func(ctx context.Context, id int, req models.PostArrival) (*models.Appointment, error)
+	FuncDaySchedule         func(ctx context.Context, warehouseId int, day time.Time) (*models.DaySchedule, error)
+}
+
+func (m *DockServiceMock) FindDocks(ctx context.Context, warehouseId int) ([]models.Dock, error) {
+	return m.FuncFindDocks(ctx, warehouseId)
+}
+
+func (m *DockServiceMock) CreateDock(ctx context.Context, req models.PostDock) (*models.Dock, error) {
+	return m.FuncCreateDock(ctx, req)
+}
+
+func (m *DockServiceMock) Book(ctx context.Context, req models.PostAppointment) (*models.Appointment, error) {
+	return m.FuncBook(ctx, req)
+}
+
+func (m *DockServiceMock) FindAppointmentById(ctx context.Context, id int) (*models.Appointment, error) {
+	return m.FuncFindAppointmentById(ctx, id)
+}
+
+func (m *DockServiceMock) Cancel(ctx context.Context, id int) (*models.Appointment, error) {
+	return m.FuncCancel(ctx, id)
+}
+
+func (m *DockServiceMock) MarkMissed(ctx context.Context, id int) (*models.Appointment, error) {
+	return m.FuncMarkMissed(ctx, id)
+}
+
+func (m *DockServiceMock) RecordArrival(ctx context.Context, id int, req models.PostArrival) (*models.Appointment, error) {
+	return m.FuncRecordArrival(ctx, id, req)
+}
+
+func (m *DockServiceMock) DaySchedule(ctx context.Context, warehouseId int, day time.Time) (*models.DaySchedule, error) {
+	return m.FuncDaySchedule(ctx, warehouseId, day)
+}
@@ -15,6 +15,7 @@ type GeographyRepositoryMock struct {
     FuncFindProvinceByName             func(ctx context.Context, name string, countryId int) (*models.Province, error)
     FuncCreateLocality                 func(ctx context.Context, exec repository.Executor, l models.Locality) (*models.Locality, error)
     FuncFindLocalityById               func(ctx context.Context, id string) (*models.Locality, error)
+    FuncUpdateLocalityCoordinates      func(ctx context.Context, id string, latitude float64, longitude float64) error
     FuncCountSellersByLocality         func(ctx context.Context, id string) (*models.ResponseLocalitySellers, error)
     FuncCountSellersGroupedByLocality  func(ctx context.Context) ([]models.ResponseLocalitySellers, error)
     FuncBeginTx                        func(ctx context.Context) (*sql.Tx, error)
@@ -65,6 +66,13 @@ func (m *GeographyRepositoryMock) FindLocalityById(ctx context.Context, id strin
     return nil, nil
 }
 
+func (m *GeographyRepositoryMock) UpdateLocalityCoordinates(ctx context.Context, id string, latitude float64, longitude float64) error {
+    if m.FuncUpdateLocalityCoordinates != nil {
+        return m.FuncUpdateLocalityCoordinates(ctx, id, latitude, longitude)
+    }
+    return nil
+}
+
 func (m *GeographyRepositoryMock) CountSellersByLocality(ctx context.Context, id string) (*models.ResponseLocalitySellers, error) {
     if m.FuncCountSellersByLocality != nil {
         return m.FuncCountSellersByLocality(ctx, id)
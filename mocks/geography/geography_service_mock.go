@@ -11,6 +11,7 @@ type GeographyServiceMock struct {
 	CreateFn                        func(ctx context.Context, gr models.RequestGeography) (*models.ResponseGeography, error)
 	CountSellersByLocalityFn        func(ctx context.Context, id string) (*models.ResponseLocalitySellers, error)
 	CountSellersGroupedByLocalityFn func(ctx context.Context) ([]models.ResponseLocalitySellers, error)
+	SetLocalityCoordinatesFn        func(ctx context.Context, id string, c models.RequestCoordinates) (*models.Locality, error)
 }
 
 func (g *GeographyServiceMock) Create(ctx context.Context, gr models.RequestGeography) (*models.ResponseGeography, error) {
@@ -24,3 +25,7 @@ func (g *GeographyServiceMock) CountSellersByLocality(ctx context.Context, id st
 func (g *GeographyServiceMock) CountSellersGroupedByLocality(ctx context.Context) ([]models.ResponseLocalitySellers, error) {
 	return g.CountSellersGroupedByLocalityFn(ctx)
 }
+
+func (g *GeographyServiceMock) SetLocalityCoordinates(ctx context.Context, id string, c models.RequestCoordinates) (*models.Locality, error) {
+	return g.SetLocalityCoordinatesFn(ctx, id, c)
+}
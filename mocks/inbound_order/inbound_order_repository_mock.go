@@ -10,6 +10,7 @@ import (
 type InboundOrderRepositoryMock struct {
 	MockCreate              func(ctx context.Context, o *models.InboundOrder) (*models.InboundOrder, error)
 	MockExistsByOrderNumber func(ctx context.Context, orderNumber string) (bool, error)
+	MockBatchInWarehouse    func(ctx context.Context, productBatchID int, warehouseID int) (bool, error)
 	MockReportAll           func(ctx context.Context) ([]models.InboundOrderReport, error)
 	MockReportByID          func(ctx context.Context, employeeID int) (*models.InboundOrderReport, error)
 }
@@ -20,6 +21,9 @@ func (m *InboundOrderRepositoryMock) Create(ctx context.Context, o *models.Inbou
 func (m *InboundOrderRepositoryMock) ExistsByOrderNumber(ctx context.Context, orderNumber string) (bool, error) {
 	return m.MockExistsByOrderNumber(ctx, orderNumber)
 }
+func (m *InboundOrderRepositoryMock) BatchInWarehouse(ctx context.Context, productBatchID int, warehouseID int) (bool, error) {
+	return m.MockBatchInWarehouse(ctx, productBatchID, warehouseID)
+}
 func (m *InboundOrderRepositoryMock) ReportAll(ctx context.Context) ([]models.InboundOrderReport, error) {
 	return m.MockReportAll(ctx)
 }
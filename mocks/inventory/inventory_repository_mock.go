@@ -0,0 +1,19 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inventory"
+)
+
+type InventoryRepositoryMock struct {
+	FuncFindOnHand func(ctx context.Context, filter models.InventoryFilter, now time.Time) ([]models.InventoryLine, error)
+}
+
+func (m *InventoryRepositoryMock) FindOnHand(ctx context.Context, filter models.InventoryFilter, now time.Time) ([]models.InventoryLine, error) {
+	if m.FuncFindOnHand != nil {
+		return m.FuncFindOnHand(ctx, filter, now)
+	}
+	return []models.InventoryLine{}, nil
+}
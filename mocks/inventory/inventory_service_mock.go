@@ -0,0 +1,15 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inventory"
+)
+
+type InventoryServiceMock struct {
+	FuncFindOnHand func(ctx context.Context, filter models.InventoryFilter) ([]models.InventoryLine, error)
+}
+
+func (m *InventoryServiceMock) FindOnHand(ctx context.Context, filter models.InventoryFilter) ([]models.InventoryLine, error) {
+	return m.FuncFindOnHand(ctx, filter)
+}
@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/occupancy"
+)
+
+type OccupancyRepositoryMock struct {
+	FuncFindWarehouses           func(ctx context.Context, warehouseId int) ([]models.WarehouseOccupancy, error)
+	FuncFindProductTypeUsage     func(ctx context.Context, warehouseId int) ([]models.ProductTypeUsage, error)
+	FuncFindSectionsBelowMinimum func(ctx context.Context, warehouseId int) ([]models.SectionBelowMinimum, error)
+	FuncFindExpiryCounts         func(ctx context.Context, now, until time.Time, warehouseId int) ([]models.ExpiryCounts, error)
+}
+
+func (m *OccupancyRepositoryMock) FindWarehouses(ctx context.Context, warehouseId int) ([]models.WarehouseOccupancy, error) {
+	if m.FuncFindWarehouses != nil {
+		return m.FuncFindWarehouses(ctx, warehouseId)
+	}
+	return []models.WarehouseOccupancy{}, nil
+}
+
+func (m *OccupancyRepositoryMock) FindProductTypeUsage(ctx context.Context, warehouseId int) ([]models.ProductTypeUsage, error) {
+	if m.FuncFindProductTypeUsage != nil {
+		return m.FuncFindProductTypeUsage(ctx, warehouseId)
+	}
+	return []models.ProductTypeUsage{}, nil
+}
+
+func (m *OccupancyRepositoryMock) FindSectionsBelowMinimum(ctx context.Context, warehouseId int) ([]models.SectionBelowMinimum, error) {
+	if m.FuncFindSectionsBelowMinimum != nil {
+		return m.FuncFindSectionsBelowMinimum(ctx, warehouseId)
+	}
+	return []models.SectionBelowMinimum{}, nil
+}
+
+func (m *OccupancyRepositoryMock) FindExpiryCounts(ctx context.Context, now, until time.Time, warehouseId int) ([]models.ExpiryCounts, error) {
+	if m.FuncFindExpiryCounts != nil {
+		return m.FuncFindExpiryCounts(ctx, now, until, warehouseId)
+	}
+	return []models.ExpiryCounts{}, nil
+}
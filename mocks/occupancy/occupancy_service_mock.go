@@ -0,0 +1,21 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/occupancy"
+)
+
+type OccupancyServiceMock struct {
+	FuncFindAll  func(ctx context.Context, within time.Duration) ([]models.WarehouseOccupancy, error)
+	FuncFindById func(ctx context.Context, warehouseId int, within time.Duration) (*models.WarehouseOccupancy, error)
+}
+
+func (m *OccupancyServiceMock) FindAll(ctx context.Context, within time.Duration) ([]models.WarehouseOccupancy, error) {
+	return m.FuncFindAll(ctx, within)
+}
+
+func (m *OccupancyServiceMock) FindById(ctx context.Context, warehouseId int, within time.Duration) (*models.WarehouseOccupancy, error) {
+	return m.FuncFindById(ctx, warehouseId, within)
+}
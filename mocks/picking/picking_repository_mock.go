@@ -0,0 +1,189 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/picking"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/picking"
+)
+
+type PickingRepositoryMock struct {
+	FuncFindWaveCandidatesForUpdate func(ctx context.Context, exec repository.Executor, warehouseId int) ([]models.PickLine, error)
+	FuncCreateWave                  func(ctx context.Context, exec repository.Executor, w models.Wave) (int, error)
+	FuncCreateList                  func(ctx context.Context, exec repository.Executor, waveId int) (int, error)
+	FuncCreateLine                  func(ctx context.Context, exec repository.Executor, listId int, l models.PickLine) error
+	FuncFindWaves                   func(ctx context.Context, warehouseId int, status string) ([]models.Wave, error)
+	FuncFindWaveById                func(ctx context.Context, id int) (*models.Wave, error)
+	FuncFindListsByWave             func(ctx context.Context, waveId int) ([]models.PickList, error)
+	FuncFindListById                func(ctx context.Context, id int) (*models.PickList, error)
+	FuncFindListForUpdate           func(ctx context.Context, exec repository.Executor, id int) (*models.PickList, error)
+	FuncFindLines                   func(ctx context.Context, listId int) ([]models.PickLine, error)
+	FuncFindLinesForUpdate          func(ctx context.Context, exec repository.Executor, listId int) ([]models.PickLine, error)
+	FuncRecordPicked                func(ctx context.Context, exec repository.Executor, lineId int, picked int) error
+	FuncMarkAllocationPicked        func(ctx context.Context, exec repository.Executor, allocationId int) error
+	FuncAddSectionCapacity          func(ctx context.Context, exec repository.Executor, sectionId int, delta int) error
+	FuncConfirmList                 func(ctx context.Context, exec repository.Executor, l models.PickList) error
+	FuncCountOpenLists              func(ctx context.Context, exec repository.Executor, waveId int) (int, error)
+	FuncCompleteWave                func(ctx context.Context, exec repository.Executor, waveId int, completedAt time.Time) error
+	FuncFindEmployeeWarehouse       func(ctx context.Context, exec repository.Executor, employeeId int) (int, error)
+	FuncFindShortages               func(ctx context.Context, warehouseId int) ([]models.PickShortage, error)
+	FuncBeginTx                     func(ctx context.Context) (*sql.Tx, error)
+	FuncCommitTx                    func(tx *sql.Tx) error
+	FuncRollbackTx                  func(tx *sql.Tx) error
+}
+
+func (m *PickingRepositoryMock) FindWaveCandidatesForUpdate(ctx context.Context, exec repository.Executor, warehouseId int) ([]models.PickLine, error) {
+	if m.FuncFindWaveCandidatesForUpdate != nil {
+		return m.FuncFindWaveCandidatesForUpdate(ctx, exec, warehouseId)
+	}
+	return []models.PickLine{}, nil
+}
+
+func (m *PickingRepositoryMock) CreateWave(ctx context.Context, exec repository.Executor, w models.Wave) (int, error) {
+	if m.FuncCreateWave != nil {
+		return m.FuncCreateWave(ctx, exec, w)
+	}
+	return 1, nil
+}
+
+func (m *PickingRepositoryMock) CreateList(ctx context.Context, exec repository.Executor, waveId int) (int, error) {
+	if m.FuncCreateList != nil {
+		return m.FuncCreateList(ctx, exec, waveId)
+	}
+	return 1, nil
+}
+
+func (m *PickingRepositoryMock) CreateLine(ctx context.Context, exec repository.Executor, listId int, l models.PickLine) error {
+	if m.FuncCreateLine != nil {
+		return m.FuncCreateLine(ctx, exec, listId, l)
+	}
+	return nil
+}
+
+func (m *PickingRepositoryMock) FindWaves(ctx context.Context, warehouseId int, status string) ([]models.Wave, error) {
+	if m.FuncFindWaves != nil {
+		return m.FuncFindWaves(ctx, warehouseId, status)
+	}
+	return []models.Wave{}, nil
+}
+
+func (m *PickingRepositoryMock) FindWaveById(ctx context.Context, id int) (*models.Wave, error) {
+	if m.FuncFindWaveById != nil {
+		return m.FuncFindWaveById(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *PickingRepositoryMock) FindListsByWave(ctx context.Context, waveId int) ([]models.PickList, error) {
+	if m.FuncFindListsByWave != nil {
+		return m.FuncFindListsByWave(ctx, waveId)
+	}
+	return []models.PickList{}, nil
+}
+
+func (m *PickingRepositoryMock) FindListById(ctx context.Context, id int) (*models.PickList, error) {
+	if m.FuncFindListById != nil {
+		return m.FuncFindListById(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *PickingRepositoryMock) FindListForUpdate(ctx context.Context, exec repository.Executor, id int) (*models.PickList, error) {
+	if m.FuncFindListForUpdate != nil {
+		return m.FuncFindListForUpdate(ctx, exec, id)
+	}
+	return nil, nil
+}
+
+func (m *PickingRepositoryMock) FindLines(ctx context.Context, listId int) ([]models.PickLine, error) {
+	if m.FuncFindLines != nil {
+		return m.FuncFindLines(ctx, listId)
+	}
+	return []models.PickLine{}, nil
+}
+
+func (m *PickingRepositoryMock) FindLinesForUpdate(ctx context.Context, exec repository.Executor, listId int) ([]models.PickLine, error) {
+	if m.FuncFindLinesForUpdate != nil {
+		return m.FuncFindLinesForUpdate(ctx, exec, listId)
+	}
+	return []models.PickLine{}, nil
+}
+
+func (m *PickingRepositoryMock) RecordPicked(ctx context.Context, exec repository.Executor, lineId int, picked int) error {
+	if m.FuncRecordPicked != nil {
+		return m.FuncRecordPicked(ctx, exec, lineId, picked)
+	}
+	return nil
+}
+
+func (m *PickingRepositoryMock) MarkAllocationPicked(ctx context.Context, exec repository.Executor, allocationId int) error {
+	if m.FuncMarkAllocationPicked != nil {
+		return m.FuncMarkAllocationPicked(ctx, exec, allocationId)
+	}
+	return nil
+}
+
+func (m *PickingRepositoryMock) AddSectionCapacity(ctx context.Context, exec repository.Executor, sectionId int, delta int) error {
+	if m.FuncAddSectionCapacity != nil {
+		return m.FuncAddSectionCapacity(ctx, exec, sectionId, delta)
+	}
+	return nil
+}
+
+func (m *PickingRepositoryMock) ConfirmList(ctx context.Context, exec repository.Executor, l models.PickList) error {
+	if m.FuncConfirmList != nil {
+		return m.FuncConfirmList(ctx, exec, l)
+	}
+	return nil
+}
+
+func (m *PickingRepositoryMock) CountOpenLists(ctx context.Context, exec repository.Executor, waveId int) (int, error) {
+	if m.FuncCountOpenLists != nil {
+		return m.FuncCountOpenLists(ctx, exec, waveId)
+	}
+	return 0, nil
+}
+
+func (m *PickingRepositoryMock) CompleteWave(ctx context.Context, exec repository.Executor, waveId int, completedAt time.Time) error {
+	if m.FuncCompleteWave != nil {
+		return m.FuncCompleteWave(ctx, exec, waveId, completedAt)
+	}
+	return nil
+}
+
+func (m *PickingRepositoryMock) FindEmployeeWarehouse(ctx context.Context, exec repository.Executor, employeeId int) (int, error) {
+	if m.FuncFindEmployeeWarehouse != nil {
+		return m.FuncFindEmployeeWarehouse(ctx, exec, employeeId)
+	}
+	return 0, nil
+}
+
+func (m *PickingRepositoryMock) FindShortages(ctx context.Context, warehouseId int) ([]models.PickShortage, error) {
+	if m.FuncFindShortages != nil {
+		return m.FuncFindShortages(ctx, warehouseId)
+	}
+	return []models.PickShortage{}, nil
+}
+
+func (m *PickingRepositoryMock) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	if m.FuncBeginTx != nil {
+		return m.FuncBeginTx(ctx)
+	}
+	return &sql.Tx{}, nil
+}
+
+func (m *PickingRepositoryMock) CommitTx(tx *sql.Tx) error {
+	if m.FuncCommitTx != nil {
+		return m.FuncCommitTx(tx)
+	}
+	return nil
+}
+
+func (m *PickingRepositoryMock) RollbackTx(tx *sql.Tx) error {
+	if m.FuncRollbackTx != nil {
+		return m.FuncRollbackTx(tx)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/picking"
+)
+
+type PickingServiceMock struct {
+	FuncCreateWave func(ctx context.Context, req models.PostWave) (*models.WaveDetail, error)
+	FuncFindWaves  func(ctx context.Context, warehouseId int, status string) ([]models.Wave, error)
+	FuncFindWave   func(ctx context.Context, id int) (*models.WaveDetail, error)
+	FuncFindList   func(ctx context.Context, id int) (*models.PickList, error)
+	FuncConfirm    func(ctx context.Context, listId int, req models.PostPickConfirmation) (*models.PickConfirmation, error)
+	FuncShortages  func(ctx context.Context, warehouseId int) ([]models.PickShortage, error)
+}
+
+func (m *PickingServiceMock) CreateWave(ctx context.Context, req models.PostWave) (*models.WaveDetail, error) {
+	return m.FuncCreateWave(ctx, req)
+}
+
+func (m *PickingServiceMock) FindWaves(ctx context.Context, warehouseId int, status string) ([]models.Wave, error) {
+	return m.FuncFindWaves(ctx, warehouseId, status)
+}
+
+func (m *PickingServiceMock) FindWave(ctx context.Context, id int) (*models.WaveDetail, error) {
+	return m.FuncFindWave(ctx, id)
+}
+
+func (m *PickingServiceMock) FindList(ctx context.Context, id int) (*models.PickList, error) {
+	return m.FuncFindList(ctx, id)
+}
+
+func (m *PickingServiceMock) Confirm(ctx context.Context, listId int, req models.PostPickConfirmation) (*models.PickConfirmation, error) {
+	return m.FuncConfirm(ctx, listId, req)
+}
+
+func (m *PickingServiceMock) Shortages(ctx context.Context, warehouseId int) ([]models.PickShortage, error) {
+	return m.FuncShortages(ctx, warehouseId)
+}
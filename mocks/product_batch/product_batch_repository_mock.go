@@ -2,13 +2,17 @@ package mocks
 
 import (
 	"context"
+	"time"
+
 	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
 )
 
 type ProductBatchRepositoryMock struct {
 	FuncCreate        func(ctx context.Context, proBa models.ProductBatches) (*models.ProductBatches, error)
 	FuncGetReportById func(ctx context.Context, id int) (*models.ReportProduct, error)
-	FuncGetReport     func(ctx context.Context) ([]models.ReportProduct, error)
+	FuncGetReport     func(ctx context.Context, warehouseId int) ([]models.ReportProduct, error)
+	FuncGetExpiring   func(ctx context.Context, until time.Time, warehouseId int) ([]models.ExpiringBatch, error)
+	FuncQuarantine    func(ctx context.Context, now time.Time) ([]int, error)
 }
 
 func (m *ProductBatchRepositoryMock) CreateProductBatches(ctx context.Context, proBa models.ProductBatches) (*models.ProductBatches, error) {
@@ -18,6 +22,12 @@ func (m *ProductBatchRepositoryMock) GetReportProductById(ctx context.Context, i
 
 	return m.FuncGetReportById(ctx, id)
 }
-func (m *ProductBatchRepositoryMock) GetReportProduct(ctx context.Context) ([]models.ReportProduct, error) {
-	return m.FuncGetReport(ctx)
+func (m *ProductBatchRepositoryMock) GetReportProduct(ctx context.Context, warehouseId int) ([]models.ReportProduct, error) {
+	return m.FuncGetReport(ctx, warehouseId)
+}
+func (m *ProductBatchRepositoryMock) GetExpiring(ctx context.Context, until time.Time, warehouseId int) ([]models.ExpiringBatch, error) {
+	return m.FuncGetExpiring(ctx, until, warehouseId)
+}
+func (m *ProductBatchRepositoryMock) QuarantineExpired(ctx context.Context, now time.Time) ([]int, error) {
+	return m.FuncQuarantine(ctx, now)
 }
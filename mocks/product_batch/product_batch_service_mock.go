@@ -2,13 +2,17 @@ package mocks
 
 import (
 	"context"
+	"time"
+
 	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
 )
 
 type ProductBatchServiceMock struct {
 	FuncCreate        func(ctx context.Context, proBa models.ProductBatches) (*models.ProductBatches, error)
 	FuncGetReportById func(ctx context.Context, id int) (*models.ReportProduct, error)
-	FuncGetReport     func(ctx context.Context) ([]models.ReportProduct, error)
+	FuncGetReport     func(ctx context.Context, warehouseId int) ([]models.ReportProduct, error)
+	FuncGetExpiring   func(ctx context.Context, within time.Duration, warehouseId int) ([]models.ExpiringBatch, error)
+	FuncQuarantine    func(ctx context.Context) ([]int, error)
 }
 
 func (m *ProductBatchServiceMock) CreateProductBatches(ctx context.Context, proBa models.ProductBatches) (*models.ProductBatches, error) {
@@ -18,6 +22,12 @@ func (m *ProductBatchServiceMock) GetReportProductById(ctx context.Context, id i
 
 	return m.FuncGetReportById(ctx, id)
 }
-func (m *ProductBatchServiceMock) GetReportProduct(ctx context.Context) ([]models.ReportProduct, error) {
-	return m.FuncGetReport(ctx)
+func (m *ProductBatchServiceMock) GetReportProduct(ctx context.Context, warehouseId int) ([]models.ReportProduct, error) {
+	return m.FuncGetReport(ctx, warehouseId)
+}
+func (m *ProductBatchServiceMock) GetExpiring(ctx context.Context, within time.Duration, warehouseId int) ([]models.ExpiringBatch, error) {
+	return m.FuncGetExpiring(ctx, within, warehouseId)
+}
+func (m *ProductBatchServiceMock) QuarantineExpired(ctx context.Context) ([]int, error) {
+	return m.FuncQuarantine(ctx)
 }
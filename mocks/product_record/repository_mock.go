@@ -20,3 +20,13 @@ func (m *MockProductRecordRepository) GetRecordsReport(ctx context.Context, id i
 	args := m.Called(ctx, id)
 	return args.Get(0).([]models.ProductRecordReport), args.Error(1)
 }
+
+func (m *MockProductRecordRepository) GetMarginReport(ctx context.Context, f models.MarginReportFilter) ([]models.MarginReportLine, error) {
+	args := m.Called(ctx, f)
+
+	var lines []models.MarginReportLine
+	if arg0 := args.Get(0); arg0 != nil {
+		lines = arg0.([]models.MarginReportLine)
+	}
+	return lines, args.Error(1)
+}
@@ -31,3 +31,14 @@ func (m *MockProductRecordService) GetRecordsReport(ctx context.Context, id int)
 	}
 	return rep, args.Error(1)
 }
+
+// GetMarginReport returns the report programmed for the filter; a nil report is returned as is.
+func (m *MockProductRecordService) GetMarginReport(ctx context.Context, f models.MarginReportFilter) (*models.MarginReport, error) {
+	args := m.Called(ctx, f)
+
+	var rep *models.MarginReport
+	if arg0 := args.Get(0); arg0 != nil {
+		rep = arg0.(*models.MarginReport)
+	}
+	return rep, args.Error(1)
+}
@@ -14,6 +14,10 @@ type PurchaseOrderRepositoryMock struct {
 	FuncExistsOrderNumber       func(ctx context.Context, orderNumber string) bool
 	FuncGetCountByBuyer         func(ctx context.Context, buyerID int) ([]models.BuyerWithPurchaseCount, error)
 	FuncGetAllWithPurchaseCount func(ctx context.Context) ([]models.BuyerWithPurchaseCount, error)
+	FuncExistsBuyer             func(ctx context.Context, buyerID int) bool
+	FuncFindBuyerOrders         func(ctx context.Context, filter models.PurchaseHistoryFilter) ([]models.PurchaseHistoryOrder, error)
+	FuncFindOrderLines          func(ctx context.Context, orderIDs []int) ([]models.PurchaseHistoryLine, error)
+	FuncFindPeriodTotals        func(ctx context.Context, filter models.PurchaseHistoryFilter) ([]models.PeriodTotal, error)
 }
 
 func (m *PurchaseOrderRepositoryMock) Create(ctx context.Context, po models.PurchaseOrder) (*models.PurchaseOrder, error) {
@@ -57,3 +61,31 @@ func (m *PurchaseOrderRepositoryMock) GetAllWithPurchaseCount(ctx context.Contex
 	}
 	return nil, nil
 }
+
+func (m *PurchaseOrderRepositoryMock) ExistsBuyer(ctx context.Context, buyerID int) bool {
+	if m.FuncExistsBuyer != nil {
+		return m.FuncExistsBuyer(ctx, buyerID)
+	}
+	return false
+}
+
+func (m *PurchaseOrderRepositoryMock) FindBuyerOrders(ctx context.Context, filter models.PurchaseHistoryFilter) ([]models.PurchaseHistoryOrder, error) {
+	if m.FuncFindBuyerOrders != nil {
+		return m.FuncFindBuyerOrders(ctx, filter)
+	}
+	return nil, nil
+}
+
+func (m *PurchaseOrderRepositoryMock) FindOrderLines(ctx context.Context, orderIDs []int) ([]models.PurchaseHistoryLine, error) {
+	if m.FuncFindOrderLines != nil {
+		return m.FuncFindOrderLines(ctx, orderIDs)
+	}
+	return nil, nil
+}
+
+func (m *PurchaseOrderRepositoryMock) FindPeriodTotals(ctx context.Context, filter models.PurchaseHistoryFilter) ([]models.PeriodTotal, error) {
+	if m.FuncFindPeriodTotals != nil {
+		return m.FuncFindPeriodTotals(ctx, filter)
+	}
+	return nil, nil
+}
@@ -12,6 +12,7 @@ type PurchaseOrderServiceMock struct {
 	GetAllFn           func(ctx context.Context) ([]models.ResponsePurchaseOrder, error)
 	GetByIDFn          func(ctx context.Context, id int) (*models.ResponsePurchaseOrder, error)
 	GetReportByBuyerFn func(ctx context.Context, buyerID *int) ([]models.BuyerWithPurchaseCount, error)
+	HistoryFn          func(ctx context.Context, filter models.PurchaseHistoryFilter) (*models.PurchaseHistory, error)
 }
 
 func (m *PurchaseOrderServiceMock) Create(ctx context.Context, req models.RequestPurchaseOrder) (*models.ResponsePurchaseOrder, error) {
@@ -29,3 +30,7 @@ func (m *PurchaseOrderServiceMock) GetByID(ctx context.Context, id int) (*models
 func (m *PurchaseOrderServiceMock) GetReportByBuyer(ctx context.Context, buyerID *int) ([]models.BuyerWithPurchaseCount, error) {
 	return m.GetReportByBuyerFn(ctx, buyerID)
 }
+
+func (m *PurchaseOrderServiceMock) History(ctx context.Context, filter models.PurchaseHistoryFilter) (*models.PurchaseHistory, error) {
+	return m.HistoryFn(ctx, filter)
+}
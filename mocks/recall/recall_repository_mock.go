@@ -0,0 +1,92 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/recall"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/recall"
+)
+
+type RecallRepositoryMock struct {
+	FuncFindBatchesForUpdate func(ctx context.Context, exec repository.Executor, batchIds []int) ([]models.RecallBatch, error)
+	FuncFindBatchLineage     func(ctx context.Context, exec repository.Executor, batchIds []int) ([]int, error)
+	FuncFindProductBatchIds  func(ctx context.Context, exec repository.Executor, productId int) ([]int, error)
+	FuncCreateRecall         func(ctx context.Context, exec repository.Executor, r models.Recall) (*models.Recall, error)
+	FuncCreateRecallBatch    func(ctx context.Context, exec repository.Executor, recallId int, b models.RecallBatch) error
+	FuncFindById             func(ctx context.Context, id int) (*models.Recall, error)
+	FuncFindBatches          func(ctx context.Context, recallId int) ([]models.RecallBatch, error)
+	FuncBeginTx              func(ctx context.Context) (*sql.Tx, error)
+	FuncCommitTx             func(tx *sql.Tx) error
+	FuncRollbackTx           func(tx *sql.Tx) error
+}
+
+func (m *RecallRepositoryMock) FindBatchesForUpdate(ctx context.Context, exec repository.Executor, batchIds []int) ([]models.RecallBatch, error) {
+	if m.FuncFindBatchesForUpdate != nil {
+		return m.FuncFindBatchesForUpdate(ctx, exec, batchIds)
+	}
+	return []models.RecallBatch{}, nil
+}
+
+func (m *RecallRepositoryMock) FindBatchLineage(ctx context.Context, exec repository.Executor, batchIds []int) ([]int, error) {
+	if m.FuncFindBatchLineage != nil {
+		return m.FuncFindBatchLineage(ctx, exec, batchIds)
+	}
+	return batchIds, nil
+}
+
+func (m *RecallRepositoryMock) FindProductBatchIds(ctx context.Context, exec repository.Executor, productId int) ([]int, error) {
+	if m.FuncFindProductBatchIds != nil {
+		return m.FuncFindProductBatchIds(ctx, exec, productId)
+	}
+	return []int{}, nil
+}
+
+func (m *RecallRepositoryMock) CreateRecall(ctx context.Context, exec repository.Executor, r models.Recall) (*models.Recall, error) {
+	if m.FuncCreateRecall != nil {
+		return m.FuncCreateRecall(ctx, exec, r)
+	}
+	return &r, nil
+}
+
+func (m *RecallRepositoryMock) CreateRecallBatch(ctx context.Context, exec repository.Executor, recallId int, b models.RecallBatch) error {
+	if m.FuncCreateRecallBatch != nil {
+		return m.FuncCreateRecallBatch(ctx, exec, recallId, b)
+	}
+	return nil
+}
+
+func (m *RecallRepositoryMock) FindById(ctx context.Context, id int) (*models.Recall, error) {
+	if m.FuncFindById != nil {
+		return m.FuncFindById(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *RecallRepositoryMock) FindBatches(ctx context.Context, recallId int) ([]models.RecallBatch, error) {
+	if m.FuncFindBatches != nil {
+		return m.FuncFindBatches(ctx, recallId)
+	}
+	return []models.RecallBatch{}, nil
+}
+
+func (m *RecallRepositoryMock) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	if m.FuncBeginTx != nil {
+		return m.FuncBeginTx(ctx)
+	}
+	return &sql.Tx{}, nil
+}
+
+func (m *RecallRepositoryMock) CommitTx(tx *sql.Tx) error {
+	if m.FuncCommitTx != nil {
+		return m.FuncCommitTx(tx)
+	}
+	return nil
+}
+
+func (m *RecallRepositoryMock) RollbackTx(tx *sql.Tx) error {
+	if m.FuncRollbackTx != nil {
+		return m.FuncRollbackTx(tx)
+	}
+	return nil
+}
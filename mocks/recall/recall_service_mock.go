@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/recall"
+)
+
+type RecallServiceMock struct {
+	FuncCreate   func(ctx context.Context, req models.PostRecall) (*models.Recall, error)
+	FuncFindById func(ctx context.Context, id int) (*models.Recall, error)
+}
+
+func (m *RecallServiceMock) Create(ctx context.Context, req models.PostRecall) (*models.Recall, error) {
+	return m.FuncCreate(ctx, req)
+}
+
+func (m *RecallServiceMock) FindById(ctx context.Context, id int) (*models.Recall, error) {
+	return m.FuncFindById(ctx, id)
+}
@@ -0,0 +1,86 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/receiving"
+	inboundOrderModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inbound_order"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/receiving"
+)
+
+type ReceivingRepositoryMock struct {
+	FuncExistsOrderNumber     func(ctx context.Context, exec repository.Executor, orderNumber string) (bool, error)
+	FuncFindEmployeeWarehouse func(ctx context.Context, exec repository.Executor, employeeId int) (int, error)
+	FuncFindSectionForUpdate  func(ctx context.Context, exec repository.Executor, sectionId int) (*models.ReceivingSection, error)
+	FuncCreateBatch           func(ctx context.Context, exec repository.Executor, b batchModels.ProductBatches) (int, error)
+	FuncAddSectionCapacity    func(ctx context.Context, exec repository.Executor, sectionId int, delta int) error
+	FuncCreateInboundOrder    func(ctx context.Context, exec repository.Executor, o inboundOrderModels.InboundOrder) (*inboundOrderModels.InboundOrder, error)
+	FuncBeginTx               func(ctx context.Context) (*sql.Tx, error)
+	FuncCommitTx              func(tx *sql.Tx) error
+	FuncRollbackTx            func(tx *sql.Tx) error
+}
+
+func (m *ReceivingRepositoryMock) ExistsOrderNumber(ctx context.Context, exec repository.Executor, orderNumber string) (bool, error) {
+	if m.FuncExistsOrderNumber != nil {
+		return m.FuncExistsOrderNumber(ctx, exec, orderNumber)
+	}
+	return false, nil
+}
+
+func (m *ReceivingRepositoryMock) FindEmployeeWarehouse(ctx context.Context, exec repository.Executor, employeeId int) (int, error) {
+	if m.FuncFindEmployeeWarehouse != nil {
+		return m.FuncFindEmployeeWarehouse(ctx, exec, employeeId)
+	}
+	return 0, nil
+}
+
+func (m *ReceivingRepositoryMock) FindSectionForUpdate(ctx context.Context, exec repository.Executor, sectionId int) (*models.ReceivingSection, error) {
+	if m.FuncFindSectionForUpdate != nil {
+		return m.FuncFindSectionForUpdate(ctx, exec, sectionId)
+	}
+	return nil, nil
+}
+
+func (m *ReceivingRepositoryMock) CreateBatch(ctx context.Context, exec repository.Executor, b batchModels.ProductBatches) (int, error) {
+	if m.FuncCreateBatch != nil {
+		return m.FuncCreateBatch(ctx, exec, b)
+	}
+	return 0, nil
+}
+
+func (m *ReceivingRepositoryMock) AddSectionCapacity(ctx context.Context, exec repository.Executor, sectionId int, delta int) error {
+	if m.FuncAddSectionCapacity != nil {
+		return m.FuncAddSectionCapacity(ctx, exec, sectionId, delta)
+	}
+	return nil
+}
+
+func (m *ReceivingRepositoryMock) CreateInboundOrder(ctx context.Context, exec repository.Executor, o inboundOrderModels.InboundOrder) (*inboundOrderModels.InboundOrder, error) {
+	if m.FuncCreateInboundOrder != nil {
+		return m.FuncCreateInboundOrder(ctx, exec, o)
+	}
+	return &o, nil
+}
+
+func (m *ReceivingRepositoryMock) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	if m.FuncBeginTx != nil {
+		return m.FuncBeginTx(ctx)
+	}
+	return &sql.Tx{}, nil
+}
+
+func (m *ReceivingRepositoryMock) CommitTx(tx *sql.Tx) error {
+	if m.FuncCommitTx != nil {
+		return m.FuncCommitTx(tx)
+	}
+	return nil
+}
+
+func (m *ReceivingRepositoryMock) RollbackTx(tx *sql.Tx) error {
+	if m.FuncRollbackTx != nil {
+		return m.FuncRollbackTx(tx)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/receiving"
+)
+
+type ReceivingServiceMock struct {
+	FuncReceive func(ctx context.Context, req models.PostReceipt) (*models.Receipt, error)
+}
+
+func (m *ReceivingServiceMock) Receive(ctx context.Context, req models.PostReceipt) (*models.Receipt, error) {
+	return m.FuncReceive(ctx, req)
+}
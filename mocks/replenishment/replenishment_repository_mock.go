@@ -0,0 +1,59 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/replenishment"
+)
+
+type ReplenishmentRepositoryMock struct {
+	FuncFindAll         func(ctx context.Context, warehouseId int, productId int) ([]models.ReorderPoint, error)
+	FuncFindById        func(ctx context.Context, id int) (*models.ReorderPoint, error)
+	FuncCreate          func(ctx context.Context, p models.ReorderPoint) (*models.ReorderPoint, error)
+	FuncUpdate          func(ctx context.Context, p models.ReorderPoint) error
+	FuncDelete          func(ctx context.Context, id int) error
+	FuncFindStockLevels func(ctx context.Context, now time.Time, since time.Time, warehouseId int, sellerId int) ([]models.StockLevel, error)
+}
+
+func (m *ReplenishmentRepositoryMock) FindAll(ctx context.Context, warehouseId int, productId int) ([]models.ReorderPoint, error) {
+	if m.FuncFindAll != nil {
+		return m.FuncFindAll(ctx, warehouseId, productId)
+	}
+	return []models.ReorderPoint{}, nil
+}
+
+func (m *ReplenishmentRepositoryMock) FindById(ctx context.Context, id int) (*models.ReorderPoint, error) {
+	if m.FuncFindById != nil {
+		return m.FuncFindById(ctx, id)
+	}
+	return &models.ReorderPoint{Id: id}, nil
+}
+
+func (m *ReplenishmentRepositoryMock) Create(ctx context.Context, p models.ReorderPoint) (*models.ReorderPoint, error) {
+	if m.FuncCreate != nil {
+		return m.FuncCreate(ctx, p)
+	}
+	return &p, nil
+}
+
+func (m *ReplenishmentRepositoryMock) Update(ctx context.Context, p models.ReorderPoint) error {
+	if m.FuncUpdate != nil {
+		return m.FuncUpdate(ctx, p)
+	}
+	return nil
+}
+
+func (m *ReplenishmentRepositoryMock) Delete(ctx context.Context, id int) error {
+	if m.FuncDelete != nil {
+		return m.FuncDelete(ctx, id)
+	}
+	return nil
+}
+
+func (m *ReplenishmentRepositoryMock) FindStockLevels(ctx context.Context, now time.Time, since time.Time, warehouseId int, sellerId int) ([]models.StockLevel, error) {
+	if m.FuncFindStockLevels != nil {
+		return m.FuncFindStockLevels(ctx, now, since, warehouseId, sellerId)
+	}
+	return []models.StockLevel{}, nil
+}
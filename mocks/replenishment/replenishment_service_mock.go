@@ -0,0 +1,36 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/replenishment"
+)
+
+type ReplenishmentServiceMock struct {
+	FuncFindAll func(ctx context.Context, warehouseId int, productId int) ([]models.ReorderPoint, error)
+	FuncCreate  func(ctx context.Context, p models.PostReorderPoint) (*models.ReorderPoint, error)
+	FuncUpdate  func(ctx context.Context, id int, p models.PatchReorderPoint) (*models.ReorderPoint, error)
+	FuncDelete  func(ctx context.Context, id int) error
+	FuncReport  func(ctx context.Context, warehouseId int, sellerId int, window time.Duration) ([]models.SellerReplenishment, error)
+}
+
+func (m *ReplenishmentServiceMock) FindAll(ctx context.Context, warehouseId int, productId int) ([]models.ReorderPoint, error) {
+	return m.FuncFindAll(ctx, warehouseId, productId)
+}
+
+func (m *ReplenishmentServiceMock) Create(ctx context.Context, p models.PostReorderPoint) (*models.ReorderPoint, error) {
+	return m.FuncCreate(ctx, p)
+}
+
+func (m *ReplenishmentServiceMock) Update(ctx context.Context, id int, p models.PatchReorderPoint) (*models.ReorderPoint, error) {
+	return m.FuncUpdate(ctx, id, p)
+}
+
+func (m *ReplenishmentServiceMock) Delete(ctx context.Context, id int) error {
+	return m.FuncDelete(ctx, id)
+}
+
+func (m *ReplenishmentServiceMock) Report(ctx context.Context, warehouseId int, sellerId int, window time.Duration) ([]models.SellerReplenishment, error) {
+	return m.FuncReport(ctx, warehouseId, sellerId, window)
+}
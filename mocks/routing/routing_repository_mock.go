@@ -0,0 +1,35 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/routing"
+)
+
+type RoutingRepositoryMock struct {
+	FuncFindDepot     func(ctx context.Context, warehouseId int) (*models.Depot, error)
+	FuncFindPlace     func(ctx context.Context, localityId string) (*models.Place, error)
+	FuncFindShipments func(ctx context.Context, warehouseId, carrierId int, from, to time.Time) ([]models.RouteShipment, error)
+}
+
+func (m *RoutingRepositoryMock) FindDepot(ctx context.Context, warehouseId int) (*models.Depot, error) {
+	if m.FuncFindDepot != nil {
+		return m.FuncFindDepot(ctx, warehouseId)
+	}
+	return nil, nil
+}
+
+func (m *RoutingRepositoryMock) FindPlace(ctx context.Context, localityId string) (*models.Place, error) {
+	if m.FuncFindPlace != nil {
+		return m.FuncFindPlace(ctx, localityId)
+	}
+	return nil, nil
+}
+
+func (m *RoutingRepositoryMock) FindShipments(ctx context.Context, warehouseId, carrierId int, from, to time.Time) ([]models.RouteShipment, error) {
+	if m.FuncFindShipments != nil {
+		return m.FuncFindShipments(ctx, warehouseId, carrierId, from, to)
+	}
+	return []models.RouteShipment{}, nil
+}
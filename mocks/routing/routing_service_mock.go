@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/routing"
+)
+
+type RoutingServiceMock struct {
+	FuncPlan     func(ctx context.Context, filter models.PlanFilter) (*models.RoutePlan, error)
+	FuncDistance func(ctx context.Context, fromLocalityId, toLocalityId string) (*models.Distance, error)
+}
+
+func (m *RoutingServiceMock) Plan(ctx context.Context, filter models.PlanFilter) (*models.RoutePlan, error) {
+	return m.FuncPlan(ctx, filter)
+}
+
+func (m *RoutingServiceMock) Distance(ctx context.Context, fromLocalityId, toLocalityId string) (*models.Distance, error) {
+	return m.FuncDistance(ctx, fromLocalityId, toLocalityId)
+}
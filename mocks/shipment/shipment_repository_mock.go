@@ -0,0 +1,140 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/shipment"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipment"
+)
+
+type ShipmentRepositoryMock struct {
+	FuncFindOrdersForUpdate         func(ctx context.Context, exec repository.Executor, orderIds []int) ([]models.ShipmentOrder, error)
+	FuncCreate                      func(ctx context.Context, exec repository.Executor, s models.Shipment) (int, error)
+	FuncAddOrder                    func(ctx context.Context, exec repository.Executor, shipmentId int, orderId int) error
+	FuncStampTrackingCode           func(ctx context.Context, exec repository.Executor, shipmentId int, trackingCode string) error
+	FuncCreateEvent                 func(ctx context.Context, exec repository.Executor, e models.Event) (int, error)
+	FuncExistsExternalEvent         func(ctx context.Context, exec repository.Executor, shipmentId int, externalEventId string) (bool, error)
+	FuncUpdateStatus                func(ctx context.Context, exec repository.Executor, s models.Shipment) error
+	FuncFindAll                     func(ctx context.Context, filter models.ShipmentFilter) ([]models.Shipment, error)
+	FuncFindByTrackingCode          func(ctx context.Context, trackingCode string) (*models.Shipment, error)
+	FuncFindByTrackingCodeForUpdate func(ctx context.Context, exec repository.Executor, trackingCode string) (*models.Shipment, error)
+	FuncFindOrderIds                func(ctx context.Context, shipmentId int) ([]int, error)
+	FuncFindEvents                  func(ctx context.Context, shipmentId int) ([]models.Event, error)
+	FuncFindPerformance             func(ctx context.Context, filter models.PerformanceFilter) ([]models.ShipmentPerformance, error)
+	FuncBeginTx                     func(ctx context.Context) (*sql.Tx, error)
+	FuncCommitTx                    func(tx *sql.Tx) error
+	FuncRollbackTx                  func(tx *sql.Tx) error
+}
+
+func (m *ShipmentRepositoryMock) FindOrdersForUpdate(ctx context.Context, exec repository.Executor, orderIds []int) ([]models.ShipmentOrder, error) {
+	if m.FuncFindOrdersForUpdate != nil {
+		return m.FuncFindOrdersForUpdate(ctx, exec, orderIds)
+	}
+	return []models.ShipmentOrder{}, nil
+}
+
+func (m *ShipmentRepositoryMock) Create(ctx context.Context, exec repository.Executor, s models.Shipment) (int, error) {
+	if m.FuncCreate != nil {
+		return m.FuncCreate(ctx, exec, s)
+	}
+	return 1, nil
+}
+
+func (m *ShipmentRepositoryMock) AddOrder(ctx context.Context, exec repository.Executor, shipmentId int, orderId int) error {
+	if m.FuncAddOrder != nil {
+		return m.FuncAddOrder(ctx, exec, shipmentId, orderId)
+	}
+	return nil
+}
+
+func (m *ShipmentRepositoryMock) StampTrackingCode(ctx context.Context, exec repository.Executor, shipmentId int, trackingCode string) error {
+	if m.FuncStampTrackingCode != nil {
+		return m.FuncStampTrackingCode(ctx, exec, shipmentId, trackingCode)
+	}
+	return nil
+}
+
+func (m *ShipmentRepositoryMock) CreateEvent(ctx context.Context, exec repository.Executor, e models.Event) (int, error) {
+	if m.FuncCreateEvent != nil {
+		return m.FuncCreateEvent(ctx, exec, e)
+	}
+	return 1, nil
+}
+
+func (m *ShipmentRepositoryMock) ExistsExternalEvent(ctx context.Context, exec repository.Executor, shipmentId int, externalEventId string) (bool, error) {
+	if m.FuncExistsExternalEvent != nil {
+		return m.FuncExistsExternalEvent(ctx, exec, shipmentId, externalEventId)
+	}
+	return false, nil
+}
+
+func (m *ShipmentRepositoryMock) UpdateStatus(ctx context.Context, exec repository.Executor, s models.Shipment) error {
+	if m.FuncUpdateStatus != nil {
+		return m.FuncUpdateStatus(ctx, exec, s)
+	}
+	return nil
+}
+
+func (m *ShipmentRepositoryMock) FindAll(ctx context.Context, filter models.ShipmentFilter) ([]models.Shipment, error) {
+	if m.FuncFindAll != nil {
+		return m.FuncFindAll(ctx, filter)
+	}
+	return []models.Shipment{}, nil
+}
+
+func (m *ShipmentRepositoryMock) FindByTrackingCode(ctx context.Context, trackingCode string) (*models.Shipment, error) {
+	if m.FuncFindByTrackingCode != nil {
+		return m.FuncFindByTrackingCode(ctx, trackingCode)
+	}
+	return nil, nil
+}
+
+func (m *ShipmentRepositoryMock) FindByTrackingCodeForUpdate(ctx context.Context, exec repository.Executor, trackingCode string) (*models.Shipment, error) {
+	if m.FuncFindByTrackingCodeForUpdate != nil {
+		return m.FuncFindByTrackingCodeForUpdate(ctx, exec, trackingCode)
+	}
+	return nil, nil
+}
+
+func (m *ShipmentRepositoryMock) FindOrderIds(ctx context.Context, shipmentId int) ([]int, error) {
+	if m.FuncFindOrderIds != nil {
+		return m.FuncFindOrderIds(ctx, shipmentId)
+	}
+	return []int{}, nil
+}
+
+func (m *ShipmentRepositoryMock) FindEvents(ctx context.Context, shipmentId int) ([]models.Event, error) {
+	if m.FuncFindEvents != nil {
+		return m.FuncFindEvents(ctx, shipmentId)
+	}
+	return []models.Event{}, nil
+}
+
+func (m *ShipmentRepositoryMock) FindPerformance(ctx context.Context, filter models.PerformanceFilter) ([]models.ShipmentPerformance, error) {
+	if m.FuncFindPerformance != nil {
+		return m.FuncFindPerformance(ctx, filter)
+	}
+	return []models.ShipmentPerformance{}, nil
+}
+
+func (m *ShipmentRepositoryMock) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	if m.FuncBeginTx != nil {
+		return m.FuncBeginTx(ctx)
+	}
+	return &sql.Tx{}, nil
+}
+
+func (m *ShipmentRepositoryMock) CommitTx(tx *sql.Tx) error {
+	if m.FuncCommitTx != nil {
+		return m.FuncCommitTx(tx)
+	}
+	return nil
+}
+
+func (m *ShipmentRepositoryMock) RollbackTx(tx *sql.Tx) error {
+	if m.FuncRollbackTx != nil {
+		return m.FuncRollbackTx(tx)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipment"
+)
+
+type ShipmentServiceMock struct {
+	FuncCreate             func(ctx context.Context, req models.PostShipment) (*models.ShipmentDetail, error)
+	FuncFindAll            func(ctx context.Context, filter models.ShipmentFilter) ([]models.Shipment, error)
+	FuncFindByTrackingCode func(ctx context.Context, trackingCode string) (*models.ShipmentDetail, error)
+	FuncRecordEvent        func(ctx context.Context, trackingCode string, req models.PostEvent) (*models.ShipmentDetail, error)
+	FuncPerformance        func(ctx context.Context, filter models.PerformanceFilter) ([]models.CarrierPerformance, error)
+}
+
+func (m *ShipmentServiceMock) Create(ctx context.Context, req models.PostShipment) (*models.ShipmentDetail, error) {
+	return m.FuncCreate(ctx, req)
+}
+
+func (m *ShipmentServiceMock) FindAll(ctx context.Context, filter models.ShipmentFilter) ([]models.Shipment, error) {
+	return m.FuncFindAll(ctx, filter)
+}
+
+func (m *ShipmentServiceMock) FindByTrackingCode(ctx context.Context, trackingCode string) (*models.ShipmentDetail, error) {
+	return m.FuncFindByTrackingCode(ctx, trackingCode)
+}
+
+func (m *ShipmentServiceMock) RecordEvent(ctx context.Context, trackingCode string, req models.PostEvent) (*models.ShipmentDetail, error) {
+	return m.FuncRecordEvent(ctx, trackingCode, req)
+}
+
+func (m *ShipmentServiceMock) Performance(ctx context.Context, filter models.PerformanceFilter) ([]models.CarrierPerformance, error) {
+	return m.FuncPerformance(ctx, filter)
+}
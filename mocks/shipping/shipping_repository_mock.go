@@ -0,0 +1,124 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/shipping"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipping"
+)
+
+type ShippingRepositoryMock struct {
+	FuncFindAreas           func(ctx context.Context, filter models.CoverageFilter) ([]models.CoverageArea, error)
+	FuncFindAreaById        func(ctx context.Context, id int) (*models.CoverageArea, error)
+	FuncExistsArea          func(ctx context.Context, exec repository.Executor, a models.CoverageArea) (bool, error)
+	FuncCreateArea          func(ctx context.Context, exec repository.Executor, a models.CoverageArea) (int, error)
+	FuncCreateBand          func(ctx context.Context, exec repository.Executor, b models.RateBand) (int, error)
+	FuncDeleteBands         func(ctx context.Context, exec repository.Executor, areaId int) error
+	FuncDeleteArea          func(ctx context.Context, exec repository.Executor, id int) error
+	FuncFindCoverage        func(ctx context.Context, place models.Place) ([]models.CarrierCoverage, error)
+	FuncFindPlace           func(ctx context.Context, localityId string) (*models.Place, error)
+	FuncFindWarehousePlace  func(ctx context.Context, warehouseId int) (*models.Place, error)
+	FuncFindProductMeasures func(ctx context.Context, productIds []int) ([]models.ProductMeasures, error)
+	FuncBeginTx             func(ctx context.Context) (*sql.Tx, error)
+	FuncCommitTx            func(tx *sql.Tx) error
+	FuncRollbackTx          func(tx *sql.Tx) error
+}
+
+func (m *ShippingRepositoryMock) FindAreas(ctx context.Context, filter models.CoverageFilter) ([]models.CoverageArea, error) {
+	if m.FuncFindAreas != nil {
+		return m.FuncFindAreas(ctx, filter)
+	}
+	return []models.CoverageArea{}, nil
+}
+
+func (m *ShippingRepositoryMock) FindAreaById(ctx context.Context, id int) (*models.CoverageArea, error) {
+	if m.FuncFindAreaById != nil {
+		return m.FuncFindAreaById(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *ShippingRepositoryMock) ExistsArea(ctx context.Context, exec repository.Executor, a models.CoverageArea) (bool, error) {
+	if m.FuncExistsArea != nil {
+		return m.FuncExistsArea(ctx, exec, a)
+	}
+	return false, nil
+}
+
+func (m *ShippingRepositoryMock) CreateArea(ctx context.Context, exec repository.Executor, a models.CoverageArea) (int, error) {
+	if m.FuncCreateArea != nil {
+		return m.FuncCreateArea(ctx, exec, a)
+	}
+	return 0, nil
+}
+
+func (m *ShippingRepositoryMock) CreateBand(ctx context.Context, exec repository.Executor, b models.RateBand) (int, error) {
+	if m.FuncCreateBand != nil {
+		return m.FuncCreateBand(ctx, exec, b)
+	}
+	return 0, nil
+}
+
+func (m *ShippingRepositoryMock) DeleteBands(ctx context.Context, exec repository.Executor, areaId int) error {
+	if m.FuncDeleteBands != nil {
+		return m.FuncDeleteBands(ctx, exec, areaId)
+	}
+	return nil
+}
+
+func (m *ShippingRepositoryMock) DeleteArea(ctx context.Context, exec repository.Executor, id int) error {
+	if m.FuncDeleteArea != nil {
+		return m.FuncDeleteArea(ctx, exec, id)
+	}
+	return nil
+}
+
+func (m *ShippingRepositoryMock) FindCoverage(ctx context.Context, place models.Place) ([]models.CarrierCoverage, error) {
+	if m.FuncFindCoverage != nil {
+		return m.FuncFindCoverage(ctx, place)
+	}
+	return []models.CarrierCoverage{}, nil
+}
+
+func (m *ShippingRepositoryMock) FindPlace(ctx context.Context, localityId string) (*models.Place, error) {
+	if m.FuncFindPlace != nil {
+		return m.FuncFindPlace(ctx, localityId)
+	}
+	return nil, nil
+}
+
+func (m *ShippingRepositoryMock) FindWarehousePlace(ctx context.Context, warehouseId int) (*models.Place, error) {
+	if m.FuncFindWarehousePlace != nil {
+		return m.FuncFindWarehousePlace(ctx, warehouseId)
+	}
+	return nil, nil
+}
+
+func (m *ShippingRepositoryMock) FindProductMeasures(ctx context.Context, productIds []int) ([]models.ProductMeasures, error) {
+	if m.FuncFindProductMeasures != nil {
+		return m.FuncFindProductMeasures(ctx, productIds)
+	}
+	return []models.ProductMeasures{}, nil
+}
+
+func (m *ShippingRepositoryMock) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	if m.FuncBeginTx != nil {
+		return m.FuncBeginTx(ctx)
+	}
+	return &sql.Tx{}, nil
+}
+
+func (m *ShippingRepositoryMock) CommitTx(tx *sql.Tx) error {
+	if m.FuncCommitTx != nil {
+		return m.FuncCommitTx(tx)
+	}
+	return nil
+}
+
+func (m *ShippingRepositoryMock) RollbackTx(tx *sql.Tx) error {
+	if m.FuncRollbackTx != nil {
+		return m.FuncRollbackTx(tx)
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipping"
+)
+
+type ShippingServiceMock struct {
+	FuncFindAreas    func(ctx context.Context, filter models.CoverageFilter) ([]models.CoverageArea, error)
+	FuncFindAreaById func(ctx context.Context, id int) (*models.CoverageArea, error)
+	FuncCreateArea   func(ctx context.Context, req models.PostCoverageArea) (*models.CoverageArea, error)
+	FuncDeleteArea   func(ctx context.Context, id int) error
+	FuncQuote        func(ctx context.Context, req models.QuoteRequest) (*models.Quote, error)
+}
+
+func (m *ShippingServiceMock) FindAreas(ctx context.Context, filter models.CoverageFilter) ([]models.CoverageArea, error) {
+	return m.FuncFindAreas(ctx, filter)
+}
+
+func (m *ShippingServiceMock) FindAreaById(ctx context.Context, id int) (*models.CoverageArea, error) {
+	return m.FuncFindAreaById(ctx, id)
+}
+
+func (m *ShippingServiceMock) CreateArea(ctx context.Context, req models.PostCoverageArea) (*models.CoverageArea, error) {
+	return m.FuncCreateArea(ctx, req)
+}
+
+func (m *ShippingServiceMock) DeleteArea(ctx context.Context, id int) error {
+	return m.FuncDeleteArea(ctx, id)
+}
+
+func (m *ShippingServiceMock) Quote(ctx context.Context, req models.QuoteRequest) (*models.Quote, error) {
+	return m.FuncQuote(ctx, req)
+}
@@ -0,0 +1,18 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/sourcing"
+)
+
+type SourcingRepositoryMock struct {
+	FuncFindStock func(ctx context.Context, productIds []int) ([]models.WarehouseStock, error)
+}
+
+func (m *SourcingRepositoryMock) FindStock(ctx context.Context, productIds []int) ([]models.WarehouseStock, error) {
+	if m.FuncFindStock != nil {
+		return m.FuncFindStock(ctx, productIds)
+	}
+	return []models.WarehouseStock{}, nil
+}
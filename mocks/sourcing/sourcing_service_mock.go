@@ -0,0 +1,15 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/sourcing"
+)
+
+type SourcingServiceMock struct {
+	FuncPlan func(ctx context.Context, req models.SourcingRequest) (*models.SourcingPlan, error)
+}
+
+func (m *SourcingServiceMock) Plan(ctx context.Context, req models.SourcingRequest) (*models.SourcingPlan, error) {
+	return m.FuncPlan(ctx, req)
+}
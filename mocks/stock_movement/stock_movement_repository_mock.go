@@ -0,0 +1,43 @@
+package mocks
+
+import (
+	"context"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/stock_movement"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+)
+
+type StockMovementRepositoryMock struct {
+	FuncAppend      func(ctx context.Context, exec repository.Executor, m models.StockMovement) (*models.StockMovement, error)
+	FuncFindByBatch func(ctx context.Context, batchId int) ([]models.StockMovement, error)
+	FuncBatchExists func(ctx context.Context, batchId int) (bool, error)
+	FuncFindDrift   func(ctx context.Context) ([]models.BatchDrift, error)
+}
+
+func (m *StockMovementRepositoryMock) Append(ctx context.Context, exec repository.Executor, mv models.StockMovement) (*models.StockMovement, error) {
+	if m.FuncAppend != nil {
+		return m.FuncAppend(ctx, exec, mv)
+	}
+	return &mv, nil
+}
+
+func (m *StockMovementRepositoryMock) FindByBatch(ctx context.Context, batchId int) ([]models.StockMovement, error) {
+	if m.FuncFindByBatch != nil {
+		return m.FuncFindByBatch(ctx, batchId)
+	}
+	return []models.StockMovement{}, nil
+}
+
+func (m *StockMovementRepositoryMock) BatchExists(ctx context.Context, batchId int) (bool, error) {
+	if m.FuncBatchExists != nil {
+		return m.FuncBatchExists(ctx, batchId)
+	}
+	return true, nil
+}
+
+func (m *StockMovementRepositoryMock) FindDrift(ctx context.Context) ([]models.BatchDrift, error) {
+	if m.FuncFindDrift != nil {
+		return m.FuncFindDrift(ctx)
+	}
+	return []models.BatchDrift{}, nil
+}
@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+)
+
+type StockMovementServiceMock struct {
+	FuncFindByBatch func(ctx context.Context, batchId int) ([]models.StockMovement, error)
+	FuncReconcile   func(ctx context.Context) ([]models.BatchDrift, error)
+}
+
+func (m *StockMovementServiceMock) FindByBatch(ctx context.Context, batchId int) ([]models.StockMovement, error) {
+	return m.FuncFindByBatch(ctx, batchId)
+}
+
+func (m *StockMovementServiceMock) Reconcile(ctx context.Context) ([]models.BatchDrift, error) {
+	return m.FuncReconcile(ctx)
+}
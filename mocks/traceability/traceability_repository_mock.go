@@ -0,0 +1,42 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/traceability"
+)
+
+type TraceabilityRepositoryMock struct {
+	FuncFindBatch        func(ctx context.Context, batchId int) (*models.TracedBatch, error)
+	FuncFindBatchOrders  func(ctx context.Context, batchId int) ([]models.TracedOrder, error)
+	FuncFindOrder        func(ctx context.Context, orderId int) (*models.TracedPurchaseOrder, error)
+	FuncFindOrderOrigins func(ctx context.Context, orderId int) ([]models.BatchOrigin, error)
+}
+
+func (m *TraceabilityRepositoryMock) FindBatch(ctx context.Context, batchId int) (*models.TracedBatch, error) {
+	if m.FuncFindBatch != nil {
+		return m.FuncFindBatch(ctx, batchId)
+	}
+	return nil, nil
+}
+
+func (m *TraceabilityRepositoryMock) FindBatchOrders(ctx context.Context, batchId int) ([]models.TracedOrder, error) {
+	if m.FuncFindBatchOrders != nil {
+		return m.FuncFindBatchOrders(ctx, batchId)
+	}
+	return []models.TracedOrder{}, nil
+}
+
+func (m *TraceabilityRepositoryMock) FindOrder(ctx context.Context, orderId int) (*models.TracedPurchaseOrder, error) {
+	if m.FuncFindOrder != nil {
+		return m.FuncFindOrder(ctx, orderId)
+	}
+	return nil, nil
+}
+
+func (m *TraceabilityRepositoryMock) FindOrderOrigins(ctx context.Context, orderId int) ([]models.BatchOrigin, error) {
+	if m.FuncFindOrderOrigins != nil {
+		return m.FuncFindOrderOrigins(ctx, orderId)
+	}
+	return []models.BatchOrigin{}, nil
+}
@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/traceability"
+)
+
+type TraceabilityServiceMock struct {
+	FuncTraceBatch     func(ctx context.Context, batchId int) (*models.BatchTrace, error)
+	FuncTraceOrder     func(ctx context.Context, orderId int) (*models.OrderTrace, error)
+	FuncAffectedBuyers func(ctx context.Context, batchIds []int) ([]models.AffectedBuyer, error)
+}
+
+func (m *TraceabilityServiceMock) TraceBatch(ctx context.Context, batchId int) (*models.BatchTrace, error) {
+	return m.FuncTraceBatch(ctx, batchId)
+}
+
+func (m *TraceabilityServiceMock) TraceOrder(ctx context.Context, orderId int) (*models.OrderTrace, error) {
+	return m.FuncTraceOrder(ctx, orderId)
+}
+
+func (m *TraceabilityServiceMock) AffectedBuyers(ctx context.Context, batchIds []int) ([]models.AffectedBuyer, error) {
+	return m.FuncAffectedBuyers(ctx, batchIds)
+}
@@ -0,0 +1,100 @@
+package mocks
+
+import (
+	"context"
+	"database/sql"
+
+	repository "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/repository/transfer"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/transfer"
+)
+
+type TransferRepositoryMock struct {
+	FuncFindBatchForUpdate   func(ctx context.Context, exec repository.Executor, batchId int) (*models.TransferBatch, error)
+	FuncFindSectionForUpdate func(ctx context.Context, exec repository.Executor, sectionId int) (*models.TransferSection, error)
+	FuncMoveBatch            func(ctx context.Context, exec repository.Executor, batchId int, sectionId int) error
+	FuncCreateSplitBatch     func(ctx context.Context, exec repository.Executor, b models.TransferBatch, initialQuantity int) (int, error)
+	FuncNextBatchNumber      func(ctx context.Context, exec repository.Executor) (int, error)
+	FuncAddSectionCapacity   func(ctx context.Context, exec repository.Executor, sectionId int, delta int) error
+	FuncCreateTransfer       func(ctx context.Context, exec repository.Executor, t models.BatchTransfer) (*models.BatchTransfer, error)
+	FuncFindByBatch          func(ctx context.Context, batchId int) ([]models.BatchTransfer, error)
+	FuncBeginTx              func(ctx context.Context) (*sql.Tx, error)
+	FuncCommitTx             func(tx *sql.Tx) error
+	FuncRollbackTx           func(tx *sql.Tx) error
+}
+
+func (m *TransferRepositoryMock) FindBatchForUpdate(ctx context.Context, exec repository.Executor, batchId int) (*models.TransferBatch, error) {
+	if m.FuncFindBatchForUpdate != nil {
+		return m.FuncFindBatchForUpdate(ctx, exec, batchId)
+	}
+	return nil, nil
+}
+
+func (m *TransferRepositoryMock) FindSectionForUpdate(ctx context.Context, exec repository.Executor, sectionId int) (*models.TransferSection, error) {
+	if m.FuncFindSectionForUpdate != nil {
+		return m.FuncFindSectionForUpdate(ctx, exec, sectionId)
+	}
+	return nil, nil
+}
+
+func (m *TransferRepositoryMock) MoveBatch(ctx context.Context, exec repository.Executor, batchId int, sectionId int) error {
+	if m.FuncMoveBatch != nil {
+		return m.FuncMoveBatch(ctx, exec, batchId, sectionId)
+	}
+	return nil
+}
+
+func (m *TransferRepositoryMock) CreateSplitBatch(ctx context.Context, exec repository.Executor, b models.TransferBatch, initialQuantity int) (int, error) {
+	if m.FuncCreateSplitBatch != nil {
+		return m.FuncCreateSplitBatch(ctx, exec, b, initialQuantity)
+	}
+	return 0, nil
+}
+
+func (m *TransferRepositoryMock) NextBatchNumber(ctx context.Context, exec repository.Executor) (int, error) {
+	if m.FuncNextBatchNumber != nil {
+		return m.FuncNextBatchNumber(ctx, exec)
+	}
+	return 0, nil
+}
+
+func (m *TransferRepositoryMock) AddSectionCapacity(ctx context.Context, exec repository.Executor, sectionId int, delta int) error {
+	if m.FuncAddSectionCapacity != nil {
+		return m.FuncAddSectionCapacity(ctx, exec, sectionId, delta)
+	}
+	return nil
+}
+
+func (m *TransferRepositoryMock) CreateTransfer(ctx context.Context, exec repository.Executor, t models.BatchTransfer) (*models.BatchTransfer, error) {
+	if m.FuncCreateTransfer != nil {
+		return m.FuncCreateTransfer(ctx, exec, t)
+	}
+	return &t, nil
+}
+
+func (m *TransferRepositoryMock) FindByBatch(ctx context.Context, batchId int) ([]models.BatchTransfer, error) {
+	if m.FuncFindByBatch != nil {
+		return m.FuncFindByBatch(ctx, batchId)
+	}
+	return []models.BatchTransfer{}, nil
+}
+
+func (m *TransferRepositoryMock) BeginTx(ctx context.Context) (*sql.Tx, error) {
+	if m.FuncBeginTx != nil {
+		return m.FuncBeginTx(ctx)
+	}
+	return &sql.Tx{}, nil
+}
+
+func (m *TransferRepositoryMock) CommitTx(tx *sql.Tx) error {
+	if m.FuncCommitTx != nil {
+		return m.FuncCommitTx(tx)
+	}
+	return nil
+}
+
+func (m *TransferRepositoryMock) RollbackTx(tx *sql.Tx) error {
+	if m.FuncRollbackTx != nil {
+		return m.FuncRollbackTx(tx)
+	}
+	return nil
+}
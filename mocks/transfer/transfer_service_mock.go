@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/transfer"
+)
+
+type TransferServiceMock struct {
+	FuncTransfer    func(ctx context.Context, batchId int, req models.PostBatchTransfer) (*models.BatchTransfer, error)
+	FuncFindByBatch func(ctx context.Context, batchId int) ([]models.BatchTransfer, error)
+}
+
+func (m *TransferServiceMock) Transfer(ctx context.Context, batchId int, req models.PostBatchTransfer) (*models.BatchTransfer, error) {
+	return m.FuncTransfer(ctx, batchId, req)
+}
+
+func (m *TransferServiceMock) FindByBatch(ctx context.Context, batchId int) ([]models.BatchTransfer, error) {
+	return m.FuncFindByBatch(ctx, batchId)
+}
@@ -0,0 +1,42 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/warehouse_exception"
+)
+
+type WarehouseExceptionRepositoryMock struct {
+	FuncFindByEmployee func(ctx context.Context, employeeId int) ([]models.WarehouseException, error)
+	FuncCreate         func(ctx context.Context, e models.WarehouseException) (*models.WarehouseException, error)
+	FuncDelete         func(ctx context.Context, employeeId int, warehouseId int) error
+	FuncExists         func(ctx context.Context, employeeId int, warehouseId int) (bool, error)
+}
+
+func (m *WarehouseExceptionRepositoryMock) FindByEmployee(ctx context.Context, employeeId int) ([]models.WarehouseException, error) {
+	if m.FuncFindByEmployee != nil {
+		return m.FuncFindByEmployee(ctx, employeeId)
+	}
+	return []models.WarehouseException{}, nil
+}
+
+func (m *WarehouseExceptionRepositoryMock) Create(ctx context.Context, e models.WarehouseException) (*models.WarehouseException, error) {
+	if m.FuncCreate != nil {
+		return m.FuncCreate(ctx, e)
+	}
+	return &e, nil
+}
+
+func (m *WarehouseExceptionRepositoryMock) Delete(ctx context.Context, employeeId int, warehouseId int) error {
+	if m.FuncDelete != nil {
+		return m.FuncDelete(ctx, employeeId, warehouseId)
+	}
+	return nil
+}
+
+func (m *WarehouseExceptionRepositoryMock) Exists(ctx context.Context, employeeId int, warehouseId int) (bool, error) {
+	if m.FuncExists != nil {
+		return m.FuncExists(ctx, employeeId, warehouseId)
+	}
+	return false, nil
+}
@@ -0,0 +1,25 @@
+package mocks
+
+import (
+	"context"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/warehouse_exception"
+)
+
+type WarehouseExceptionServiceMock struct {
+	FuncFindByEmployee func(ctx context.Context, employeeId int) ([]models.WarehouseException, error)
+	FuncCreate         func(ctx context.Context, employeeId int, p models.PostWarehouseException) (*models.WarehouseException, error)
+	FuncDelete         func(ctx context.Context, employeeId int, warehouseId int) error
+}
+
+func (m *WarehouseExceptionServiceMock) FindByEmployee(ctx context.Context, employeeId int) ([]models.WarehouseException, error) {
+	return m.FuncFindByEmployee(ctx, employeeId)
+}
+
+func (m *WarehouseExceptionServiceMock) Create(ctx context.Context, employeeId int, p models.PostWarehouseException) (*models.WarehouseException, error) {
+	return m.FuncCreate(ctx, employeeId, p)
+}
+
+func (m *WarehouseExceptionServiceMock) Delete(ctx context.Context, employeeId int, warehouseId int) error {
+	return m.FuncDelete(ctx, employeeId, warehouseId)
+}
@@ -15,6 +15,9 @@ const (
 	// Handler specific
 	CodeMethodNotAllowed = "METHOD_NOT_ALLOWED"
 	CodeValidationError  = "VALIDATION_ERROR"
+
+	// Domain specific
+	CodeOutOfStock = "OUT_OF_STOCK"
 )
 
 // Mapping of codes to HTTP statuses
@@ -30,4 +33,7 @@ var codeToStatus = map[string]int{
 	// Handler specific
 	CodeMethodNotAllowed: http.StatusMethodNotAllowed,    // 405
 	CodeValidationError:  http.StatusUnprocessableEntity, // 422
+
+	// Domain specific
+	CodeOutOfStock: http.StatusConflict, // 409
 }
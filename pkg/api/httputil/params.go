@@ -3,6 +3,8 @@ package httputil
 import (
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/api/apperrors"
@@ -70,3 +72,59 @@ func ParseOptionalIntParam(r *http.Request, name string) (int, error) {
 
 	return validateIntValue(valueStr, name)
 }
+
+// ParseDurationQueryParam parses an optional duration query parameter such as "7d", "12h" or "90m".
+// Besides the units of time.ParseDuration it accepts whole days with the "d" suffix.
+// Returns def if the parameter is not present.
+func ParseDurationQueryParam(r *http.Request, name string, def time.Duration) (time.Duration, error) {
+	valueStr := r.URL.Query().Get(name)
+	if valueStr == "" {
+		return def, nil
+	}
+
+	invalid := apperrors.NewAppError(apperrors.CodeBadRequest, name+" must be a positive duration such as 7d or 12h")
+	if days, ok := strings.CutSuffix(valueStr, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, invalid
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	value, err := time.ParseDuration(valueStr)
+	if err != nil || value < 0 {
+		return 0, invalid
+	}
+	return value, nil
+}
+
+// ParseDateQueryParam parses an optional date query parameter in the YYYY-MM-DD format.
+// The date is read in the server's local time zone, as the database connection is.
+// Returns def if the parameter is not present.
+func ParseDateQueryParam(r *http.Request, name string, def time.Time) (time.Time, error) {
+	valueStr := r.URL.Query().Get(name)
+	if valueStr == "" {
+		return def, nil
+	}
+
+	value, err := time.ParseInLocation(time.DateOnly, valueStr, time.Local)
+	if err != nil {
+		return time.Time{}, apperrors.NewAppError(apperrors.CodeBadRequest, name+" must be a date such as 2025-06-02")
+	}
+	return value, nil
+}
+
+// ParseBoolQueryParam parses an optional boolean query parameter ("true", "false", "1", "0").
+// Returns false if the parameter is not present.
+func ParseBoolQueryParam(r *http.Request, name string) (bool, error) {
+	valueStr := r.URL.Query().Get(name)
+	if valueStr == "" {
+		return false, nil
+	}
+
+	value, err := strconv.ParseBool(valueStr)
+	if err != nil {
+		return false, apperrors.NewAppError(apperrors.CodeBadRequest, name+" must be true or false")
+	}
+	return value, nil
+}
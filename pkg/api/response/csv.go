@@ -0,0 +1,26 @@
+package response
+
+import (
+	"bytes"
+	"encoding/csv"
+	"net/http"
+)
+
+// CSV writes the records as a CSV attachment with the given file name, header row first
+func CSV(w http.ResponseWriter, code int, filename string, header []string, records [][]string) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	writer.Write(header)
+	writer.WriteAll(records)
+	if err := writer.Error(); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+
+	w.WriteHeader(code)
+
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	sourcingModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/sourcing"
+)
+
+// Allocation statuses
+const (
+	AllocationStatusReserved = "reserved"
+	AllocationStatusReleased = "released"
+	AllocationStatusPicked   = "picked"
+)
+
+// StockAllocation links an order line (order_details row) to the batch its stock was reserved from.
+type StockAllocation struct {
+	Id              int       `json:"id"`
+	PurchaseOrderId int       `json:"purchase_order_id"`
+	OrderDetailId   int       `json:"order_detail_id"`
+	ProductBatchId  int       `json:"product_batch_id"`
+	Quantity        int       `json:"quantity"`
+	Status          string    `json:"status"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// OrderLine is an order_details row resolved to the product it requests.
+type OrderLine struct {
+	OrderDetailId int
+	ProductId     int
+	Quantity      int
+}
+
+// BatchStock is the stock a batch can still give for allocation.
+type BatchStock struct {
+	ProductBatchId  int
+	DueDate         time.Time
+	CurrentQuantity int
+}
+
+// ConfirmRequest is the optional body of an order confirmation. With a delivery locality the stock is reserved
+// from the warehouses nearest to it; without one, from any warehouse.
+type ConfirmRequest struct {
+	DeliveryLocalityId *string `json:"delivery_locality_id"`
+}
+
+type AllocationResponse struct {
+	PurchaseOrderId int                          `json:"purchase_order_id"`
+	OrderStatusId   int                          `json:"order_status_id"`
+	Allocations     []StockAllocation            `json:"allocations"`
+	Sourcing        *sourcingModels.SourcingPlan `json:"sourcing,omitempty"`
+}
@@ -0,0 +1,97 @@
+package models
+
+import "time"
+
+// ASN statuses. An advance shipping notice is scheduled by the seller, arriving once the
+// delivery is at the dock, receiving while its batches are being received, received when
+// receiving is done and closed once its discrepancies have been settled.
+const (
+	StatusScheduled = "scheduled"
+	StatusArriving  = "arriving"
+	StatusReceiving = "receiving"
+	StatusReceived  = "received"
+	StatusClosed    = "closed"
+)
+
+// Asn is an expected delivery from a seller into a warehouse.
+type Asn struct {
+	Id          int        `json:"id"`
+	AsnNumber   string     `json:"asn_number"`
+	SellerId    int        `json:"seller_id"`
+	WarehouseId int        `json:"warehouse_id"`
+	Status      string     `json:"status"`
+	ExpectedAt  time.Time  `json:"expected_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ArrivedAt   *time.Time `json:"arrived_at"`
+	ReceivedAt  *time.Time `json:"received_at"`
+	ClosedAt    *time.Time `json:"closed_at"`
+}
+
+// AsnLine is the quantity of a product expected in a delivery against what was received.
+// Products received without being expected get a line with a zero ExpectedQuantity.
+type AsnLine struct {
+	Id               int    `json:"id"`
+	ProductId        int    `json:"product_id"`
+	ProductCode      string `json:"product_code"`
+	ExpectedQuantity int    `json:"expected_quantity"`
+	ReceivedQuantity int    `json:"received_quantity"`
+	Variance         int    `json:"variance"`
+}
+
+// AsnDetail is an ASN with its lines.
+type AsnDetail struct {
+	Asn
+	Lines []AsnLine `json:"lines"`
+}
+
+// PostAsn is the request body to schedule an expected delivery.
+type PostAsn struct {
+	AsnNumber   string        `json:"asn_number"`
+	SellerId    int           `json:"seller_id"`
+	WarehouseId int           `json:"warehouse_id"`
+	ExpectedAt  time.Time     `json:"expected_at"`
+	Lines       []PostAsnLine `json:"lines"`
+}
+
+// PostAsnLine is a product and the quantity of it a delivery is expected to bring.
+type PostAsnLine struct {
+	ProductId        int  `json:"product_id"`
+	ExpectedQuantity *int `json:"expected_quantity"`
+}
+
+// AsnFilter narrows the ASN list. Zero values are ignored.
+type AsnFilter struct {
+	SellerId    int
+	WarehouseId int
+	Status      string
+}
+
+// DiscrepancyLine is an ASN line of a completed delivery, read for the supplier discrepancy report.
+type DiscrepancyLine struct {
+	SellerId         int    `json:"-"`
+	CompanyName      string `json:"-"`
+	AsnId            int    `json:"asn_id"`
+	AsnNumber        string `json:"asn_number"`
+	WarehouseId      int    `json:"warehouse_id"`
+	ProductId        int    `json:"product_id"`
+	ProductCode      string `json:"product_code"`
+	ExpectedQuantity int    `json:"expected_quantity"`
+	ReceivedQuantity int    `json:"received_quantity"`
+	Variance         int    `json:"variance"`
+}
+
+// SupplierDiscrepancy summarises how a seller's completed deliveries matched what they announced.
+// FillRate is the share of the expected units that were received, not counting overages.
+// Lines only lists the lines whose received quantity differs from the expected one.
+type SupplierDiscrepancy struct {
+	SellerId         int               `json:"seller_id"`
+	CompanyName      string            `json:"company_name"`
+	AsnCount         int               `json:"asn_count"`
+	ExpectedQuantity int               `json:"expected_quantity"`
+	ReceivedQuantity int               `json:"received_quantity"`
+	ShortQuantity    int               `json:"short_quantity"`
+	OverQuantity     int               `json:"over_quantity"`
+	DiscrepantLines  int               `json:"discrepant_lines"`
+	FillRate         float64           `json:"fill_rate"`
+	Lines            []DiscrepancyLine `json:"lines"`
+}
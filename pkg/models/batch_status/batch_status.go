@@ -0,0 +1,63 @@
+package models
+
+import "time"
+
+// Reason codes explaining why a batch status was set.
+const (
+	ReasonInspectionFailed     = "inspection_failed"
+	ReasonInspectionPassed     = "inspection_passed"
+	ReasonTemperatureExcursion = "temperature_excursion"
+	ReasonDamagedPackaging     = "damaged_packaging"
+	ReasonContamination        = "contamination"
+	ReasonExpired              = "expired"
+	ReasonRecall               = "recall"
+	ReasonOther                = "other"
+)
+
+// ReasonCodes is the set of accepted reason codes.
+var ReasonCodes = map[string]bool{
+	ReasonInspectionFailed:     true,
+	ReasonInspectionPassed:     true,
+	ReasonTemperatureExcursion: true,
+	ReasonDamagedPackaging:     true,
+	ReasonContamination:        true,
+	ReasonExpired:              true,
+	ReasonRecall:               true,
+	ReasonOther:                true,
+}
+
+// BatchStatus is the current status of a product batch and who set it.
+type BatchStatus struct {
+	ProductBatchId  int        `json:"product_batch_id"`
+	Status          string     `json:"status"`
+	ReasonCode      *string    `json:"reason_code"`
+	SetBy           *string    `json:"set_by"`
+	UpdatedAt       *time.Time `json:"updated_at"`
+	CurrentQuantity int        `json:"-"`
+	SectionId       int        `json:"-"`
+}
+
+// StatusChange is one entry of a batch status history.
+type StatusChange struct {
+	Id             int       `json:"id"`
+	ProductBatchId int       `json:"product_batch_id"`
+	FromStatus     string    `json:"from_status"`
+	ToStatus       string    `json:"to_status"`
+	ReasonCode     string    `json:"reason_code"`
+	Note           string    `json:"note"`
+	Actor          string    `json:"actor"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// BatchStatusDetail is the current status of a batch together with its history.
+type BatchStatusDetail struct {
+	BatchStatus
+	History []StatusChange `json:"history"`
+}
+
+// PostStatusChange is the request body to place or release a hold.
+type PostStatusChange struct {
+	ReasonCode string `json:"reason_code"`
+	Note       string `json:"note"`
+	Actor      string `json:"actor"`
+}
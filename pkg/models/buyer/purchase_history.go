@@ -0,0 +1,69 @@
+package models
+
+import "time"
+
+// Periods the totals of a purchase history are grouped by.
+const (
+	PeriodDay   = "day"
+	PeriodMonth = "month"
+	PeriodYear  = "year"
+)
+
+// PurchaseHistoryFilter selects the orders of a buyer placed between From and To, both whole days
+// included, and the page of them to return. Period groups the totals.
+type PurchaseHistoryFilter struct {
+	BuyerID  int
+	From     time.Time
+	To       time.Time
+	Period   string
+	Page     int
+	PageSize int
+}
+
+// PurchaseHistory is a page of the orders of a buyer, newest first, with the totals of every order
+// in the date range per period. TotalOrders counts the orders in the range, across all pages.
+type PurchaseHistory struct {
+	BuyerID     int                    `json:"buyer_id"`
+	From        string                 `json:"from"`
+	To          string                 `json:"to"`
+	Page        int                    `json:"page"`
+	PageSize    int                    `json:"page_size"`
+	TotalOrders int                    `json:"total_orders"`
+	TotalPages  int                    `json:"total_pages"`
+	Orders      []PurchaseHistoryOrder `json:"orders"`
+	Totals      []PeriodTotal          `json:"totals"`
+}
+
+// PurchaseHistoryOrder is an order with its line items. Total adds up the line totals.
+type PurchaseHistoryOrder struct {
+	ID                 int                   `json:"id"`
+	OrderNumber        string                `json:"order_number"`
+	OrderDate          time.Time             `json:"order_date"`
+	TrackingCode       string                `json:"tracking_code"`
+	Status             string                `json:"status"`
+	DeliveryAddress    *string               `json:"delivery_address"`
+	DeliveryLocalityID *string               `json:"delivery_locality_id"`
+	Lines              []PurchaseHistoryLine `json:"lines"`
+	Total              float64               `json:"total"`
+}
+
+// PurchaseHistoryLine is an order detail priced at the sale price of its product record.
+type PurchaseHistoryLine struct {
+	OrderDetailID   int     `json:"order_detail_id"`
+	PurchaseOrderID int     `json:"-"`
+	ProductRecordID int     `json:"product_record_id"`
+	ProductID       int     `json:"product_id"`
+	Description     string  `json:"description"`
+	Quantity        int     `json:"quantity"`
+	UnitPrice       float64 `json:"unit_price"`
+	LineTotal       float64 `json:"line_total"`
+}
+
+// PeriodTotal sums the orders of a buyer placed in a period: "2025-06-02" by day, "2025-06" by month
+// or "2025" by year.
+type PeriodTotal struct {
+	Period string  `json:"period"`
+	Orders int     `json:"orders"`
+	Items  int     `json:"items"`
+	Amount float64 `json:"amount"`
+}
@@ -3,30 +3,49 @@ package models
 
 import "time"
 
+// Purchase order statuses, matching the ids seeded in the order_status table.
+const (
+	OrderStatusPending   = 1
+	OrderStatusConfirmed = 2
+	OrderStatusCancelled = 3
+)
+
+// PurchaseOrder is an order placed by a buyer. The delivery fields are a snapshot of the buyer
+// address the order ships to, kept as they were when the order was placed; they are nil when
+// the buyer had no address.
 type PurchaseOrder struct {
-	ID              int       `json:"id"`
-	OrderNumber     string    `json:"order_number"`
-	OrderDate       time.Time `json:"order_date"`
-	TrackingCode    string    `json:"tracking_code"`
-	BuyerID         int       `json:"buyer_id"`
-	ProductRecordID int       `json:"product_record_id"`
+	ID                 int       `json:"id"`
+	OrderNumber        string    `json:"order_number"`
+	OrderDate          time.Time `json:"order_date"`
+	TrackingCode       string    `json:"tracking_code"`
+	BuyerID            int       `json:"buyer_id"`
+	ProductRecordID    int       `json:"product_record_id"`
+	DeliveryAddressID  *int      `json:"delivery_address_id"`
+	DeliveryAddress    *string   `json:"delivery_address"`
+	DeliveryLocalityID *string   `json:"delivery_locality_id"`
 }
 
+// RequestPurchaseOrder is the request body to place a purchase order.
+// DeliveryAddressID picks the buyer address to deliver to, the default address when nil.
 type RequestPurchaseOrder struct {
-	OrderNumber     string `json:"order_number" validate:"required"`
-	OrderDate       string `json:"order_date" validate:"required"`
-	TrackingCode    string `json:"tracking_code" validate:"required"`
-	BuyerID         int    `json:"buyer_id" validate:"required"`
-	ProductRecordID int    `json:"product_record_id" validate:"required"`
+	OrderNumber       string `json:"order_number" validate:"required"`
+	OrderDate         string `json:"order_date" validate:"required"`
+	TrackingCode      string `json:"tracking_code" validate:"required"`
+	BuyerID           int    `json:"buyer_id" validate:"required"`
+	ProductRecordID   int    `json:"product_record_id" validate:"required"`
+	DeliveryAddressID *int   `json:"delivery_address_id"`
 }
 
 type ResponsePurchaseOrder struct {
-	ID              int    `json:"id"`
-	OrderNumber     string `json:"order_number"`
-	OrderDate       string `json:"order_date"`
-	TrackingCode    string `json:"tracking_code"`
-	BuyerID         int    `json:"buyer_id"`
-	ProductRecordID int    `json:"product_record_id"`
+	ID                 int     `json:"id"`
+	OrderNumber        string  `json:"order_number"`
+	OrderDate          string  `json:"order_date"`
+	TrackingCode       string  `json:"tracking_code"`
+	BuyerID            int     `json:"buyer_id"`
+	ProductRecordID    int     `json:"product_record_id"`
+	DeliveryAddressID  *int    `json:"delivery_address_id"`
+	DeliveryAddress    *string `json:"delivery_address"`
+	DeliveryLocalityID *string `json:"delivery_locality_id"`
 }
 
 type BuyerWithPurchaseCount struct {
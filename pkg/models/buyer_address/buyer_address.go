@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// Address is a delivery address of a buyer. A buyer with addresses has exactly one default,
+// used for purchase orders that do not name the address to deliver to.
+type Address struct {
+	Id         int       `json:"id"`
+	BuyerId    int       `json:"buyer_id"`
+	Label      string    `json:"label"`
+	Address    string    `json:"address"`
+	LocalityId string    `json:"locality_id"`
+	IsDefault  bool      `json:"is_default"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// PostAddress is the request body to add an address to a buyer.
+// The first address of a buyer becomes its default whatever IsDefault says.
+type PostAddress struct {
+	Label      string `json:"label"`
+	Address    string `json:"address"`
+	LocalityId string `json:"locality_id"`
+	IsDefault  bool   `json:"is_default"`
+}
+
+// PatchAddress is the request body to update an address; nil fields are left unchanged.
+// Setting IsDefault makes the address the default of its buyer; the default cannot be unset
+// directly, another address has to be made the default instead.
+type PatchAddress struct {
+	Label      *string `json:"label"`
+	Address    *string `json:"address"`
+	LocalityId *string `json:"locality_id"`
+	IsDefault  *bool   `json:"is_default"`
+}
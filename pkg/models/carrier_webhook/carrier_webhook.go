@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// WebhookSecret is the secret a carrier signs its webhook requests with. It is only shown when it is generated.
+type WebhookSecret struct {
+	CarrierId int       `json:"carrier_id"`
+	Cid       string    `json:"cid"`
+	Secret    string    `json:"secret"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Credentials is a carrier and its webhook secret, empty while no secret has been generated.
+type Credentials struct {
+	CarrierId int
+	Secret    string
+}
+
+// Signature is what a webhook request carries in its headers to prove who sent it and when.
+type Signature struct {
+	Timestamp string
+	Nonce     string
+	Signature string
+}
+
+// Payload is the body of a webhook request: a status event of one of the carrier's shipments.
+// EventId is the carrier's id for the event, used to ignore repeated deliveries of it.
+type Payload struct {
+	EventId      string    `json:"event_id"`
+	TrackingCode string    `json:"tracking_code"`
+	Status       string    `json:"status"`
+	Location     string    `json:"location"`
+	Description  string    `json:"description"`
+	Temperature  *float64  `json:"temperature"`
+	OccurredAt   time.Time `json:"occurred_at"`
+}
+
+// Receipt acknowledges a webhook event. Duplicate is set when the event had already been recorded.
+type Receipt struct {
+	TrackingCode string `json:"tracking_code"`
+	EventId      string `json:"event_id"`
+	Status       string `json:"status"`
+	Duplicate    bool   `json:"duplicate"`
+}
@@ -0,0 +1,127 @@
+package models
+
+import "time"
+
+// Statuses of a cycle count. A count is open until every line is counted; approving a count with a
+// large variance first moves it to pending second approval. Approved and cancelled are final.
+const (
+	StatusOpen                  = "open"
+	StatusCounted               = "counted"
+	StatusPendingSecondApproval = "pending_second_approval"
+	StatusApproved              = "approved"
+	StatusCancelled             = "cancelled"
+)
+
+// Reason codes explaining a counted variance.
+const (
+	ReasonMiscount          = "miscount"
+	ReasonDamaged           = "damaged"
+	ReasonTheft             = "theft"
+	ReasonUnrecordedReceipt = "unrecorded_receipt"
+	ReasonUnrecordedPick    = "unrecorded_pick"
+	ReasonExpired           = "expired"
+	ReasonOther             = "other"
+)
+
+// ReasonCodes is the set of accepted variance reason codes.
+var ReasonCodes = map[string]bool{
+	ReasonMiscount:          true,
+	ReasonDamaged:           true,
+	ReasonTheft:             true,
+	ReasonUnrecordedReceipt: true,
+	ReasonUnrecordedPick:    true,
+	ReasonExpired:           true,
+	ReasonOther:             true,
+}
+
+// LargeVariancePercent is the share of the system quantity above which a variance needs a second approver.
+const LargeVariancePercent = 10
+
+// CycleCount is a physical count task for one section.
+type CycleCount struct {
+	Id               int        `json:"id"`
+	SectionId        int        `json:"section_id"`
+	Status           string     `json:"status"`
+	Note             string     `json:"note"`
+	CreatedBy        string     `json:"created_by"`
+	CreatedAt        time.Time  `json:"created_at"`
+	ApprovedBy       *string    `json:"approved_by"`
+	SecondApprovedBy *string    `json:"second_approved_by"`
+	ApprovedAt       *time.Time `json:"approved_at"`
+}
+
+// CountLine is the count of one batch of the section. SystemQuantity is the batch current_quantity
+// when it was counted and Variance is CountedQuantity minus SystemQuantity; all are nil until counted.
+type CountLine struct {
+	Id              int        `json:"id"`
+	CycleCountId    int        `json:"cycle_count_id"`
+	ProductBatchId  int        `json:"product_batch_id"`
+	BatchNumber     int        `json:"batch_number"`
+	SystemQuantity  *int       `json:"system_quantity"`
+	CountedQuantity *int       `json:"counted_quantity"`
+	Variance        *int       `json:"variance"`
+	ReasonCode      *string    `json:"reason_code"`
+	CountedBy       *string    `json:"counted_by"`
+	CountedAt       *time.Time `json:"counted_at"`
+	CurrentQuantity int        `json:"-"`
+	// ReservedQuantity is the part of the batch reserved for confirmed orders but not picked yet.
+	// It is off current_quantity but still on the shelf.
+	ReservedQuantity int `json:"-"`
+}
+
+// IsLargeVariance reports whether the counted variance is over LargeVariancePercent of the system quantity.
+// Any variance on a batch the system believes empty is large.
+func (l CountLine) IsLargeVariance() bool {
+	if l.Variance == nil || *l.Variance == 0 {
+		return false
+	}
+	v := *l.Variance
+	if v < 0 {
+		v = -v
+	}
+	return v*100 > LargeVariancePercent*(*l.SystemQuantity)
+}
+
+// CountEvent is one entry of a cycle count history. FromStatus is nil for the creation.
+type CountEvent struct {
+	Id           int       `json:"id"`
+	CycleCountId int       `json:"cycle_count_id"`
+	FromStatus   *string   `json:"from_status"`
+	ToStatus     string    `json:"to_status"`
+	Note         string    `json:"note"`
+	Actor        string    `json:"actor"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// CycleCountDetail is a cycle count with its lines and history.
+type CycleCountDetail struct {
+	CycleCount
+	Lines   []CountLine  `json:"lines"`
+	History []CountEvent `json:"history"`
+}
+
+// PostCycleCount is the request body to open a count for a section.
+type PostCycleCount struct {
+	SectionId int    `json:"section_id"`
+	Note      string `json:"note"`
+	Actor     string `json:"actor"`
+}
+
+// PostCountedLine is the counted quantity of one batch. ReasonCode is required when it differs from the system quantity.
+type PostCountedLine struct {
+	ProductBatchId  int    `json:"product_batch_id"`
+	CountedQuantity *int   `json:"counted_quantity"`
+	ReasonCode      string `json:"reason_code"`
+}
+
+// PostCountedLines is the request body to record counted quantities. Counting a batch again replaces its previous count.
+type PostCountedLines struct {
+	Lines []PostCountedLine `json:"lines"`
+	Actor string            `json:"actor"`
+}
+
+// PostCountDecision is the request body to approve or cancel a count.
+type PostCountDecision struct {
+	Note  string `json:"note"`
+	Actor string `json:"actor"`
+}
@@ -0,0 +1,81 @@
+package models
+
+import "time"
+
+// Appointment statuses. A booked appointment becomes arrived when the delivery shows up,
+// missed when its slot went by without it, or cancelled when it is called off.
+// Cancelled appointments no longer hold their slot.
+const (
+	AppointmentBooked    = "booked"
+	AppointmentArrived   = "arrived"
+	AppointmentMissed    = "missed"
+	AppointmentCancelled = "cancelled"
+)
+
+// Dock is an unloading bay of a warehouse. OpensAt and ClosesAt are HH:MM clock times
+// bounding the slots that can be booked on it every day.
+type Dock struct {
+	Id          int    `json:"id"`
+	WarehouseId int    `json:"warehouse_id"`
+	Code        string `json:"code"`
+	OpensAt     string `json:"opens_at"`
+	ClosesAt    string `json:"closes_at"`
+}
+
+// PostDock is the request body to add a dock to a warehouse.
+type PostDock struct {
+	WarehouseId int    `json:"warehouse_id"`
+	Code        string `json:"code"`
+	OpensAt     string `json:"opens_at"`
+	ClosesAt    string `json:"closes_at"`
+}
+
+// Appointment is a time slot booked on a dock by a seller or a carrier for an inbound delivery.
+// InboundOrderId links the inbound order that eventually arrived, also for missed appointments.
+// LateMinutes is how long after the start of the slot the delivery arrived.
+type Appointment struct {
+	Id             int        `json:"id"`
+	DockId         int        `json:"dock_id"`
+	WarehouseId    int        `json:"warehouse_id"`
+	SellerId       *int       `json:"seller_id"`
+	CarrierId      *int       `json:"carrier_id"`
+	AsnId          *int       `json:"asn_id"`
+	StartsAt       time.Time  `json:"starts_at"`
+	EndsAt         time.Time  `json:"ends_at"`
+	Status         string     `json:"status"`
+	InboundOrderId *int       `json:"inbound_order_id"`
+	ArrivedAt      *time.Time `json:"arrived_at"`
+	LateMinutes    int        `json:"late_minutes"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// PostAppointment is the request body to book a slot on a dock.
+// Exactly one of SellerId and CarrierId books it; AsnId optionally names the expected delivery.
+type PostAppointment struct {
+	DockId    int       `json:"dock_id"`
+	SellerId  *int      `json:"seller_id"`
+	CarrierId *int      `json:"carrier_id"`
+	AsnId     *int      `json:"asn_id"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+}
+
+// PostArrival is the request body to link the inbound order that arrived for an appointment.
+// ArrivedAt defaults to the current time.
+type PostArrival struct {
+	InboundOrderId int        `json:"inbound_order_id"`
+	ArrivedAt      *time.Time `json:"arrived_at"`
+}
+
+// DockDay is a dock with the appointments booked on it for a day.
+type DockDay struct {
+	Dock
+	Appointments []Appointment `json:"appointments"`
+}
+
+// DaySchedule is the day view of a warehouse: every dock with its appointments, cancelled ones left out.
+type DaySchedule struct {
+	WarehouseId int       `json:"warehouse_id"`
+	Date        string    `json:"date"`
+	Docks       []DockDay `json:"docks"`
+}
@@ -11,24 +11,38 @@ type Province struct {
 	CountryId int    `json:"country_id"`
 }
 
+// Locality is a postal code area. Latitude and Longitude, in decimal degrees, place it for route planning
+// and are null until they are set.
 type Locality struct {
-	Id         string `json:"id"`
-	Name       string `json:"name"`
-	ProvinceId int    `json:"province_id"`
+	Id         string   `json:"id"`
+	Name       string   `json:"name"`
+	ProvinceId int      `json:"province_id"`
+	Latitude   *float64 `json:"latitude"`
+	Longitude  *float64 `json:"longitude"`
 }
 
 type RequestGeography struct {
-	Id           *string `json:"id"`
-	LocalityName *string `json:"locality_name"`
-	ProvinceName *string `json:"province_name"`
-	CountryName  *string `json:"country_name"`
+	Id           *string  `json:"id"`
+	LocalityName *string  `json:"locality_name"`
+	ProvinceName *string  `json:"province_name"`
+	CountryName  *string  `json:"country_name"`
+	Latitude     *float64 `json:"latitude"`
+	Longitude    *float64 `json:"longitude"`
 }
 
 type ResponseGeography struct {
-	LocalityId   string `json:"locality_id"`
-	LocalityName string `json:"locality_name"`
-	ProvinceName string `json:"province_name"`
-	CountryName  string `json:"country_name"`
+	LocalityId   string   `json:"locality_id"`
+	LocalityName string   `json:"locality_name"`
+	ProvinceName string   `json:"province_name"`
+	CountryName  string   `json:"country_name"`
+	Latitude     *float64 `json:"latitude,omitempty"`
+	Longitude    *float64 `json:"longitude,omitempty"`
+}
+
+// RequestCoordinates is the request body to place a locality on the map.
+type RequestCoordinates struct {
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
 }
 
 type ResponseLocalitySellers struct {
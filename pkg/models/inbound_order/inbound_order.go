@@ -7,6 +7,7 @@ type InboundOrder struct {
 	EmployeeID     int    `json:"employee_id"`
 	ProductBatchID int    `json:"product_batch_id"`
 	WarehouseID    int    `json:"warehouse_id"`
+	AsnID          *int   `json:"asn_id,omitempty"`
 }
 
 type InboundOrderReport struct {
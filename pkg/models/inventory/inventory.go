@@ -0,0 +1,47 @@
+package models
+
+// Grouping levels for the inventory query. Lines are always per product; warehouse and
+// section grouping split each product further by where the stock is stored.
+const (
+	GroupByProduct   = "product"
+	GroupByWarehouse = "warehouse"
+	GroupBySection   = "section"
+)
+
+// GroupByOptions holds the accepted values of InventoryFilter.GroupBy.
+var GroupByOptions = map[string]bool{
+	GroupByProduct:   true,
+	GroupByWarehouse: true,
+	GroupBySection:   true,
+}
+
+// InventoryFilter narrows the inventory query. Zero ids mean no filter.
+// ExcludeHeld drops on hold and quarantined batches; ExcludeExpired drops batches past their due date.
+type InventoryFilter struct {
+	ProductId      int
+	SellerId       int
+	WarehouseId    int
+	SectionId      int
+	ProductTypeId  int
+	GroupBy        string
+	ExcludeHeld    bool
+	ExcludeExpired bool
+}
+
+// InventoryLine is the stock on hand of a product, optionally at one warehouse or section.
+// Quantity counts every batch included by the filter; AvailableQuantity only the available,
+// unexpired ones. Warehouse and section fields are only set when grouping by them.
+type InventoryLine struct {
+	ProductId         int     `json:"product_id"`
+	ProductCode       string  `json:"product_code"`
+	Description       string  `json:"description"`
+	WarehouseId       *int    `json:"warehouse_id,omitempty"`
+	WarehouseCode     *string `json:"warehouse_code,omitempty"`
+	SectionId         *int    `json:"section_id,omitempty"`
+	SectionNumber     *int    `json:"section_number,omitempty"`
+	Quantity          int     `json:"quantity"`
+	AvailableQuantity int     `json:"available_quantity"`
+	HeldQuantity      int     `json:"held_quantity"`
+	ExpiredQuantity   int     `json:"expired_quantity"`
+	BatchCount        int     `json:"batch_count"`
+}
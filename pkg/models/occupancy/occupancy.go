@@ -0,0 +1,56 @@
+package models
+
+// WarehouseOccupancy aggregates the sections of a warehouse into a capacity dashboard.
+// Capacities are the sum of the section capacities; UsedPercentage is UsedCapacity over TotalCapacity.
+type WarehouseOccupancy struct {
+	WarehouseId          int                   `json:"warehouse_id"`
+	WarehouseCode        string                `json:"warehouse_code"`
+	MinimumCapacity      int                   `json:"minimum_capacity"`
+	BelowMinimumCapacity bool                  `json:"below_minimum_capacity"`
+	SectionCount         int                   `json:"section_count"`
+	TotalCapacity        int                   `json:"total_capacity"`
+	UsedCapacity         int                   `json:"used_capacity"`
+	FreeCapacity         int                   `json:"free_capacity"`
+	UsedPercentage       float64               `json:"used_percentage"`
+	ProductTypes         []ProductTypeUsage    `json:"product_types"`
+	SectionsBelowMinimum []SectionBelowMinimum `json:"sections_below_minimum"`
+	Temperature          TemperatureSpread     `json:"temperature"`
+	Expiry               ExpiryCounts          `json:"expiry"`
+}
+
+// ProductTypeUsage is the capacity of the sections holding one product type.
+type ProductTypeUsage struct {
+	WarehouseId    int     `json:"-"`
+	ProductTypeId  int     `json:"product_type_id"`
+	Description    string  `json:"description"`
+	SectionCount   int     `json:"section_count"`
+	TotalCapacity  int     `json:"total_capacity"`
+	UsedCapacity   int     `json:"used_capacity"`
+	UsedPercentage float64 `json:"used_percentage"`
+}
+
+// SectionBelowMinimum is a section whose current capacity is under its own minimum capacity.
+type SectionBelowMinimum struct {
+	WarehouseId     int `json:"-"`
+	SectionId       int `json:"section_id"`
+	SectionNumber   int `json:"section_number"`
+	CurrentCapacity int `json:"current_capacity"`
+	MinimumCapacity int `json:"minimum_capacity"`
+}
+
+// TemperatureSpread summarises the current temperature of the sections.
+// The fields are nil when the warehouse has no section reporting a temperature.
+type TemperatureSpread struct {
+	Minimum *float64 `json:"minimum"`
+	Maximum *float64 `json:"maximum"`
+	Average *float64 `json:"average"`
+	Spread  *float64 `json:"spread"`
+}
+
+// ExpiryCounts counts the batches with stock left that are already past their due date
+// and the ones due within the requested window.
+type ExpiryCounts struct {
+	WarehouseId int `json:"-"`
+	Expired     int `json:"expired"`
+	Expiring    int `json:"expiring"`
+}
@@ -0,0 +1,104 @@
+package models
+
+import "time"
+
+// Wave statuses. A wave is open until every one of its pick lists has been confirmed.
+const (
+	WaveStatusOpen      = "open"
+	WaveStatusCompleted = "completed"
+)
+
+// Pick list statuses.
+const (
+	ListStatusOpen      = "open"
+	ListStatusConfirmed = "confirmed"
+)
+
+// Wave groups the stock reserved for confirmed purchase orders at one warehouse into pick lists.
+type Wave struct {
+	Id          int        `json:"id"`
+	WarehouseId int        `json:"warehouse_id"`
+	Status      string     `json:"status"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+// WaveDetail is a wave with its pick lists. Blocked lists the allocations left out of a new wave because
+// their batch is no longer available: they stay reserved until the batch is released or the order cancelled.
+type WaveDetail struct {
+	Wave
+	Lists   []PickList `json:"lists"`
+	Blocked []PickLine `json:"blocked,omitempty"`
+}
+
+// PickList is the work of one picker: lines ordered by section number so the sections are walked once.
+type PickList struct {
+	Id          int        `json:"id"`
+	WaveId      int        `json:"wave_id"`
+	WarehouseId int        `json:"warehouse_id"`
+	Status      string     `json:"status"`
+	EmployeeId  *int       `json:"employee_id"`
+	ConfirmedAt *time.Time `json:"confirmed_at"`
+	Lines       []PickLine `json:"lines"`
+}
+
+// PickLine is a stock allocation to pick: the batch, the section it sits in and how much to take.
+// PickedQuantity stays nil until the list is confirmed. An allocation released after the wave was
+// planned, because its purchase order was cancelled, has nothing left to pick.
+type PickLine struct {
+	Id                int    `json:"id"`
+	Sequence          int    `json:"sequence"`
+	StockAllocationId int    `json:"stock_allocation_id"`
+	AllocationStatus  string `json:"allocation_status"`
+	PurchaseOrderId   int    `json:"purchase_order_id"`
+	ProductId         int    `json:"product_id"`
+	ProductBatchId    int    `json:"product_batch_id"`
+	BatchNumber       int    `json:"batch_number"`
+	BatchStatus       string `json:"batch_status,omitempty"`
+	SectionId         int    `json:"section_id"`
+	SectionNumber     int    `json:"section_number"`
+	Quantity          int    `json:"quantity"`
+	PickedQuantity    *int   `json:"picked_quantity"`
+}
+
+// PostWave is the request body to plan a wave. MaxLines caps the lines of each pick list;
+// zero puts every line in a single list.
+type PostWave struct {
+	WarehouseId int `json:"warehouse_id"`
+	MaxLines    int `json:"max_lines"`
+}
+
+// PostPickConfirmation is the request body an employee sends once a pick list is walked,
+// with the quantity actually picked for every line.
+type PostPickConfirmation struct {
+	EmployeeId int              `json:"employee_id"`
+	Lines      []PostPickedLine `json:"lines"`
+}
+
+// PostPickedLine is the quantity picked for one line of a pick list.
+type PostPickedLine struct {
+	LineId         int  `json:"line_id"`
+	PickedQuantity *int `json:"picked_quantity"`
+}
+
+// PickConfirmation is the confirmed pick list with the lines that could not be fully picked.
+type PickConfirmation struct {
+	PickList  PickList       `json:"pick_list"`
+	Shortages []PickShortage `json:"shortages"`
+}
+
+// PickShortage is a confirmed pick line where fewer units were found than were reserved.
+type PickShortage struct {
+	PickListId      int       `json:"pick_list_id"`
+	WaveId          int       `json:"wave_id"`
+	WarehouseId     int       `json:"warehouse_id"`
+	PurchaseOrderId int       `json:"purchase_order_id"`
+	ProductId       int       `json:"product_id"`
+	ProductBatchId  int       `json:"product_batch_id"`
+	BatchNumber     int       `json:"batch_number"`
+	SectionNumber   int       `json:"section_number"`
+	Quantity        int       `json:"quantity"`
+	PickedQuantity  int       `json:"picked_quantity"`
+	ShortQuantity   int       `json:"short_quantity"`
+	ConfirmedAt     time.Time `json:"confirmed_at"`
+}
@@ -2,6 +2,15 @@ package models
 
 import "time"
 
+// Batch statuses. Only available batches can be allocated to purchase orders
+// or count towards the section product report.
+const (
+	BatchStatusAvailable   = "available"
+	BatchStatusOnHold      = "on_hold"
+	BatchStatusQuarantined = "quarantined"
+	BatchStatusDisposed    = "disposed"
+)
+
 type ProductBatches struct {
 	Id                 int
 	BatchNumber        int
@@ -43,8 +52,45 @@ type PostProductBatches struct {
 	SectionId          int       `json:"section_id"`
 }
 
+// ReportProduct summarises the stock held in a section. Quantities, counts and the earliest due date
+// only consider available batches with stock left; HeldQuantity is the stock on hold or quarantined,
+// which still takes up room and counts towards FillPercentage.
+// ProductsCount is kept for existing clients and carries the same value as TotalQuantity.
 type ReportProduct struct {
-	SectionId     int `json:"section_id"`
-	SectionNumber int `json:"section_number"`
-	ProductsCount int `json:"products_count"`
+	SectionId        int                 `json:"section_id"`
+	SectionNumber    int                 `json:"section_number"`
+	WarehouseId      int                 `json:"warehouse_id"`
+	ProductsCount    int                 `json:"products_count"`
+	TotalQuantity    int                 `json:"total_quantity"`
+	HeldQuantity     int                 `json:"held_quantity"`
+	DistinctProducts int                 `json:"distinct_products"`
+	BatchCount       int                 `json:"batch_count"`
+	EarliestDueDate  *time.Time          `json:"earliest_due_date"`
+	MaximumCapacity  int                 `json:"maximum_capacity"`
+	FillPercentage   float64             `json:"fill_percentage"`
+	Products         []ReportProductLine `json:"products"`
+}
+
+// ReportProductLine is the available stock of one product within a section.
+type ReportProductLine struct {
+	ProductId   int    `json:"product_id"`
+	ProductCode string `json:"product_code"`
+	Quantity    int    `json:"quantity"`
+	BatchCount  int    `json:"batch_count"`
+}
+
+// ExpiringBatch is a product batch with stock left that is due within the requested window.
+type ExpiringBatch struct {
+	ProductBatchId     int       `json:"product_batch_id"`
+	BatchNumber        int       `json:"batch_number"`
+	ProductId          int       `json:"product_id"`
+	ProductCode        string    `json:"product_code"`
+	ProductDescription string    `json:"product_description"`
+	SectionId          int       `json:"section_id"`
+	SectionNumber      int       `json:"section_number"`
+	WarehouseId        int       `json:"warehouse_id"`
+	CurrentQuantity    int       `json:"current_quantity"`
+	DueDate            time.Time `json:"due_date"`
+	Status             string    `json:"status"`
+	Expired            bool      `json:"expired"`
 }
@@ -30,3 +30,47 @@ type ProductRecordReport struct {
 	Description  string `json:"description" db:"description"`
 	RecordsCount int    `json:"records_count" db:"records_count"`
 }
+
+// Dimensions a margin report can be grouped by.
+const (
+	GroupByProduct     = "product"
+	GroupBySeller      = "seller"
+	GroupByProductType = "product_type"
+	GroupByBuyer       = "buyer"
+	GroupByMonth       = "month"
+)
+
+// MarginReportFilter selects the orders placed between From and To, both whole days included,
+// and the dimension their lines are grouped by.
+type MarginReportFilter struct {
+	GroupBy string
+	From    time.Time
+	To      time.Time
+}
+
+// MarginReportLine sums the order lines of a group. Key identifies the group: the id of the product,
+// seller, product type or buyer, or the month as YYYY-MM; Name describes it where there is a name.
+// Revenue and Cost price every line at the sale and purchase price of the product record in effect on
+// the order date. MarginRate is Margin over Revenue, nil without revenue.
+type MarginReportLine struct {
+	Key        string   `json:"key" db:"group_key"`
+	Name       string   `json:"name" db:"group_name"`
+	Orders     int      `json:"orders" db:"orders"`
+	Units      int      `json:"units" db:"units"`
+	Revenue    float64  `json:"revenue" db:"revenue"`
+	Cost       float64  `json:"cost" db:"cost"`
+	Margin     float64  `json:"margin"`
+	MarginRate *float64 `json:"margin_rate"`
+}
+
+// MarginReport is the revenue, cost and margin of the orders of a period, per group and overall.
+type MarginReport struct {
+	GroupBy    string             `json:"group_by"`
+	From       string             `json:"from"`
+	To         string             `json:"to"`
+	Lines      []MarginReportLine `json:"lines"`
+	Revenue    float64            `json:"revenue"`
+	Cost       float64            `json:"cost"`
+	Margin     float64            `json:"margin"`
+	MarginRate *float64           `json:"margin_rate"`
+}
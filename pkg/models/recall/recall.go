@@ -0,0 +1,34 @@
+package models
+
+import (
+	"time"
+
+	traceModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/traceability"
+)
+
+// Recall is a recall of one or more product batches, with the buyers that must be notified.
+type Recall struct {
+	Id        int                         `json:"id"`
+	Note      string                      `json:"note"`
+	Actor     string                      `json:"actor"`
+	CreatedAt time.Time                   `json:"created_at"`
+	Batches   []RecallBatch               `json:"batches"`
+	Buyers    []traceModels.AffectedBuyer `json:"buyers"`
+}
+
+// RecallBatch is a batch included in a recall. Held is false when the batch was already
+// out of circulation (on hold, quarantined or disposed) and kept its status.
+type RecallBatch struct {
+	ProductBatchId int    `json:"product_batch_id"`
+	BatchNumber    int    `json:"batch_number"`
+	PreviousStatus string `json:"previous_status"`
+	Held           bool   `json:"held"`
+}
+
+// PostRecall is the request body to start a recall, either for a list of batches or for every batch of a product.
+type PostRecall struct {
+	ProductBatchIds []int  `json:"product_batch_ids"`
+	ProductId       *int   `json:"product_id"`
+	Note            string `json:"note"`
+	Actor           string `json:"actor"`
+}
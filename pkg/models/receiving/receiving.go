@@ -0,0 +1,32 @@
+package models
+
+import (
+	inboundOrderModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inbound_order"
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+)
+
+// PostReceipt is the request body to receive a new product batch into a warehouse.
+// The batch and the inbound order recording its arrival are created together.
+// AsnId optionally names the expected delivery the batch arrived with.
+type PostReceipt struct {
+	OrderNumber  string                         `json:"order_number"`
+	OrderDate    string                         `json:"order_date"`
+	EmployeeId   int                            `json:"employee_id"`
+	WarehouseId  int                            `json:"warehouse_id"`
+	AsnId        *int                           `json:"asn_id"`
+	ProductBatch batchModels.PostProductBatches `json:"product_batch"`
+}
+
+// Receipt is the result of receiving a batch: the inbound order and the batch it created.
+type Receipt struct {
+	InboundOrder inboundOrderModels.InboundOrder    `json:"inbound_order"`
+	ProductBatch batchModels.ProductBatchesResponse `json:"product_batch"`
+}
+
+// ReceivingSection is the part of a section a receipt checks before storing a batch in it.
+type ReceivingSection struct {
+	Id              int
+	WarehouseId     int
+	CurrentCapacity int
+	MaximumCapacity int
+}
@@ -0,0 +1,58 @@
+package models
+
+// ReorderPoint configures when a product must be replenished at a warehouse. When the available
+// stock drops to ReorderPoint or below, the product should be brought back up to TargetQuantity.
+type ReorderPoint struct {
+	Id             int `json:"id"`
+	ProductId      int `json:"product_id"`
+	WarehouseId    int `json:"warehouse_id"`
+	ReorderPoint   int `json:"reorder_point"`
+	TargetQuantity int `json:"target_quantity"`
+}
+
+// PostReorderPoint is the request body to configure a reorder point.
+type PostReorderPoint struct {
+	ProductId      int  `json:"product_id"`
+	WarehouseId    int  `json:"warehouse_id"`
+	ReorderPoint   *int `json:"reorder_point"`
+	TargetQuantity *int `json:"target_quantity"`
+}
+
+// PatchReorderPoint is the request body to change the levels of a reorder point.
+type PatchReorderPoint struct {
+	ReorderPoint   *int `json:"reorder_point"`
+	TargetQuantity *int `json:"target_quantity"`
+}
+
+// StockLevel is a reorder point with the stock and recent demand of its product at its warehouse.
+// OnHand is the available, unexpired stock; Demand is the quantity allocated to orders in the demand window.
+type StockLevel struct {
+	ReorderPoint
+	ProductCode   string
+	WarehouseCode string
+	SellerId      int
+	CompanyName   string
+	OnHand        int
+	Demand        int
+}
+
+// ReplenishmentLine is a product to reorder for a warehouse.
+type ReplenishmentLine struct {
+	ProductId         int    `json:"product_id"`
+	ProductCode       string `json:"product_code"`
+	WarehouseId       int    `json:"warehouse_id"`
+	WarehouseCode     string `json:"warehouse_code"`
+	OnHand            int    `json:"on_hand"`
+	ReorderPoint      int    `json:"reorder_point"`
+	TargetQuantity    int    `json:"target_quantity"`
+	Demand            int    `json:"demand"`
+	SuggestedQuantity int    `json:"suggested_quantity"`
+}
+
+// SellerReplenishment groups the products to reorder from one seller.
+type SellerReplenishment struct {
+	SellerId       int                 `json:"seller_id"`
+	CompanyName    string              `json:"company_name"`
+	TotalSuggested int                 `json:"total_suggested"`
+	Lines          []ReplenishmentLine `json:"lines"`
+}
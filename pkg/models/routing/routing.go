@@ -0,0 +1,70 @@
+package models
+
+import "time"
+
+// Depot is the warehouse a route starts from and returns to. Its coordinates are null until they are set.
+type Depot struct {
+	WarehouseId int
+	Latitude    *float64
+	Longitude   *float64
+}
+
+// Place is a locality and its coordinates, null until they are set.
+type Place struct {
+	LocalityId   string
+	LocalityName string
+	Latitude     *float64
+	Longitude    *float64
+}
+
+// RouteShipment is a shipment to deliver on the planned day and the place it goes to.
+type RouteShipment struct {
+	ShipmentId   int
+	TrackingCode string
+	Place
+}
+
+// PlanFilter picks the shipments of a route: those of the carrier leaving the warehouse that are promised for Date.
+type PlanFilter struct {
+	WarehouseId int
+	CarrierId   int
+	Date        time.Time
+}
+
+// RoutePlan is the order a carrier should visit the localities of its shipments in, starting and ending at the warehouse.
+// Distances are in kilometres as the crow flies. Shipments to localities with no coordinates cannot be placed
+// on the route and are listed as unplanned.
+type RoutePlan struct {
+	WarehouseId      int                `json:"warehouse_id"`
+	CarrierId        int                `json:"carrier_id"`
+	Date             string             `json:"date"`
+	Stops            []Stop             `json:"stops"`
+	ReturnDistanceKm float64            `json:"return_distance_km"`
+	TotalDistanceKm  float64            `json:"total_distance_km"`
+	Unplanned        []RouteShipmentRef `json:"unplanned"`
+}
+
+// Stop is a locality on the route with the shipments delivered there. DistanceKm is from the previous stop,
+// or from the warehouse for the first one.
+type Stop struct {
+	Sequence      int      `json:"sequence"`
+	LocalityId    string   `json:"locality_id"`
+	LocalityName  string   `json:"locality_name"`
+	Latitude      float64  `json:"latitude"`
+	Longitude     float64  `json:"longitude"`
+	TrackingCodes []string `json:"tracking_codes"`
+	DistanceKm    float64  `json:"distance_km"`
+}
+
+// RouteShipmentRef names a shipment left out of a route.
+type RouteShipmentRef struct {
+	TrackingCode string `json:"tracking_code"`
+	LocalityId   string `json:"locality_id"`
+}
+
+// Distance is how far apart two localities are, in kilometres as the crow flies.
+type Distance struct {
+	FromLocalityId string  `json:"from_locality_id"`
+	ToLocalityId   string  `json:"to_locality_id"`
+	DistanceKm     float64 `json:"distance_km"`
+}
@@ -0,0 +1,128 @@
+package models
+
+import "time"
+
+// Shipment statuses. A shipment is created when its orders are handed to a carrier, picked up when the
+// carrier collects it, in transit while on its way and ends delivered or failed. A failed delivery can
+// be attempted again, which puts the shipment back in transit.
+const (
+	StatusCreated   = "created"
+	StatusPickedUp  = "picked_up"
+	StatusInTransit = "in_transit"
+	StatusDelivered = "delivered"
+	StatusFailed    = "failed"
+)
+
+// Shipment is a delivery of one or more purchase orders by a carrier, from a warehouse to a locality.
+// MaxTemperature is set on cold-chain shipments: the warmest the goods may get on the way.
+type Shipment struct {
+	Id                    int        `json:"id"`
+	TrackingCode          string     `json:"tracking_code"`
+	CarrierId             int        `json:"carrier_id"`
+	WarehouseId           int        `json:"warehouse_id"`
+	DestinationLocalityId string     `json:"destination_locality_id"`
+	Status                string     `json:"status"`
+	PromisedAt            *time.Time `json:"promised_at"`
+	MaxTemperature        *float64   `json:"max_temperature"`
+	CreatedAt             time.Time  `json:"created_at"`
+	UpdatedAt             time.Time  `json:"updated_at"`
+}
+
+// ShipmentDetail is a shipment with the purchase orders it carries and its status history.
+type ShipmentDetail struct {
+	Shipment
+	PurchaseOrderIds []int   `json:"purchase_order_ids"`
+	Events           []Event `json:"events"`
+}
+
+// Event is a status change of a shipment, with where and when it happened.
+// ExternalEventId is the carrier's own id for events pushed through its webhook.
+// Temperature is the reading taken with the event, if any.
+type Event struct {
+	Id              int       `json:"id"`
+	ShipmentId      int       `json:"shipment_id"`
+	Status          string    `json:"status"`
+	Location        string    `json:"location"`
+	Description     string    `json:"description"`
+	OccurredAt      time.Time `json:"occurred_at"`
+	Temperature     *float64  `json:"temperature"`
+	ExternalEventId *string   `json:"external_event_id"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// ShipmentOrder is a purchase order about to be shipped, read to check it can be.
+type ShipmentOrder struct {
+	Id            int
+	OrderStatusId int
+	ShipmentId    *int
+}
+
+// PostShipment is the request body to hand confirmed purchase orders to a carrier.
+type PostShipment struct {
+	CarrierId             int        `json:"carrier_id"`
+	WarehouseId           int        `json:"warehouse_id"`
+	DestinationLocalityId string     `json:"destination_locality_id"`
+	PromisedAt            *time.Time `json:"promised_at"`
+	MaxTemperature        *float64   `json:"max_temperature"`
+	PurchaseOrderIds      []int      `json:"purchase_order_ids"`
+}
+
+// PostEvent is the request body to record a status change. OccurredAt defaults to now.
+type PostEvent struct {
+	Status      string     `json:"status"`
+	Location    string     `json:"location"`
+	Description string     `json:"description"`
+	Temperature *float64   `json:"temperature"`
+	OccurredAt  *time.Time `json:"occurred_at"`
+}
+
+// ShipmentFilter narrows the shipment list. Zero values are ignored.
+type ShipmentFilter struct {
+	CarrierId int
+	Status    string
+}
+
+// PerformanceFilter picks the shipments of the carrier performance report: those created from From to To,
+// both days included. CarrierId optionally narrows it to one carrier.
+type PerformanceFilter struct {
+	From      time.Time
+	To        time.Time
+	CarrierId int
+}
+
+// ShipmentPerformance is what the performance report reads about each shipment.
+// DeliveredAt is its last delivered event and FailedAttempts how many failed events it has.
+// Excursions counts the readings above MaxTemperature, which only cold-chain shipments have.
+type ShipmentPerformance struct {
+	ShipmentId     int
+	CarrierId      int
+	Cid            string
+	CompanyName    string
+	PromisedAt     *time.Time
+	CreatedAt      time.Time
+	PickedUpAt     *time.Time
+	DeliveredAt    *time.Time
+	FailedAttempts int
+	ColdChain      bool
+	Excursions     int
+}
+
+// CarrierPerformance sums up how a carrier did with the shipments handed to it in a period.
+//   - OnTimeRate is the percentage of the delivered shipments with a promised date that arrived by it,
+//     and is null when there are none.
+//   - AverageTransitHours runs from pickup, or creation when the pickup was not recorded, to delivery,
+//     and is null when nothing was delivered.
+//   - TemperatureExcursions counts the cold-chain shipments with at least one reading above their maximum.
+type CarrierPerformance struct {
+	CarrierId             int      `json:"carrier_id"`
+	Cid                   string   `json:"cid"`
+	CompanyName           string   `json:"company_name"`
+	ShipmentsHandled      int      `json:"shipments_handled"`
+	Delivered             int      `json:"delivered"`
+	OnTime                int      `json:"on_time"`
+	OnTimeRate            *float64 `json:"on_time_rate"`
+	AverageTransitHours   *float64 `json:"average_transit_hours"`
+	FailedDeliveries      int      `json:"failed_deliveries"`
+	ColdChainShipments    int      `json:"cold_chain_shipments"`
+	TemperatureExcursions int      `json:"temperature_excursions"`
+}
@@ -0,0 +1,109 @@
+package models
+
+import "time"
+
+// Scopes of a coverage area, from the most to the least specific.
+const (
+	ScopeLocality = "locality"
+	ScopeProvince = "province"
+	ScopeCountry  = "country"
+)
+
+// CoverageArea is a locality, province or country a carrier delivers to, with its rate card.
+// Exactly one of LocalityId, ProvinceId and CountryId is set.
+type CoverageArea struct {
+	Id          int        `json:"id"`
+	CarrierId   int        `json:"carrier_id"`
+	LocalityId  *string    `json:"locality_id"`
+	ProvinceId  *int       `json:"province_id"`
+	CountryId   *int       `json:"country_id"`
+	TransitDays int        `json:"transit_days"`
+	CreatedAt   time.Time  `json:"created_at"`
+	RateBands   []RateBand `json:"rate_bands"`
+}
+
+// RateBand prices a shipment weighing and measuring up to MaxWeight and MaxVolume,
+// in the units products are registered with.
+type RateBand struct {
+	Id             int     `json:"id"`
+	CoverageAreaId int     `json:"coverage_area_id"`
+	MaxWeight      float64 `json:"max_weight"`
+	MaxVolume      float64 `json:"max_volume"`
+	Price          float64 `json:"price"`
+}
+
+// CarrierCoverage is a coverage area together with the carrier it belongs to, read when quoting.
+type CarrierCoverage struct {
+	CoverageArea
+	Cid         string
+	CompanyName string
+}
+
+// Place is a locality with the province and country it belongs to.
+type Place struct {
+	LocalityId string
+	ProvinceId int
+	CountryId  int
+}
+
+// ProductMeasures are the weight and dimensions of one unit of a product.
+type ProductMeasures struct {
+	ProductId int
+	NetWeight float64
+	Width     float64
+	Height    float64
+	Length    float64
+}
+
+type PostCoverageArea struct {
+	CarrierId   int            `json:"carrier_id"`
+	LocalityId  *string        `json:"locality_id"`
+	ProvinceId  *int           `json:"province_id"`
+	CountryId   *int           `json:"country_id"`
+	TransitDays int            `json:"transit_days"`
+	RateBands   []PostRateBand `json:"rate_bands"`
+}
+
+type PostRateBand struct {
+	MaxWeight float64 `json:"max_weight"`
+	MaxVolume float64 `json:"max_volume"`
+	Price     float64 `json:"price"`
+}
+
+type QuoteRequest struct {
+	WarehouseId           int         `json:"warehouse_id"`
+	DestinationLocalityId string      `json:"destination_locality_id"`
+	Items                 []QuoteItem `json:"items"`
+}
+
+type QuoteItem struct {
+	ProductId int `json:"product_id"`
+	Quantity  int `json:"quantity"`
+}
+
+// Quote is the total weight and volume of the items and what each carrier able to take them charges, cheapest first.
+type Quote struct {
+	WarehouseId           int            `json:"warehouse_id"`
+	DestinationLocalityId string         `json:"destination_locality_id"`
+	TotalWeight           float64        `json:"total_weight"`
+	TotalVolume           float64        `json:"total_volume"`
+	Quotes                []CarrierQuote `json:"quotes"`
+}
+
+// CarrierQuote is the price and transit time of one carrier, taken from its most specific coverage area
+// of the destination and the cheapest band the shipment fits in.
+type CarrierQuote struct {
+	Rank           int     `json:"rank"`
+	CarrierId      int     `json:"carrier_id"`
+	Cid            string  `json:"cid"`
+	CompanyName    string  `json:"company_name"`
+	CoverageAreaId int     `json:"coverage_area_id"`
+	Scope          string  `json:"scope"`
+	RateBandId     int     `json:"rate_band_id"`
+	TransitDays    int     `json:"transit_days"`
+	Price          float64 `json:"price"`
+}
+
+type CoverageFilter struct {
+	CarrierId int
+}
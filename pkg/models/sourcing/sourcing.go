@@ -0,0 +1,51 @@
+package models
+
+// SourcingRequest is what a buyer orders and the locality it is delivered to.
+type SourcingRequest struct {
+	DeliveryLocalityId string         `json:"delivery_locality_id"`
+	Lines              []SourcingLine `json:"lines"`
+}
+
+// SourcingLine is a quantity of a product, requested or sourced from a warehouse.
+type SourcingLine struct {
+	ProductId int `json:"product_id"`
+	Quantity  int `json:"quantity"`
+}
+
+// WarehouseStock is how much of a product a warehouse can still allocate, and where the warehouse is.
+// Latitude and Longitude are null until the warehouse is placed on the map.
+type WarehouseStock struct {
+	WarehouseId   int
+	WarehouseCode string
+	Latitude      *float64
+	Longitude     *float64
+	ProductId     int
+	Available     int
+}
+
+// SourcingPlan says which warehouses fill an order. A single warehouse is used whenever one can fill it all;
+// otherwise the order is split across warehouses, nearest first. Products no warehouse has enough of are listed
+// as shortages and leave the plan unfulfillable.
+type SourcingPlan struct {
+	DeliveryLocalityId string            `json:"delivery_locality_id"`
+	Fulfillable        bool              `json:"fulfillable"`
+	Split              bool              `json:"split"`
+	Shipments          []SourcedShipment `json:"shipments"`
+	Shortages          []Shortage        `json:"shortages"`
+}
+
+// SourcedShipment is the part of an order shipped from one warehouse. DistanceKm, as the crow flies,
+// is null when the warehouse has no coordinates.
+type SourcedShipment struct {
+	WarehouseId   int            `json:"warehouse_id"`
+	WarehouseCode string         `json:"warehouse_code"`
+	DistanceKm    *float64       `json:"distance_km"`
+	Lines         []SourcingLine `json:"lines"`
+}
+
+// Shortage is a product the warehouses together cannot supply in full.
+type Shortage struct {
+	ProductId int `json:"product_id"`
+	Requested int `json:"requested"`
+	Available int `json:"available"`
+}
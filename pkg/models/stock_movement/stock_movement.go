@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// Movement types recorded in the stock ledger.
+const (
+	MovementTypeReceipt    = "receipt"
+	MovementTypeAllocation = "allocation"
+	MovementTypePick       = "pick"
+	MovementTypeAdjustment = "adjustment"
+	MovementTypeTransfer   = "transfer"
+	MovementTypeDisposal   = "disposal"
+)
+
+// ActorSystem is the actor recorded for movements the application posts on its own.
+const ActorSystem = "system"
+
+// StockMovement is one append-only entry of the stock ledger.
+// Quantity is signed: positive movements add stock to the batch, negative ones take it out.
+type StockMovement struct {
+	Id             int       `json:"id"`
+	ProductBatchId int       `json:"product_batch_id"`
+	MovementType   string    `json:"movement_type"`
+	Quantity       int       `json:"quantity"`
+	Reason         string    `json:"reason"`
+	Actor          string    `json:"actor"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// BatchDrift reports a product batch whose current_quantity does not match its ledger balance.
+type BatchDrift struct {
+	ProductBatchId  int `json:"product_batch_id"`
+	BatchNumber     int `json:"batch_number"`
+	CurrentQuantity int `json:"current_quantity"`
+	LedgerBalance   int `json:"ledger_balance"`
+}
@@ -0,0 +1,108 @@
+package models
+
+import "time"
+
+// How an order is linked to a batch. Allocation links come from stock_allocations and are exact;
+// product links cover orders placed without allocations, matched through the product and the
+// batch shelf life, so they may include orders served from another batch.
+const (
+	LinkAllocation = "allocation"
+	LinkProduct    = "product"
+)
+
+// TracedBatch identifies the batch a trace starts from.
+type TracedBatch struct {
+	Id                int       `json:"id"`
+	BatchNumber       int       `json:"batch_number"`
+	ProductId         int       `json:"product_id"`
+	ProductCode       string    `json:"product_code"`
+	Status            string    `json:"status"`
+	ManufacturingDate time.Time `json:"manufacturing_date"`
+	DueDate           time.Time `json:"due_date"`
+}
+
+// TracedOrder is a purchase order that received product from a batch, with its buyer.
+// Quantity is only known for allocation links.
+type TracedOrder struct {
+	PurchaseOrderId int       `json:"purchase_order_id"`
+	OrderNumber     string    `json:"order_number"`
+	OrderDate       time.Time `json:"order_date"`
+	BuyerId         int       `json:"buyer_id"`
+	CardNumberId    string    `json:"card_number_id"`
+	FirstName       string    `json:"first_name"`
+	LastName        string    `json:"last_name"`
+	Quantity        *int      `json:"quantity"`
+	Link            string    `json:"link"`
+}
+
+// BatchTrace is the forward trace of a batch: the orders and buyers it reached.
+type BatchTrace struct {
+	Batch  TracedBatch   `json:"batch"`
+	Orders []TracedOrder `json:"orders"`
+}
+
+// TracedPurchaseOrder identifies the order a backward trace starts from.
+type TracedPurchaseOrder struct {
+	Id          int       `json:"id"`
+	OrderNumber string    `json:"order_number"`
+	OrderDate   time.Time `json:"order_date"`
+	BuyerId     int       `json:"buyer_id"`
+	ProductId   int       `json:"product_id"`
+}
+
+// BatchOrigin is one row of a backward trace: a batch that may have served the order,
+// joined with one of the inbound orders that received it. Inbound fields are nil when
+// the batch has no inbound order.
+type BatchOrigin struct {
+	ProductBatchId     int
+	BatchNumber        int
+	ProductId          int
+	Quantity           *int
+	Link               string
+	InboundOrderId     *int
+	InboundOrderNumber *string
+	InboundOrderDate   *time.Time
+	EmployeeId         *int
+	EmployeeFirstName  *string
+	EmployeeLastName   *string
+	WarehouseId        *int
+	WarehouseCode      *string
+}
+
+// InboundOrigin is the inbound order that received a batch, with who received it and where.
+type InboundOrigin struct {
+	InboundOrderId    int       `json:"inbound_order_id"`
+	OrderNumber       string    `json:"order_number"`
+	OrderDate         time.Time `json:"order_date"`
+	EmployeeId        int       `json:"employee_id"`
+	EmployeeFirstName string    `json:"employee_first_name"`
+	EmployeeLastName  string    `json:"employee_last_name"`
+	WarehouseId       int       `json:"warehouse_id"`
+	WarehouseCode     string    `json:"warehouse_code"`
+}
+
+// OrderBatch is a batch that served an order, with the inbound orders that received it.
+type OrderBatch struct {
+	ProductBatchId int             `json:"product_batch_id"`
+	BatchNumber    int             `json:"batch_number"`
+	ProductId      int             `json:"product_id"`
+	Quantity       *int            `json:"quantity"`
+	Link           string          `json:"link"`
+	Inbound        []InboundOrigin `json:"inbound_orders"`
+}
+
+// OrderTrace is the backward trace of a purchase order: the batches that served it and where they came from.
+type OrderTrace struct {
+	Order   TracedPurchaseOrder `json:"order"`
+	Batches []OrderBatch        `json:"batches"`
+}
+
+// AffectedBuyer is a buyer reached by one or more traced batches, with the orders and batches involved.
+type AffectedBuyer struct {
+	BuyerId          int    `json:"buyer_id"`
+	CardNumberId     string `json:"card_number_id"`
+	FirstName        string `json:"first_name"`
+	LastName         string `json:"last_name"`
+	PurchaseOrderIds []int  `json:"purchase_order_ids"`
+	ProductBatchIds  []int  `json:"product_batch_ids"`
+}
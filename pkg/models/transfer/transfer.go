@@ -0,0 +1,60 @@
+package models
+
+import "time"
+
+// BatchTransfer is the audit record of stock moved from one section to another.
+// On a full transfer SourceBatchId and DestinationBatchId are the same batch;
+// on a partial one DestinationBatchId is the batch split off with a new batch number.
+type BatchTransfer struct {
+	Id                 int       `json:"id"`
+	SourceBatchId      int       `json:"source_batch_id"`
+	DestinationBatchId int       `json:"destination_batch_id"`
+	FromSectionId      int       `json:"from_section_id"`
+	ToSectionId        int       `json:"to_section_id"`
+	FromWarehouseId    int       `json:"from_warehouse_id"`
+	ToWarehouseId      int       `json:"to_warehouse_id"`
+	Quantity           int       `json:"quantity"`
+	Reason             string    `json:"reason"`
+	Actor              string    `json:"actor"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+// PostBatchTransfer is the request body of a transfer.
+// A nil Quantity moves the whole batch.
+type PostBatchTransfer struct {
+	ToSectionId int    `json:"to_section_id"`
+	Quantity    *int   `json:"quantity"`
+	Reason      string `json:"reason"`
+	Actor       string `json:"actor"`
+}
+
+// TransferBatch is the part of a product batch a transfer reads and copies when splitting.
+// The split keeps the status of its source, so held or quarantined units stay blocked.
+// ReservedQuantity is reserved for orders but not picked yet: it is off CurrentQuantity but still on
+// the shelf, and travels with the batch when it is moved whole.
+type TransferBatch struct {
+	Id                 int
+	BatchNumber        int
+	CurrentQuantity    int
+	ReservedQuantity   int
+	CurrentTemperature float64
+	DueDate            time.Time
+	ManufacturingDate  time.Time
+	ManufacturingHour  int
+	MinimumTemperature float64
+	ProductId          int
+	SectionId          int
+	Status             string
+	StatusReason       *string
+	StatusSetBy        *string
+	StatusUpdatedAt    *time.Time
+}
+
+// TransferSection is the part of a section a transfer validates and updates.
+type TransferSection struct {
+	Id                 int
+	CurrentCapacity    int
+	MaximumCapacity    int
+	CurrentTemperature float64
+	WarehouseId        int
+}
@@ -8,6 +8,8 @@ type WarehouseDoc struct {
 	MinimumCapacity    int     `json:"minimum_capacity"`
 	MinimumTemperature float64 `json:"minimum_temperature"`
 	LocalityId         string  `json:"locality_id"`
+	Latitude           *float64 `json:"latitude"`
+	Longitude          *float64 `json:"longitude"`
 }
 
 type WarehouseRequest struct {
@@ -18,6 +20,8 @@ type WarehouseRequest struct {
 	MinimumCapacity    int      `json:"minimum_capacity,omitempty"`
 	MinimumTemperature *float64 `json:"minimum_temperature,omitempty"`
 	LocalityId         string   `json:"locality_id"`
+	Latitude           *float64 `json:"latitude,omitempty"`
+	Longitude          *float64 `json:"longitude,omitempty"`
 }
 
 type WarehousePatchDTO struct {
@@ -27,4 +31,6 @@ type WarehousePatchDTO struct {
 	MinimumCapacity    *int     `json:"minimum_capacity,omitempty"`
 	MinimumTemperature *float64 `json:"minimum_temperature,omitempty"`
 	LocalityId         *string   `json:"locality_id"`
+	Latitude           *float64  `json:"latitude,omitempty"`
+	Longitude          *float64  `json:"longitude,omitempty"`
 }
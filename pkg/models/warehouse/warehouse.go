@@ -8,4 +8,6 @@ type Warehouse struct {
 	MinimumCapacity    int     `json:"minimum_capacity"`
 	MinimumTemperature float64 `json:"minimum_temperature"`
 	LocalityId         string     `json:"locality_id"`
+	Latitude           *float64   `json:"latitude"`
+	Longitude          *float64   `json:"longitude"`
 }
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// WarehouseException lets an employee register inbound orders at a warehouse other than the one
+// they are assigned to, for roaming staff covering several warehouses.
+type WarehouseException struct {
+	Id          int       `json:"id"`
+	EmployeeId  int       `json:"employee_id"`
+	WarehouseId int       `json:"warehouse_id"`
+	Reason      string    `json:"reason"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// PostWarehouseException is the request body to grant an employee access to another warehouse.
+type PostWarehouseException struct {
+	WarehouseId int    `json:"warehouse_id"`
+	Reason      string `json:"reason"`
+}
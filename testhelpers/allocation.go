@@ -0,0 +1,35 @@
+package testhelpers
+
+import (
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+)
+
+func DummyOrderLine(orderDetailId, productId, quantity int) models.OrderLine {
+	return models.OrderLine{
+		OrderDetailId: orderDetailId,
+		ProductId:     productId,
+		Quantity:      quantity,
+	}
+}
+
+// DummyBatchStocks returns two batches of the same product, the first one expiring earlier.
+func DummyBatchStocks() []models.BatchStock {
+	return []models.BatchStock{
+		{ProductBatchId: 1, DueDate: time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC), CurrentQuantity: 4},
+		{ProductBatchId: 2, DueDate: time.Date(2030, 3, 10, 0, 0, 0, 0, time.UTC), CurrentQuantity: 10},
+	}
+}
+
+func DummyStockAllocation(id, batchId, quantity int) models.StockAllocation {
+	return models.StockAllocation{
+		Id:              id,
+		PurchaseOrderId: 1,
+		OrderDetailId:   1,
+		ProductBatchId:  batchId,
+		Quantity:        quantity,
+		Status:          models.AllocationStatusReserved,
+		CreatedAt:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
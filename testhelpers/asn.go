@@ -0,0 +1,47 @@
+package testhelpers
+
+import (
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/asn"
+)
+
+func DummyAsn(status string) models.Asn {
+	return models.Asn{
+		Id:          7,
+		AsnNumber:   "ASN-001",
+		SellerId:    3,
+		WarehouseId: 1,
+		Status:      status,
+		ExpectedAt:  time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC),
+		CreatedAt:   time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func DummyPostAsn() models.PostAsn {
+	return models.PostAsn{
+		AsnNumber:   "ASN-001",
+		SellerId:    3,
+		WarehouseId: 1,
+		ExpectedAt:  time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC),
+		Lines: []models.PostAsnLine{
+			{ProductId: 22, ExpectedQuantity: IntPtr(100)},
+			{ProductId: 23, ExpectedQuantity: IntPtr(40)},
+		},
+	}
+}
+
+func DummyDiscrepancyLine(sellerId, asnId, productId, expected, received int) models.DiscrepancyLine {
+	return models.DiscrepancyLine{
+		SellerId:         sellerId,
+		CompanyName:      "Seller",
+		AsnId:            asnId,
+		AsnNumber:        "ASN-001",
+		WarehouseId:      1,
+		ProductId:        productId,
+		ProductCode:      "P-001",
+		ExpectedQuantity: expected,
+		ReceivedQuantity: received,
+		Variance:         received - expected,
+	}
+}
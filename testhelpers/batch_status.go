@@ -0,0 +1,22 @@
+package testhelpers
+
+import (
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/batch_status"
+)
+
+func DummyBatchStatus(status string) models.BatchStatus {
+	return models.BatchStatus{
+		ProductBatchId:  1,
+		Status:          status,
+		CurrentQuantity: 40,
+		SectionId:       3,
+	}
+}
+
+func DummyPostStatusChange() models.PostStatusChange {
+	return models.PostStatusChange{
+		ReasonCode: models.ReasonInspectionFailed,
+		Note:       "seal broken on two pallets",
+		Actor:      "qa.lead",
+	}
+}
@@ -0,0 +1,20 @@
+package testhelpers
+
+import (
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer_address"
+)
+
+// DummyAddress is an address of buyer 101 in locality 1001.
+func DummyAddress(id int, isDefault bool) models.Address {
+	return models.Address{
+		Id:         id,
+		BuyerId:    101,
+		Label:      "home",
+		Address:    "Av. Corrientes 1234",
+		LocalityId: "1001",
+		IsDefault:  isDefault,
+		CreatedAt:  time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC),
+	}
+}
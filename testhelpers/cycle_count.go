@@ -0,0 +1,47 @@
+package testhelpers
+
+import (
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/cycle_count"
+)
+
+func DummyCycleCount(id int, status string) models.CycleCount {
+	return models.CycleCount{
+		Id:        id,
+		SectionId: 3,
+		Status:    status,
+		Note:      "monthly count",
+		CreatedBy: "supervisor",
+		CreatedAt: time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+// DummyCountLine returns a line of batch batchId holding current units. When counted is not nil the
+// line is counted against current with the given reason.
+func DummyCountLine(batchId, current int, counted *int, reason string) models.CountLine {
+	l := models.CountLine{
+		Id:              batchId * 10,
+		CycleCountId:    1,
+		ProductBatchId:  batchId,
+		BatchNumber:     batchId + 100,
+		CurrentQuantity: current,
+	}
+	if counted != nil {
+		variance := *counted - current
+		l.SystemQuantity = &current
+		l.CountedQuantity = counted
+		l.Variance = &variance
+		if reason != "" {
+			l.ReasonCode = &reason
+		}
+	}
+	return l
+}
+
+func DummyPostCountedLines(lines ...models.PostCountedLine) models.PostCountedLines {
+	return models.PostCountedLines{
+		Lines: lines,
+		Actor: "counter",
+	}
+}
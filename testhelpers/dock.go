@@ -0,0 +1,40 @@
+package testhelpers
+
+import (
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/dock"
+)
+
+func DummyDock(id int) models.Dock {
+	return models.Dock{
+		Id:          id,
+		WarehouseId: 1,
+		Code:        "D-01",
+		OpensAt:     "08:00",
+		ClosesAt:    "18:00",
+	}
+}
+
+// DummyAppointment is booked by seller 3 on dock 5 of warehouse 1, from startsAt for an hour.
+func DummyAppointment(status string, startsAt time.Time) models.Appointment {
+	return models.Appointment{
+		Id:          11,
+		DockId:      5,
+		WarehouseId: 1,
+		SellerId:    IntPtr(3),
+		StartsAt:    startsAt,
+		EndsAt:      startsAt.Add(time.Hour),
+		Status:      status,
+		CreatedAt:   startsAt.AddDate(0, 0, -1),
+	}
+}
+
+func DummyPostAppointment(startsAt, endsAt time.Time) models.PostAppointment {
+	return models.PostAppointment{
+		DockId:   5,
+		SellerId: IntPtr(3),
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+	}
+}
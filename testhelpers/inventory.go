@@ -0,0 +1,30 @@
+package testhelpers
+
+import (
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/inventory"
+)
+
+func DummyInventoryLine(productId int) models.InventoryLine {
+	return models.InventoryLine{
+		ProductId:         productId,
+		ProductCode:       "P-22",
+		Description:       "Frozen peas",
+		Quantity:          150,
+		AvailableQuantity: 100,
+		HeldQuantity:      30,
+		ExpiredQuantity:   20,
+		BatchCount:        4,
+	}
+}
+
+// DummyInventorySectionLine returns a line grouped down to one section of a warehouse.
+func DummyInventorySectionLine(productId, warehouseId, sectionId int) models.InventoryLine {
+	l := DummyInventoryLine(productId)
+	code := "WH001"
+	number := sectionId * 10
+	l.WarehouseId = &warehouseId
+	l.WarehouseCode = &code
+	l.SectionId = &sectionId
+	l.SectionNumber = &number
+	return l
+}
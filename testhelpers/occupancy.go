@@ -0,0 +1,43 @@
+package testhelpers
+
+import (
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/occupancy"
+)
+
+func DummyWarehouseOccupancy(warehouseId int) models.WarehouseOccupancy {
+	minTemp, maxTemp, avgTemp := -18.0, 4.5, -6.756
+	return models.WarehouseOccupancy{
+		WarehouseId:     warehouseId,
+		WarehouseCode:   "WH001",
+		MinimumCapacity: 100,
+		SectionCount:    3,
+		TotalCapacity:   300,
+		UsedCapacity:    120,
+		Temperature: models.TemperatureSpread{
+			Minimum: &minTemp,
+			Maximum: &maxTemp,
+			Average: &avgTemp,
+		},
+	}
+}
+
+func DummyProductTypeUsage(warehouseId, productTypeId, total, used int) models.ProductTypeUsage {
+	return models.ProductTypeUsage{
+		WarehouseId:   warehouseId,
+		ProductTypeId: productTypeId,
+		Description:   "Frozen",
+		SectionCount:  1,
+		TotalCapacity: total,
+		UsedCapacity:  used,
+	}
+}
+
+func DummySectionBelowMinimum(warehouseId, sectionId int) models.SectionBelowMinimum {
+	return models.SectionBelowMinimum{
+		WarehouseId:     warehouseId,
+		SectionId:       sectionId,
+		SectionNumber:   sectionId * 10,
+		CurrentCapacity: 5,
+		MinimumCapacity: 20,
+	}
+}
@@ -0,0 +1,40 @@
+package testhelpers
+
+import (
+	allocationModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/allocation"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/picking"
+)
+
+// DummyPickLine reserves quantity units of batch 20 in section 4 for purchase order 7.
+func DummyPickLine(id, quantity int) models.PickLine {
+	return models.PickLine{
+		Id:                id,
+		Sequence:          id,
+		StockAllocationId: 100 + id,
+		AllocationStatus:  allocationModels.AllocationStatusReserved,
+		PurchaseOrderId:   7,
+		ProductId:         3,
+		ProductBatchId:    20,
+		BatchNumber:       2001,
+		SectionId:         4,
+		SectionNumber:     40,
+		Quantity:          quantity,
+	}
+}
+
+// DummyPickList is pick list 9 of wave 2 at warehouse 1.
+func DummyPickList(status string) models.PickList {
+	return models.PickList{
+		Id:          9,
+		WaveId:      2,
+		WarehouseId: 1,
+		Status:      status,
+	}
+}
+
+func DummyPostPickConfirmation(picked ...models.PostPickedLine) models.PostPickConfirmation {
+	return models.PostPickConfirmation{
+		EmployeeId: 6,
+		Lines:      picked,
+	}
+}
@@ -22,24 +22,35 @@ func DummyProductBatch(id int) models.ProductBatches {
 }
 
 func DummyReportProduct() models.ReportProduct {
+	due := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
 	return models.ReportProduct{
-		SectionId:     10,
-		SectionNumber: 5,
-		ProductsCount: 123,
+		SectionId:        10,
+		SectionNumber:    5,
+		WarehouseId:      1,
+		ProductsCount:    123,
+		TotalQuantity:    123,
+		HeldQuantity:     27,
+		DistinctProducts: 2,
+		BatchCount:       3,
+		EarliestDueDate:  &due,
+		MaximumCapacity:  200,
+		FillPercentage:   75,
+		Products: []models.ReportProductLine{
+			{ProductId: 1, ProductCode: "P-1", Quantity: 100, BatchCount: 2},
+			{ProductId: 2, ProductCode: "P-2", Quantity: 23, BatchCount: 1},
+		},
 	}
 }
 
 func DummyReportProductsList() []models.ReportProduct {
 	return []models.ReportProduct{
+		DummyReportProduct(),
 		{
-			SectionId:     10,
-			SectionNumber: 5,
-			ProductsCount: 123,
-		},
-		{
-			SectionId:     20,
-			SectionNumber: 7,
-			ProductsCount: 42,
+			SectionId:       20,
+			SectionNumber:   7,
+			WarehouseId:     1,
+			MaximumCapacity: 100,
+			Products:        []models.ReportProductLine{},
 		},
 	}
 }
@@ -74,3 +85,19 @@ func DummyPostProductBatch(id int) models.PostProductBatches {
 		SectionId:          33,
 	}
 }
+
+func DummyExpiringBatch(id int, dueDate time.Time) models.ExpiringBatch {
+	return models.ExpiringBatch{
+		ProductBatchId:     id,
+		BatchNumber:        100 + id,
+		ProductId:          22,
+		ProductCode:        "PROD-22",
+		ProductDescription: "Yogurt",
+		SectionId:          33,
+		SectionNumber:      3,
+		WarehouseId:        1,
+		CurrentQuantity:    50,
+		DueDate:            dueDate,
+		Status:             models.BatchStatusAvailable,
+	}
+}
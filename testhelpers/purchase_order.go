@@ -4,6 +4,7 @@ import (
 	"time"
 
 	service "github.com/varobledo_meli/W17-G10-Bootcamp.git/internal/service/purchase_order"
+	addressMocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/buyer_address"
 	mocks "github.com/varobledo_meli/W17-G10-Bootcamp.git/mocks/purchase_order"
 	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/buyer"
 )
@@ -112,6 +113,6 @@ func DummyResponsePurchaseOrder() models.ResponsePurchaseOrder {
 // NewPurchaseOrderServiceMock returns a repository mock and the service using that mock
 func NewPurchaseOrderServiceMock() (*mocks.PurchaseOrderRepositoryMock, service.PurchaseOrderService) {
 	repoMock := &mocks.PurchaseOrderRepositoryMock{}
-	svc := service.NewPurchaseOrderService(repoMock)
+	svc := service.NewPurchaseOrderService(repoMock, &addressMocks.BuyerAddressRepositoryMock{})
 	return repoMock, svc
 }
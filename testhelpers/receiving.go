@@ -0,0 +1,24 @@
+package testhelpers
+
+import (
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/receiving"
+)
+
+func DummyPostReceipt() models.PostReceipt {
+	return models.PostReceipt{
+		OrderNumber:  "ORD-001",
+		OrderDate:    "2025-06-02",
+		EmployeeId:   4,
+		WarehouseId:  1,
+		ProductBatch: DummyPostProductBatch(1),
+	}
+}
+
+func DummyReceivingSection(warehouseId, current, maximum int) models.ReceivingSection {
+	return models.ReceivingSection{
+		Id:              33,
+		WarehouseId:     warehouseId,
+		CurrentCapacity: current,
+		MaximumCapacity: maximum,
+	}
+}
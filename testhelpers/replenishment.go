@@ -0,0 +1,29 @@
+package testhelpers
+
+import (
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/replenishment"
+)
+
+func DummyReorderPoint(id int) models.ReorderPoint {
+	return models.ReorderPoint{
+		Id:             id,
+		ProductId:      1,
+		WarehouseId:    1,
+		ReorderPoint:   20,
+		TargetQuantity: 100,
+	}
+}
+
+func DummyStockLevel(sellerId, productId, onHand, demand int) models.StockLevel {
+	point := DummyReorderPoint(productId)
+	point.ProductId = productId
+	return models.StockLevel{
+		ReorderPoint:  point,
+		ProductCode:   "P001",
+		WarehouseCode: "WH001",
+		SellerId:      sellerId,
+		CompanyName:   "Acme",
+		OnHand:        onHand,
+		Demand:        demand,
+	}
+}
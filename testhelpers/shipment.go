@@ -0,0 +1,31 @@
+package testhelpers
+
+import (
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipment"
+)
+
+// DummyShipment is shipment 4, carried by carrier 2 from warehouse 1 to locality 1001.
+func DummyShipment(status string) models.Shipment {
+	createdAt := time.Date(2025, 6, 2, 9, 0, 0, 0, time.Local)
+	return models.Shipment{
+		Id:                    4,
+		TrackingCode:          "TRK-ABCDEFGH23",
+		CarrierId:             2,
+		WarehouseId:           1,
+		DestinationLocalityId: "1001",
+		Status:                status,
+		CreatedAt:             createdAt,
+		UpdatedAt:             createdAt,
+	}
+}
+
+func DummyPostShipment(orderIds ...int) models.PostShipment {
+	return models.PostShipment{
+		CarrierId:             2,
+		WarehouseId:           1,
+		DestinationLocalityId: "1001",
+		PurchaseOrderIds:      orderIds,
+	}
+}
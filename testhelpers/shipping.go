@@ -0,0 +1,34 @@
+package testhelpers
+
+import (
+	"fmt"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/shipping"
+)
+
+// DummyCarrierCoverage is a country wide coverage area of a carrier, with the given rate bands.
+func DummyCarrierCoverage(id int, carrierId int, countryId int, transitDays int, bands ...models.RateBand) models.CarrierCoverage {
+	return models.CarrierCoverage{
+		CoverageArea: models.CoverageArea{
+			Id:          id,
+			CarrierId:   carrierId,
+			CountryId:   IntPtr(countryId),
+			TransitDays: transitDays,
+			RateBands:   bands,
+		},
+		Cid:         fmt.Sprintf("CID-%d", carrierId),
+		CompanyName: "Carrier",
+	}
+}
+
+func DummyPostCoverageArea() models.PostCoverageArea {
+	return models.PostCoverageArea{
+		CarrierId:   2,
+		ProvinceId:  IntPtr(2),
+		TransitDays: 3,
+		RateBands: []models.PostRateBand{
+			{MaxWeight: 5, MaxVolume: 5000, Price: 500},
+			{MaxWeight: 20, MaxVolume: 20000, Price: 700},
+		},
+	}
+}
@@ -0,0 +1,19 @@
+package testhelpers
+
+import (
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/stock_movement"
+)
+
+func DummyStockMovement(id, batchId, quantity int, movementType string) models.StockMovement {
+	return models.StockMovement{
+		Id:             id,
+		ProductBatchId: batchId,
+		MovementType:   movementType,
+		Quantity:       quantity,
+		Reason:         "test movement",
+		Actor:          models.ActorSystem,
+		CreatedAt:      time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+	}
+}
@@ -0,0 +1,61 @@
+package testhelpers
+
+import (
+	"fmt"
+	"time"
+
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/traceability"
+)
+
+func DummyTracedBatch() models.TracedBatch {
+	return models.TracedBatch{
+		Id:                1,
+		BatchNumber:       101,
+		ProductId:         22,
+		ProductCode:       "P-22",
+		Status:            "available",
+		ManufacturingDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		DueDate:           time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func DummyTracedOrder(orderId, buyerId int, link string) models.TracedOrder {
+	o := models.TracedOrder{
+		PurchaseOrderId: orderId,
+		OrderNumber:     fmt.Sprintf("PO-%03d", orderId),
+		OrderDate:       time.Date(2025, 2, orderId, 0, 0, 0, 0, time.UTC),
+		BuyerId:         buyerId,
+		CardNumberId:    "CARD-1",
+		FirstName:       "Ana",
+		LastName:        "Lopez",
+		Link:            link,
+	}
+	if link == models.LinkAllocation {
+		o.Quantity = IntPtr(10)
+	}
+	return o
+}
+
+func DummyBatchOrigin(batchId int, inboundId *int) models.BatchOrigin {
+	o := models.BatchOrigin{
+		ProductBatchId: batchId,
+		BatchNumber:    100 + batchId,
+		ProductId:      22,
+		Quantity:       IntPtr(10),
+		Link:           models.LinkAllocation,
+	}
+	if inboundId != nil {
+		number := "INB-1"
+		date := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+		first, last, code := "Juan", "Perez", "WH001"
+		o.InboundOrderId = inboundId
+		o.InboundOrderNumber = &number
+		o.InboundOrderDate = &date
+		o.EmployeeId = IntPtr(3)
+		o.EmployeeFirstName = &first
+		o.EmployeeLastName = &last
+		o.WarehouseId = IntPtr(1)
+		o.WarehouseCode = &code
+	}
+	return o
+}
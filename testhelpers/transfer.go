@@ -0,0 +1,34 @@
+package testhelpers
+
+import (
+	"time"
+
+	batchModels "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/product_batches"
+	models "github.com/varobledo_meli/W17-G10-Bootcamp.git/pkg/models/transfer"
+)
+
+func DummyTransferBatch() models.TransferBatch {
+	return models.TransferBatch{
+		Id:                 1,
+		BatchNumber:        101,
+		CurrentQuantity:    50,
+		CurrentTemperature: 3,
+		DueDate:            time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC),
+		ManufacturingDate:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		ManufacturingHour:  10,
+		MinimumTemperature: 2,
+		ProductId:          22,
+		SectionId:          1,
+		Status:             batchModels.BatchStatusAvailable,
+	}
+}
+
+func DummyTransferSection(id, warehouseId int) models.TransferSection {
+	return models.TransferSection{
+		Id:                 id,
+		CurrentCapacity:    100,
+		MaximumCapacity:    200,
+		CurrentTemperature: 5,
+		WarehouseId:        warehouseId,
+	}
+}